@@ -147,6 +147,17 @@ var goerliGanacheTokenOverrides = params.TokenOverride{
 	Address: ganacheTokenAddress,
 }
 
+func appendToken(urls []string, provider string, token string) []string {
+	var result []string
+	for _, url := range urls {
+		if strings.Contains(url, provider) {
+			url += token
+		}
+		result = append(result, url)
+	}
+	return result
+}
+
 func setRPCs(networks []params.Network, request *requests.WalletSecretsConfig) []params.Network {
 
 	var networksWithRPC []params.Network
@@ -160,6 +171,7 @@ func setRPCs(networks []params.Network, request *requests.WalletSecretsConfig) [
 			if strings.Contains(n.FallbackURL, "infura") {
 				n.FallbackURL += request.InfuraToken
 			}
+			n.AdditionalRPCURLs = appendToken(n.AdditionalRPCURLs, "infura", request.InfuraToken)
 		}
 
 		if request.PoktToken != "" {
@@ -169,12 +181,14 @@ func setRPCs(networks []params.Network, request *requests.WalletSecretsConfig) [
 			if strings.Contains(n.FallbackURL, "pokt") {
 				n.FallbackURL += request.PoktToken
 			}
+			n.AdditionalRPCURLs = appendToken(n.AdditionalRPCURLs, "pokt", request.PoktToken)
 
 		}
 
 		if request.GanacheURL != "" {
 			n.RPCURL = request.GanacheURL
 			n.FallbackURL = request.GanacheURL
+			n.AdditionalRPCURLs = nil
 			if n.ChainID == mainnetChainID {
 				n.TokenOverrides = []params.TokenOverride{
 					mainnetGanacheTokenOverrides,
@@ -81,6 +81,15 @@
 // 1689248269_add_related_chain_id_networks.up.sql (66B)
 // 1689340211_index_filter_columns.up.sql (633B)
 // 1689498471_make_wallet_accounts_positions_non_negative.up.sql (1.619kB)
+// 1689930000_add_typing_indicators_and_read_receipts_settings.up.sql (355B)
+// 1689940000_add_additional_rpc_urls_to_networks.up.sql (81B)
+// 1689950000_add_replacements_to_pending_transactions.up.sql (117B)
+// 1689970000_add_token_approvals.up.sql (780B)
+// 1689980000_add_portfolio_lots.up.sql (1.338kB)
+// 1689990000_add_wallet_alert_rules.up.sql (758B)
+// 1690000000_add_safe_accounts.up.sql (1.355kB)
+// 1690010000_add_token_lists.up.sql (1.136kB)
+// 1690020000_add_payment_requests.up.sql (521B)
 // doc.go (74B)
 
 package migrations
@@ -1770,6 +1779,186 @@ func _1689498471_make_wallet_accounts_positions_non_negativeUpSql() (*asset, err
 	return a, nil
 }
 
+var __1689930000_add_typing_indicators_and_read_receipts_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\xce\x31\x0b\xc2\x30\x10\x05\xe0\xdd\x5f\x71\x3f\xc1\xbd\xd3\xd5\x5c\x45\x38\x13\xa8\x97\x39\x94\x34\x48\x50\xd2\xd2\x64\xe9\xbf\x37\x8e\xa2\x68\x5d\xde\xf0\x86\xef\x3d\x64\xa1\x1e\x04\x5b\x26\xc8\xa1\x94\x98\xae\x19\x50\x29\x38\x18\xb6\x67\x5d\xbb\x34\xba\xb2\xce\xb5\x77\x31\x8d\xd1\x0f\x65\x5a\x32\xb4\xc6\x30\xa1\x06\x6d\x04\xb4\x65\x06\x45\x1d\x5a\x16\xe8\x90\x2f\xd4\xec\x70\x0b\xbb\x84\xe1\x19\x3e\xc4\xb9\xfc\x26\x3f\x9a\x2e\xaf\xc9\x3b\x7f\x9f\xfc\x6d\xc3\xeb\x93\x16\x3a\x56\xe3\x6d\x62\xdf\xfc\xad\xbf\x9e\xff\x26\x3f\x00\x01\xe0\x2a\xfb\x63\x01\x00\x00")
+
+func _1689930000_add_typing_indicators_and_read_receipts_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689930000_add_typing_indicators_and_read_receipts_settingsUpSql,
+		"1689930000_add_typing_indicators_and_read_receipts_settings.up.sql",
+	)
+}
+
+func _1689930000_add_typing_indicators_and_read_receipts_settingsUpSql() (*asset, error) {
+	bytes, err := _1689930000_add_typing_indicators_and_read_receipts_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689930000_add_typing_indicators_and_read_receipts_settings.up.sql", size: 355, mode: os.FileMode(0644), modTime: time.Unix(1792322568, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2e, 0x28, 0xe5, 0x86, 0xe1, 0x61, 0xd5, 0xd1, 0x7, 0xc6, 0xe1, 0x10, 0xcc, 0xaf, 0x55, 0xb4, 0x92, 0x44, 0xb6, 0x5, 0x52, 0x81, 0x2e, 0xa2, 0x84, 0x3b, 0x69, 0x65, 0x62, 0x85, 0x26, 0xe6}}
+	return a, nil
+}
+
+var __1689940000_add_additional_rpc_urls_to_networksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x41\x0a\x80\x20\x10\x05\xd0\x7d\xa7\xf8\x78\x8d\x56\x93\x1a\x2d\x26\x03\xd1\xb6\x22\xd5\x22\x12\x0b\x35\xba\x7e\xef\x11\x3b\x6d\xe1\x68\x60\x8d\x7c\xb4\xef\x2e\x57\x05\x29\x05\xb9\xb0\x9f\x0d\xe2\xbe\x9f\xed\xbc\x73\x4c\xa1\x3c\x5b\x78\x4b\xaa\x58\xc9\xca\x89\x2c\xcc\xe2\x60\x3c\x33\x94\x1e\xc9\xb3\x83\x10\x7d\xf7\x03\x8a\x22\x1c\xd4\x51\x00\x00\x00")
+
+func _1689940000_add_additional_rpc_urls_to_networksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689940000_add_additional_rpc_urls_to_networksUpSql,
+		"1689940000_add_additional_rpc_urls_to_networks.up.sql",
+	)
+}
+
+func _1689940000_add_additional_rpc_urls_to_networksUpSql() (*asset, error) {
+	bytes, err := _1689940000_add_additional_rpc_urls_to_networksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689940000_add_additional_rpc_urls_to_networks.up.sql", size: 81, mode: os.FileMode(0644), modTime: time.Unix(1792323713, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0xc1, 0xdf, 0x8b, 0xb4, 0x7d, 0xad, 0x57, 0x55, 0xd5, 0xdc, 0x95, 0x56, 0x82, 0x64, 0x73, 0x10, 0xd1, 0xd3, 0x8a, 0x9e, 0x1d, 0x7f, 0xcf, 0x4e, 0xa1, 0x7b, 0x9c, 0xd8, 0xf9, 0xcb, 0x50}}
+	return a, nil
+}
+
+var __1689950000_add_replacements_to_pending_transactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x48\xcd\x4b\xc9\xcc\x4b\x8f\x2f\x29\x4a\xcc\x2b\x4e\x4c\x2e\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xcb\xcf\x4b\x4e\x55\xf0\xf4\x0b\xb1\xe6\x72\x24\x41\x5b\x51\x6a\x41\x4e\x62\x72\x6a\x4a\x7c\x52\xa5\x82\x93\x8f\xbf\x93\x35\x17\x00\x39\x30\x4d\x2c\x75\x00\x00\x00")
+
+func _1689950000_add_replacements_to_pending_transactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689950000_add_replacements_to_pending_transactionsUpSql,
+		"1689950000_add_replacements_to_pending_transactions.up.sql",
+	)
+}
+
+func _1689950000_add_replacements_to_pending_transactionsUpSql() (*asset, error) {
+	bytes, err := _1689950000_add_replacements_to_pending_transactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689950000_add_replacements_to_pending_transactions.up.sql", size: 117, mode: os.FileMode(0644), modTime: time.Unix(1792326234, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc0, 0x81, 0x64, 0xc2, 0x16, 0x7a, 0xad, 0xc4, 0x94, 0x9, 0x6d, 0xfd, 0x41, 0x85, 0x7e, 0x97, 0x83, 0xad, 0xc0, 0xc8, 0x5d, 0x44, 0x85, 0x9c, 0x8b, 0x73, 0x64, 0xe7, 0x74, 0xf5, 0xe1, 0x5a}}
+	return a, nil
+}
+
+var __1689970000_add_token_approvalsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x92\xcb\x6e\xc2\x30\x14\x44\xf7\xf9\x8a\xd9\x01\x12\x91\x50\xb7\x5d\x39\x60\xc0\x6a\x9a\x54\x79\xb4\x65\x15\x19\xc7\x40\x44\xb0\xa3\xd8\x80\xfa\xf7\x4d\x09\x20\x51\xe8\x4b\xaa\xb7\x77\xe6\xea\xfa\xcc\xb8\x2e\xfc\x62\x27\x41\xa3\xa1\x7b\x37\x00\x2f\x4b\xbd\xe7\x4a\x48\x83\x65\xcd\x95\x95\x39\xe6\x6f\xb0\x2b\x89\x7d\x33\x92\x16\x5c\x08\xbd\x55\xd6\x38\xc3\x88\x92\x84\x22\x21\x9e\x4f\xc1\xc6\x08\xc2\x04\xf4\x95\xc5\x49\x0c\xab\xd7\x52\x65\xbc\xaa\x6a\xbd\xe3\xa5\x41\xd7\x41\xf3\xc4\x8a\x17\x2a\x2b\x72\xa4\x41\xcc\x26\x01\x1d\xc1\x63\x13\x16\x24\x07\x67\x90\xfa\x7e\xff\x20\xd3\x7b\x25\x6b\x78\x7e\xe8\x7d\x1a\x1c\xb7\xe6\x79\x2d\x8d\xb9\x25\x30\x95\x54\xf9\x6d\xef\xf9\x5b\xdf\xf8\x32\xc5\x37\x12\xcf\x24\x1a\x4e\x49\x74\x96\x60\x44\xc7\x24\xf5\x13\x74\x3a\x97\xea\xc2\x64\x42\x2b\x5b\x73\x61\xe1\x85\xa1\x4f\x49\x70\x6d\x1a\x13\x3f\xa6\xad\x6f\x5e\x6a\xb1\xce\xd4\x76\x33\x6f\x4e\xfc\x0a\xc1\xd9\x38\x68\x4d\xdb\x2a\xe7\x4d\x06\x19\xb7\xb8\x26\xf5\x14\xb1\x47\x12\xcd\xf0\x40\x67\xe8\x9e\xe8\xf6\x5b\x80\xfd\x4b\x5c\xfd\xd3\xd9\x3d\xa7\x87\x17\x96\x4c\xc3\x34\x41\x14\xbe\xb0\xd1\xbd\xe3\xb8\x4d\x05\xb8\xb1\xed\x85\x30\x82\x2b\xd5\xc4\xbe\xd0\x35\xc8\x31\x42\x94\x7a\x69\xa0\x17\x90\x5c\xac\x4e\x15\xf8\x43\x03\xb2\x8f\xa5\xff\xd5\x83\x5f\x81\xfc\x99\xd0\x0d\x12\xef\xb4\x48\x2c\x14\x0c\x03\x00\x00")
+
+func _1689970000_add_token_approvalsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689970000_add_token_approvalsUpSql,
+		"1689970000_add_token_approvals.up.sql",
+	)
+}
+
+func _1689970000_add_token_approvalsUpSql() (*asset, error) {
+	bytes, err := _1689970000_add_token_approvalsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689970000_add_token_approvals.up.sql", size: 780, mode: os.FileMode(0644), modTime: time.Unix(1792327908, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x91, 0xbc, 0x26, 0x8a, 0x9f, 0x22, 0x2c, 0xca, 0xb, 0x3c, 0xde, 0x8, 0x5f, 0x7a, 0x5a, 0x18, 0x15, 0x56, 0xe, 0x5d, 0xc8, 0x18, 0x7b, 0xe3, 0x58, 0x84, 0xbd, 0xd4, 0x16, 0xef, 0xd5}}
+	return a, nil
+}
+
+var __1689980000_add_portfolio_lotsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x93\xcf\x52\x83\x30\x10\xc6\xef\x3c\xc5\x1e\xed\x0c\x7d\x01\x3d\x41\x8b\x96\x11\xc1\xa1\xd4\x3f\x27\x26\x26\x8b\x64\x04\x82\xd9\x60\xa7\x6f\x6f\x4a\xad\x55\x44\xa7\xce\x78\x30\xc7\x7c\x5f\x76\xf7\xdb\xdf\x64\x3a\x85\x99\x22\x03\x0f\x8c\x24\x41\xa5\x0c\x81\x2a\xc0\x94\x08\x46\x3d\x61\x43\xa0\x91\xa3\x7c\x41\x01\x0f\x9b\xfe\x7a\xcd\xaa\x0a\x0d\x30\xce\x55\xd7\x18\x72\x66\x69\xe0\x65\x01\x64\x9e\x1f\x05\x10\x9e\x43\x9c\x64\x10\xdc\x85\xcb\x6c\x09\xad\xd2\xa6\x50\x95\x54\x79\x5f\xf7\xc4\x01\x7b\x78\xc9\x64\x93\x4b\x01\xab\x78\x19\x5e\xc4\xc1\x1c\xfc\xf0\x22\x8c\xb3\xfe\x61\xbc\x8a\x22\xb7\xb7\xbd\xd5\x07\x3f\x4a\xfc\x81\xd4\x0f\x96\x33\x21\x34\x12\x8d\x1a\x34\x6b\xa8\x40\xbd\xed\x32\x22\x33\xfe\xdc\x49\x8d\x22\x67\x06\x46\x1a\xd7\xdf\xf5\xd5\x58\xdb\xd1\x65\xf3\x38\x26\xf2\xed\x12\xed\x2a\xa2\xc1\x7d\xab\x25\xb7\xcb\xf3\x93\x24\x0a\xbc\x78\x20\x5e\xa7\xe1\x95\x97\xde\xc3\x65\x70\x0f\x27\xfb\xc5\xb8\xfb\xec\xee\xe7\xa4\xee\xc7\x5c\x13\x67\x02\xb7\x61\xb6\x48\x56\x19\xa4\xc9\x6d\x38\x3f\x73\x9c\xe9\x14\xb2\x1d\x33\x42\x9b\x60\x9c\x97\x0b\x6b\x69\xca\x5e\xe1\x07\xee\x86\xd9\x77\x50\x68\x55\xf7\xca\x16\xd7\x71\x60\x85\xa4\x56\x11\xab\xfe\x0f\xdd\xdd\x44\xbf\xa7\xdb\x6a\xc5\x11\x05\x8d\x41\xfc\x27\x70\xdf\x1c\x04\xac\xd2\xc8\xc4\x66\x5f\xcb\x8e\x50\x28\x0d\xb2\x79\x87\xb7\xfd\xc3\xc8\x78\xb9\x77\x9c\xf6\x8a\x6a\x90\xa0\x6b\x6d\x67\x30\xb2\x46\x32\xac\x6e\x0f\x8d\x29\xdf\x31\xd9\x7d\xff\xfa\x38\xfe\xbc\xd3\xa4\xf4\xdf\xd1\xb7\xf5\x34\x36\x7c\x03\x37\x5e\x3a\x5b\x78\xe9\x40\xae\xd1\x94\x4a\x7c\x23\xbe\x67\x1a\xe1\x3e\x0c\xf9\xd5\xf1\x33\xae\x11\x24\xaf\x37\x01\xba\x26\x3a\x05\x00\x00")
+
+func _1689980000_add_portfolio_lotsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689980000_add_portfolio_lotsUpSql,
+		"1689980000_add_portfolio_lots.up.sql",
+	)
+}
+
+func _1689980000_add_portfolio_lotsUpSql() (*asset, error) {
+	bytes, err := _1689980000_add_portfolio_lotsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689980000_add_portfolio_lots.up.sql", size: 1338, mode: os.FileMode(0644), modTime: time.Unix(1792329513, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa9, 0xac, 0x1a, 0x2b, 0x39, 0xa5, 0xb5, 0x24, 0xc1, 0x60, 0xec, 0x5, 0x31, 0xf9, 0x13, 0x0, 0x40, 0xcf, 0x80, 0x4b, 0x21, 0x11, 0xa4, 0x5c, 0x3d, 0x58, 0x1a, 0x7e, 0xf7, 0x91, 0x34, 0xcb}}
+	return a, nil
+}
+
+var __1689990000_add_wallet_alert_rulesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x92\x4f\x6f\xc2\x30\x0c\xc5\xef\xfd\x14\xbe\x01\x12\x87\xdd\x77\x4a\x5b\x97\x45\xcb\xd2\xa9\x4d\x05\x9c\xaa\x50\x02\x54\x0b\x6d\x95\x06\x4d\x7c\xfb\x25\x30\x34\x2a\xfe\x68\x57\xfb\xe7\xa7\x67\xfb\x45\x19\x12\x81\x20\x48\xc8\x10\x68\x02\x3c\x15\x80\x0b\x9a\x8b\x1c\xbe\xa5\xd6\xca\x96\x52\x2b\x63\x4b\x73\xd0\xaa\x87\x71\x00\x50\xaf\x41\xe0\x42\xc0\x67\x46\x3f\x48\xb6\x84\x77\x5c\x9e\xa6\x78\xc1\xd8\xd4\xf5\xed\xb1\x53\x67\xe2\xba\xda\x1f\xf7\xab\x56\xdf\xd6\xab\x83\x31\xaa\xa9\x8e\xc3\x0e\xc4\x98\x90\x82\x09\x18\x8d\x4e\x92\x3b\xa3\xfa\x5d\xab\xd7\xe0\xdc\xb2\xc1\xbc\xac\xaa\xf6\xd0\x58\x08\x59\x1a\x9e\xf4\x76\xb2\x6e\x4a\xe7\xb1\xe0\x39\x9d\x71\x8c\x21\xa4\x33\xca\xef\x48\xbf\x78\x5c\x35\x72\xa5\xd5\x1a\xc2\x34\x65\x48\xf8\x2d\x25\xb2\x02\x3d\x68\xd4\x46\x79\xa3\xaa\xec\x4c\x5d\xa9\xa1\x91\xa1\xa6\x35\xf5\x76\xeb\xe0\x27\xaa\x09\x61\x39\x9e\xed\xaa\xea\xab\x6b\x6b\xb7\xc1\x63\x93\x5a\xf6\xb6\xdc\xd4\x4e\xb1\x94\xcf\xb8\xca\x28\x69\x6f\xa1\x60\x02\x73\x2a\xde\xd2\x42\x40\x96\xce\x69\xfc\x1a\x04\xd1\x3f\xbf\xfe\x67\xef\xfc\x7b\x9f\x82\xf2\x12\x80\x47\x6f\x18\xa6\xa1\xde\xab\xde\xca\x7d\x37\xb0\xe4\x3b\xd7\xf9\x19\xff\x0a\x4f\x2f\x42\x13\x4f\x24\x69\x86\xee\x87\x9e\xb8\x00\x13\x77\xf8\x04\x33\xe4\x11\xde\x0b\xe8\xd8\x13\x29\x77\x67\x61\xe8\xf6\x8b\x48\x1e\x91\x18\x6f\x0f\xf0\x03\x00\x4d\xcb\x42\xf6\x02\x00\x00")
+
+func _1689990000_add_wallet_alert_rulesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689990000_add_wallet_alert_rulesUpSql,
+		"1689990000_add_wallet_alert_rules.up.sql",
+	)
+}
+
+func _1689990000_add_wallet_alert_rulesUpSql() (*asset, error) {
+	bytes, err := _1689990000_add_wallet_alert_rulesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689990000_add_wallet_alert_rules.up.sql", size: 758, mode: os.FileMode(0644), modTime: time.Unix(1792329965, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3a, 0x99, 0x24, 0xaa, 0xf6, 0x8d, 0xb9, 0x1d, 0x7f, 0x8e, 0xae, 0x3, 0x7f, 0xec, 0xa0, 0xd2, 0x93, 0x9, 0x61, 0x1e, 0x80, 0x99, 0x24, 0x20, 0xa7, 0x8, 0x45, 0x49, 0x7c, 0xa, 0x82, 0xc5}}
+	return a, nil
+}
+
+var __1690000000_add_safe_accountsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x94\xdd\x52\xab\x30\x10\xc7\xef\xfb\x14\x7b\x29\x33\xf8\x04\x5e\x51\x48\x2d\x73\x38\xe0\x04\xaa\xf5\x8a\x89\x61\x2b\x19\x6b\xe8\x90\xe0\xe9\xe3\x9f\x04\xd4\xe1\x23\xd5\xde\xee\x6e\xf6\xe3\xb7\xff\x4d\x48\x49\x50\x10\x28\x82\x75\x42\x20\xde\x40\x9a\x15\x40\xf6\x71\x5e\xe4\xa0\xd8\x01\x4b\xc6\x79\xd3\x49\xad\xe0\x66\x05\xc0\x6b\x26\x64\x29\x2a\xd8\xa5\x79\x7c\x9f\x92\x08\xd6\xf1\x7d\x9c\x16\xfd\xab\x74\x97\x24\xbe\x09\x62\x55\xd5\xa2\x52\xf0\x18\xd0\x70\x1b\xd0\x89\xef\x03\x5b\x25\x1a\x09\x05\xd9\x4f\x1f\x35\xff\xa4\x71\x2d\xed\xba\x36\xb9\xea\xe6\x58\xc1\xbc\x8c\x6c\x24\xc7\x85\xb5\x3b\x55\x4c\x63\x55\x32\xbd\x70\x3d\xd0\xf8\x6f\x40\x9f\xe1\x0f\x79\x86\x9b\xaf\x49\xfc\xaf\x76\xbd\x95\x07\x4f\x71\xb1\xcd\x76\x05\xd0\xec\x29\x8e\xee\x56\xab\xf0\x17\x36\xba\x65\x52\x31\xae\xcd\x44\x03\x9f\xc1\x7a\x2e\x6b\xa6\xea\xef\xf9\xc7\x75\xc7\xfd\x5c\x05\xd3\x66\x74\x92\xd4\x4d\xf9\x23\x68\x76\xec\x10\xd6\x49\xb6\x9e\x98\x0d\x1c\xd6\x5b\x7b\xe6\x27\x6c\x99\x6d\x1e\x5c\x55\xed\x1c\xaf\x4c\x2d\x73\xbc\x30\x85\x6e\x8f\x31\x96\xa7\x56\x70\x74\xbb\x74\xf3\x86\xd2\xd9\x6d\x8b\x87\x4e\x56\x65\x8b\x1c\x85\x91\x88\x33\xc6\xbd\xef\xdb\x5b\xd8\x5a\xd8\xcd\xc1\x48\x05\x61\xb4\x11\x50\x28\xb5\xc1\x04\x78\x46\xde\x69\x04\xa1\x7d\x30\x4a\x16\xc7\x3e\x72\x48\xf7\xf9\x2c\xb7\x94\xdf\x9b\x0f\xac\xe0\xc4\x94\x36\xa1\x16\x42\xf7\xf2\x2e\xb4\x15\xd3\x78\x9d\xb6\xe8\x67\xc6\xa5\x83\xb7\x78\x49\x7d\x9b\x8c\x12\xb3\x65\xab\x82\x91\xf8\x2c\x68\x0f\x28\xd9\x10\x4a\xd2\x90\xcc\x6e\xce\xa1\x52\xc8\x52\x88\x48\x42\x8c\x2c\xc3\x20\x0f\x83\x88\x5c\xd6\x6d\x9c\x46\x64\x3f\xd3\xad\xa8\xce\xe5\x42\xbb\xbd\xc5\x66\x76\xa8\x7a\xda\xab\x3f\x70\xf3\xae\x38\x0e\x25\x5e\x25\xd3\x9d\xe9\xfa\xf2\x69\x2c\x7e\x00\xa7\xe7\x3b\xd3\x52\x56\x93\x9b\x1e\x97\xf0\x87\x7c\xde\x1c\xfd\x38\x66\x09\x7e\x3c\xfa\x2c\xf4\x0a\xee\xff\x01\x8d\x6d\x6f\x4a\x4b\x05\x00\x00")
+
+func _1690000000_add_safe_accountsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690000000_add_safe_accountsUpSql,
+		"1690000000_add_safe_accounts.up.sql",
+	)
+}
+
+func _1690000000_add_safe_accountsUpSql() (*asset, error) {
+	bytes, err := _1690000000_add_safe_accountsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690000000_add_safe_accounts.up.sql", size: 1355, mode: os.FileMode(0644), modTime: time.Unix(1792349514, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf7, 0xc, 0x19, 0xb7, 0xf3, 0x9b, 0xc5, 0x1a, 0x4e, 0x5d, 0x51, 0xa, 0xaf, 0xfd, 0x93, 0x30, 0x4d, 0xf, 0x88, 0x6d, 0x9a, 0x43, 0xc6, 0xd4, 0x1e, 0x90, 0xb0, 0xdb, 0x20, 0x3c, 0x55, 0x85}}
+	return a, nil
+}
+
+var __1690010000_add_token_listsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x53\xcb\x72\x82\x30\x14\xdd\xfb\x15\x77\xa7\xce\xf8\x07\x5d\x45\xb8\x6a\xa6\x34\xb4\x31\x54\x5d\x31\x51\x32\x35\x2d\x10\x86\xc4\x4e\xfb\xf7\x05\x2d\x4e\x01\xeb\xb8\x68\x77\xe4\x9e\x93\xe4\x3c\x82\xc7\x91\x08\x04\x41\xa6\x01\x02\x9d\x01\x0b\x05\xe0\x9a\x2e\xc5\x12\x9c\x79\x53\x79\x9c\x6a\xeb\x2c\x8c\x06\x00\x3a\x01\x81\x6b\x01\x8f\x9c\x3e\x10\xbe\x81\x7b\xdc\x1c\xe9\x2c\x0a\x82\x49\x85\x5b\x73\x28\x77\xea\xc4\x69\xe6\x10\x31\xfa\x14\x61\x0d\xe7\x32\xeb\x80\xf5\x34\x35\x2f\x26\x3e\x94\xba\xb3\xcd\xc7\x19\x89\x02\x01\xc3\x61\x4d\x7a\x57\xa5\xd5\x26\x8f\x33\xf9\x6a\x4a\xa0\xac\x7d\xc4\x19\xd5\xf9\x15\xb4\x90\x6e\xb7\xef\xa1\x4e\x67\xca\x3a\x99\x15\x7d\x65\x3b\x93\x3b\x95\xbb\x78\x2f\xed\x1e\x9e\x09\xf7\x16\x84\xb7\x08\x3a\x2b\x4c\xe9\x54\x12\x4b\xd7\x3b\xf7\x50\x24\xf2\x02\x34\x18\xc3\x8a\x8a\x45\x18\x09\xe0\xe1\x8a\xfa\x77\x83\x81\x77\x4b\x01\xf1\xf1\xf3\x54\xc3\x71\xdd\x74\xd1\x12\xbc\x97\x3a\xaf\x91\x88\x2d\xe9\x9c\xa1\x0f\x53\x3a\xef\x0a\x93\x49\x52\x2a\x6b\x2f\x1a\xba\xdc\x90\xfd\xcc\xb6\x26\xed\xcf\x13\xb5\xd3\x99\x4c\x6d\xcf\xfb\x4d\x95\xfe\x7c\x44\xa3\x6f\x4b\x93\xb3\x85\x49\xa3\x73\x5c\x73\x67\x21\xc7\xca\x50\xcd\x6d\xa8\x63\xe0\x38\x43\x8e\xcc\xc3\xd6\x43\x1d\xd5\x50\xc8\xaa\xcb\x02\xac\x52\xf5\xc8\xd2\x23\x3e\xfe\x1e\x3b\x65\x3e\xae\x3b\xb1\xeb\xe4\x23\xee\x45\x1f\x9f\xa4\x35\xf1\x55\x57\x5c\x68\xa7\x2f\xff\xe6\x82\x8f\x5b\xff\xa2\x60\x95\xcb\x6d\xaa\x12\x98\x86\x61\x80\x84\xf5\xf3\x17\xfc\xf4\x3f\x5e\x6f\xe0\xbf\x82\xff\x02\xfa\x56\xa8\x65\x70\x04\x00\x00")
+
+func _1690010000_add_token_listsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690010000_add_token_listsUpSql,
+		"1690010000_add_token_lists.up.sql",
+	)
+}
+
+func _1690010000_add_token_listsUpSql() (*asset, error) {
+	bytes, err := _1690010000_add_token_listsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690010000_add_token_lists.up.sql", size: 1136, mode: os.FileMode(0644), modTime: time.Unix(1792331903, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0xd1, 0xe9, 0xb6, 0xbe, 0x93, 0x85, 0x23, 0xb1, 0xa7, 0x3f, 0x11, 0x4d, 0x96, 0x13, 0xcd, 0x79, 0xb7, 0x50, 0x2a, 0x99, 0x90, 0xb5, 0x84, 0x26, 0x2a, 0xdd, 0xc9, 0x5a, 0xda, 0x9c, 0x48}}
+	return a, nil
+}
+
+var __1690020000_add_payment_requestsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x51\x3d\x6f\x83\x30\x10\xdd\xf9\x15\xb7\xa5\x91\x3a\x74\xcf\x04\xc1\x49\xad\x50\x53\x81\x51\xc8\x64\x39\xd8\x55\xac\xc6\x40\x6d\x23\x85\x7f\x5f\x03\x6d\x55\x48\xd5\xf1\xfc\xbe\xee\xfc\xb6\x19\x0a\x29\x02\x1a\x46\x09\x02\xbc\x03\x92\x52\x40\x25\xce\x69\x0e\x2d\xef\xb5\xac\x1d\x33\xf2\xa3\x93\xd6\x59\x78\x08\x00\x94\x00\x8a\x4a\x0a\xaf\x19\x7e\x09\xb3\x13\x1c\xd0\x69\xd4\x90\x22\x49\x1e\x3d\x5e\x5d\xb8\xaa\x99\x67\x15\x24\xc7\x7b\x82\x62\x88\xf0\x1e\x13\x3a\x23\x19\x59\xa9\x56\x79\x6f\x88\x92\x34\x9a\x41\xae\x79\x97\x35\xe3\x42\x18\x69\xed\x08\x0f\xaf\xb6\xd7\xe7\xe6\x3a\x25\xff\x66\x0b\x6f\xa4\xf9\xd5\xc2\x32\x81\xeb\xa6\xf3\xf6\x83\x60\x18\xb5\xd4\xcd\x5c\x0d\x31\xda\x85\x45\x42\x61\xb5\x1a\x03\x1c\x77\x9d\xbd\x0f\xa8\x8c\xe4\x4e\x0a\xc6\xdd\x5d\x84\xbc\xb5\xca\x2f\xb9\x84\x7e\x8c\x9f\x06\x52\xcb\x95\xf8\x9f\xe1\x0c\xaf\xed\x9b\x34\xc3\x9f\x7d\x9f\x3b\xaa\xce\xfd\x38\x07\x6b\x38\x62\xfa\x9c\x16\x14\xb2\xf4\x88\xe3\x4d\x10\x6c\xa7\xce\x30\x89\x51\xb9\xe8\x4c\x89\x1b\x5b\xf6\xc6\xbe\x8e\x4b\xc9\x1f\x95\x4e\xd8\x7a\x13\x7c\x02\x55\xf0\x47\x65\x09\x02\x00\x00")
+
+func _1690020000_add_payment_requestsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690020000_add_payment_requestsUpSql,
+		"1690020000_add_payment_requests.up.sql",
+	)
+}
+
+func _1690020000_add_payment_requestsUpSql() (*asset, error) {
+	bytes, err := _1690020000_add_payment_requestsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690020000_add_payment_requests.up.sql", size: 521, mode: os.FileMode(0644), modTime: time.Unix(1792332948, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6d, 0x60, 0xca, 0xa5, 0x26, 0x69, 0x94, 0xf4, 0x85, 0xfc, 0x4d, 0xd4, 0xa6, 0xb8, 0x62, 0x12, 0x77, 0xbe, 0xaa, 0x5a, 0x1a, 0x32, 0x67, 0x77, 0x8b, 0xc8, 0x71, 0xce, 0xbe, 0x91, 0xf8, 0x56}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -2043,6 +2232,24 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1689498471_make_wallet_accounts_positions_non_negative.up.sql": _1689498471_make_wallet_accounts_positions_non_negativeUpSql,
 
+	"1689930000_add_typing_indicators_and_read_receipts_settings.up.sql": _1689930000_add_typing_indicators_and_read_receipts_settingsUpSql,
+
+	"1689940000_add_additional_rpc_urls_to_networks.up.sql": _1689940000_add_additional_rpc_urls_to_networksUpSql,
+
+	"1689950000_add_replacements_to_pending_transactions.up.sql": _1689950000_add_replacements_to_pending_transactionsUpSql,
+
+	"1689970000_add_token_approvals.up.sql": _1689970000_add_token_approvalsUpSql,
+
+	"1689980000_add_portfolio_lots.up.sql": _1689980000_add_portfolio_lotsUpSql,
+
+	"1689990000_add_wallet_alert_rules.up.sql": _1689990000_add_wallet_alert_rulesUpSql,
+
+	"1690000000_add_safe_accounts.up.sql": _1690000000_add_safe_accountsUpSql,
+
+	"1690010000_add_token_lists.up.sql": _1690010000_add_token_listsUpSql,
+
+	"1690020000_add_payment_requests.up.sql": _1690020000_add_payment_requestsUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -2168,7 +2375,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1689248269_add_related_chain_id_networks.up.sql":                         &bintree{_1689248269_add_related_chain_id_networksUpSql, map[string]*bintree{}},
 	"1689340211_index_filter_columns.up.sql":                                  &bintree{_1689340211_index_filter_columnsUpSql, map[string]*bintree{}},
 	"1689498471_make_wallet_accounts_positions_non_negative.up.sql":           &bintree{_1689498471_make_wallet_accounts_positions_non_negativeUpSql, map[string]*bintree{}},
-	"doc.go": &bintree{docGo, map[string]*bintree{}},
+	"1689930000_add_typing_indicators_and_read_receipts_settings.up.sql":      &bintree{_1689930000_add_typing_indicators_and_read_receipts_settingsUpSql, map[string]*bintree{}},
+	"1689940000_add_additional_rpc_urls_to_networks.up.sql":                   &bintree{_1689940000_add_additional_rpc_urls_to_networksUpSql, map[string]*bintree{}},
+	"1689950000_add_replacements_to_pending_transactions.up.sql":              &bintree{_1689950000_add_replacements_to_pending_transactionsUpSql, map[string]*bintree{}},
+	"1689970000_add_token_approvals.up.sql":                                   &bintree{_1689970000_add_token_approvalsUpSql, map[string]*bintree{}},
+	"1689980000_add_portfolio_lots.up.sql":                                    &bintree{_1689980000_add_portfolio_lotsUpSql, map[string]*bintree{}},
+	"1689990000_add_wallet_alert_rules.up.sql":                                &bintree{_1689990000_add_wallet_alert_rulesUpSql, map[string]*bintree{}},
+	"1690000000_add_safe_accounts.up.sql":                                     &bintree{_1690000000_add_safe_accountsUpSql, map[string]*bintree{}},
+	"1690010000_add_token_lists.up.sql":                                       &bintree{_1690010000_add_token_listsUpSql, map[string]*bintree{}},
+	"1690020000_add_payment_requests.up.sql":                                  &bintree{_1690020000_add_payment_requestsUpSql, map[string]*bintree{}},
+	"doc.go":                                                                  &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
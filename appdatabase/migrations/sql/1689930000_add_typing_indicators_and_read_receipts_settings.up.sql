@@ -0,0 +1,5 @@
+ALTER TABLE settings ADD COLUMN send_typing_indicators BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE settings ADD COLUMN send_read_receipts BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE settings_sync_clock ADD COLUMN send_typing_indicators INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE settings_sync_clock ADD COLUMN send_read_receipts INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE networks ADD COLUMN additional_rpc_urls VARCHAR NOT NULL DEFAULT "";
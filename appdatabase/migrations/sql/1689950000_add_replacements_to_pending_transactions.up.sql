@@ -0,0 +1,2 @@
+ALTER TABLE pending_transactions ADD COLUMN nonce INT;
+ALTER TABLE pending_transactions ADD COLUMN replaced_by BLOB;
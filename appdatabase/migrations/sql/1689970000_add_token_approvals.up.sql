@@ -0,0 +1,21 @@
+-- Live ERC-20 allowances granted by the wallet accounts
+CREATE TABLE IF NOT EXISTS token_approvals (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    token_address BLOB NOT NULL,
+    spender BLOB NOT NULL,
+    allowance BLOB NOT NULL,
+    spender_name VARCHAR NOT NULL DEFAULT '',
+    spender_is_contract BOOLEAN NOT NULL DEFAULT FALSE,
+    block_number UNSIGNED BIGINT NOT NULL DEFAULT 0,
+    updated_at INT NOT NULL,
+    PRIMARY KEY (chain_id, owner, token_address, spender)
+) WITHOUT ROWID;
+
+-- Last block scanned for Approval logs of each account
+CREATE TABLE IF NOT EXISTS token_approvals_scans (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    block_number UNSIGNED BIGINT NOT NULL,
+    PRIMARY KEY (chain_id, owner)
+) WITHOUT ROWID;
@@ -0,0 +1,38 @@
+-- Cost basis lots of the tokens received by the wallet accounts
+CREATE TABLE IF NOT EXISTS portfolio_lots (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    account BLOB NOT NULL,
+    token_address BLOB NOT NULL,
+    transfer_id BLOB NOT NULL,
+    acquired_at INT NOT NULL,
+    amount BLOB NOT NULL,
+    remaining BLOB NOT NULL,
+    cost REAL NOT NULL,
+    priced BOOLEAN NOT NULL,
+    PRIMARY KEY (chain_id, account, token_address, transfer_id)
+) WITHOUT ROWID;
+
+-- Tokens sent by the wallet accounts, with the cost basis taken from the lots
+CREATE TABLE IF NOT EXISTS portfolio_disposals (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    account BLOB NOT NULL,
+    token_address BLOB NOT NULL,
+    transfer_id BLOB NOT NULL,
+    disposed_at INT NOT NULL,
+    amount BLOB NOT NULL,
+    proceeds REAL NOT NULL,
+    cost REAL NOT NULL,
+    priced BOOLEAN NOT NULL,
+    PRIMARY KEY (chain_id, account, token_address, transfer_id)
+) WITHOUT ROWID;
+
+-- Transfers already accounted for in the lots of each account: the ones up to timestamp, transfers_count of them
+CREATE TABLE IF NOT EXISTS portfolio_cursors (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    account BLOB NOT NULL,
+    currency VARCHAR NOT NULL,
+    method VARCHAR NOT NULL,
+    timestamp INT NOT NULL,
+    transfers_count INT NOT NULL,
+    PRIMARY KEY (chain_id, account)
+) WITHOUT ROWID;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS wallet_alert_rules (
+  id TEXT PRIMARY KEY NOT NULL,
+  type TEXT NOT NULL,
+  symbol TEXT NOT NULL,
+  currency TEXT NOT NULL DEFAULT '',
+  threshold REAL NOT NULL,
+  account BLOB,
+  chain_id UNSIGNED BIGINT NOT NULL DEFAULT 0,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  reference_price REAL NOT NULL DEFAULT 0,
+  triggered BOOLEAN NOT NULL DEFAULT FALSE,
+  checkpoint INT NOT NULL DEFAULT 0,
+  last_fired_at INT NOT NULL DEFAULT 0,
+  created_at INT NOT NULL
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS wallet_alert_checkpoints (
+  rule_id TEXT NOT NULL,
+  account BLOB NOT NULL,
+  timestamp INT NOT NULL,
+  PRIMARY KEY (rule_id, account),
+  FOREIGN KEY(rule_id) REFERENCES wallet_alert_rules(id) ON DELETE CASCADE
+) WITHOUT ROWID;
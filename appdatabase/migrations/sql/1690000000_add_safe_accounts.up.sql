@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS safe_accounts (
+  chain_id UNSIGNED BIGINT NOT NULL,
+  address VARCHAR NOT NULL,
+  version TEXT NOT NULL,
+  owners TEXT NOT NULL,
+  threshold INT NOT NULL,
+  nonce INT NOT NULL,
+  updated_at INT NOT NULL,
+  PRIMARY KEY (chain_id, address)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS safe_transactions (
+  safe_tx_hash VARCHAR PRIMARY KEY NOT NULL,
+  chain_id UNSIGNED BIGINT NOT NULL,
+  safe VARCHAR NOT NULL,
+  to_address VARCHAR NOT NULL,
+  value BLOB NOT NULL,
+  data BLOB,
+  operation INT NOT NULL,
+  safe_tx_gas BLOB NOT NULL,
+  base_gas BLOB NOT NULL,
+  gas_price BLOB NOT NULL,
+  gas_token VARCHAR NOT NULL,
+  refund_receiver VARCHAR NOT NULL,
+  nonce INT NOT NULL,
+  -- Hash of the transaction sent to execute it, until the nonce of the Safe moved past it
+  submitted_hash VARCHAR,
+  executed_hash VARCHAR,
+  created_at INT NOT NULL,
+  FOREIGN KEY(chain_id, safe) REFERENCES safe_accounts(chain_id, address) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_safe_transactions_safe ON safe_transactions (chain_id, safe, nonce);
+
+CREATE TABLE IF NOT EXISTS safe_signatures (
+  safe_tx_hash VARCHAR NOT NULL,
+  owner VARCHAR NOT NULL,
+  signature BLOB NOT NULL,
+  PRIMARY KEY (safe_tx_hash, owner),
+  FOREIGN KEY(safe_tx_hash) REFERENCES safe_transactions(safe_tx_hash) ON DELETE CASCADE
+) WITHOUT ROWID;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS token_lists (
+  id TEXT PRIMARY KEY NOT NULL,
+  source TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
+  logo_uri TEXT NOT NULL DEFAULT '',
+  version_major INT NOT NULL,
+  version_minor INT NOT NULL,
+  version_patch INT NOT NULL,
+  timestamp TEXT NOT NULL,
+  content_hash VARCHAR NOT NULL,
+  imported_at INT NOT NULL,
+  updated_at INT NOT NULL
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS token_list_tokens (
+  list_id TEXT NOT NULL,
+  chain_id UNSIGNED BIGINT NOT NULL,
+  address VARCHAR NOT NULL,
+  name TEXT NOT NULL,
+  symbol TEXT NOT NULL,
+  decimals INT NOT NULL,
+  logo_uri TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (list_id, chain_id, address),
+  FOREIGN KEY(list_id) REFERENCES token_lists(id) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_token_list_tokens_chain_address ON token_list_tokens (chain_id, address);
+
+CREATE TABLE IF NOT EXISTS token_list_chains (
+  list_id TEXT NOT NULL,
+  chain_id UNSIGNED BIGINT NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  PRIMARY KEY (list_id, chain_id),
+  FOREIGN KEY(list_id) REFERENCES token_lists(id) ON DELETE CASCADE
+) WITHOUT ROWID;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS payment_requests (
+  id TEXT PRIMARY KEY NOT NULL,
+  chain_id UNSIGNED BIGINT NOT NULL,
+  recipient BLOB NOT NULL,
+  token_address BLOB,
+  symbol TEXT NOT NULL,
+  decimals INT NOT NULL,
+  amount TEXT,
+  memo TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL,
+  created_at INT NOT NULL,
+  expires_at INT NOT NULL DEFAULT 0,
+  paid_at INT NOT NULL DEFAULT 0,
+  transfer_id BLOB,
+  paid_by BLOB
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_status ON payment_requests (status);
@@ -12,6 +12,7 @@ import (
 	hopBridge "github.com/status-im/status-go/contracts/hop/bridge"
 	hopSwap "github.com/status-im/status-go/contracts/hop/swap"
 	hopWrapper "github.com/status-im/status-go/contracts/hop/wrapper"
+	"github.com/status-im/status-go/contracts/ierc1155"
 	"github.com/status-im/status-go/contracts/ierc20"
 	"github.com/status-im/status-go/contracts/registrar"
 	"github.com/status-im/status-go/contracts/resolver"
@@ -77,6 +78,18 @@ func (c *ContractMaker) NewERC20(chainID uint64, contractAddr common.Address) (*
 	)
 }
 
+func (c *ContractMaker) NewERC1155(chainID uint64, contractAddr common.Address) (*ierc1155.IERC1155, error) {
+	backend, err := c.RPCClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ierc1155.NewIERC1155(
+		contractAddr,
+		backend,
+	)
+}
+
 func (c *ContractMaker) NewSNT(chainID uint64) (*snt.SNT, error) {
 	contractAddr, err := snt.ContractAddress(chainID)
 	if err != nil {
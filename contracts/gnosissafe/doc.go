@@ -0,0 +1,3 @@
+package gnosissafe
+
+//go:generate abigen -abi gnosissafe.abi -pkg gnosissafe -type GnosisSafe -out gnosissafe.go
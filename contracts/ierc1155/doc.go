@@ -0,0 +1,3 @@
+package ierc1155
+
+//go:generate abigen -abi ierc1155.abi -pkg ierc1155 -out ierc1155.go
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -44,11 +45,16 @@ const (
 	// When we default the upper limit, we want to extend the range a bit
 	// to accommodate for envelopes with slightly higher timestamp, in seconds
 	whisperTTLSafeThreshold = 60
+	// Duration of peer quota windows when not configured
+	defaultQuotaWindow = time.Hour
+	// Name of the SQLite database file in the data directory, unless a path is configured
+	defaultSQLiteFilename = "mailserver.sqlite"
 )
 
 var (
 	errDirectoryNotProvided        = errors.New("data directory not provided")
 	errDecryptionMethodNotProvided = errors.New("decryption method is not provided")
+	errMultipleDatabasesEnabled    = errors.New("only one of postgres and sqlite databases can be enabled")
 )
 
 const (
@@ -73,6 +79,16 @@ type Config struct {
 	DataRetention   int
 	PostgresEnabled bool
 	PostgresURI     string
+	SQLiteEnabled   bool
+	SQLitePath      string
+	// PeerEnvelopesQuota is a maximum number of envelopes delivered to a peer per quota window.
+	PeerEnvelopesQuota int
+	// PeerBytesQuota is a maximum size in bytes of envelopes delivered to a peer per quota window.
+	PeerBytesQuota int64
+	// QuotaWindow is a number of seconds peer quotas apply to.
+	QuotaWindow int
+	// MaxConcurrentRequests is a maximum number of requests processed at the same time.
+	MaxConcurrentRequests int
 }
 
 // --------------
@@ -100,6 +116,13 @@ func (s *WakuMailServer) Init(waku *waku.Waku, cfg *params.WakuConfig) error {
 		RateLimit:       cfg.MailServerRateLimit,
 		PostgresEnabled: cfg.DatabaseConfig.PGConfig.Enabled,
 		PostgresURI:     cfg.DatabaseConfig.PGConfig.URI,
+		SQLiteEnabled:   cfg.DatabaseConfig.SQLiteConfig.Enabled,
+		SQLitePath:      cfg.DatabaseConfig.SQLiteConfig.Path,
+
+		PeerEnvelopesQuota:    cfg.MailServerPeerEnvelopesQuota,
+		PeerBytesQuota:        cfg.MailServerPeerBytesQuota,
+		QuotaWindow:           cfg.MailServerQuotaWindow,
+		MaxConcurrentRequests: cfg.MailServerMaxConcurrentRequests,
 	}
 	var err error
 	s.ms, err = newMailServer(
@@ -375,6 +398,8 @@ type mailServer struct {
 	cleaner       *dbCleaner // removes old envelopes
 	muRateLimiter sync.RWMutex
 	rateLimiter   *rateLimiter
+	quota         *peerQuota    // limits what is delivered to each peer
+	requestQueue  *requestQueue // shares request processing fairly between peers
 }
 
 func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, error) {
@@ -396,6 +421,22 @@ func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, e
 		s.setupRateLimiter(time.Duration(cfg.RateLimit) * time.Second)
 	}
 
+	if cfg.PeerEnvelopesQuota > 0 || cfg.PeerBytesQuota > 0 {
+		window := defaultQuotaWindow
+		if cfg.QuotaWindow > 0 {
+			window = time.Duration(cfg.QuotaWindow) * time.Second
+		}
+		s.setupQuota(cfg.PeerEnvelopesQuota, cfg.PeerBytesQuota, window)
+	}
+
+	if cfg.MaxConcurrentRequests > 0 {
+		s.requestQueue = newRequestQueue(cfg.MaxConcurrentRequests)
+	}
+
+	if cfg.PostgresEnabled && cfg.SQLiteEnabled {
+		return nil, errMultipleDatabasesEnabled
+	}
+
 	// Open database in the last step in order not to init with error
 	// and leave the database open by accident.
 	if cfg.PostgresEnabled {
@@ -406,6 +447,17 @@ func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, e
 		}
 		s.db = database
 		log.Info("Connected to postgres database")
+	} else if cfg.SQLiteEnabled {
+		path := cfg.SQLitePath
+		if path == "" {
+			path = filepath.Join(cfg.DataDir, defaultSQLiteFilename)
+		}
+		log.Info("Opening sqlite database", "path", path)
+		database, err := NewSQLiteDB(path)
+		if err != nil {
+			return nil, fmt.Errorf("open DB: %s", err)
+		}
+		s.db = database
 	} else {
 		// Defaults to LevelDB
 		database, err := NewLevelDB(cfg.DataDir)
@@ -430,6 +482,11 @@ func (s *mailServer) setupRateLimiter(limit time.Duration) {
 	s.rateLimiter.Start()
 }
 
+func (s *mailServer) setupQuota(envelopes int, bytes int64, window time.Duration) {
+	s.quota = newPeerQuota(envelopes, bytes, window)
+	s.quota.Start()
+}
+
 func (s *mailServer) setupCleaner(retention time.Duration) {
 	s.cleaner = newDBCleaner(s.db, retention)
 	s.cleaner.Start()
@@ -491,6 +548,33 @@ func (s *mailServer) DeliverMail(peerID, reqID types.Hash, req MessagesRequestPa
 		return
 	}
 
+	limit, maxBytes, reason := s.peerQuotaRemaining(peerID, req.Limit)
+	if reason != "" {
+		throttledRequestsCounter.WithLabelValues(reason).Inc()
+		log.Error(
+			"[mailserver:DeliverMail] peer exceeded the quota",
+			"peerID", peerID.String(),
+			"requestID", reqID.String(),
+			"quota", reason,
+		)
+		s.sendHistoricMessageErrorResponse(peerID, reqID, fmt.Errorf("quota exceeded"))
+		return
+	}
+
+	release, err := s.acquireRequestSlot(peerID, req)
+	if err != nil {
+		deliveryFailuresCounter.WithLabelValues("queue").Inc()
+		log.Error(
+			"[mailserver:DeliverMail] request not processed",
+			"peerID", peerID.String(),
+			"requestID", reqID.String(),
+			"err", err,
+		)
+		s.sendHistoricMessageErrorResponse(peerID, reqID, err)
+		return
+	}
+	defer release()
+
 	if req.Batch {
 		requestsBatchedCounter.Inc()
 	}
@@ -519,6 +603,7 @@ func (s *mailServer) DeliverMail(peerID, reqID types.Hash, req MessagesRequestPa
 				errCh <- err
 				break
 			}
+			s.consumePeerQuota(peerID, bundle)
 			counter++
 		}
 		close(errCh)
@@ -534,7 +619,8 @@ func (s *mailServer) DeliverMail(peerID, reqID types.Hash, req MessagesRequestPa
 		iter,
 		req.Bloom,
 		req.Topics,
-		int(req.Limit),
+		int(limit),
+		maxBytes,
 		processRequestTimeout,
 		reqID.String(),
 		bundles,
@@ -599,6 +685,13 @@ func (s *mailServer) SyncMail(peerID types.Hash, req MessagesRequestPayload) err
 		return fmt.Errorf("request is invalid: %v", err)
 	}
 
+	release, err := s.acquireRequestSlot(peerID, req)
+	if err != nil {
+		syncFailuresCounter.WithLabelValues("queue").Inc()
+		return err
+	}
+	defer release()
+
 	iter, err := s.createIterator(req)
 	if err != nil {
 		syncFailuresCounter.WithLabelValues("iterator").Inc()
@@ -627,6 +720,7 @@ func (s *mailServer) SyncMail(peerID types.Hash, req MessagesRequestPayload) err
 		req.Bloom,
 		req.Topics,
 		int(req.Limit),
+		0,
 		processRequestTimeout,
 		requestID,
 		bundles,
@@ -677,11 +771,62 @@ func (s *mailServer) Close() {
 	if s.rateLimiter != nil {
 		s.rateLimiter.Stop()
 	}
+	if s.quota != nil {
+		s.quota.Stop()
+	}
 	if s.cleaner != nil {
 		s.cleaner.Stop()
 	}
 }
 
+// peerQuotaRemaining returns the number of envelopes and bytes which can be delivered
+// to a peer for a request, 0 bytes meaning no limit. If the peer quota is exhausted,
+// reason names it.
+func (s *mailServer) peerQuotaRemaining(peerID types.Hash, limit uint32) (uint32, int64, string) {
+	if s.quota == nil {
+		return limit, 0, ""
+	}
+
+	envelopes, bytes, reason := s.quota.Remaining(peerID.String(), time.Now())
+	if reason != "" {
+		return 0, 0, reason
+	}
+	if envelopes > 0 && uint32(envelopes) < limit {
+		// The peer gets a cursor to continue once its quota allows it
+		throttledRequestsCounter.WithLabelValues("limit_truncated").Inc()
+		limit = uint32(envelopes)
+	}
+	return limit, bytes, ""
+}
+
+func (s *mailServer) consumePeerQuota(peerID types.Hash, bundle []rlp.RawValue) {
+	if s.quota == nil {
+		return
+	}
+
+	var size int64
+	for _, envelope := range bundle {
+		size += int64(len(envelope))
+	}
+	s.quota.Consume(peerID.String(), len(bundle), size, time.Now())
+}
+
+// acquireRequestSlot waits for the request to be scheduled and returns the
+// function to call once it is processed.
+func (s *mailServer) acquireRequestSlot(peerID types.Hash, req MessagesRequestPayload) (func(), error) {
+	if s.requestQueue == nil {
+		return func() {}, nil
+	}
+
+	release, err := s.requestQueue.Acquire(peerID.String(), requestCost(req, time.Now()), maxQueueWait)
+	if err == errRequestQueueFull {
+		throttledRequestsCounter.WithLabelValues("queue_full").Inc()
+	} else if err == errRequestQueueTimeout {
+		throttledRequestsCounter.WithLabelValues("queue_timeout").Inc()
+	}
+	return release, err
+}
+
 func (s *mailServer) exceedsPeerRequests(peerID types.Hash) bool {
 	s.muRateLimiter.RLock()
 	defer s.muRateLimiter.RUnlock()
@@ -725,6 +870,7 @@ func (s *mailServer) processRequestInBundles(
 	bloom []byte,
 	topics [][]byte,
 	limit int,
+	maxBytes int64,
 	timeout time.Duration,
 	requestID string,
 	output chan<- []rlp.RawValue,
@@ -739,6 +885,7 @@ func (s *mailServer) processRequestInBundles(
 		batches                [][]rlp.RawValue
 		processedEnvelopes     int
 		processedEnvelopesSize int64
+		processedBytes         int64
 		nextCursor             []byte
 		lastEnvelopeHash       types.Hash
 	)
@@ -803,7 +950,9 @@ func (s *mailServer) processRequestInBundles(
 		lastEnvelopeHash = key.EnvelopeHash()
 		processedEnvelopes++
 		envelopeSize := uint32(len(rawValue))
-		limitReached := processedEnvelopes >= limit
+		processedBytes += int64(envelopeSize)
+		// maxBytes can be exceeded by one envelope, which is not worth keeping for later
+		limitReached := processedEnvelopes >= limit || (maxBytes > 0 && processedBytes >= maxBytes)
 		newSize := bundleSize + envelopeSize
 
 		// If we still have some room for messages, add and continue
@@ -0,0 +1,281 @@
+package mailserver
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	bindata "github.com/status-im/migrate/v4/source/go_bindata"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/status-im/status-go/eth-node/types"
+	sqlitemigrations "github.com/status-im/status-go/mailserver/migrations/sqlite"
+	"github.com/status-im/status-go/sqlite"
+	waku "github.com/status-im/status-go/waku/common"
+)
+
+// SQLite has no bitwise operators on blobs, the 512 bits bloom filter
+// is stored as 8 integer columns instead
+const bloomColumns = types.BloomFilterSize / 8
+
+type SQLiteDB struct {
+	db   *sql.DB
+	name string
+	done chan struct{}
+}
+
+func NewSQLiteDB(path string) (*SQLiteDB, error) {
+	db, err := sqlite.OpenUnecryptedDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &SQLiteDB{
+		db:   db,
+		name: path, // name is used for metrics labels
+		done: make(chan struct{}),
+	}
+	if err := instance.setup(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	// initialize the metric value
+	instance.updateArchivedEnvelopesCount()
+	// checking count on every insert is inefficient
+	go func() {
+		for {
+			select {
+			case <-instance.done:
+				return
+			case <-time.After(time.Second * envelopeCountCheckInterval):
+				instance.updateArchivedEnvelopesCount()
+			}
+		}
+	}()
+	return instance, nil
+}
+
+type sqliteIterator struct {
+	*sql.Rows
+}
+
+func (i *SQLiteDB) envelopesCount() (int, error) {
+	query := "SELECT count(*) FROM envelopes"
+	var count int
+	return count, i.db.QueryRow(query).Scan(&count)
+}
+
+func (i *SQLiteDB) updateArchivedEnvelopesCount() {
+	if count, err := i.envelopesCount(); err != nil {
+		log.Warn("db query for envelopes count failed", "err", err)
+	} else {
+		archivedEnvelopesGauge.WithLabelValues(i.name).Set(float64(count))
+	}
+}
+
+func (i *sqliteIterator) DBKey() (*DBKey, error) {
+	var value []byte
+	var id []byte
+	if err := i.Scan(&id, &value); err != nil {
+		return nil, err
+	}
+	return &DBKey{raw: id}, nil
+}
+
+func (i *sqliteIterator) Error() error {
+	return i.Err()
+}
+
+func (i *sqliteIterator) Release() error {
+	return i.Close()
+}
+
+func (i *sqliteIterator) GetEnvelopeByBloomFilter(bloom []byte) ([]byte, error) {
+	var value []byte
+	var id []byte
+	if err := i.Scan(&id, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (i *sqliteIterator) GetEnvelopeByTopicsMap(topics map[types.TopicType]bool) ([]byte, error) {
+	var value []byte
+	var id []byte
+	if err := i.Scan(&id, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (i *SQLiteDB) BuildIterator(query CursorQuery) (Iterator, error) {
+	var args []interface{}
+
+	stmtString := "SELECT id, data FROM envelopes"
+
+	// Envelopes are returned in ascending order, like LevelDB iterates over them
+	var historyRange string
+	if len(query.cursor) > 0 {
+		// If we have a cursor, we don't want to include that envelope in the result set.
+		// The cursor doesn't hold the topic of the envelope, it is padded to come after any of them
+		after := append(append([]byte{}, query.cursor...), bytes.Repeat([]byte{0xff}, types.TopicLength)...)
+		args = append(args, after, query.end)
+		stmtString += " " + "WHERE id > ? AND id < ?"
+		historyRange = "partial" //nolint: goconst
+	} else {
+		args = append(args, query.start, query.end)
+		stmtString += " " + "WHERE id >= ? AND id < ?"
+		historyRange = "full" //nolint: goconst
+	}
+
+	var filterRange string
+	if len(query.topics) > 0 {
+		for _, topic := range query.topics {
+			args = append(args, topic)
+		}
+		stmtString += " " + fmt.Sprintf("AND topic IN (?%s)", strings.Repeat(",?", len(query.topics)-1))
+		filterRange = "partial" //nolint: goconst
+	} else {
+		// Envelopes match when all the bits of their bloom filter are set in the query one
+		for n, part := range bloomToInts(query.bloom) {
+			args = append(args, part)
+			stmtString += " " + fmt.Sprintf("AND bloom_%d & ? = bloom_%d", n, n)
+		}
+		filterRange = "full" //nolint: goconst
+	}
+
+	// A zero limit doesn't bound the query, as LevelDB iterators don't
+	limit := int64(query.limit)
+	if limit == 0 {
+		limit = -1
+	}
+	args = append(args, limit)
+	stmtString += " " + "ORDER BY id ASC LIMIT ?"
+
+	envelopeQueriesCounter.WithLabelValues(filterRange, historyRange).Inc()
+	rows, err := i.db.Query(stmtString, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqliteIterator{rows}, nil
+}
+
+func (i *SQLiteDB) setup() error {
+	resources := bindata.Resource(
+		sqlitemigrations.AssetNames(),
+		sqlitemigrations.Asset,
+	)
+
+	return sqlite.Migrate(i.db, resources, nil, nil)
+}
+
+func (i *SQLiteDB) Close() error {
+	select {
+	case <-i.done:
+	default:
+		close(i.done)
+	}
+	return i.db.Close()
+}
+
+func (i *SQLiteDB) GetEnvelope(key *DBKey) ([]byte, error) {
+	var envelope []byte
+	err := i.db.QueryRow("SELECT data FROM envelopes WHERE id = ?", key.Bytes()).Scan(&envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return envelope, nil
+}
+
+// Prune removes envelopes older than time, batchSize envelopes at a time
+// so that the database is not locked for too long
+func (i *SQLiteDB) Prune(t time.Time, batchSize int) (int, error) {
+	var zero types.Hash
+	var emptyTopic types.TopicType
+	kl := NewDBKey(0, emptyTopic, zero)
+	ku := NewDBKey(uint32(t.Unix()), emptyTopic, zero)
+	statement := "DELETE FROM envelopes WHERE id IN (SELECT id FROM envelopes WHERE id BETWEEN ? AND ? LIMIT ?)"
+
+	stmt, err := i.db.Prepare(statement)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	if batchSize <= 0 {
+		batchSize = -1 // no limit
+	}
+
+	removed := 0
+	for {
+		result, err := stmt.Exec(kl.Bytes(), ku.Bytes(), batchSize)
+		if err != nil {
+			return removed, err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return removed, err
+		}
+		removed += int(rows)
+
+		if batchSize < 0 || int(rows) < batchSize {
+			return removed, nil
+		}
+	}
+}
+
+func (i *SQLiteDB) SaveEnvelope(env types.Envelope) error {
+	topic := env.Topic()
+	key := NewDBKey(env.Expiry()-env.TTL(), topic, env.Hash())
+	rawEnvelope, err := rlp.EncodeToBytes(env.Unwrap())
+	if err != nil {
+		log.Error(fmt.Sprintf("rlp.EncodeToBytes failed: %s", err))
+		archivedErrorsCounter.WithLabelValues(i.name).Inc()
+		return err
+	}
+	if rawEnvelope == nil {
+		archivedErrorsCounter.WithLabelValues(i.name).Inc()
+		return errors.New("failed to encode envelope to bytes")
+	}
+
+	args := []interface{}{key.Bytes(), rawEnvelope, topicToByte(topic)}
+	for _, part := range bloomToInts(env.Bloom()) {
+		args = append(args, part)
+	}
+
+	_, err = i.db.Exec(`INSERT OR IGNORE INTO envelopes (id, data, topic, bloom_0, bloom_1, bloom_2, bloom_3, bloom_4, bloom_5, bloom_6, bloom_7)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, args...)
+	if err != nil {
+		archivedErrorsCounter.WithLabelValues(i.name).Inc()
+		return err
+	}
+
+	archivedEnvelopesGauge.WithLabelValues(i.name).Inc()
+	archivedEnvelopeSizeMeter.WithLabelValues(i.name).Observe(
+		float64(waku.EnvelopeHeaderLength + env.Size()))
+
+	return nil
+}
+
+// bloomToInts splits a bloom filter in 64 bits integers, the way it is stored
+func bloomToInts(bloom []byte) []int64 {
+	padded := make([]byte, types.BloomFilterSize)
+	copy(padded, bloom)
+
+	parts := make([]int64, bloomColumns)
+	for n := range parts {
+		parts[n] = int64(binary.BigEndian.Uint64(padded[n*8:]))
+	}
+	return parts
+}
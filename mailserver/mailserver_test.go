@@ -55,12 +55,22 @@ func TestMailserverSuite(t *testing.T) {
 	suite.Run(t, new(MailserverSuite))
 }
 
+func TestMailserverSQLiteSuite(t *testing.T) {
+	suite.Run(t, &MailserverSuite{
+		databaseConfig: params.DatabaseConfig{
+			SQLiteConfig: params.SQLiteConfig{Enabled: true},
+		},
+	})
+}
+
 type MailserverSuite struct {
 	suite.Suite
 	server  *WakuMailServer
 	shh     *waku.Waku
 	config  *params.WakuConfig
 	dataDir string
+	// databaseConfig selects the database backend the server is tested with, LevelDB by default
+	databaseConfig params.DatabaseConfig
 }
 
 func (s *MailserverSuite) SetupTest() {
@@ -74,6 +84,7 @@ func (s *MailserverSuite) SetupTest() {
 	s.config = &params.WakuConfig{
 		DataDir:            tmpDir,
 		MailServerPassword: "testpassword",
+		DatabaseConfig:     s.databaseConfig,
 	}
 }
 
@@ -105,6 +116,29 @@ func (s *MailserverSuite) TestInit() {
 			expectedError: nil,
 			info:          "config with rate limit",
 		},
+		{
+			config: params.WakuConfig{
+				DataDir:            s.config.DataDir,
+				MailServerPassword: "pwd",
+				DatabaseConfig: params.DatabaseConfig{
+					SQLiteConfig: params.SQLiteConfig{Enabled: true},
+				},
+			},
+			expectedError: nil,
+			info:          "config with sqlite database",
+		},
+		{
+			config: params.WakuConfig{
+				DataDir:            s.config.DataDir,
+				MailServerPassword: "pwd",
+				DatabaseConfig: params.DatabaseConfig{
+					PGConfig:     params.PGConfig{Enabled: true},
+					SQLiteConfig: params.SQLiteConfig{Enabled: true},
+				},
+			},
+			expectedError: errMultipleDatabasesEnabled,
+			info:          "config with several databases",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -167,6 +201,32 @@ func (s *MailserverSuite) TestManageLimits() {
 	s.Equal(firstSaved, s.server.ms.rateLimiter.db["peerID"])
 }
 
+func (s *MailserverSuite) TestPeerQuota() {
+	err := s.server.Init(s.shh, s.config)
+	s.NoError(err)
+	defer s.server.Close()
+
+	peerID := types.BytesToHash([]byte("peerID"))
+
+	// no quota configured
+	limit, maxBytes, reason := s.server.ms.peerQuotaRemaining(peerID, 100)
+	s.Equal(uint32(100), limit)
+	s.Equal(int64(0), maxBytes)
+	s.Empty(reason)
+
+	s.server.ms.setupQuota(10, 1000, time.Hour)
+
+	limit, maxBytes, reason = s.server.ms.peerQuotaRemaining(peerID, 100)
+	s.Equal(uint32(10), limit)
+	s.Equal(int64(1000), maxBytes)
+	s.Empty(reason)
+
+	s.server.ms.consumePeerQuota(peerID, []rlp.RawValue{make([]byte, 600), make([]byte, 400)})
+
+	_, _, reason = s.server.ms.peerQuotaRemaining(peerID, 100)
+	s.Equal("bytes_quota", reason)
+}
+
 func (s *MailserverSuite) TestDBKey() {
 	var h types.Hash
 	var emptyTopic types.TopicType
@@ -419,7 +479,7 @@ func (s *MailserverSuite) TestProcessRequestDeadlockHandling() {
 				processFinished := make(chan struct{})
 
 				go func() {
-					s.server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), timeout, "req-01", bundles, done)
+					s.server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), 0, timeout, "req-01", bundles, done)
 					close(processFinished)
 				}()
 				go close(done)
@@ -443,7 +503,7 @@ func (s *MailserverSuite) TestProcessRequestDeadlockHandling() {
 				processFinished := make(chan struct{})
 
 				go func() {
-					s.server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), time.Second, "req-01", bundles, done)
+					s.server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), 0, time.Second, "req-01", bundles, done)
 					close(processFinished)
 				}()
 
@@ -497,6 +557,7 @@ func (s *MailserverSuite) setupServer(server *WakuMailServer) {
 		DataDir:            s.dataDir,
 		MailServerPassword: password,
 		MinimumPoW:         powRequirement,
+		DatabaseConfig:     s.databaseConfig,
 	})
 	if err != nil {
 		s.T().Fatal(err)
@@ -643,7 +704,7 @@ func processRequestAndCollectHashes(server *WakuMailServer, payload MessagesRequ
 		close(done)
 	}()
 
-	cursor, lastHash := server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), time.Minute, "req-01", bundles, done)
+	cursor, lastHash := server.ms.processRequestInBundles(iter, payload.Bloom, payload.Topics, int(payload.Limit), 0, time.Minute, "req-01", bundles, done)
 
 	<-done
 
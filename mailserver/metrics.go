@@ -64,6 +64,18 @@ var (
 		Name: "mailserver_envelope_queries_total",
 		Help: "Number of queries for envelopes in the DB.",
 	}, []string{"filter", "history"})
+	throttledRequestsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "mailserver_throttled_requests_total",
+		Help: "Number of requests rejected or truncated because of peer quotas or a full queue.",
+	}, []string{"reason"})
+	queuedRequestsGauge = prom.NewGauge(prom.GaugeOpts{
+		Name: "mailserver_queued_requests",
+		Help: "Number of requests waiting to be processed.",
+	})
+	requestQueueDuration = prom.NewHistogram(prom.HistogramOpts{
+		Name: "mailserver_request_queue_duration_seconds",
+		Help: "The time requests waited to be processed.",
+	})
 )
 
 func init() {
@@ -81,4 +93,7 @@ func init() {
 	prom.MustRegister(archivedEnvelopesGauge)
 	prom.MustRegister(archivedEnvelopeSizeMeter)
 	prom.MustRegister(envelopeQueriesCounter)
+	prom.MustRegister(throttledRequestsCounter)
+	prom.MustRegister(queuedRequestsGauge)
+	prom.MustRegister(requestQueueDuration)
 }
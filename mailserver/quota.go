@@ -0,0 +1,123 @@
+package mailserver
+
+import (
+	"sync"
+	"time"
+)
+
+// quotaUsage is what was delivered to a peer since the start of the current window
+type quotaUsage struct {
+	windowStart time.Time
+	envelopes   int
+	bytes       int64
+}
+
+// peerQuota limits the number of envelopes and bytes delivered to each peer
+// per time window. A zero limit is not enforced.
+type peerQuota struct {
+	sync.RWMutex
+
+	envelopes int
+	bytes     int64
+	window    time.Duration
+	db        map[string]*quotaUsage
+
+	period time.Duration
+	cancel chan struct{}
+}
+
+func newPeerQuota(envelopes int, bytes int64, window time.Duration) *peerQuota {
+	return &peerQuota{
+		envelopes: envelopes,
+		bytes:     bytes,
+		window:    window,
+		db:        make(map[string]*quotaUsage),
+		period:    time.Minute,
+	}
+}
+
+func (q *peerQuota) Start() {
+	cancel := make(chan struct{})
+
+	q.Lock()
+	q.cancel = cancel
+	q.Unlock()
+
+	go q.cleanUp(q.period, cancel)
+}
+
+func (q *peerQuota) Stop() {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.cancel == nil {
+		return
+	}
+	close(q.cancel)
+	q.cancel = nil
+}
+
+// Remaining returns how many envelopes and bytes can still be delivered to a peer
+// in the current window, 0 meaning no limit. If a quota is exhausted, reason names it.
+func (q *peerQuota) Remaining(id string, now time.Time) (envelopes int, bytes int64, reason string) {
+	q.RLock()
+	defer q.RUnlock()
+
+	var usage quotaUsage
+	if u, ok := q.db[id]; ok && now.Before(u.windowStart.Add(q.window)) {
+		usage = *u
+	}
+
+	if q.envelopes > 0 {
+		envelopes = q.envelopes - usage.envelopes
+		if envelopes <= 0 {
+			return 0, 0, "envelopes_quota"
+		}
+	}
+	if q.bytes > 0 {
+		bytes = q.bytes - usage.bytes
+		if bytes <= 0 {
+			return 0, 0, "bytes_quota"
+		}
+	}
+	return envelopes, bytes, ""
+}
+
+// Consume records envelopes delivered to a peer
+func (q *peerQuota) Consume(id string, envelopes int, bytes int64, now time.Time) {
+	q.Lock()
+	defer q.Unlock()
+
+	usage, ok := q.db[id]
+	if !ok || !now.Before(usage.windowStart.Add(q.window)) {
+		usage = &quotaUsage{windowStart: now}
+		q.db[id] = usage
+	}
+	usage.envelopes += envelopes
+	usage.bytes += bytes
+}
+
+func (q *peerQuota) cleanUp(period time.Duration, cancel <-chan struct{}) {
+	t := time.NewTicker(period)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			q.deleteExpired(time.Now())
+		case <-cancel:
+			return
+		}
+	}
+}
+
+func (q *peerQuota) deleteExpired(now time.Time) {
+	q.Lock()
+	defer q.Unlock()
+
+	for id, usage := range q.db {
+		if !now.Before(usage.windowStart.Add(q.window)) {
+			delete(q.db, id)
+		}
+	}
+}
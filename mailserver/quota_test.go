@@ -0,0 +1,64 @@
+package mailserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerQuota(t *testing.T) {
+	now := time.Now()
+	q := newPeerQuota(10, 1000, time.Minute)
+
+	envelopes, bytes, reason := q.Remaining("peer", now)
+	require.Equal(t, 10, envelopes)
+	require.Equal(t, int64(1000), bytes)
+	require.Empty(t, reason)
+
+	q.Consume("peer", 4, 100, now)
+	envelopes, bytes, reason = q.Remaining("peer", now.Add(time.Second))
+	require.Equal(t, 6, envelopes)
+	require.Equal(t, int64(900), bytes)
+	require.Empty(t, reason)
+
+	// Other peers have their own quota
+	envelopes, _, _ = q.Remaining("other", now)
+	require.Equal(t, 10, envelopes)
+
+	q.Consume("peer", 6, 100, now.Add(time.Second))
+	_, _, reason = q.Remaining("peer", now.Add(time.Second))
+	require.Equal(t, "envelopes_quota", reason)
+
+	// The quota is restored once the window is over
+	envelopes, _, reason = q.Remaining("peer", now.Add(time.Minute))
+	require.Equal(t, 10, envelopes)
+	require.Empty(t, reason)
+}
+
+func TestPeerQuotaBytesOnly(t *testing.T) {
+	now := time.Now()
+	q := newPeerQuota(0, 1000, time.Minute)
+
+	q.Consume("peer", 100, 999, now)
+	envelopes, bytes, reason := q.Remaining("peer", now)
+	require.Equal(t, 0, envelopes)
+	require.Equal(t, int64(1), bytes)
+	require.Empty(t, reason)
+
+	q.Consume("peer", 1, 1, now)
+	_, _, reason = q.Remaining("peer", now)
+	require.Equal(t, "bytes_quota", reason)
+}
+
+func TestRemoveExpiredQuotas(t *testing.T) {
+	now := time.Now()
+	q := newPeerQuota(10, 0, time.Minute)
+
+	q.Consume("expired", 1, 1, now.Add(-2*time.Minute))
+	q.Consume("current", 1, 1, now.Add(-time.Second))
+
+	q.deleteExpired(now)
+	require.NotContains(t, q.db, "expired")
+	require.Contains(t, q.db, "current")
+}
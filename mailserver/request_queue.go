@@ -0,0 +1,174 @@
+package mailserver
+
+import (
+	"container/heap"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// maxQueuedRequests is the number of requests waiting for a slot above which new ones are rejected
+	maxQueuedRequests = 1000
+	// maxQueueWait is how long a request can wait for a slot
+	maxQueueWait = processRequestTimeout
+)
+
+var (
+	errRequestQueueFull    = errors.New("too many requests queued")
+	errRequestQueueTimeout = errors.New("request queue timeout")
+)
+
+// requestCost estimates how expensive serving a request is, in hours of history
+// to scan weighted by the number of topics. Older history weighs more, so that
+// small and recent queries are served first.
+func requestCost(req MessagesRequestPayload, now time.Time) float64 {
+	hours := float64(req.Upper-req.Lower)/3600 + 1
+	topics := len(req.Topics)
+	if topics == 0 {
+		topics = 1
+	}
+	ageDays := 0.0
+	if upper := int64(req.Upper); upper < now.Unix() {
+		ageDays = float64(now.Unix()-upper) / (24 * 3600)
+	}
+	return hours * float64(topics) * (1 + ageDays)
+}
+
+type queuedRequest struct {
+	peerID   string
+	tag      float64
+	sequence uint64
+	index    int
+	ready    chan struct{}
+}
+
+type queuedRequests []*queuedRequest
+
+func (r queuedRequests) Len() int { return len(r) }
+
+func (r queuedRequests) Less(i, j int) bool {
+	if r[i].tag == r[j].tag {
+		return r[i].sequence < r[j].sequence
+	}
+	return r[i].tag < r[j].tag
+}
+
+func (r queuedRequests) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+	r[i].index = i
+	r[j].index = j
+}
+
+func (r *queuedRequests) Push(x interface{}) {
+	request := x.(*queuedRequest)
+	request.index = len(*r)
+	*r = append(*r, request)
+}
+
+func (r *queuedRequests) Pop() interface{} {
+	old := *r
+	n := len(old)
+	request := old[n-1]
+	old[n-1] = nil
+	request.index = -1
+	*r = old[:n-1]
+	return request
+}
+
+// requestQueue limits the number of requests processed at the same time and
+// shares the slots fairly between peers. Each request is tagged with the
+// cost of the requests its peer already queued plus its own cost, and the
+// lowest tag is served first, so that peers sending many or expensive
+// requests are interleaved with the others instead of monopolising the server.
+type requestQueue struct {
+	sync.Mutex
+
+	slots    int
+	running  int
+	queue    queuedRequests
+	sequence uint64
+	// virtual time, the tag of the latest dispatched request
+	vtime float64
+	// tag of the latest request queued by each peer
+	lastTags map[string]float64
+}
+
+func newRequestQueue(slots int) *requestQueue {
+	return &requestQueue{
+		slots:    slots,
+		lastTags: make(map[string]float64),
+	}
+}
+
+// Acquire waits for a slot to process a request of the given cost and
+// returns the function releasing it.
+func (q *requestQueue) Acquire(peerID string, cost float64, timeout time.Duration) (func(), error) {
+	q.Lock()
+	if q.running < q.slots && len(q.queue) == 0 {
+		q.running++
+		q.Unlock()
+		return q.release, nil
+	}
+
+	if len(q.queue) >= maxQueuedRequests {
+		q.Unlock()
+		return nil, errRequestQueueFull
+	}
+
+	start := q.vtime
+	if last, ok := q.lastTags[peerID]; ok && last > start {
+		start = last
+	}
+	request := &queuedRequest{
+		peerID:   peerID,
+		tag:      start + cost,
+		sequence: q.sequence,
+		ready:    make(chan struct{}),
+	}
+	q.sequence++
+	q.lastTags[peerID] = request.tag
+	heap.Push(&q.queue, request)
+	queuedRequestsGauge.Set(float64(len(q.queue)))
+	q.Unlock()
+
+	queuedAt := time.Now()
+	defer func() { requestQueueDuration.Observe(time.Since(queuedAt).Seconds()) }()
+
+	select {
+	case <-request.ready:
+		return q.release, nil
+	case <-time.After(timeout):
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	if request.index < 0 {
+		// Dispatched while timing out, the slot is ours
+		return q.release, nil
+	}
+	heap.Remove(&q.queue, request.index)
+	queuedRequestsGauge.Set(float64(len(q.queue)))
+	return nil, errRequestQueueTimeout
+}
+
+func (q *requestQueue) release() {
+	q.Lock()
+	defer q.Unlock()
+
+	q.running--
+	for q.running < q.slots && len(q.queue) > 0 {
+		request := heap.Pop(&q.queue).(*queuedRequest)
+		q.vtime = request.tag
+		q.running++
+		close(request.ready)
+	}
+	queuedRequestsGauge.Set(float64(len(q.queue)))
+
+	// Peers which are not ahead of the virtual time start again from it
+	for peerID, tag := range q.lastTags {
+		if tag <= q.vtime {
+			delete(q.lastTags, peerID)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package mailserver
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCost(t *testing.T) {
+	now := time.Now()
+	upper := uint32(now.Unix())
+
+	recent := MessagesRequestPayload{Lower: upper - 3600, Upper: upper, Topics: [][]byte{{0x01}}}
+	wide := MessagesRequestPayload{Lower: upper - 24*3600, Upper: upper, Topics: [][]byte{{0x01}}}
+	old := MessagesRequestPayload{Lower: upper - 30*24*3600, Upper: upper - 29*24*3600, Topics: [][]byte{{0x01}}}
+	manyTopics := MessagesRequestPayload{Lower: upper - 3600, Upper: upper, Topics: [][]byte{{0x01}, {0x02}, {0x03}}}
+
+	require.Less(t, requestCost(recent, now), requestCost(wide, now))
+	require.Less(t, requestCost(wide, now), requestCost(old, now))
+	require.Less(t, requestCost(recent, now), requestCost(manyTopics, now))
+}
+
+func TestRequestQueueFairness(t *testing.T) {
+	q := newRequestQueue(1)
+
+	// Occupy the only slot so that the next requests are queued
+	release, err := q.Acquire("busy", 1, time.Second)
+	require.NoError(t, err)
+
+	var (
+		mu     sync.Mutex
+		order  []string
+		wg     sync.WaitGroup
+		queued int
+	)
+	enqueue := func(peerID string, cost float64) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := q.Acquire(peerID, cost, time.Second)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, peerID)
+			mu.Unlock()
+			release()
+		}()
+		// Wait for the request to be queued, so that they are queued in order
+		queued++
+		require.Eventually(t, func() bool {
+			q.Lock()
+			defer q.Unlock()
+			return len(q.queue) == queued
+		}, time.Second, time.Millisecond)
+	}
+
+	// A peer asking for a lot of history does not get in the way of small requests from other peers
+	enqueue("greedy", 10)
+	enqueue("greedy", 10)
+	enqueue("greedy", 10)
+	enqueue("small", 1)
+	enqueue("recent", 5)
+
+	release()
+	wg.Wait()
+
+	require.Equal(t, []string{"small", "recent", "greedy", "greedy", "greedy"}, order)
+}
+
+func TestRequestQueueTimeout(t *testing.T) {
+	q := newRequestQueue(1)
+
+	release, err := q.Acquire("busy", 1, time.Second)
+	require.NoError(t, err)
+
+	_, err = q.Acquire("peer", 1, 10*time.Millisecond)
+	require.Equal(t, errRequestQueueTimeout, err)
+	require.Len(t, q.queue, 0)
+
+	release()
+	release, err = q.Acquire("peer", 1, 10*time.Millisecond)
+	require.NoError(t, err)
+	release()
+	require.Equal(t, 0, q.running)
+}
@@ -331,6 +331,17 @@ var (
 		dBColumnName:   "send_push_notifications",
 		valueHandler:   BoolHandler,
 	}
+	SendReadReceipts = SettingField{
+		reactFieldName: "send-read-receipts?",
+		dBColumnName:   "send_read_receipts",
+		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     sendReadReceiptsProtobufFactory,
+			fromStruct:        sendReadReceiptsProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_SEND_READ_RECEIPTS,
+		},
+	}
 	SendStatusUpdates = SettingField{
 		reactFieldName: "send-status-updates?",
 		dBColumnName:   "send_status_updates",
@@ -344,6 +355,17 @@ var (
 			protobufType:      protobuf.SyncSetting_SEND_STATUS_UPDATES,
 		},
 	}
+	SendTypingIndicators = SettingField{
+		reactFieldName: "send-typing-indicators?",
+		dBColumnName:   "send_typing_indicators",
+		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     sendTypingIndicatorsProtobufFactory,
+			fromStruct:        sendTypingIndicatorsProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_SEND_TYPING_INDICATORS,
+		},
+	}
 	StickersPacksInstalled = SettingField{
 		reactFieldName: "stickers/packs-installed",
 		dBColumnName:   "stickers_packs_installed",
@@ -506,7 +528,9 @@ var (
 		RememberSyncingChoice,
 		RemotePushNotificationsEnabled,
 		SendPushNotifications,
+		SendReadReceipts,
 		SendStatusUpdates,
+		SendTypingIndicators,
 		StickersPacksInstalled,
 		StickersPacksPending,
 		StickersRecentStickers,
@@ -191,6 +191,8 @@ type Settings struct {
 	GifAPIKey                      string                        `json:"gifs/api-key"`
 	TestNetworksEnabled            bool                          `json:"test-networks-enabled?,omitempty"`
 	IncludeWatchOnlyAccount        bool                          `json:"include-watch-only-account?,omitempty"`
+	SendTypingIndicators           bool                          `json:"send-typing-indicators?,omitempty"`
+	SendReadReceipts               bool                          `json:"send-read-receipts?,omitempty"`
 }
 
 func (s Settings) MarshalJSON() ([]byte, error) {
@@ -565,3 +565,53 @@ func includeWatchOnlyAccountProtobufFactory(value interface{}, clock uint64, cha
 func includeWatchOnlyAccountProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
 	return buildRawIncludeWatchOnlyAccountSyncMessage(s.IncludeWatchOnlyAccount, clock, chatID)
 }
+
+// SendTypingIndicators
+
+func buildRawSendTypingIndicatorsSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_SEND_TYPING_INDICATORS,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func sendTypingIndicatorsProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawSendTypingIndicatorsSyncMessage(v, clock, chatID)
+}
+
+func sendTypingIndicatorsProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawSendTypingIndicatorsSyncMessage(s.SendTypingIndicators, clock, chatID)
+}
+
+// SendReadReceipts
+
+func buildRawSendReadReceiptsSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_SEND_READ_RECEIPTS,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func sendReadReceiptsProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawSendReadReceiptsSyncMessage(v, clock, chatID)
+}
+
+func sendReadReceiptsProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawSendReadReceiptsSyncMessage(s.SendReadReceipts, clock, chatID)
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/status-im/status-go/services/wakuext"
 	"github.com/status-im/status-go/services/wakuv2ext"
 	"github.com/status-im/status-go/services/wallet"
+	"github.com/status-im/status-go/services/wallet/addressbook"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/web3provider"
 	"github.com/status-im/status-go/timesource"
@@ -119,6 +120,7 @@ func (b *StatusNode) initServices(config *params.NodeConfig, mediaServer *server
 		services = append(services, wakuext)
 
 		b.SetWalletNFTMetadataProvider(wakuext)
+		b.SetWalletContactsProvider(wakuext)
 	}
 
 	if config.WakuV2Config.Enabled {
@@ -146,6 +148,7 @@ func (b *StatusNode) initServices(config *params.NodeConfig, mediaServer *server
 		services = append(services, wakuext)
 
 		b.SetWalletNFTMetadataProvider(wakuext)
+		b.SetWalletContactsProvider(wakuext)
 	}
 
 	// We ignore for now local notifications flag as users who are upgrading have no mean to enable it
@@ -493,6 +496,12 @@ func (b *StatusNode) SetWalletNFTMetadataProvider(provider thirdparty.NFTMetadat
 	}
 }
 
+func (b *StatusNode) SetWalletContactsProvider(provider addressbook.ContactsProvider) {
+	if b.walletSrvc != nil {
+		b.walletSrvc.SetContactsProvider(provider)
+	}
+}
+
 func (b *StatusNode) walletService(accountsDB *accounts.Database, accountsFeed *event.Feed) *wallet.Service {
 	if b.walletSrvc == nil {
 		b.walletSrvc = wallet.NewService(
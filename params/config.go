@@ -53,7 +53,8 @@ type LightEthConfig struct {
 // ----------
 
 type DatabaseConfig struct {
-	PGConfig PGConfig
+	PGConfig     PGConfig
+	SQLiteConfig SQLiteConfig
 }
 
 // ----------
@@ -67,6 +68,17 @@ type PGConfig struct {
 	URI string
 }
 
+// ----------
+// SQLiteConfig
+// ----------
+
+type SQLiteConfig struct {
+	// Enabled whether we should use a SQLite database
+	Enabled bool
+	// Path of the database file, defaults to a file in the data directory
+	Path string
+}
+
 // ----------
 // WakuConfig
 // ----------
@@ -103,6 +115,19 @@ type WakuConfig struct {
 	// MailServerDataRetention is a number of days data should be stored by MailServer.
 	MailServerDataRetention int
 
+	// MailServerPeerEnvelopesQuota is a maximum number of envelopes MailServer delivers to a peer per quota window.
+	MailServerPeerEnvelopesQuota int
+
+	// MailServerPeerBytesQuota is a maximum size in bytes of envelopes MailServer delivers to a peer per quota window.
+	MailServerPeerBytesQuota int64
+
+	// MailServerQuotaWindow is a number of seconds peer quotas apply to, an hour by default.
+	MailServerQuotaWindow int
+
+	// MailServerMaxConcurrentRequests is a maximum number of history requests MailServer processes
+	// at the same time, the others are queued fairly between peers.
+	MailServerMaxConcurrentRequests int
+
 	// TTL time to live for messages, in seconds
 	TTL int
 
@@ -497,6 +522,7 @@ type Network struct {
 	ChainName              string          `json:"chainName"`
 	RPCURL                 string          `json:"rpcUrl"`
 	FallbackURL            string          `json:"fallbackURL"`
+	AdditionalRPCURLs      []string        `json:"additionalRpcUrls,omitempty"`
 	BlockExplorerURL       string          `json:"blockExplorerUrl,omitempty"`
 	IconURL                string          `json:"iconUrl,omitempty"`
 	NativeCurrencyName     string          `json:"nativeCurrencyName,omitempty"`
@@ -6,6 +6,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/verification"
 )
 
@@ -28,6 +29,7 @@ const (
 	ActivityCenterNotificationTypeCommunityKicked
 	ActivityCenterNotificationTypeContactVerification
 	ActivityCenterNotificationTypeContactRemoved
+	ActivityCenterNotificationTypeCommunityModeration
 )
 
 type ActivityCenterMembershipStatus int
@@ -66,6 +68,8 @@ type ActivityCenterNotification struct {
 	Deleted                   bool                           `json:"deleted"`
 	Accepted                  bool                           `json:"accepted"`
 	ContactVerificationStatus verification.RequestStatus     `json:"contactVerificationStatus"`
+	// ModerationViolation is the community moderation rule Message broke, for community moderation notifications
+	ModerationViolation communities.ModerationViolation `json:"moderationViolation,omitempty"`
 	//Used for synchronization. Each update should increment the UpdatedAt.
 	//The value should represent the time when the update occurred.
 	UpdatedAt uint64 `json:"updatedAt"`
@@ -12,7 +12,7 @@ import (
 )
 
 const allFieldsForTableActivityCenterNotification = `id, timestamp, notification_type, chat_id, read, dismissed, accepted, message, author, 
-    reply_message, community_id, membership_status, contact_verification_status, deleted, updated_at`
+    reply_message, community_id, membership_status, contact_verification_status, deleted, updated_at, moderation_violation`
 
 func (db sqlitePersistence) DeleteActivityCenterNotificationByID(id []byte, updatedAt uint64) error {
 	_, err := db.db.Exec(`UPDATE activity_center_notifications SET deleted = 1, updated_at = ? WHERE id = ? AND NOT deleted`, updatedAt, id)
@@ -140,9 +140,10 @@ func (db sqlitePersistence) SaveActivityCenterNotification(notification *Activit
 			accepted,
 			dismissed,
 			deleted,
-		    updated_at
+		    updated_at,
+			moderation_violation
 		)
-		SELECT ?,?,?,?,?,?,?,?,?,?,?,?,?,?,? WHERE NOT EXISTS (SELECT 1 FROM activity_center_notifications WHERE id = ? AND updated_at >= ?)
+		SELECT ?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,? WHERE NOT EXISTS (SELECT 1 FROM activity_center_notifications WHERE id = ? AND updated_at >= ?)
 		`,
 		notification.ID,
 		notification.Timestamp,
@@ -159,6 +160,7 @@ func (db sqlitePersistence) SaveActivityCenterNotification(notification *Activit
 		notification.Dismissed,
 		notification.Deleted,
 		notification.UpdatedAt,
+		notification.ModerationViolation,
 		notification.ID,
 		notification.UpdatedAt,
 	)
@@ -204,6 +206,7 @@ func (db sqlitePersistence) parseRowFromTableActivityCenterNotification(rows *sq
 			&notification.ContactVerificationStatus,
 			&notification.Deleted,
 			&notification.UpdatedAt,
+			&notification.ModerationViolation,
 		)
 		if err != nil {
 			return nil, err
@@ -270,7 +273,8 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRow(row *sql.Row)
 		&notification.ContactVerificationStatus,
 		&name,
 		&author,
-		&notification.UpdatedAt)
+		&notification.UpdatedAt,
+		&notification.ModerationViolation)
 
 	if err != nil {
 		return nil, err
@@ -351,7 +355,8 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRows(rows *sql.Ro
 			&name,
 			&author,
 			&latestCursor,
-			&notification.UpdatedAt)
+			&notification.UpdatedAt,
+			&notification.ModerationViolation)
 		if err != nil {
 			return "", nil, err
 		}
@@ -503,7 +508,8 @@ func (db sqlitePersistence) buildActivityCenterQuery(tx *sql.Tx, params activity
 	c.name,
 	a.author,
 	substr('0000000000000000000000000000000000000000000000000000000000000000' || a.timestamp, -64, 64) || hex(a.id) as cursor,
-	a.updated_at
+	a.updated_at,
+	a.moderation_violation
 	FROM activity_center_notifications a
 	LEFT JOIN chats c
 	ON
@@ -645,7 +651,8 @@ func (db sqlitePersistence) GetActivityCenterNotificationByID(id types.HexBytes)
 		a.contact_verification_status,
 		c.name,
 		a.author,
-		a.updated_at
+		a.updated_at,
+		a.moderation_violation
 		FROM activity_center_notifications a
 		LEFT JOIN chats c
 		ON
@@ -1074,7 +1081,8 @@ func (db sqlitePersistence) ActiveContactRequestNotification(contactID string) (
 			a.contact_verification_status,
 			c.name,
 			a.author,
-			a.updated_at
+			a.updated_at,
+			a.moderation_violation
 		FROM activity_center_notifications a
 		LEFT JOIN chats c ON c.id = a.chat_id
 		WHERE a.author = ? 
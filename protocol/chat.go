@@ -147,6 +147,13 @@ type Chat struct {
 
 	// Image of the chat in Base64 format
 	Base64Image string `json:"image,omitempty"`
+
+	// DisappearingMessagesTimer is the number of seconds after which new messages
+	// in the chat are deleted, 0 means messages don't disappear
+	DisappearingMessagesTimer uint64 `json:"disappearingMessagesTimer,omitempty"`
+	// DisappearingMessagesClock is the clock value of the last change of the timer,
+	// only messages with a greater or equal clock value disappear
+	DisappearingMessagesClock uint64 `json:"disappearingMessagesClock,omitempty"`
 }
 
 type ChatPreview struct {
@@ -0,0 +1,147 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+
+	accountJson "github.com/status-im/status-go/account/json"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// TypingIndicator represents whether a user is composing a message in a chat.
+// It is not persisted, the latest one received from each user is relevant
+type TypingIndicator struct {
+	protobuf.TypingIndicator
+
+	// From is a public key of the user typing
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the user typing
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// LocalChatID is the chatID of the local chat (one-to-one are not symmetric)
+	LocalChatID string `json:"localChatId"`
+
+	// Timestamp is the whisper timestamp of the indicator
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (t TypingIndicator) GetSigPubKey() *ecdsa.PublicKey {
+	return t.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (t TypingIndicator) GetProtobuf() proto.Message {
+	return &t.TypingIndicator
+}
+
+// GetGrant returns nil, typing indicators are not sent to communities
+// this function is required to implement the ChatEntity interface
+func (t TypingIndicator) GetGrant() []byte {
+	return nil
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (t *TypingIndicator) SetMessageType(messageType protobuf.MessageType) {
+	t.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (t TypingIndicator) WrapGroupMessage() bool {
+	return false
+}
+
+func (t TypingIndicator) MarshalJSON() ([]byte, error) {
+	item := struct {
+		Clock       uint64 `json:"clock,omitempty"`
+		LocalChatID string `json:"localChatId,omitempty"`
+		From        string `json:"from"`
+		Typing      bool   `json:"typing"`
+		Timestamp   uint64 `json:"timestamp,omitempty"`
+	}{
+		Clock:       t.Clock,
+		LocalChatID: t.LocalChatID,
+		From:        t.From,
+		Typing:      t.Typing,
+		Timestamp:   t.Timestamp,
+	}
+
+	ext, err := accountJson.ExtendStructWithPubKeyData(item.From, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ext)
+}
+
+// ReadReceipt notifies the sender of messages that they have been read
+type ReadReceipt struct {
+	protobuf.ReadReceipt
+
+	// From is a public key of the reader
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the reader
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// LocalChatID is the chatID of the local chat (one-to-one are not symmetric)
+	LocalChatID string `json:"localChatId"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (r ReadReceipt) GetSigPubKey() *ecdsa.PublicKey {
+	return r.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (r ReadReceipt) GetProtobuf() proto.Message {
+	return &r.ReadReceipt
+}
+
+// GetGrant returns nil, read receipts are not sent to communities
+// this function is required to implement the ChatEntity interface
+func (r ReadReceipt) GetGrant() []byte {
+	return nil
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (r *ReadReceipt) SetMessageType(messageType protobuf.MessageType) {
+	r.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (r ReadReceipt) WrapGroupMessage() bool {
+	return false
+}
+
+func (r ReadReceipt) MarshalJSON() ([]byte, error) {
+	item := struct {
+		Clock       uint64   `json:"clock,omitempty"`
+		LocalChatID string   `json:"localChatId,omitempty"`
+		From        string   `json:"from"`
+		MessageIDs  []string `json:"messageIds"`
+		ReadClock   uint64   `json:"readClock,omitempty"`
+	}{
+		Clock:       r.Clock,
+		LocalChatID: r.LocalChatID,
+		From:        r.From,
+		MessageIDs:  r.MessageIds,
+		ReadClock:   r.ReadClock,
+	}
+
+	ext, err := accountJson.ExtendStructWithPubKeyData(item.From, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ext)
+}
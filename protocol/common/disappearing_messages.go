@@ -0,0 +1,44 @@
+package common
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type DisappearingMessages struct {
+	protobuf.DisappearingMessages
+
+	// From is a public key of the user who changed the setting.
+	From      string           `json:"from"`
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (m *DisappearingMessages) WrapGroupMessage() bool {
+	return false
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (m *DisappearingMessages) SetMessageType(messageType protobuf.MessageType) {
+	m.MessageType = messageType
+}
+
+func (m *DisappearingMessages) GetGrant() []byte {
+	return nil
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (m *DisappearingMessages) GetProtobuf() proto.Message {
+	return &m.DisappearingMessages
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (m DisappearingMessages) GetSigPubKey() *ecdsa.PublicKey {
+	return m.SigPubKey
+}
@@ -129,6 +129,19 @@ type GapParameters struct {
 	To   uint32 `json:"to,omitempty"`
 }
 
+// ThreadSummary describes the replies to a message
+type ThreadSummary struct {
+	RepliesCount   uint64 `json:"repliesCount"`
+	LastReplyID    string `json:"lastReplyId,omitempty"`
+	LastReplyFrom  string `json:"lastReplyFrom,omitempty"`
+	LastReplyClock uint64 `json:"lastReplyClock,omitempty"`
+	// UnviewedRepliesCount and UnviewedMentionsCount are the number of
+	// replies not seen yet, and those among them mentioning or replying to us
+	UnviewedRepliesCount  uint64 `json:"unviewedRepliesCount"`
+	UnviewedMentionsCount uint64 `json:"unviewedMentionsCount"`
+	Muted                 bool   `json:"muted,omitempty"`
+}
+
 func (c *CommandParameters) IsTokenTransfer() bool {
 	return len(c.Contract) != 0
 }
@@ -137,6 +150,7 @@ const (
 	OutgoingStatusSending   = "sending"
 	OutgoingStatusSent      = "sent"
 	OutgoingStatusDelivered = "delivered"
+	OutgoingStatusRead      = "read"
 )
 
 type Messages []*Message
@@ -238,6 +252,13 @@ type Message struct {
 	ContactVerificationState ContactVerificationState `json:"contactVerificationState,omitempty"`
 
 	DiscordMessage *protobuf.DiscordMessage `json:"discordMessage,omitempty"`
+
+	// ThreadID is the id of the first message of the reply chain
+	// this message belongs to, empty if it's not a reply
+	ThreadID string `json:"threadId,omitempty"`
+
+	// Thread is set on messages that have replies
+	Thread *ThreadSummary `json:"thread,omitempty"`
 }
 
 func (m *Message) MarshalJSON() ([]byte, error) {
@@ -294,6 +315,9 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		ContactRequestState      ContactRequestState              `json:"contactRequestState,omitempty"`
 		ContactVerificationState ContactVerificationState         `json:"contactVerificationState,omitempty"`
 		DiscordMessage           *protobuf.DiscordMessage         `json:"discordMessage,omitempty"`
+		Poll                     *protobuf.PollMessage            `json:"poll,omitempty"`
+		ThreadID                 string                           `json:"threadId,omitempty"`
+		Thread                   *ThreadSummary                   `json:"thread,omitempty"`
 	}
 	item := MessageStructType{
 		ID:                       m.ID,
@@ -335,6 +359,8 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		DeletedForMe:             m.DeletedForMe,
 		ContactRequestState:      m.ContactRequestState,
 		ContactVerificationState: m.ContactVerificationState,
+		ThreadID:                 m.ThreadID,
+		Thread:                   m.Thread,
 	}
 
 	if sticker := m.GetSticker(); sticker != nil {
@@ -359,6 +385,10 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 	if discordMessage := m.GetDiscordMessage(); discordMessage != nil {
 		item.DiscordMessage = discordMessage
 	}
+
+	if poll := m.GetPoll(); poll != nil {
+		item.Poll = poll
+	}
 	if item.From != "" {
 		ext, err := accountJson.ExtendStructWithPubKeyData(item.From, item)
 		if err != nil {
@@ -390,6 +420,7 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		From             string                           `json:"from"`
 		Deleted          bool                             `json:"deleted,omitempty"`
 		DeletedForMe     bool                             `json:"deletedForMe,omitempty"`
+		Poll             *protobuf.PollMessage            `json:"poll"`
 	}{
 		Alias: (*Alias)(m),
 	}
@@ -415,6 +446,10 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if aux.ContentType == protobuf.ChatMessage_POLL {
+		m.Payload = &protobuf.ChatMessage_Poll{Poll: aux.Poll}
+	}
+
 	m.ResponseTo = aux.ResponseTo
 	m.EnsName = aux.EnsName
 	m.DisplayName = aux.DisplayName
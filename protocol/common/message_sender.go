@@ -425,7 +425,7 @@ func (s *MessageSender) sendPrivate(
 		}
 
 		messageIDs := [][]byte{messageID}
-		hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs)
+		hash, newMessage, err := s.dispatchMessageSpec(ctx, recipient, messageSpec, messageIDs, rawMessage.Ephemeral)
 		if err != nil {
 			s.logger.Error("failed to send a private message", zap.Error(err))
 			return nil, errors.Wrap(err, "failed to send a message spec")
@@ -865,6 +865,7 @@ func (s *MessageSender) sendPrivateRawMessage(ctx context.Context, rawMessage *R
 		Payload:   payload,
 		PowTarget: calculatePoW(payload),
 		PowTime:   whisperPoWTime,
+		Ephemeral: rawMessage.Ephemeral,
 	}
 	var hash []byte
 	var err error
@@ -901,10 +902,16 @@ func (s *MessageSender) dispatchCommunityMessage(ctx context.Context, publicKey
 
 // sendMessageSpec analyses the spec properties and selects a proper transport method.
 func (s *MessageSender) sendMessageSpec(ctx context.Context, publicKey *ecdsa.PublicKey, messageSpec *encryption.ProtocolMessageSpec, messageIDs [][]byte) ([]byte, *types.NewMessage, error) {
+	return s.dispatchMessageSpec(ctx, publicKey, messageSpec, messageIDs, false)
+}
+
+// dispatchMessageSpec is like sendMessageSpec, ephemeral messages are not stored by mailservers
+func (s *MessageSender) dispatchMessageSpec(ctx context.Context, publicKey *ecdsa.PublicKey, messageSpec *encryption.ProtocolMessageSpec, messageIDs [][]byte, ephemeral bool) ([]byte, *types.NewMessage, error) {
 	newMessage, err := MessageSpecToWhisper(messageSpec)
 	if err != nil {
 		return nil, nil, err
 	}
+	newMessage.Ephemeral = ephemeral
 
 	logger := s.logger.With(zap.String("site", "sendMessageSpec"))
 
@@ -0,0 +1,663 @@
+package communities
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrAuditLogTampered = errors.New("community audit log has been tampered with")
+
+// auditLogRetention is how long audit log entries are kept
+const auditLogRetention = 90 * 24 * time.Hour
+
+// AuditLogSource is the kind of message an audit log entry was recorded from
+type AuditLogSource string
+
+const (
+	// AuditLogSourceDescription entries record the changes made by a description signed by the control node
+	AuditLogSourceDescription AuditLogSource = "description"
+	// AuditLogSourceEvent entries record the changes made by an admin event
+	AuditLogSourceEvent AuditLogSource = "event"
+)
+
+type AuditLogStatus string
+
+const (
+	// AuditLogStatusApplied is the status of changes signed by the control node
+	AuditLogStatusApplied AuditLogStatus = "applied"
+	// AuditLogStatusPending is the status of admin events the control node hasn't signed a description over yet
+	AuditLogStatusPending AuditLogStatus = "pending"
+	// AuditLogStatusSuperseded is the status of admin events overwritten by a description signed by the control node,
+	// which may have kept or reverted them. The entry of that description records what was eventually applied
+	AuditLogStatusSuperseded AuditLogStatus = "superseded"
+)
+
+// AuditLogEntry is a change made to a community, chained to the previous entry of the community by its hash
+// so that entries can't be removed or reordered without it being detected
+type AuditLogEntry struct {
+	Seq         uint64         `json:"seq"`
+	CommunityID types.HexBytes `json:"communityId"`
+	// Signer is the public key of the control node for descriptions, and of the admin whose message
+	// delivered the event first for events
+	Signer    string                            `json:"signer"`
+	Source    AuditLogSource                    `json:"source"`
+	EventType protobuf.CommunityEvent_EventType `json:"eventType,omitempty"`
+	EventID   string                            `json:"eventId,omitempty"`
+	// Clock is the clock of the description, or of the event
+	Clock uint64 `json:"clock"`
+	// BaseClock is the clock of the description the change was made on
+	BaseClock uint64        `json:"baseClock"`
+	Changes   *AuditChanges `json:"changes"`
+	// Timestamp is the time in seconds at which the entry was recorded
+	Timestamp uint64 `json:"timestamp"`
+	// MessageHash is the hash of the description signed by the control node, or of the events message signed
+	// by the admin, the entry was recorded from
+	MessageHash types.HexBytes `json:"messageHash"`
+	PrevHash    types.HexBytes `json:"prevHash,omitempty"`
+	Hash        types.HexBytes `json:"hash"`
+	// Status and SupersededByClock change as descriptions are received and aren't part of the hash
+	Status            AuditLogStatus `json:"status"`
+	SupersededByClock uint64         `json:"supersededByClock,omitempty"`
+
+	// rawChanges are the changes as hashed and stored
+	rawChanges []byte
+}
+
+// AuditLogPage is a page of the audit log of a community, newest entries first
+type AuditLogPage struct {
+	Entries []*AuditLogEntry `json:"entries"`
+	// Cursor is the cursor of the next page, empty when there are no older entries
+	Cursor string `json:"cursor"`
+}
+
+type AuditChatChanges struct {
+	MembersAdded     []string `json:"membersAdded,omitempty"`
+	MembersRemoved   []string `json:"membersRemoved,omitempty"`
+	CategoryModified string   `json:"categoryModified,omitempty"`
+	Edited           bool     `json:"edited,omitempty"`
+}
+
+// AuditTokenPermissionChange is a token permission before and after it was modified
+type AuditTokenPermissionChange struct {
+	Before *protobuf.CommunityTokenPermission `json:"before"`
+	After  *protobuf.CommunityTokenPermission `json:"after"`
+}
+
+// AuditCategoryChange is a category before and after it was modified
+type AuditCategoryChange struct {
+	Before *protobuf.CommunityCategory `json:"before"`
+	After  *protobuf.CommunityCategory `json:"after"`
+}
+
+// AuditSettingChange is the value of a community setting before and after it was modified
+type AuditSettingChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// AuditChanges are the changes made to a community description, as recorded in the audit log.
+// Members are only recorded by public key, their revealed accounts are left out
+type AuditChanges struct {
+	MembersAdded    []string `json:"membersAdded,omitempty"`
+	MembersRemoved  []string `json:"membersRemoved,omitempty"`
+	MembersBanned   []string `json:"membersBanned,omitempty"`
+	MembersUnbanned []string `json:"membersUnbanned,omitempty"`
+
+	TokenPermissionsAdded    map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissionsAdded,omitempty"`
+	TokenPermissionsModified map[string]*AuditTokenPermissionChange        `json:"tokenPermissionsModified,omitempty"`
+	TokenPermissionsRemoved  []string                                      `json:"tokenPermissionsRemoved,omitempty"`
+
+	ChatsAdded    map[string]*protobuf.ChatIdentity `json:"chatsAdded,omitempty"`
+	ChatsRemoved  []string                          `json:"chatsRemoved,omitempty"`
+	ChatsModified map[string]*AuditChatChanges      `json:"chatsModified,omitempty"`
+
+	CategoriesAdded    map[string]*protobuf.CommunityCategory `json:"categoriesAdded,omitempty"`
+	CategoriesModified map[string]*AuditCategoryChange        `json:"categoriesModified,omitempty"`
+	CategoriesRemoved  []string                               `json:"categoriesRemoved,omitempty"`
+
+	// SettingsModified are the community settings that were modified, by name
+	SettingsModified map[string]*AuditSettingChange `json:"settingsModified,omitempty"`
+}
+
+func newAuditChanges(origin, modified *protobuf.CommunityDescription) (*AuditChanges, error) {
+	// EvaluateCommunityChanges initializes the maps of the descriptions
+	origin = proto.Clone(origin).(*protobuf.CommunityDescription)
+	modified = proto.Clone(modified).(*protobuf.CommunityDescription)
+	changes := EvaluateCommunityChanges(origin, modified)
+
+	auditChanges := &AuditChanges{
+		MembersAdded:      sortedKeys(changes.MembersAdded),
+		MembersRemoved:    sortedKeys(changes.MembersRemoved),
+		MembersBanned:     sortedStrings(changes.MembersBanned),
+		MembersUnbanned:   sortedStrings(changes.MembersUnbanned),
+		CategoriesRemoved: sortedStrings(changes.CategoriesRemoved),
+		ChatsRemoved:      sortedKeys(changes.ChatsRemoved),
+	}
+
+	for id, permission := range changes.TokenPermissionsAdded {
+		if auditChanges.TokenPermissionsAdded == nil {
+			auditChanges.TokenPermissionsAdded = make(map[string]*protobuf.CommunityTokenPermission)
+		}
+		auditChanges.TokenPermissionsAdded[id] = permission
+	}
+	auditChanges.TokenPermissionsRemoved = sortedKeys(changes.TokenPermissionsRemoved)
+	for id, permission := range modified.TokenPermissions {
+		if previous, ok := origin.TokenPermissions[id]; ok && !proto.Equal(previous, permission) {
+			if auditChanges.TokenPermissionsModified == nil {
+				auditChanges.TokenPermissionsModified = make(map[string]*AuditTokenPermissionChange)
+			}
+			auditChanges.TokenPermissionsModified[id] = &AuditTokenPermissionChange{Before: previous, After: permission}
+		}
+	}
+
+	for id, chat := range changes.ChatsAdded {
+		if auditChanges.ChatsAdded == nil {
+			auditChanges.ChatsAdded = make(map[string]*protobuf.ChatIdentity)
+		}
+		auditChanges.ChatsAdded[id] = chat.Identity
+	}
+	for id, chat := range modified.Chats {
+		previous, ok := origin.Chats[id]
+		if !ok {
+			continue
+		}
+		chatChanges := &AuditChatChanges{
+			Edited: !proto.Equal(previous.Identity, chat.Identity) || !proto.Equal(previous.Permissions, chat.Permissions) ||
+				previous.SlowModeSeconds != chat.SlowModeSeconds,
+		}
+		if c, ok := changes.ChatsModified[id]; ok {
+			chatChanges.MembersAdded = sortedKeys(c.MembersAdded)
+			chatChanges.MembersRemoved = sortedKeys(c.MembersRemoved)
+			chatChanges.CategoryModified = c.CategoryModified
+		}
+		if !chatChanges.Edited && len(chatChanges.MembersAdded) == 0 && len(chatChanges.MembersRemoved) == 0 && chatChanges.CategoryModified == "" {
+			continue
+		}
+		if auditChanges.ChatsModified == nil {
+			auditChanges.ChatsModified = make(map[string]*AuditChatChanges)
+		}
+		auditChanges.ChatsModified[id] = chatChanges
+	}
+
+	for id, category := range changes.CategoriesAdded {
+		if auditChanges.CategoriesAdded == nil {
+			auditChanges.CategoriesAdded = make(map[string]*protobuf.CommunityCategory)
+		}
+		auditChanges.CategoriesAdded[id] = category
+	}
+	for id, category := range changes.CategoriesModified {
+		if auditChanges.CategoriesModified == nil {
+			auditChanges.CategoriesModified = make(map[string]*AuditCategoryChange)
+		}
+		auditChanges.CategoriesModified[id] = &AuditCategoryChange{Before: origin.Categories[id], After: category}
+	}
+
+	settings := []struct {
+		name          string
+		modified      bool
+		before, after interface{}
+	}{
+		{"identity", !proto.Equal(origin.Identity, modified.Identity), origin.Identity, modified.Identity},
+		{"permissions", !proto.Equal(origin.Permissions, modified.Permissions), origin.Permissions, modified.Permissions},
+		{"adminSettings", !proto.Equal(origin.AdminSettings, modified.AdminSettings), origin.AdminSettings, modified.AdminSettings},
+		{"introMessage", origin.IntroMessage != modified.IntroMessage, origin.IntroMessage, modified.IntroMessage},
+		{"outroMessage", origin.OutroMessage != modified.OutroMessage, origin.OutroMessage, modified.OutroMessage},
+		{"tags", !equalStrings(origin.Tags, modified.Tags), origin.Tags, modified.Tags},
+		{"moderationRules", !proto.Equal(origin.ModerationRules, modified.ModerationRules), origin.ModerationRules, modified.ModerationRules},
+	}
+	for _, setting := range settings {
+		if !setting.modified {
+			continue
+		}
+		before, err := json.Marshal(setting.before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := json.Marshal(setting.after)
+		if err != nil {
+			return nil, err
+		}
+		if auditChanges.SettingsModified == nil {
+			auditChanges.SettingsModified = make(map[string]*AuditSettingChange)
+		}
+		auditChanges.SettingsModified[setting.name] = &AuditSettingChange{Before: before, After: after}
+	}
+
+	return auditChanges, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// calculateHash hashes the immutable fields of the entry along with the hash of the previous entry.
+// Fields are length-prefixed so that no two entries hash the same material
+func (e *AuditLogEntry) calculateHash() []byte {
+	var buf bytes.Buffer
+	writeBytes := func(b []byte) {
+		_ = binary.Write(&buf, binary.BigEndian, uint64(len(b)))
+		buf.Write(b)
+	}
+	writeUint := func(n uint64) {
+		_ = binary.Write(&buf, binary.BigEndian, n)
+	}
+
+	writeBytes(e.PrevHash)
+	writeBytes(e.CommunityID)
+	writeBytes([]byte(e.Signer))
+	writeBytes([]byte(e.Source))
+	writeUint(uint64(e.EventType))
+	writeBytes([]byte(e.EventID))
+	writeUint(e.Clock)
+	writeUint(e.BaseClock)
+	writeBytes(e.rawChanges)
+	writeUint(e.Timestamp)
+	writeBytes(e.MessageHash)
+
+	return crypto.Keccak256(buf.Bytes())
+}
+
+// VerifyAuditLog checks that entries, the audit log of the community in order, chain up to each other. Entries
+// older than auditLogRetention are pruned, the first one may follow an entry that's gone. The last description
+// recorded must be the current one when it's signed by the control node, so that the newest entries can't be
+// dropped or rewritten along with it
+func (o *Community) VerifyAuditLog(entries []*AuditLogEntry) error {
+	var lastDescription *AuditLogEntry
+	for i, e := range entries {
+		if (i > 0 && !bytes.Equal(e.PrevHash, entries[i-1].Hash)) || !bytes.Equal(e.Hash, e.calculateHash()) {
+			return ErrAuditLogTampered
+		}
+		if e.Source == AuditLogSourceDescription {
+			lastDescription = e
+		}
+	}
+
+	if lastDescription == nil {
+		return nil
+	}
+	current, err := signedCommunityDescription(o.config.MarshaledCommunityDescription, o.config.ID)
+	if err != nil {
+		return nil
+	}
+	if lastDescription.Clock < current.Clock ||
+		lastDescription.Clock == current.Clock && !bytes.Equal(lastDescription.MessageHash, crypto.Keccak256(o.config.MarshaledCommunityDescription)) {
+		return ErrAuditLogTampered
+	}
+
+	return nil
+}
+
+// unwrapSignedMessage returns the signer and the payload of signedMessage, a message of type messageType
+func unwrapSignedMessage(signedMessage []byte, messageType protobuf.ApplicationMetadataMessage_Type) (*ecdsa.PublicKey, []byte, error) {
+	metadata := &protobuf.ApplicationMetadataMessage{}
+	err := proto.Unmarshal(signedMessage, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	if metadata.Type != messageType {
+		return nil, nil, ErrInvalidMessage
+	}
+
+	signer, err := metadata.RecoverKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	if signer == nil {
+		return nil, nil, ErrInvalidMessage
+	}
+
+	return signer, metadata.Payload, nil
+}
+
+// signedCommunityDescription returns the description of signedDescription, which must be signed by the control node
+func signedCommunityDescription(signedDescription []byte, controlNode *ecdsa.PublicKey) (*protobuf.CommunityDescription, error) {
+	signer, payload, err := unwrapSignedMessage(signedDescription, protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION)
+	if err != nil {
+		return nil, err
+	}
+	if !signer.Equal(controlNode) {
+		return nil, ErrNotAuthorized
+	}
+
+	description := &protobuf.CommunityDescription{}
+	err = proto.Unmarshal(payload, description)
+	if err != nil {
+		return nil, err
+	}
+	return description, nil
+}
+
+// descriptionAuditLogEntry returns an entry with the changes of signedDescription, signed by the control node,
+// to origin, nil if signedDescription isn't newer
+func descriptionAuditLogEntry(controlNode *ecdsa.PublicKey, origin *protobuf.CommunityDescription, signedDescription []byte) (*AuditLogEntry, error) {
+	modified, err := signedCommunityDescription(signedDescription, controlNode)
+	if err != nil {
+		return nil, err
+	}
+	if modified.Clock <= origin.Clock {
+		return nil, nil
+	}
+
+	changes, err := newAuditChanges(origin, modified)
+	if err != nil {
+		return nil, err
+	}
+	entry := &AuditLogEntry{
+		CommunityID: crypto.CompressPubkey(controlNode),
+		Signer:      common.PubkeyToHex(controlNode),
+		Source:      AuditLogSourceDescription,
+		Clock:       modified.Clock,
+		BaseClock:   origin.Clock,
+		Changes:     changes,
+		Status:      AuditLogStatusApplied,
+		MessageHash: crypto.Keccak256(signedDescription),
+	}
+	entry.rawChanges, err = json.Marshal(entry.Changes)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// eventsAuditLogEntries replays the events of signedMessage, an events message signed by an admin, on top of
+// the description they were made on and returns an entry with the changes of each of them
+func (o *Community) eventsAuditLogEntries(signedMessage []byte) ([]*AuditLogEntry, error) {
+	signer, payload, err := unwrapSignedMessage(signedMessage, protobuf.ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &protobuf.CommunityEventsMessage{}
+	err = proto.Unmarshal(payload, message)
+	if err != nil {
+		return nil, err
+	}
+	eventsMessage, err := CommunityEventsMessageFromProtobuf(message)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !bytes.Equal(eventsMessage.CommunityID, crypto.CompressPubkey(o.config.ID)) {
+		return nil, ErrInvalidMessage
+	}
+
+	base, err := signedCommunityDescription(eventsMessage.EventsBaseCommunityDescription, o.config.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := eventsMessage.Events
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CommunityEventClock < events[j].CommunityEventClock
+	})
+
+	replay := o.createDeepCopy()
+	replay.config.CommunityDescription = proto.Clone(base).(*protobuf.CommunityDescription)
+
+	var entries []*AuditLogEntry
+	for _, event := range events {
+		before := proto.Clone(replay.config.CommunityDescription).(*protobuf.CommunityDescription)
+		err := replay.updateCommunityDescriptionByCommunityEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := newAuditChanges(before, replay.config.CommunityDescription)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &AuditLogEntry{
+			CommunityID: crypto.CompressPubkey(o.config.ID),
+			Signer:      common.PubkeyToHex(signer),
+			Source:      AuditLogSourceEvent,
+			EventType:   event.Type,
+			EventID:     types.EncodeHex(crypto.Keccak256(event.RawPayload)),
+			Clock:       event.CommunityEventClock,
+			BaseClock:   base.Clock,
+			Changes:     changes,
+			Status:      AuditLogStatusPending,
+			MessageHash: crypto.Keccak256(signedMessage),
+		}
+		entry.rawChanges, err = json.Marshal(entry.Changes)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (p *Persistence) insertAuditLogEntry(tx *sql.Tx, entry *AuditLogEntry) error {
+	var prevHash []byte
+	err := tx.QueryRow(`SELECT hash FROM communities_audit_log WHERE community_id = ? ORDER BY seq DESC LIMIT 1`, entry.CommunityID).Scan(&prevHash)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	now := time.Now()
+	entry.PrevHash = prevHash
+	entry.Timestamp = uint64(now.Unix())
+	entry.Hash = entry.calculateHash()
+
+	result, err := tx.Exec(`
+		INSERT INTO communities_audit_log (community_id, signer, source, event_type, event_id, clock, base_clock, changes, timestamp, prev_hash, hash, status,
+		superseded_by_clock, message_hash)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.CommunityID, entry.Signer, entry.Source, entry.EventType, entry.EventID, entry.Clock, entry.BaseClock, entry.rawChanges,
+		entry.Timestamp, prevHash, entry.Hash, entry.Status, entry.SupersededByClock, entry.MessageHash)
+	if err != nil {
+		return err
+	}
+
+	seq, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	entry.Seq = uint64(seq)
+
+	_, err = tx.Exec(`DELETE FROM communities_audit_log WHERE community_id = ? AND timestamp < ?`,
+		entry.CommunityID, now.Add(-auditLogRetention).Unix())
+	return err
+}
+
+// saveDescriptionAuditLogEntry records the changes made by description, a newer description of the community
+// than the stored one, and marks the pending events it was built on top of as superseded. Changes are evaluated
+// against the description the events were made on, not the one patched by them. The audit log starts with the
+// first change we witness, the description a community is first saved with isn't recorded. Only the control node
+// and the admins, who can read the audit log, record it
+func (p *Persistence) saveDescriptionAuditLogEntry(tx *sql.Tx, community *Community, description []byte) error {
+	if !community.IsOwnerOrAdmin() {
+		return nil
+	}
+
+	var storedDescription, eventsBaseDescription []byte
+	err := tx.QueryRow(`SELECT c.description, e.raw_description FROM communities_communities c LEFT JOIN communities_events e ON c.id = e.id WHERE c.id = ?`,
+		community.ID()).Scan(&storedDescription, &eventsBaseDescription)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(eventsBaseDescription) > 0 {
+		storedDescription = eventsBaseDescription
+	}
+
+	origin, err := decodeCommunityDescription(storedDescription)
+	if err != nil {
+		return err
+	}
+
+	modified, err := decodeCommunityDescription(description)
+	if err != nil {
+		return err
+	}
+
+	if modified.Clock <= origin.Clock {
+		return nil
+	}
+
+	// Only descriptions signed by the control node are recorded, others (e.g. the one of a request to join
+	// response) are left out and the changes they made are recorded with the next signed one
+	if _, err := signedCommunityDescription(description, community.PublicKey()); err == nil {
+		entry, err := descriptionAuditLogEntry(community.PublicKey(), origin, description)
+		if err != nil {
+			return err
+		}
+
+		if entry != nil {
+			err = p.insertAuditLogEntry(tx, entry)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.Exec(`UPDATE communities_audit_log SET status = ?, superseded_by_clock = ? WHERE community_id = ? AND source = ? AND status = ? AND base_clock < ?`,
+		AuditLogStatusSuperseded, modified.Clock, community.ID(), AuditLogSourceEvent, AuditLogStatusPending, modified.Clock)
+	return err
+}
+
+// SaveCommunityEventsAuditLogEntries records the events of signedMessage, an events message of the community
+// signed by an admin, that aren't in the audit log yet. Like descriptions, they're only recorded by the control
+// node and the admins
+func (p *Persistence) SaveCommunityEventsAuditLogEntries(community *Community, signedMessage []byte) (err error) {
+	if !community.IsOwnerOrAdmin() {
+		return nil
+	}
+
+	entries, err := community.eventsAuditLogEntries(signedMessage)
+	if err != nil || len(entries) == 0 {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, entry := range entries {
+		var exists bool
+		err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM communities_audit_log WHERE community_id = ? AND event_id = ?)`, entry.CommunityID, entry.EventID).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		err = p.insertAuditLogEntry(tx, entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const auditLogBaseQuery = `SELECT seq, community_id, signer, source, event_type, event_id, clock, base_clock, changes, timestamp, prev_hash, hash, status,
+	superseded_by_clock, message_hash FROM communities_audit_log`
+
+func (p *Persistence) queryAuditLog(query string, args ...interface{}) ([]*AuditLogEntry, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*AuditLogEntry
+	for rows.Next() {
+		entry := &AuditLogEntry{}
+		var communityID, prevHash, hash, messageHash []byte
+		err := rows.Scan(&entry.Seq, &communityID, &entry.Signer, &entry.Source, &entry.EventType, &entry.EventID, &entry.Clock,
+			&entry.BaseClock, &entry.rawChanges, &entry.Timestamp, &prevHash, &hash, &entry.Status, &entry.SupersededByClock, &messageHash)
+		if err != nil {
+			return nil, err
+		}
+		entry.CommunityID = communityID
+		entry.PrevHash = prevHash
+		entry.Hash = hash
+		entry.MessageHash = messageHash
+
+		if len(entry.rawChanges) > 0 {
+			entry.Changes = &AuditChanges{}
+			if err := json.Unmarshal(entry.rawChanges, entry.Changes); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetAuditLog returns at most limit entries of the audit log of the community older than cursor,
+// newest first. A zero cursor starts from the newest entry
+func (p *Persistence) GetAuditLog(communityID types.HexBytes, cursor uint64, limit int) ([]*AuditLogEntry, error) {
+	if cursor == 0 {
+		return p.queryAuditLog(auditLogBaseQuery+` WHERE community_id = ? ORDER BY seq DESC LIMIT ?`, communityID, limit)
+	}
+	return p.queryAuditLog(auditLogBaseQuery+` WHERE community_id = ? AND seq < ? ORDER BY seq DESC LIMIT ?`, communityID, cursor, limit)
+}
+
+// VerifyAuditLog checks the whole audit log of the community
+func (p *Persistence) VerifyAuditLog(community *Community) error {
+	entries, err := p.queryAuditLog(auditLogBaseQuery+` WHERE community_id = ? ORDER BY seq ASC`, community.ID())
+	if err != nil {
+		return err
+	}
+	return community.VerifyAuditLog(entries)
+}
@@ -0,0 +1,326 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+func (s *PersistenceSuite) TestAuditLogDescriptionEntries() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	memberKey := common.PubkeyToHex(&member.PublicKey)
+
+	com := s.makeNewCommunity(identity)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	// The description the community is first saved with isn't recorded
+	entries, err := s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	_, err = com.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	_, err = com.BanUserFromCommunity(&member.PublicKey)
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	// Saving the same description again doesn't record anything
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	entries, err = s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+
+	banned := entries[0]
+	s.Require().Equal(AuditLogSourceDescription, banned.Source)
+	s.Require().Equal(AuditLogStatusApplied, banned.Status)
+	s.Require().Equal(common.PubkeyToHex(com.PublicKey()), banned.Signer)
+	s.Require().Equal(com.Clock(), banned.Clock)
+	s.Require().Equal(com.Clock()-1, banned.BaseClock)
+	s.Require().Equal([]string{memberKey}, banned.Changes.MembersBanned)
+	s.Require().Equal([]string{memberKey}, banned.Changes.MembersRemoved)
+	s.Require().Equal(entries[1].Hash, banned.PrevHash)
+
+	added := entries[1]
+	s.Require().Equal([]string{memberKey}, added.Changes.MembersAdded)
+	s.Require().Nil(added.PrevHash)
+
+	// Pages are returned newest first
+	page, err := s.db.GetAuditLog(com.ID(), 0, 1)
+	s.Require().NoError(err)
+	s.Require().Len(page, 1)
+	s.Require().Equal(banned.Seq, page[0].Seq)
+	page, err = s.db.GetAuditLog(com.ID(), page[0].Seq, 1)
+	s.Require().NoError(err)
+	s.Require().Len(page, 1)
+	s.Require().Equal(added.Seq, page[0].Seq)
+
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+
+	_, err = s.db.db.Exec(`UPDATE communities_audit_log SET signer = ? WHERE seq = ?`, memberKey, added.Seq)
+	s.Require().NoError(err)
+	s.Require().Equal(ErrAuditLogTampered, s.db.VerifyAuditLog(com))
+
+	s.Require().NoError(s.db.DeleteCommunity(com.ID()))
+	entries, err = s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+}
+
+func (s *PersistenceSuite) TestAuditLogEventEntriesSuperseded() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	admin, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	adminKey := common.PubkeyToHex(&admin.PublicKey)
+	memberKey := common.PubkeyToHex(&member.PublicKey)
+
+	com := s.makeNewCommunity(identity)
+	_, err = com.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	com.config.MarshaledCommunityDescription, err = com.ToBytes()
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	// An admin event banning the member, built on top of the current description
+	s.Require().NoError(com.addNewCommunityEvent(com.ToBanCommunityMemberCommunityEvent(memberKey)))
+	signedMessage := s.signedEventsMessage(com, admin)
+	s.Require().NoError(s.db.SaveCommunityEventsAuditLogEntries(com, signedMessage))
+	// Events already recorded are skipped
+	s.Require().NoError(s.db.SaveCommunityEventsAuditLogEntries(com, signedMessage))
+
+	entries, err := s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	event := entries[0]
+	s.Require().Equal(AuditLogSourceEvent, event.Source)
+	s.Require().Equal(AuditLogStatusPending, event.Status)
+	s.Require().Equal(adminKey, event.Signer)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN, event.EventType)
+	s.Require().NotEmpty(event.EventID)
+	s.Require().Equal(com.Clock(), event.BaseClock)
+	s.Require().Equal([]string{memberKey}, event.Changes.MembersBanned)
+
+	// The owner signs a new description, which supersedes the event
+	_, err = com.BanUserFromCommunity(&member.PublicKey)
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	entries, err = s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(AuditLogSourceDescription, entries[0].Source)
+	s.Require().Equal([]string{memberKey}, entries[0].Changes.MembersBanned)
+	s.Require().Equal(AuditLogStatusSuperseded, entries[1].Status)
+	s.Require().Equal(com.Clock(), entries[1].SupersededByClock)
+
+	// Status updates don't break the chain
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+}
+
+func (s *PersistenceSuite) TestAuditLogTampering() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	admin, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	memberKey := common.PubkeyToHex(&member.PublicKey)
+
+	com := s.makeNewCommunity(identity)
+	s.Require().NoError(s.db.SaveCommunity(com))
+	_, err = com.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+	com.config.CommunityDescription.IntroMessage = "welcome"
+	com.increaseClock()
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	com.config.MarshaledCommunityDescription, err = com.ToBytes()
+	s.Require().NoError(err)
+	s.Require().NoError(com.addNewCommunityEvent(com.ToKickCommunityMemberCommunityEvent(memberKey)))
+	signedMessage := s.signedEventsMessage(com, admin)
+	s.Require().NoError(s.db.SaveCommunityEventsAuditLogEntries(com, signedMessage))
+
+	entries, err := s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 3)
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+
+	// Entries keep the hash of the signed messages they were recorded from, not the messages
+	s.Require().Equal(types.HexBytes(crypto.Keccak256(signedMessage)), entries[0].MessageHash)
+	s.Require().Equal(types.HexBytes(crypto.Keccak256(com.config.MarshaledCommunityDescription)), entries[1].MessageHash)
+
+	// Events must be signed
+	unsigned, err := v1protocol.WrapMessageV1(s.eventsMessagePayload(com), protobuf.ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(ErrInvalidMessage, s.db.SaveCommunityEventsAuditLogEntries(com, unsigned))
+
+	// Entries can't be rewritten without the hashes of the chain
+	_, err = s.db.db.Exec(`UPDATE communities_audit_log SET changes = ? WHERE seq = ?`, `{"membersAdded":["0x04"]}`, entries[2].Seq)
+	s.Require().NoError(err)
+	s.Require().Equal(ErrAuditLogTampered, s.db.VerifyAuditLog(com))
+	s.rehashAuditLog(com, func(e *AuditLogEntry) {})
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+
+	// Nor can the newest description be rewritten or dropped along with them
+	s.rehashAuditLog(com, func(e *AuditLogEntry) {
+		if e.Seq == entries[1].Seq {
+			e.MessageHash = crypto.Keccak256([]byte("description"))
+		}
+	})
+	s.Require().Equal(ErrAuditLogTampered, s.db.VerifyAuditLog(com))
+	s.rehashAuditLog(com, func(e *AuditLogEntry) {
+		if e.Seq == entries[1].Seq {
+			e.MessageHash = entries[1].MessageHash
+		}
+	})
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+
+	_, err = s.db.db.Exec(`DELETE FROM communities_audit_log WHERE seq = ?`, entries[1].Seq)
+	s.Require().NoError(err)
+	s.rehashAuditLog(com, func(e *AuditLogEntry) {})
+	s.Require().Equal(ErrAuditLogTampered, s.db.VerifyAuditLog(com))
+}
+
+func (s *PersistenceSuite) TestAuditLogRetention() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	com := s.makeNewCommunity(identity)
+	s.Require().NoError(s.db.SaveCommunity(com))
+	_, err = com.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	entries, err := s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	expired := time.Now().Add(-auditLogRetention - time.Hour).Unix()
+	_, err = s.db.db.Exec(`UPDATE communities_audit_log SET timestamp = ? WHERE seq = ?`, expired, entries[0].Seq)
+	s.Require().NoError(err)
+
+	// Expired entries are pruned when new ones are recorded
+	_, err = com.BanUserFromCommunity(&member.PublicKey)
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveCommunity(com))
+
+	entries, err = s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().NotEmpty(entries[0].Changes.MembersBanned)
+	s.Require().NotNil(entries[0].PrevHash)
+
+	com.config.MarshaledCommunityDescription, err = com.ToBytes()
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.VerifyAuditLog(com))
+}
+
+func (s *PersistenceSuite) TestAuditLogNotRecordedByMembers() {
+	owner, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	com := s.makeNewCommunity(owner)
+	_, err = com.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+
+	// The member receives the descriptions signed by the owner
+	saveAsMember := func() {
+		com.config.MarshaledCommunityDescription, err = com.ToBytes()
+		s.Require().NoError(err)
+		privateKey := com.config.PrivateKey
+		com.config.PrivateKey, com.config.MemberIdentity = nil, &member.PublicKey
+		s.Require().NoError(s.db.SaveCommunity(com))
+		com.config.PrivateKey, com.config.MemberIdentity = privateKey, &owner.PublicKey
+	}
+	saveAsMember()
+	com.config.CommunityDescription.IntroMessage = "welcome"
+	com.increaseClock()
+	saveAsMember()
+
+	entries, err := s.db.GetAuditLog(com.ID(), 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+}
+
+func TestNewAuditChanges(t *testing.T) {
+	origin := &protobuf.CommunityDescription{
+		IntroMessage: "welcome",
+		Tags:         []string{"Art"},
+		TokenPermissions: map[string]*protobuf.CommunityTokenPermission{
+			"permission": {Id: "permission", Type: protobuf.CommunityTokenPermission_BECOME_MEMBER},
+		},
+		Categories: map[string]*protobuf.CommunityCategory{
+			"category": {CategoryId: "category", Name: "General", Position: 0},
+		},
+	}
+	modified := proto.Clone(origin).(*protobuf.CommunityDescription)
+	modified.IntroMessage = "hello"
+	modified.TokenPermissions["permission"].Type = protobuf.CommunityTokenPermission_BECOME_ADMIN
+	modified.Categories["category"].Name = "Off topic"
+
+	changes, err := newAuditChanges(origin, modified)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]*AuditSettingChange{
+		"introMessage": {Before: json.RawMessage(`"welcome"`), After: json.RawMessage(`"hello"`)},
+	}, changes.SettingsModified)
+	require.Len(t, changes.TokenPermissionsModified, 1)
+	require.Equal(t, protobuf.CommunityTokenPermission_BECOME_MEMBER, changes.TokenPermissionsModified["permission"].Before.Type)
+	require.Equal(t, protobuf.CommunityTokenPermission_BECOME_ADMIN, changes.TokenPermissionsModified["permission"].After.Type)
+	require.Len(t, changes.CategoriesModified, 1)
+	require.Equal(t, "General", changes.CategoriesModified["category"].Before.Name)
+	require.Equal(t, "Off topic", changes.CategoriesModified["category"].After.Name)
+}
+
+func (s *PersistenceSuite) eventsMessagePayload(com *Community) []byte {
+	payload, err := com.ToCommunityEventsMessage().Marshal()
+	s.Require().NoError(err)
+	return payload
+}
+
+// signedEventsMessage returns the events message of the community as published by admin
+func (s *PersistenceSuite) signedEventsMessage(com *Community, admin *ecdsa.PrivateKey) []byte {
+	signedMessage, err := v1protocol.WrapMessageV1(s.eventsMessagePayload(com), protobuf.ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE, admin)
+	s.Require().NoError(err)
+	return signedMessage
+}
+
+// rehashAuditLog modifies the audit log of the community and recomputes its hashes, as someone with
+// write access to the database could
+func (s *PersistenceSuite) rehashAuditLog(com *Community, modify func(*AuditLogEntry)) {
+	entries, err := s.db.queryAuditLog(auditLogBaseQuery+` WHERE community_id = ? ORDER BY seq ASC`, com.ID())
+	s.Require().NoError(err)
+
+	var prevHash []byte
+	for _, entry := range entries {
+		modify(entry)
+		entry.PrevHash = prevHash
+		entry.Hash = entry.calculateHash()
+		prevHash = entry.Hash
+		_, err = s.db.db.Exec(`UPDATE communities_audit_log SET changes = ?, message_hash = ?, prev_hash = ?, hash = ? WHERE seq = ?`,
+			entry.rawChanges, entry.MessageHash, entry.PrevHash, entry.Hash, entry.Seq)
+		s.Require().NoError(err)
+	}
+}
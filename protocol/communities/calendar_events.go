@@ -0,0 +1,297 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCalendarEventInvalid = errors.New("invalid community calendar event")
+var ErrCalendarEventNotFound = errors.New("community calendar event not found")
+var ErrCalendarEventCancelled = errors.New("community calendar event cancelled")
+var ErrCalendarEventRsvpInvalid = errors.New("invalid community calendar event response")
+var ErrCalendarEventTokenGate = errors.New("member doesn't hold the tokens required by the community calendar event")
+
+// CalendarEvent is an event scheduled in a community channel, such as an AMA or a call
+type CalendarEvent struct {
+	ID          string         `json:"id"`
+	CommunityID types.HexBytes `json:"communityId"`
+	// ChatID is the id of the channel within the community
+	ChatID      string `json:"chatId"`
+	Clock       uint64 `json:"clock"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// StartTime and EndTime are in seconds, EndTime is zero if the event has no set duration
+	StartTime     uint64                    `json:"startTime"`
+	EndTime       uint64                    `json:"endTime"`
+	TokenCriteria []*protobuf.TokenCriteria `json:"tokenCriteria"`
+	Cancelled     bool                      `json:"cancelled"`
+	PublishedBy   string                    `json:"publishedBy"`
+	Rsvps         []*CalendarEventRsvp      `json:"rsvps"`
+}
+
+// CalendarEventRsvp is the latest response of a member to a calendar event
+type CalendarEventRsvp struct {
+	EventID   string                                       `json:"eventId"`
+	PublicKey string                                       `json:"publicKey"`
+	Clock     uint64                                       `json:"clock"`
+	Response  protobuf.CommunityCalendarEventRsvp_Response `json:"response"`
+}
+
+func CalendarEventFromProtobuf(event *protobuf.CommunityCalendarEvent, publishedBy string) *CalendarEvent {
+	return &CalendarEvent{
+		ID:            event.Id,
+		CommunityID:   event.CommunityId,
+		ChatID:        event.ChatId,
+		Clock:         event.Clock,
+		Title:         event.Title,
+		Description:   event.Description,
+		StartTime:     event.StartTime,
+		EndTime:       event.EndTime,
+		TokenCriteria: event.TokenCriteria,
+		Cancelled:     event.Cancelled,
+		PublishedBy:   publishedBy,
+		Rsvps:         []*CalendarEventRsvp{},
+	}
+}
+
+func (e *CalendarEvent) ToProtobuf() *protobuf.CommunityCalendarEvent {
+	return &protobuf.CommunityCalendarEvent{
+		Clock:         e.Clock,
+		CommunityId:   e.CommunityID,
+		Id:            e.ID,
+		ChatId:        e.ChatID,
+		Title:         e.Title,
+		Description:   e.Description,
+		StartTime:     e.StartTime,
+		EndTime:       e.EndTime,
+		TokenCriteria: e.TokenCriteria,
+		Cancelled:     e.Cancelled,
+	}
+}
+
+// Rsvp returns the response of the member to the event, nil if they didn't respond
+func (e *CalendarEvent) Rsvp(publicKey string) *CalendarEventRsvp {
+	for _, rsvp := range e.Rsvps {
+		if rsvp.PublicKey == publicKey {
+			return rsvp
+		}
+	}
+	return nil
+}
+
+func (r *CalendarEventRsvp) ToProtobuf(communityID types.HexBytes) *protobuf.CommunityCalendarEventRsvp {
+	return &protobuf.CommunityCalendarEventRsvp{
+		Clock:       r.Clock,
+		CommunityId: communityID,
+		EventId:     r.EventID,
+		Response:    r.Response,
+	}
+}
+
+// Attending returns whether the member answered they are going or might go
+func (r *CalendarEventRsvp) Attending() bool {
+	return r.Response == protobuf.CommunityCalendarEventRsvp_GOING || r.Response == protobuf.CommunityCalendarEventRsvp_MAYBE
+}
+
+// ValidateCalendarEvent checks that the event was published by an admin of the community, in one of its channels
+func (o *Community) ValidateCalendarEvent(signer *ecdsa.PublicKey, event *protobuf.CommunityCalendarEvent) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !bytes.Equal(event.CommunityId, o.ID()) {
+		return ErrCalendarEventInvalid
+	}
+
+	if len(event.Id) == 0 || len(event.Title) == 0 || event.StartTime == 0 || (event.EndTime != 0 && event.EndTime < event.StartTime) {
+		return ErrCalendarEventInvalid
+	}
+
+	if _, ok := o.config.CommunityDescription.Chats[event.ChatId]; !ok {
+		return ErrChatNotFound
+	}
+
+	if !o.IsMemberOwnerOrAdmin(signer) {
+		return ErrNotAuthorized
+	}
+
+	return nil
+}
+
+// ValidateCalendarEventRsvp checks that the response was sent by a member of the community.
+// The token criteria of the event are checked against the balances of the member by the manager
+func (o *Community) ValidateCalendarEventRsvp(signer *ecdsa.PublicKey, rsvp *protobuf.CommunityCalendarEventRsvp) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !bytes.Equal(rsvp.CommunityId, o.ID()) || len(rsvp.EventId) == 0 {
+		return ErrCalendarEventRsvpInvalid
+	}
+
+	if _, ok := protobuf.CommunityCalendarEventRsvp_Response_name[int32(rsvp.Response)]; !ok || rsvp.Response == protobuf.CommunityCalendarEventRsvp_UNKNOWN_RESPONSE {
+		return ErrCalendarEventRsvpInvalid
+	}
+
+	if !o.hasMember(signer) {
+		return ErrNotAuthorized
+	}
+
+	return nil
+}
+
+// SaveCalendarEvent stores the event unless a later version of it is already known, in which case it returns false.
+// A change of start time makes the event eligible for a reminder again
+func (p *Persistence) SaveCalendarEvent(event *CalendarEvent) (bool, error) {
+	tokenCriteria, err := json.Marshal(event.TokenCriteria)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := p.db.Exec(`
+		INSERT INTO communities_calendar_events (id, community_id, chat_id, clock, title, description, start_time, end_time, token_criteria, cancelled, published_by, reminded)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE((SELECT reminded FROM communities_calendar_events WHERE id = ? AND start_time = ?), FALSE)
+		WHERE NOT EXISTS (SELECT 1 FROM communities_calendar_events WHERE id = ? AND clock >= ?)`,
+		event.ID, event.CommunityID, event.ChatID, event.Clock, event.Title, event.Description, event.StartTime, event.EndTime, tokenCriteria, event.Cancelled, event.PublishedBy,
+		event.ID, event.StartTime,
+		event.ID, event.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// SaveCalendarEventRsvp stores the response unless a later response of the member is already known,
+// in which case it returns false
+func (p *Persistence) SaveCalendarEventRsvp(communityID types.HexBytes, rsvp *CalendarEventRsvp) (bool, error) {
+	result, err := p.db.Exec(`
+		INSERT INTO communities_calendar_event_rsvps (event_id, community_id, public_key, clock, response)
+		SELECT ?, ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM communities_calendar_event_rsvps WHERE event_id = ? AND public_key = ? AND clock >= ?)`,
+		rsvp.EventID, communityID, rsvp.PublicKey, rsvp.Clock, rsvp.Response,
+		rsvp.EventID, rsvp.PublicKey, rsvp.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+const calendarEventsBaseQuery = `SELECT id, community_id, chat_id, clock, title, description, start_time, end_time, token_criteria, cancelled, published_by FROM communities_calendar_events`
+
+func (p *Persistence) queryCalendarEvents(query string, args ...interface{}) ([]*CalendarEvent, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*CalendarEvent
+	for rows.Next() {
+		event := &CalendarEvent{}
+		var communityID, tokenCriteria []byte
+		err := rows.Scan(&event.ID, &communityID, &event.ChatID, &event.Clock, &event.Title, &event.Description, &event.StartTime, &event.EndTime, &tokenCriteria, &event.Cancelled, &event.PublishedBy)
+		if err != nil {
+			return nil, err
+		}
+		event.CommunityID = communityID
+		if len(tokenCriteria) > 0 {
+			if err := json.Unmarshal(tokenCriteria, &event.TokenCriteria); err != nil {
+				return nil, err
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Release the connection before querying the responses
+	_ = rows.Close()
+
+	for _, event := range events {
+		event.Rsvps, err = p.getCalendarEventRsvps(event.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (p *Persistence) GetCalendarEvent(id string) (*CalendarEvent, error) {
+	events, err := p.queryCalendarEvents(calendarEventsBaseQuery+` WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return events[0], nil
+}
+
+// GetCalendarEvents returns the events of the community ending at or after since, in seconds, by start time
+func (p *Persistence) GetCalendarEvents(communityID types.HexBytes, since uint64) ([]*CalendarEvent, error) {
+	return p.queryCalendarEvents(calendarEventsBaseQuery+` WHERE community_id = ? AND MAX(start_time, end_time) >= ? ORDER BY start_time ASC`, communityID, since)
+}
+
+// GetUpcomingCalendarEvents returns the events of all communities that haven't ended at time now, in seconds
+func (p *Persistence) GetUpcomingCalendarEvents(now uint64) ([]*CalendarEvent, error) {
+	return p.queryCalendarEvents(calendarEventsBaseQuery+` WHERE MAX(start_time, end_time) >= ? ORDER BY start_time ASC`, now)
+}
+
+// GetCalendarEventsToRemind returns the events the member is attending, starting between now and until,
+// which they haven't been reminded of yet
+func (p *Persistence) GetCalendarEventsToRemind(member string, now uint64, until uint64) ([]*CalendarEvent, error) {
+	return p.queryCalendarEvents(calendarEventsBaseQuery+`
+		WHERE NOT cancelled AND NOT reminded AND start_time >= ? AND start_time <= ? AND id IN (
+			SELECT event_id FROM communities_calendar_event_rsvps WHERE public_key = ? AND response IN (?, ?)
+		) ORDER BY start_time ASC`,
+		now, until, member, protobuf.CommunityCalendarEventRsvp_GOING, protobuf.CommunityCalendarEventRsvp_MAYBE)
+}
+
+func (p *Persistence) MarkCalendarEventReminded(id string) error {
+	_, err := p.db.Exec(`UPDATE communities_calendar_events SET reminded = TRUE WHERE id = ?`, id)
+	return err
+}
+
+func (p *Persistence) getCalendarEventRsvps(eventID string) ([]*CalendarEventRsvp, error) {
+	rows, err := p.db.Query(`SELECT public_key, clock, response FROM communities_calendar_event_rsvps WHERE event_id = ? ORDER BY clock ASC`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rsvps := []*CalendarEventRsvp{}
+	for rows.Next() {
+		rsvp := &CalendarEventRsvp{EventID: eventID}
+		if err := rows.Scan(&rsvp.PublicKey, &rsvp.Clock, &rsvp.Response); err != nil {
+			return nil, err
+		}
+		rsvps = append(rsvps, rsvp)
+	}
+	return rsvps, rows.Err()
+}
+
+func calendarEventRsvpFromProtobuf(signer *ecdsa.PublicKey, rsvp *protobuf.CommunityCalendarEventRsvp) *CalendarEventRsvp {
+	return &CalendarEventRsvp{
+		EventID:   rsvp.EventId,
+		PublicKey: common.PubkeyToHex(signer),
+		Clock:     rsvp.Clock,
+		Response:  rsvp.Response,
+	}
+}
@@ -0,0 +1,303 @@
+package communities
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func (s *CommunitySuite) TestValidateCalendarEvent() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	_, err := org.AddRoleToMember(&s.member2.PublicKey, protobuf.CommunityMember_ROLE_ADMIN)
+	s.Require().NoError(err)
+
+	event := &protobuf.CommunityCalendarEvent{
+		Clock:       1,
+		CommunityId: org.ID(),
+		Id:          "event",
+		ChatId:      testChatID1,
+		Title:       "AMA",
+		StartTime:   1000,
+		EndTime:     2000,
+	}
+	s.Require().NoError(org.ValidateCalendarEvent(&s.member2.PublicKey, event))
+
+	// Only admins publish events
+	s.Require().Equal(ErrNotAuthorized, org.ValidateCalendarEvent(&s.member1.PublicKey, event))
+	s.Require().Equal(ErrNotAuthorized, org.ValidateCalendarEvent(&s.member3.PublicKey, event))
+
+	event.ChatId = "unknown"
+	s.Require().Equal(ErrChatNotFound, org.ValidateCalendarEvent(&s.member2.PublicKey, event))
+	event.ChatId = testChatID1
+
+	event.EndTime = 500
+	s.Require().Equal(ErrCalendarEventInvalid, org.ValidateCalendarEvent(&s.member2.PublicKey, event))
+	event.EndTime = 0
+	s.Require().NoError(org.ValidateCalendarEvent(&s.member2.PublicKey, event))
+
+	event.CommunityId = []byte("other")
+	s.Require().Equal(ErrCalendarEventInvalid, org.ValidateCalendarEvent(&s.member2.PublicKey, event))
+
+	rsvp := &protobuf.CommunityCalendarEventRsvp{
+		Clock:       1,
+		CommunityId: org.ID(),
+		EventId:     "event",
+		Response:    protobuf.CommunityCalendarEventRsvp_GOING,
+	}
+	s.Require().NoError(org.ValidateCalendarEventRsvp(&s.member1.PublicKey, rsvp))
+	s.Require().Equal(ErrNotAuthorized, org.ValidateCalendarEventRsvp(&s.member3.PublicKey, rsvp))
+
+	rsvp.Response = protobuf.CommunityCalendarEventRsvp_UNKNOWN_RESPONSE
+	s.Require().Equal(ErrCalendarEventRsvpInvalid, org.ValidateCalendarEventRsvp(&s.member1.PublicKey, rsvp))
+}
+
+func (s *CommunitySuite) TestCalendarICS() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	events := []*CalendarEvent{
+		{
+			ID:          "event",
+			ChatID:      testChatID1,
+			Clock:       1690000000000,
+			Title:       "AMA; with the team, live",
+			Description: "Ask us anything\nabout " + strings.Repeat("status ", 20),
+			StartTime:   1690200000,
+			EndTime:     1690203600,
+		},
+		{
+			ID:        "cancelled",
+			ChatID:    testChatID1,
+			Clock:     1690000000000,
+			Title:     "Call",
+			StartTime: 1690300000,
+			Cancelled: true,
+		},
+	}
+
+	ics := org.CalendarICS(events)
+	s.Require().True(strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	s.Require().True(strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	s.Require().Equal(2, strings.Count(ics, "BEGIN:VEVENT\r\n"))
+	s.Require().Contains(ics, "DTSTART:20230724T120000Z\r\n")
+	s.Require().Contains(ics, "DTEND:20230724T130000Z\r\n")
+	s.Require().Contains(ics, "DTSTAMP:20230722T042640Z\r\n")
+	s.Require().Contains(ics, `SUMMARY:AMA\; with the team\, live`)
+	s.Require().Contains(ics, "LOCATION:#display-name")
+	s.Require().Contains(ics, "STATUS:CANCELLED\r\n")
+
+	// Long lines are folded
+	s.Require().Contains(ics, `DESCRIPTION:Ask us anything\nabout status`)
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		s.Require().LessOrEqual(len(line), icsMaxLineLength)
+	}
+}
+
+func (s *PersistenceSuite) TestCalendarEvents() {
+	communityID := []byte("community")
+	event := &CalendarEvent{
+		ID:          "event",
+		CommunityID: communityID,
+		ChatID:      "chat",
+		Clock:       2,
+		Title:       "AMA",
+		StartTime:   1000,
+		TokenCriteria: []*protobuf.TokenCriteria{
+			{Type: protobuf.CommunityTokenType_ERC20, Symbol: "SNT", Amount: "10"},
+		},
+		PublishedBy: "0x01",
+	}
+
+	saved, err := s.db.SaveCalendarEvent(event)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	// Older versions of the event are ignored
+	older := *event
+	older.Clock = 1
+	older.Title = "old"
+	saved, err = s.db.SaveCalendarEvent(&older)
+	s.Require().NoError(err)
+	s.Require().False(saved)
+
+	stored, err := s.db.GetCalendarEvent("event")
+	s.Require().NoError(err)
+	s.Require().Equal("AMA", stored.Title)
+	s.Require().Len(stored.TokenCriteria, 1)
+	s.Require().Equal("SNT", stored.TokenCriteria[0].Symbol)
+	s.Require().Empty(stored.Rsvps)
+
+	saved, err = s.db.SaveCalendarEventRsvp(communityID, &CalendarEventRsvp{EventID: "event", PublicKey: "0x02", Clock: 2, Response: protobuf.CommunityCalendarEventRsvp_GOING})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+	saved, err = s.db.SaveCalendarEventRsvp(communityID, &CalendarEventRsvp{EventID: "event", PublicKey: "0x02", Clock: 1, Response: protobuf.CommunityCalendarEventRsvp_NOT_GOING})
+	s.Require().NoError(err)
+	s.Require().False(saved)
+
+	stored, err = s.db.GetCalendarEvent("event")
+	s.Require().NoError(err)
+	s.Require().Len(stored.Rsvps, 1)
+	s.Require().Equal(protobuf.CommunityCalendarEventRsvp_GOING, stored.Rsvp("0x02").Response)
+
+	events, err := s.db.GetCalendarEvents(communityID, 1001)
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+	events, err = s.db.GetCalendarEvents(communityID, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+
+	// Attendees are reminded of events once
+	events, err = s.db.GetCalendarEventsToRemind("0x03", 900, 1000)
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+	events, err = s.db.GetCalendarEventsToRemind("0x02", 900, 999)
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+	events, err = s.db.GetCalendarEventsToRemind("0x02", 900, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+
+	s.Require().NoError(s.db.MarkCalendarEventReminded("event"))
+	events, err = s.db.GetCalendarEventsToRemind("0x02", 900, 1000)
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+
+	// unless the event is moved
+	event.Clock = 3
+	event.StartTime = 2000
+	_, err = s.db.SaveCalendarEvent(event)
+	s.Require().NoError(err)
+	events, err = s.db.GetCalendarEventsToRemind("0x02", 1900, 2000)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+}
+
+func (s *ManagerSuite) TestCalendarEventTokenGate() {
+	m, _, tm := s.setupManagerForTokenPermissions()
+
+	community, err := m.CreateCommunity(&requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}, true)
+	s.Require().NoError(err)
+
+	changes, err := m.CreateChat(community.ID(), &protobuf.CommunityChat{
+		Identity:    &protobuf.ChatIdentity{DisplayName: "events"},
+		Permissions: &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_NO_MEMBERSHIP},
+		Members:     make(map[string]*protobuf.CommunityMember),
+	}, true, "")
+	s.Require().NoError(err)
+	var chatID string
+	for id := range changes.ChatsAdded {
+		chatID = id
+	}
+
+	var chainID uint64 = 5
+	contractAddress := "0x3d6afaa395c31fcd391fe3d562e75fe9e8ec7e6a"
+	address := gethcommon.HexToAddress("0xD6b912e09E797D291E8D0eA3D3D17F8000e01c32")
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member := &key.PublicKey
+
+	community, err = m.GetByID(community.ID())
+	s.Require().NoError(err)
+	_, err = community.AddMember(member, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	_, err = community.AddMemberRevealedAccounts(common.PubkeyToHex(member), []*protobuf.RevealedAccount{
+		{Address: address.Hex(), ChainIds: []uint64{chainID}},
+	}, 1)
+	s.Require().NoError(err)
+	s.Require().NoError(m.persistence.SaveCommunity(community))
+
+	event, saved, err := m.SaveCalendarEvent(&m.identity.PublicKey, &protobuf.CommunityCalendarEvent{
+		Clock:       1,
+		CommunityId: community.ID(),
+		Id:          "event",
+		ChatId:      chatID,
+		Title:       "Holders call",
+		StartTime:   1000,
+		TokenCriteria: []*protobuf.TokenCriteria{
+			{
+				ContractAddresses: map[uint64]string{chainID: contractAddress},
+				Type:              protobuf.CommunityTokenType_ERC20,
+				Symbol:            "STT",
+				Amount:            "1",
+				Decimals:          18,
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+	s.Require().Equal(common.PubkeyToHex(&m.identity.PublicKey), event.PublishedBy)
+
+	// Members whose tokens weren't checked yet don't see the event, and their responses are kept
+	_, err = m.GetCalendarEvent("event", member)
+	s.Require().Equal(ErrCalendarEventNotFound, err)
+	rsvp := &protobuf.CommunityCalendarEventRsvp{
+		Clock:       1,
+		CommunityId: community.ID(),
+		EventId:     "event",
+		Response:    protobuf.CommunityCalendarEventRsvp_GOING,
+	}
+	_, saved, err = m.SaveCalendarEventRsvp(member, rsvp)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	// Failing to check the tokens doesn't show the event either
+	tm.err = errors.New("rpc error")
+	s.Require().Error(m.EvaluateCalendarEventTokenGates(community, member, 0))
+	_, err = m.GetCalendarEvent("event", member)
+	s.Require().Equal(ErrCalendarEventNotFound, err)
+	tm.err = nil
+
+	// Members without the tokens don't see the event, admins do
+	s.Require().NoError(m.EvaluateCalendarEventTokenGates(community, member, 0))
+	_, err = m.GetCalendarEvent("event", member)
+	s.Require().Equal(ErrCalendarEventNotFound, err)
+	events, err := m.GetCalendarEvents(community.ID(), member, 0)
+	s.Require().NoError(err)
+	s.Require().Len(events, 0)
+	events, err = m.GetCalendarEvents(community.ID(), &m.identity.PublicKey, 0)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+
+	rsvp.Clock = 2
+	_, _, err = m.SaveCalendarEventRsvp(member, rsvp)
+	s.Require().Equal(ErrCalendarEventTokenGate, err)
+
+	// Declining doesn't require the tokens
+	rsvp.Response = protobuf.CommunityCalendarEventRsvp_NOT_GOING
+	_, saved, err = m.SaveCalendarEventRsvp(member, rsvp)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	tm.setResponse(chainID, address, gethcommon.HexToAddress(contractAddress), int64(math.Pow(10, 18)))
+	s.Require().NoError(m.EvaluateCalendarEventTokenGates(community, member, 0))
+	rsvp.Clock = 3
+	rsvp.Response = protobuf.CommunityCalendarEventRsvp_GOING
+	event, saved, err = m.SaveCalendarEventRsvp(member, rsvp)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+	s.Require().Equal(protobuf.CommunityCalendarEventRsvp_GOING, event.Rsvp(common.PubkeyToHex(member)).Response)
+
+	events, err = m.GetCalendarEvents(community.ID(), member, 0)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+
+	// Responses to unknown events are rejected
+	_, _, err = m.SaveCalendarEventRsvp(member, &protobuf.CommunityCalendarEventRsvp{
+		Clock:       4,
+		CommunityId: community.ID(),
+		EventId:     "unknown",
+		Response:    protobuf.CommunityCalendarEventRsvp_GOING,
+	})
+	s.Require().Equal(ErrCalendarEventNotFound, err)
+}
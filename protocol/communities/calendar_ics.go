@@ -0,0 +1,87 @@
+package communities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const icsTimeLayout = "20060102T150405Z"
+
+// icsMaxLineLength is the maximum length in octets of a content line, longer lines are folded
+const icsMaxLineLength = 75
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// CalendarICS exports the events of the community as an iCalendar (RFC 5545) document
+func (o *Community) CalendarICS(events []*CalendarEvent) string {
+	var lines []string
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Status//Community Calendar//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:"+icsText(o.Name()),
+	)
+
+	chats := o.Chats()
+	for _, event := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+icsText(event.ID),
+			// The clock is the time in milliseconds at which the event was last edited
+			"DTSTAMP:"+icsTime(event.Clock/1000),
+			"DTSTART:"+icsTime(event.StartTime),
+		)
+		if event.EndTime != 0 {
+			lines = append(lines, "DTEND:"+icsTime(event.EndTime))
+		}
+		lines = append(lines, "SUMMARY:"+icsText(event.Title))
+		if len(event.Description) != 0 {
+			lines = append(lines, "DESCRIPTION:"+icsText(event.Description))
+		}
+		if chat, ok := chats[event.ChatID]; ok && chat.Identity != nil {
+			lines = append(lines, "LOCATION:"+icsText(fmt.Sprintf("#%s in %s", chat.Identity.DisplayName, o.Name())))
+		}
+		if event.Cancelled {
+			lines = append(lines, "STATUS:CANCELLED")
+		} else {
+			lines = append(lines, "STATUS:CONFIRMED")
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	var ics strings.Builder
+	for _, line := range lines {
+		ics.WriteString(icsFold(line))
+		ics.WriteString("\r\n")
+	}
+	return ics.String()
+}
+
+func icsTime(seconds uint64) string {
+	return time.Unix(int64(seconds), 0).UTC().Format(icsTimeLayout)
+}
+
+func icsText(text string) string {
+	return icsTextEscaper.Replace(text)
+}
+
+// icsFold splits a content line longer than icsMaxLineLength octets into lines starting with a space,
+// without breaking multi-byte characters
+func icsFold(line string) string {
+	var folded strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > icsMaxLineLength {
+			folded.WriteString("\r\n ")
+			length = 1
+		}
+		folded.WriteRune(r)
+		length += size
+	}
+	return folded.String()
+}
@@ -76,16 +76,18 @@ type CommunityAdminSettings struct {
 }
 
 type CommunityChat struct {
-	ID          string                               `json:"id"`
-	Name        string                               `json:"name"`
-	Color       string                               `json:"color"`
-	Emoji       string                               `json:"emoji"`
-	Description string                               `json:"description"`
-	Members     map[string]*protobuf.CommunityMember `json:"members"`
-	Permissions *protobuf.CommunityPermissions       `json:"permissions"`
-	CanPost     bool                                 `json:"canPost"`
-	Position    int                                  `json:"position"`
-	CategoryID  string                               `json:"categoryID"`
+	ID          string                                    `json:"id"`
+	Name        string                                    `json:"name"`
+	Color       string                                    `json:"color"`
+	Emoji       string                                    `json:"emoji"`
+	Description string                                    `json:"description"`
+	Members     map[string]*protobuf.CommunityMember      `json:"members"`
+	Permissions *protobuf.CommunityPermissions            `json:"permissions"`
+	CanPost     bool                                      `json:"canPost"`
+	Position    int                                       `json:"position"`
+	CategoryID  string                                    `json:"categoryID"`
+	SlowMode    uint32                                    `json:"slowModeSeconds"`
+	MemberRoles map[string]*protobuf.CommunityMemberRoles `json:"memberRoles"`
 }
 
 type CommunityCategory struct {
@@ -157,6 +159,8 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 				CanPost:     canPost,
 				CategoryID:  c.CategoryId,
 				Position:    int(c.Position),
+				SlowMode:    c.SlowModeSeconds,
+				MemberRoles: c.MemberRoles,
 			}
 			communityItem.Chats[id] = chat
 		}
@@ -231,6 +235,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		TokenPermissions            map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissions"`
 		CommunityTokensMetadata     []*protobuf.CommunityTokenMetadata            `json:"communityTokensMetadata"`
 		ActiveMembersCount          uint64                                        `json:"activeMembersCount"`
+		ModerationRules             *protobuf.CommunityModerationRules            `json:"moderationRules"`
+		Roles                       map[string]*protobuf.CommunityRole            `json:"roles"`
 	}{
 		ID:                          o.ID(),
 		MemberRole:                  o.MemberRole(o.MemberIdentity()),
@@ -277,6 +283,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 				CanPost:     canPost,
 				CategoryID:  c.CategoryId,
 				Position:    int(c.Position),
+				SlowMode:    c.SlowModeSeconds,
+				MemberRoles: c.MemberRoles,
 			}
 			communityItem.Chats[id] = chat
 		}
@@ -288,6 +296,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		communityItem.BanList = o.config.CommunityDescription.BanList
 		communityItem.CommunityTokensMetadata = o.config.CommunityDescription.CommunityTokensMetadata
 		communityItem.ActiveMembersCount = o.config.CommunityDescription.ActiveMembersCount
+		communityItem.ModerationRules = o.config.CommunityDescription.ModerationRules
+		communityItem.Roles = o.config.CommunityDescription.Roles
 
 		if o.config.CommunityDescription.Identity != nil {
 			communityItem.Name = o.Name()
@@ -508,13 +518,13 @@ func (o *Community) CreateChat(chatID string, chat *protobuf.CommunityChat) (*Co
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToCreateChannelCommunityEvent(chatID, chat))
 		if err != nil {
 			return nil, err
@@ -540,13 +550,13 @@ func (o *Community) EditChat(chatID string, chat *protobuf.CommunityChat) (*Comm
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, chatID, protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToEditChannelCommunityEvent(chatID, chat))
 		if err != nil {
 			return nil, err
@@ -575,13 +585,13 @@ func (o *Community) DeleteChat(chatID string) (*CommunityChanges, error) {
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, chatID, protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToDeleteChannelCommunityEvent(chatID))
 		if err != nil {
 			return nil, err
@@ -799,17 +809,17 @@ func (o *Community) RemoveUserFromOrg(pk *ecdsa.PublicKey) (*protobuf.CommunityD
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_KICK_MEMBERS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if o.IsAdmin() && o.IsMemberOwnerOrAdmin(pk) {
+	if isModerator && o.IsMemberOwnerOrAdmin(pk) {
 		return nil, ErrCannotRemoveOwnerOrAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToKickCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		if err != nil {
 			return nil, err
@@ -842,13 +852,13 @@ func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Commu
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_BAN_MEMBERS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToUnbanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		if err != nil {
 			return nil, err
@@ -869,17 +879,17 @@ func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Communi
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_BAN_MEMBERS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if o.IsAdmin() && o.IsMemberOwnerOrAdmin(pk) {
+	if isModerator && o.IsMemberOwnerOrAdmin(pk) {
 		return nil, ErrCannotBanOwnerOrAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToBanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		if err != nil {
 			return nil, err
@@ -1245,6 +1255,15 @@ func (o *Community) validateRequestToJoinWithoutChatID(request *protobuf.Communi
 	//
 	// Hence, not only do we check whether the community permissions are ON_REQUEST but
 	// also NO_MEMBERSHIP.
+	//
+	// Invite links signed by the control node let users request access whatever the permissions,
+	// requests with an invalid or expired link are handled like requests without one.
+	if request.InviteLink != nil {
+		if _, err := o.validateInviteLink(request.InviteLink); err == nil {
+			return nil
+		}
+	}
+
 	if o.config.CommunityDescription.Permissions.Access != protobuf.CommunityPermissions_ON_REQUEST && o.config.CommunityDescription.Permissions.Access != protobuf.CommunityPermissions_NO_MEMBERSHIP {
 		return ErrCantRequestAccess
 	}
@@ -1439,7 +1458,7 @@ func (o *Community) AddTokenPermission(permission *protobuf.CommunityTokenPermis
 	isOwner := o.IsOwner()
 	isAdmin := o.IsAdmin()
 
-	if !isOwner && !isAdmin || (isAdmin && permission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN) {
+	if !isOwner && !isAdmin || (isAdmin && (permission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN || permission.Type == protobuf.CommunityTokenPermission_BECOME_ROLE)) {
 		return nil, ErrNotEnoughPermissions
 	}
 
@@ -1469,7 +1488,7 @@ func (o *Community) UpdateTokenPermission(permissionID string, tokenPermission *
 	isOwner := o.IsOwner()
 	isAdmin := o.IsAdmin()
 
-	if !isOwner && !isAdmin || (isAdmin && tokenPermission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN) {
+	if !isOwner && !isAdmin || (isAdmin && (tokenPermission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN || tokenPermission.Type == protobuf.CommunityTokenPermission_BECOME_ROLE)) {
 		return nil, ErrNotEnoughPermissions
 	}
 
@@ -1505,7 +1524,7 @@ func (o *Community) DeleteTokenPermission(permissionID string) (*CommunityChange
 	isOwner := o.IsOwner()
 	isAdmin := o.IsAdmin()
 
-	if !isOwner && !isAdmin || (isAdmin && permission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN) {
+	if !isOwner && !isAdmin || (isAdmin && (permission.Type == protobuf.CommunityTokenPermission_BECOME_ADMIN || permission.Type == protobuf.CommunityTokenPermission_BECOME_ROLE)) {
 		return nil, ErrNotEnoughPermissions
 	}
 
@@ -1820,7 +1839,7 @@ func (o *Community) AddMember(publicKey *ecdsa.PublicKey, roles []protobuf.Commu
 	}
 
 	if _, ok := o.config.CommunityDescription.Members[memberKey]; !ok {
-		o.config.CommunityDescription.Members[memberKey] = &protobuf.CommunityMember{Roles: roles}
+		o.config.CommunityDescription.Members[memberKey] = &protobuf.CommunityMember{Roles: roles, JoinedAt: uint64(time.Now().Unix())}
 		changes.MembersAdded[memberKey] = o.config.CommunityDescription.Members[memberKey]
 	}
 
@@ -1897,15 +1916,15 @@ func (o *Community) AddMemberWithRevealedAccounts(dbRequest *RequestToJoin, role
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_ACCEPT_REQUESTS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
 	changes := o.addMemberWithRevealedAccounts(dbRequest.PublicKey, roles, accounts, dbRequest.Clock)
 
-	if isAdmin {
+	if isModerator {
 		acceptedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
 		acceptedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
 
@@ -2026,10 +2045,14 @@ func (o *Community) editChat(chatID string, chat *protobuf.CommunityChat) error
 	if o.config.CommunityDescription.Chats == nil {
 		o.config.CommunityDescription.Chats = make(map[string]*protobuf.CommunityChat)
 	}
-	if _, exists := o.config.CommunityDescription.Chats[chatID]; !exists {
+	existing, exists := o.config.CommunityDescription.Chats[chatID]
+	if !exists {
 		return ErrChatNotFound
 	}
 
+	// Roles in the channel are only changed by the control node assigning them
+	chat.MemberRoles = existing.MemberRoles
+
 	o.config.CommunityDescription.Chats[chatID] = chat
 
 	return nil
@@ -2054,6 +2077,9 @@ func (o *Community) createChat(chatID string, chat *protobuf.CommunityChat) erro
 		}
 	}
 
+	// Roles in the channel are only given by the control node assigning them
+	chat.MemberRoles = nil
+
 	// Sets the chat position to be the last within its category
 	chat.Position = 0
 	for _, c := range o.config.CommunityDescription.Chats {
@@ -2104,6 +2130,10 @@ func (o *Community) addTokenPermission(permission *protobuf.CommunityTokenPermis
 		return nil, ErrTokenPermissionAlreadyExists
 	}
 
+	if err := validateRoleIDs(o.config.CommunityDescription, permissionRoleIDs(permission)); err != nil {
+		return nil, ErrRoleNotFound
+	}
+
 	o.config.CommunityDescription.TokenPermissions[permission.Id] = permission
 
 	changes := o.emptyCommunityChanges()
@@ -2124,6 +2154,10 @@ func (o *Community) updateTokenPermission(permission *protobuf.CommunityTokenPer
 		return nil, ErrTokenPermissionNotFound
 	}
 
+	if err := validateRoleIDs(o.config.CommunityDescription, permissionRoleIDs(permission)); err != nil {
+		return nil, ErrRoleNotFound
+	}
+
 	changes := o.emptyCommunityChanges()
 	o.config.CommunityDescription.TokenPermissions[permission.Id] = permission
 
@@ -2156,7 +2190,7 @@ func (o *Community) addMemberWithRevealedAccounts(memberKey string, roles []prot
 	}
 
 	if _, ok := o.config.CommunityDescription.Members[memberKey]; !ok {
-		o.config.CommunityDescription.Members[memberKey] = &protobuf.CommunityMember{Roles: roles}
+		o.config.CommunityDescription.Members[memberKey] = &protobuf.CommunityMember{Roles: roles, JoinedAt: uint64(time.Now().Unix())}
 		changes.MembersAdded[memberKey] = o.config.CommunityDescription.Members[memberKey]
 	}
 
@@ -2172,13 +2206,13 @@ func (o *Community) DeclineRequestToJoin(dbRequest *RequestToJoin) error {
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_ACCEPT_REQUESTS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		rejectedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
 		rejectedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
 
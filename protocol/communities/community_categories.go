@@ -41,13 +41,13 @@ func (o *Community) CreateCategory(categoryID string, categoryName string, chatI
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToCreateCategoryCommunityEvent(categoryID, categoryName, chatIDs))
 		if err != nil {
 			return nil, err
@@ -81,13 +81,13 @@ func (o *Community) EditCategory(categoryID string, categoryName string, chatIDs
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToEditCategoryCommunityEvent(categoryID, categoryName, chatIDs))
 		if err != nil {
 			return nil, err
@@ -122,13 +122,13 @@ func (o *Community) ReorderCategories(categoryID string, newPosition int) (*Comm
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToReorderCategoryCommunityEvent(categoryID, newPosition))
 		if err != nil {
 			return nil, err
@@ -162,13 +162,13 @@ func (o *Community) ReorderChat(categoryID string, chatID string, newPosition in
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToReorderChannelCommunityEvent(categoryID, chatID, newPosition))
 		if err != nil {
 			return nil, err
@@ -317,13 +317,13 @@ func (o *Community) DeleteCategory(categoryID string) (*CommunityChanges, error)
 	defer o.mutex.Unlock()
 
 	isOwner := o.IsOwner()
-	isAdmin := o.IsAdmin()
+	isModerator := !isOwner && o.hasCapability(o.config.MemberIdentity, "", protobuf.CommunityRole_MANAGE_CHANNELS)
 
-	if !isOwner && !isAdmin {
+	if !isOwner && !isModerator {
 		return nil, ErrNotAdmin
 	}
 
-	if isAdmin {
+	if isModerator {
 		err := o.addNewCommunityEvent(o.ToDeleteCategoryCommunityEvent(categoryID))
 		if err != nil {
 			return nil, err
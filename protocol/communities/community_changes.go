@@ -17,6 +17,9 @@ type CommunityChanges struct {
 	MembersAdded   map[string]*protobuf.CommunityMember `json:"membersAdded"`
 	MembersRemoved map[string]*protobuf.CommunityMember `json:"membersRemoved"`
 
+	MembersBanned   []string `json:"membersBanned"`
+	MembersUnbanned []string `json:"membersUnbanned"`
+
 	TokenPermissionsAdded    map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissionsAdded"`
 	TokenPermissionsModified map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissionsModified"`
 	TokenPermissionsRemoved  map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissionsRemoved"`
@@ -46,6 +49,9 @@ func EmptyCommunityChanges() *CommunityChanges {
 		MembersAdded:   make(map[string]*protobuf.CommunityMember),
 		MembersRemoved: make(map[string]*protobuf.CommunityMember),
 
+		MembersBanned:   []string{},
+		MembersUnbanned: []string{},
+
 		TokenPermissionsAdded:    make(map[string]*protobuf.CommunityTokenPermission),
 		TokenPermissionsModified: make(map[string]*protobuf.CommunityTokenPermission),
 		TokenPermissionsRemoved:  make(map[string]*protobuf.CommunityTokenPermission),
@@ -102,6 +108,19 @@ func EvaluateCommunityChanges(origin, modified *protobuf.CommunityDescription) *
 		}
 	}
 
+	// Check for banned and unbanned members
+	for _, pk := range modified.BanList {
+		if !includes(origin.BanList, pk) {
+			changes.MembersBanned = append(changes.MembersBanned, pk)
+		}
+	}
+
+	for _, pk := range origin.BanList {
+		if !includes(modified.BanList, pk) {
+			changes.MembersUnbanned = append(changes.MembersUnbanned, pk)
+		}
+	}
+
 	// check for removed chats
 	for chatID, chat := range origin.Chats {
 		if modified.Chats == nil {
@@ -0,0 +1,92 @@
+package communities
+
+import (
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func (s *CommunitySuite) TestSetModerationRules() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = nil
+
+	rules := &protobuf.CommunityModerationRules{BannedWords: []string{"spam"}, MaxLinks: 2}
+	_, err := org.SetModerationRules(rules)
+	s.Require().Equal(ErrNotControlNode, err)
+
+	org.config.PrivateKey = s.identity
+	_, err = org.SetModerationRules(&protobuf.CommunityModerationRules{BannedPatterns: []string{"(unclosed"}})
+	s.Require().Equal(requests.ErrInvalidModerationRulesPattern, err)
+
+	clock := org.Clock()
+	description, err := org.SetModerationRules(rules)
+	s.Require().NoError(err)
+	s.Require().Equal(rules, description.ModerationRules)
+	s.Require().Equal(clock+1, org.Clock())
+	s.Require().NoError(ValidateCommunityDescription(description))
+}
+
+func (s *CommunitySuite) TestCheckMessage() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	member := &s.member1.PublicKey
+	now := uint64(time.Now().UnixMilli())
+
+	message := func(text string) *ModeratedMessage {
+		return &ModeratedMessage{Author: member, ChatID: testChatID1, Text: text, Timestamp: now}
+	}
+
+	// No rules
+	s.Require().Equal(ModerationViolation(""), org.CheckMessage(message("buy spam now")))
+
+	org.config.CommunityDescription.Chats[testChatID1].SlowModeSeconds = 30
+	org.config.CommunityDescription.ModerationRules = &protobuf.CommunityModerationRules{
+		BannedWords:           []string{"spam", "Free Money"},
+		BannedPatterns:        []string{`(?i)discord\.gg/\w+`},
+		MaxLinks:              1,
+		MaxMentions:           2,
+		NewMemberDelaySeconds: 600,
+	}
+
+	m := message("buy spam now")
+	s.Require().Equal(ModerationViolationBannedWord, org.CheckMessage(m))
+	m = message("get FREE, money!")
+	s.Require().Equal(ModerationViolationBannedWord, org.CheckMessage(m))
+	// Only whole words are banned
+	m = message("spammer")
+	s.Require().Equal(ModerationViolation(""), org.CheckMessage(m))
+	m = message("join Discord.gg/abc")
+	s.Require().Equal(ModerationViolationBannedPattern, org.CheckMessage(m))
+
+	m = message("links")
+	m.Links = 2
+	s.Require().Equal(ModerationViolationTooManyLinks, org.CheckMessage(m))
+	m = message("mentions")
+	m.Mentions = 3
+	s.Require().Equal(ModerationViolationTooManyMentions, org.CheckMessage(m))
+
+	m = message("hello")
+	m.LastPostedAt = now - 10*1000
+	s.Require().Equal(ModerationViolationSlowMode, org.CheckMessage(m))
+	m.LastPostedAt = now - 30*1000
+	s.Require().Equal(ModerationViolation(""), org.CheckMessage(m))
+
+	// Members added before the join time was recorded aren't new
+	org.config.CommunityDescription.Members[s.member1Key].JoinedAt = now/1000 - 60
+	s.Require().Equal(ModerationViolationNewMember, org.CheckMessage(message("hello")))
+	org.config.CommunityDescription.Members[s.member1Key].JoinedAt = 0
+	s.Require().Equal(ModerationViolation(""), org.CheckMessage(message("hello")))
+
+	// Admins aren't moderated
+	org.config.CommunityDescription.Members[s.member1Key].Roles = []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}
+	s.Require().Equal(ModerationViolation(""), org.CheckMessage(message("buy spam now")))
+}
+
+func (s *CommunitySuite) TestValidateSlowMode() {
+	description := s.buildCommunityDescription()
+	description.Chats[testChatID1].SlowModeSeconds = MaxSlowModeSeconds
+	s.Require().NoError(ValidateCommunityDescription(description))
+
+	description.Chats[testChatID1].SlowModeSeconds = MaxSlowModeSeconds + 1
+	s.Require().Equal(ErrInvalidCommunityDescriptionSlowMode, ValidateCommunityDescription(description))
+}
@@ -0,0 +1,535 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"sort"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// legacyRoleCapabilities maps the predefined member roles to the capabilities they grant,
+// admins and owners have all of them
+var legacyRoleCapabilities = map[protobuf.CommunityMember_Roles][]protobuf.CommunityRole_Capability{
+	protobuf.CommunityMember_ROLE_MANAGE_USERS: {
+		protobuf.CommunityRole_KICK_MEMBERS,
+		protobuf.CommunityRole_BAN_MEMBERS,
+		protobuf.CommunityRole_ACCEPT_REQUESTS,
+	},
+	protobuf.CommunityMember_ROLE_MODERATE_CONTENT: {
+		protobuf.CommunityRole_DELETE_MESSAGES,
+	},
+}
+
+func (o *Community) Roles() map[string]*protobuf.CommunityRole {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.config.CommunityDescription.Roles
+}
+
+func (o *Community) GetRole(roleID string) *protobuf.CommunityRole {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.config.CommunityDescription.Roles[roleID]
+}
+
+func (o *Community) CreateRole(role *protobuf.CommunityRole) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	if err := validateCommunityRole(role); err != nil {
+		return nil, err
+	}
+
+	if o.config.CommunityDescription.Roles == nil {
+		o.config.CommunityDescription.Roles = make(map[string]*protobuf.CommunityRole)
+	}
+	if _, exists := o.config.CommunityDescription.Roles[role.Id]; exists {
+		return nil, ErrRoleAlreadyExists
+	}
+
+	o.config.CommunityDescription.Roles[role.Id] = role
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) EditRole(role *protobuf.CommunityRole) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	if err := validateCommunityRole(role); err != nil {
+		return nil, err
+	}
+
+	if _, exists := o.config.CommunityDescription.Roles[role.Id]; !exists {
+		return nil, ErrRoleNotFound
+	}
+
+	o.config.CommunityDescription.Roles[role.Id] = role
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// DeleteRole deletes the role and removes it from the members it was assigned to.
+// Roles granted by a token permission can't be deleted until the permission is
+func (o *Community) DeleteRole(roleID string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	if _, exists := o.config.CommunityDescription.Roles[roleID]; !exists {
+		return nil, ErrRoleNotFound
+	}
+
+	for _, permission := range o.config.CommunityDescription.TokenPermissions {
+		if permission.Type == protobuf.CommunityTokenPermission_BECOME_ROLE && permission.RoleId == roleID {
+			return nil, ErrRoleInUse
+		}
+	}
+
+	delete(o.config.CommunityDescription.Roles, roleID)
+
+	for _, member := range o.config.CommunityDescription.Members {
+		member.RoleIds = removeRoleID(member.RoleIds, roleID)
+		member.TokenRoleIds = removeRoleID(member.TokenRoleIds, roleID)
+	}
+	for _, chat := range o.config.CommunityDescription.Chats {
+		for memberKey, roles := range chat.MemberRoles {
+			roles.RoleIds = removeRoleID(roles.RoleIds, roleID)
+			roles.TokenRoleIds = removeRoleID(roles.TokenRoleIds, roleID)
+			if len(roles.RoleIds) == 0 && len(roles.TokenRoleIds) == 0 {
+				delete(chat.MemberRoles, memberKey)
+			}
+		}
+	}
+
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// AssignRole assigns the role to a member, in the whole community if chatID is empty or only in that channel otherwise
+func (o *Community) AssignRole(pk *ecdsa.PublicKey, roleID string, chatID string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	if _, exists := o.config.CommunityDescription.Roles[roleID]; !exists {
+		return nil, ErrRoleNotFound
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return nil, ErrMemberNotFound
+	}
+
+	if chatID == "" {
+		if containsRoleID(member.RoleIds, roleID) {
+			return o.config.CommunityDescription, nil
+		}
+		member.RoleIds = append(member.RoleIds, roleID)
+	} else {
+		chat, exists := o.config.CommunityDescription.Chats[chatID]
+		if !exists {
+			return nil, ErrChatNotFound
+		}
+		if chat.MemberRoles == nil {
+			chat.MemberRoles = make(map[string]*protobuf.CommunityMemberRoles)
+		}
+		memberKey := common.PubkeyToHex(pk)
+		roles, exists := chat.MemberRoles[memberKey]
+		if !exists {
+			roles = &protobuf.CommunityMemberRoles{}
+			chat.MemberRoles[memberKey] = roles
+		}
+		if containsRoleID(roles.RoleIds, roleID) {
+			return o.config.CommunityDescription, nil
+		}
+		roles.RoleIds = append(roles.RoleIds, roleID)
+	}
+
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// UnassignRole removes a role assigned to a member with AssignRole, roles granted by token permissions are left untouched
+func (o *Community) UnassignRole(pk *ecdsa.PublicKey, roleID string, chatID string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return nil, ErrMemberNotFound
+	}
+
+	updated := false
+	if chatID == "" {
+		if containsRoleID(member.RoleIds, roleID) {
+			member.RoleIds = removeRoleID(member.RoleIds, roleID)
+			updated = true
+		}
+	} else {
+		chat, exists := o.config.CommunityDescription.Chats[chatID]
+		if !exists {
+			return nil, ErrChatNotFound
+		}
+		memberKey := common.PubkeyToHex(pk)
+		if roles, exists := chat.MemberRoles[memberKey]; exists && containsRoleID(roles.RoleIds, roleID) {
+			roles.RoleIds = removeRoleID(roles.RoleIds, roleID)
+			if len(roles.RoleIds) == 0 && len(roles.TokenRoleIds) == 0 {
+				delete(chat.MemberRoles, memberKey)
+			}
+			updated = true
+		}
+	}
+
+	if updated {
+		o.increaseClock()
+	}
+
+	return o.config.CommunityDescription, nil
+}
+
+// SetMemberTokenRoles replaces the roles granted to the member by token permissions,
+// community wide and per channel. It returns whether any role changed
+func (o *Community) SetMemberTokenRoles(pk *ecdsa.PublicKey, roleIDs []string, chatRoleIDs map[string][]string) (bool, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return false, ErrNotControlNode
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return false, ErrMemberNotFound
+	}
+
+	updated := false
+	if !sameRoleIDs(member.TokenRoleIds, roleIDs) {
+		member.TokenRoleIds = sortedRoleIDs(roleIDs)
+		updated = true
+	}
+
+	memberKey := common.PubkeyToHex(pk)
+	for chatID, chat := range o.config.CommunityDescription.Chats {
+		roles := chat.MemberRoles[memberKey]
+		var current []string
+		if roles != nil {
+			current = roles.TokenRoleIds
+		}
+		if sameRoleIDs(current, chatRoleIDs[chatID]) {
+			continue
+		}
+
+		updated = true
+		if roles == nil {
+			roles = &protobuf.CommunityMemberRoles{}
+			if chat.MemberRoles == nil {
+				chat.MemberRoles = make(map[string]*protobuf.CommunityMemberRoles)
+			}
+			chat.MemberRoles[memberKey] = roles
+		}
+		roles.TokenRoleIds = sortedRoleIDs(chatRoleIDs[chatID])
+		if len(roles.RoleIds) == 0 && len(roles.TokenRoleIds) == 0 {
+			delete(chat.MemberRoles, memberKey)
+		}
+	}
+
+	if updated {
+		o.increaseClock()
+	}
+
+	return updated, nil
+}
+
+// HasTokenRoles returns whether any member was granted a role through token permissions
+func (o *Community) HasTokenRoles() bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	for _, member := range o.config.CommunityDescription.Members {
+		if len(member.TokenRoleIds) > 0 {
+			return true
+		}
+	}
+	for _, chat := range o.config.CommunityDescription.Chats {
+		for _, roles := range chat.MemberRoles {
+			if len(roles.TokenRoleIds) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasCapability returns whether the member can perform the action of the capability, in the channel chatID
+// if not empty. The owner and admins have every capability, other members get them from their roles
+func (o *Community) HasCapability(pk *ecdsa.PublicKey, chatID string, capability protobuf.CommunityRole_Capability) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.IsMemberOwner(pk) || o.hasCapability(pk, chatID, capability)
+}
+
+// hasCapability is HasCapability without the owner, who acts on the community directly rather than through events
+func (o *Community) hasCapability(pk *ecdsa.PublicKey, chatID string, capability protobuf.CommunityRole_Capability) bool {
+	if pk == nil {
+		return false
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return false
+	}
+
+	if o.hasMemberPermission(member, adminRolePermissions()) {
+		return true
+	}
+
+	for _, role := range member.Roles {
+		for _, c := range legacyRoleCapabilities[role] {
+			if c == capability {
+				return true
+			}
+		}
+	}
+
+	if o.rolesHaveCapability(member.RoleIds, capability) || o.rolesHaveCapability(member.TokenRoleIds, capability) {
+		return true
+	}
+
+	if chatID == "" {
+		return false
+	}
+
+	chat, exists := o.config.CommunityDescription.Chats[chatID]
+	if !exists {
+		return false
+	}
+	roles, exists := chat.MemberRoles[common.PubkeyToHex(pk)]
+	if !exists {
+		return false
+	}
+	return o.rolesHaveCapability(roles.RoleIds, capability) || o.rolesHaveCapability(roles.TokenRoleIds, capability)
+}
+
+func (o *Community) rolesHaveCapability(roleIDs []string, capability protobuf.CommunityRole_Capability) bool {
+	for _, roleID := range roleIDs {
+		role, exists := o.config.CommunityDescription.Roles[roleID]
+		if !exists {
+			continue
+		}
+		for _, c := range role.Capabilities {
+			if c == capability {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsMemberModerator returns whether the member has any capability, in the community or one of its channels,
+// in which case their changes are propagated as community events for the control node to sign
+func (o *Community) IsMemberModerator(pk *ecdsa.PublicKey) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.isMemberModerator(pk)
+}
+
+func (o *Community) isMemberModerator(pk *ecdsa.PublicKey) bool {
+	if pk == nil {
+		return false
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return false
+	}
+
+	if o.hasMemberPermission(member, adminRolePermissions()) {
+		return true
+	}
+
+	for _, role := range member.Roles {
+		if len(legacyRoleCapabilities[role]) > 0 {
+			return true
+		}
+	}
+
+	// The roles of the member in the whole community and in each channel
+	roleIDs := append(append([]string{}, member.RoleIds...), member.TokenRoleIds...)
+	memberKey := common.PubkeyToHex(pk)
+	for _, chat := range o.config.CommunityDescription.Chats {
+		if roles, exists := chat.MemberRoles[memberKey]; exists {
+			roleIDs = append(roleIDs, roles.RoleIds...)
+			roleIDs = append(roleIDs, roles.TokenRoleIds...)
+		}
+	}
+
+	for _, roleID := range roleIDs {
+		role, exists := o.config.CommunityDescription.Roles[roleID]
+		if !exists {
+			continue
+		}
+		for _, c := range role.Capabilities {
+			if c != protobuf.CommunityRole_UNKNOWN_CAPABILITY {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o *Community) IsModerator() bool {
+	return o.IsMemberModerator(o.config.MemberIdentity)
+}
+
+// GetMembersWithCapability returns the members other than the owner who have the capability in the whole community
+func (o *Community) GetMembersWithCapability(capability protobuf.CommunityRole_Capability) []*ecdsa.PublicKey {
+	members := make([]*ecdsa.PublicKey, 0)
+	for _, member := range o.GetMemberPubkeys() {
+		if o.hasCapability(member, "", capability) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func (o *Community) CanPinMessage(pk *ecdsa.PublicKey, chatID string) bool {
+	return o.AllowsAllMembersToPinMessage() || o.HasCapability(pk, chatID, protobuf.CommunityRole_PIN_MESSAGES)
+}
+
+func (o *Community) CanDeleteMessageForEveryoneInChat(pk *ecdsa.PublicKey, chatID string) bool {
+	return o.HasCapability(pk, chatID, protobuf.CommunityRole_DELETE_MESSAGES)
+}
+
+// eventCapability returns the capability required to send the community event, in the channel it's scoped to if any.
+// Events without a capability can only be sent by admins
+func eventCapability(event *CommunityEvent) (protobuf.CommunityRole_Capability, string) {
+	switch event.Type {
+	case protobuf.CommunityEvent_COMMUNITY_CHANNEL_EDIT, protobuf.CommunityEvent_COMMUNITY_CHANNEL_DELETE:
+		if event.ChannelData != nil {
+			return protobuf.CommunityRole_MANAGE_CHANNELS, event.ChannelData.ChannelId
+		}
+		return protobuf.CommunityRole_MANAGE_CHANNELS, ""
+	case protobuf.CommunityEvent_COMMUNITY_CHANNEL_CREATE, protobuf.CommunityEvent_COMMUNITY_CHANNEL_REORDER,
+		protobuf.CommunityEvent_COMMUNITY_CATEGORY_CREATE, protobuf.CommunityEvent_COMMUNITY_CATEGORY_EDIT,
+		protobuf.CommunityEvent_COMMUNITY_CATEGORY_DELETE, protobuf.CommunityEvent_COMMUNITY_CATEGORY_REORDER:
+		return protobuf.CommunityRole_MANAGE_CHANNELS, ""
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK:
+		return protobuf.CommunityRole_KICK_MEMBERS, ""
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN, protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN:
+		return protobuf.CommunityRole_BAN_MEMBERS, ""
+	case protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT, protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_REJECT:
+		return protobuf.CommunityRole_ACCEPT_REQUESTS, ""
+	}
+	return protobuf.CommunityRole_UNKNOWN_CAPABILITY, ""
+}
+
+// ValidateEventsSigner checks that the signer of the events message is a moderator with the capabilities required
+// by the events it adds. Events already known were checked against the member who sent them first
+func (o *Community) ValidateEventsSigner(signer *ecdsa.PublicKey, message *CommunityEventsMessage) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.isMemberModerator(signer) {
+		return ErrNotAuthorized
+	}
+
+	known := make(map[string]bool)
+	if o.config.EventsData != nil {
+		for _, event := range o.config.EventsData.Events {
+			known[string(event.RawPayload)] = true
+		}
+	}
+
+	isAdmin := o.IsMemberAdmin(signer)
+	for i := range message.Events {
+		event := &message.Events[i]
+		if known[string(event.RawPayload)] || isAdmin {
+			continue
+		}
+
+		capability, chatID := eventCapability(event)
+		if capability == protobuf.CommunityRole_UNKNOWN_CAPABILITY || !o.hasCapability(signer, chatID, capability) {
+			return ErrNotAuthorized
+		}
+
+		// Members accepted by moderators can't be given any role
+		for _, member := range event.MembersAdded {
+			if len(member.Roles) > 0 || len(member.RoleIds) > 0 || len(member.TokenRoleIds) > 0 {
+				return ErrNotAuthorized
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsRoleID(roleIDs []string, roleID string) bool {
+	for _, id := range roleIDs {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+func removeRoleID(roleIDs []string, roleID string) []string {
+	var result []string
+	for _, id := range roleIDs {
+		if id != roleID {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// sortedRoleIDs returns the role IDs sorted and without duplicates
+func sortedRoleIDs(roleIDs []string) []string {
+	var result []string
+	for _, id := range roleIDs {
+		if !containsRoleID(result, id) {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sameRoleIDs(a []string, b []string) bool {
+	a = sortedRoleIDs(a)
+	b = sortedRoleIDs(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package communities
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestCommunityRoles() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	moderator := &protobuf.CommunityRole{
+		Id:           "moderator",
+		Name:         "Moderator",
+		Color:        "#ff0000",
+		Capabilities: []protobuf.CommunityRole_Capability{protobuf.CommunityRole_KICK_MEMBERS, protobuf.CommunityRole_PIN_MESSAGES},
+	}
+
+	_, err := org.CreateRole(&protobuf.CommunityRole{Id: "unnamed"})
+	s.Require().Equal(ErrInvalidCommunityDescriptionRole, err)
+	_, err = org.CreateRole(moderator)
+	s.Require().NoError(err)
+	_, err = org.CreateRole(moderator)
+	s.Require().Equal(ErrRoleAlreadyExists, err)
+	_, err = org.AssignRole(&s.member1.PublicKey, "unknown", "")
+	s.Require().Equal(ErrRoleNotFound, err)
+	_, err = org.AssignRole(&s.member3.PublicKey, moderator.Id, "")
+	s.Require().Equal(ErrMemberNotFound, err)
+
+	s.Require().False(org.HasCapability(&s.member1.PublicKey, testChatID1, protobuf.CommunityRole_PIN_MESSAGES))
+	s.Require().False(org.IsMemberModerator(&s.member1.PublicKey))
+
+	// A role assigned in a channel only applies there
+	_, err = org.AssignRole(&s.member1.PublicKey, moderator.Id, testChatID1)
+	s.Require().NoError(err)
+	s.Require().True(org.HasCapability(&s.member1.PublicKey, testChatID1, protobuf.CommunityRole_PIN_MESSAGES))
+	s.Require().True(org.CanPinMessage(&s.member1.PublicKey, testChatID1))
+	s.Require().False(org.HasCapability(&s.member1.PublicKey, "", protobuf.CommunityRole_KICK_MEMBERS))
+	s.Require().False(org.HasCapability(&s.member1.PublicKey, testChatID1, protobuf.CommunityRole_BAN_MEMBERS))
+	s.Require().True(org.IsMemberModerator(&s.member1.PublicKey))
+
+	_, err = org.AssignRole(&s.member2.PublicKey, moderator.Id, "")
+	s.Require().NoError(err)
+	s.Require().True(org.HasCapability(&s.member2.PublicKey, "", protobuf.CommunityRole_KICK_MEMBERS))
+	s.Require().True(org.HasCapability(&s.member2.PublicKey, testChatID1, protobuf.CommunityRole_KICK_MEMBERS))
+	s.Require().Len(org.GetMembersWithCapability(protobuf.CommunityRole_KICK_MEMBERS), 1)
+
+	// Editing the role changes the capabilities of its members
+	moderator = proto.Clone(moderator).(*protobuf.CommunityRole)
+	moderator.Capabilities = []protobuf.CommunityRole_Capability{protobuf.CommunityRole_BAN_MEMBERS}
+	_, err = org.EditRole(moderator)
+	s.Require().NoError(err)
+	s.Require().False(org.HasCapability(&s.member2.PublicKey, "", protobuf.CommunityRole_KICK_MEMBERS))
+	s.Require().True(org.HasCapability(&s.member2.PublicKey, "", protobuf.CommunityRole_BAN_MEMBERS))
+
+	// The predefined roles map to capabilities as well
+	_, err = org.AddRoleToMember(&s.member1.PublicKey, protobuf.CommunityMember_ROLE_MODERATE_CONTENT)
+	s.Require().NoError(err)
+	s.Require().True(org.CanDeleteMessageForEveryoneInChat(&s.member1.PublicKey, testChatID1))
+	s.Require().False(org.CanDeleteMessageForEveryoneInChat(&s.member2.PublicKey, testChatID1))
+
+	s.Require().NoError(ValidateCommunityDescription(org.Description()))
+
+	// Roles granted through a token permission can't be deleted
+	_, err = org.AddTokenPermission(&protobuf.CommunityTokenPermission{
+		Id:     "permission",
+		Type:   protobuf.CommunityTokenPermission_BECOME_ROLE,
+		RoleId: "unknown",
+	})
+	s.Require().Equal(ErrRoleNotFound, err)
+	_, err = org.AddTokenPermission(&protobuf.CommunityTokenPermission{
+		Id:     "permission",
+		Type:   protobuf.CommunityTokenPermission_BECOME_ROLE,
+		RoleId: moderator.Id,
+	})
+	s.Require().NoError(err)
+	_, err = org.DeleteRole(moderator.Id)
+	s.Require().Equal(ErrRoleInUse, err)
+	_, err = org.DeleteTokenPermission("permission")
+	s.Require().NoError(err)
+
+	_, err = org.DeleteRole(moderator.Id)
+	s.Require().NoError(err)
+	s.Require().False(org.HasCapability(&s.member2.PublicKey, "", protobuf.CommunityRole_BAN_MEMBERS))
+	s.Require().Empty(org.GetMember(&s.member2.PublicKey).RoleIds)
+	s.Require().Empty(org.Chats()[testChatID1].MemberRoles)
+	s.Require().NoError(ValidateCommunityDescription(org.Description()))
+}
+
+func (s *CommunitySuite) TestCommunityRolesValidation() {
+	description := s.buildCommunityDescription()
+	description.Members[s.member1Key].RoleIds = []string{"unknown"}
+	s.Require().Equal(ErrInvalidCommunityDescriptionUnknownRole, ValidateCommunityDescription(description))
+
+	description = s.buildCommunityDescription()
+	description.Roles = map[string]*protobuf.CommunityRole{
+		"role": {Id: "role", Name: "role", Capabilities: []protobuf.CommunityRole_Capability{protobuf.CommunityRole_UNKNOWN_CAPABILITY}},
+	}
+	s.Require().Equal(ErrInvalidCommunityDescriptionRole, ValidateCommunityDescription(description))
+
+	description = s.buildCommunityDescription()
+	description.Roles = map[string]*protobuf.CommunityRole{
+		"role": {Id: "role", Name: "role"},
+	}
+	description.Chats[testChatID1].MemberRoles = map[string]*protobuf.CommunityMemberRoles{
+		s.member3Key: {RoleIds: []string{"role"}},
+	}
+	s.Require().Equal(ErrInvalidCommunityDescriptionMemberInChatButNotInOrg, ValidateCommunityDescription(description))
+}
+
+func (s *CommunitySuite) TestSetMemberTokenRoles() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	_, err := org.CreateRole(&protobuf.CommunityRole{Id: "role", Name: "role"})
+	s.Require().NoError(err)
+
+	updated, err := org.SetMemberTokenRoles(&s.member1.PublicKey, []string{"role", "role"}, map[string][]string{testChatID1: {"role"}})
+	s.Require().NoError(err)
+	s.Require().True(updated)
+	s.Require().Equal([]string{"role"}, org.GetMember(&s.member1.PublicKey).TokenRoleIds)
+	s.Require().Equal([]string{"role"}, org.Chats()[testChatID1].MemberRoles[s.member1Key].TokenRoleIds)
+	s.Require().True(org.HasTokenRoles())
+
+	updated, err = org.SetMemberTokenRoles(&s.member1.PublicKey, []string{"role"}, map[string][]string{testChatID1: {"role"}})
+	s.Require().NoError(err)
+	s.Require().False(updated)
+
+	updated, err = org.SetMemberTokenRoles(&s.member1.PublicKey, nil, nil)
+	s.Require().NoError(err)
+	s.Require().True(updated)
+	s.Require().Empty(org.GetMember(&s.member1.PublicKey).TokenRoleIds)
+	s.Require().Empty(org.Chats()[testChatID1].MemberRoles)
+	s.Require().False(org.HasTokenRoles())
+}
+
+func (s *CommunitySuite) TestValidateEventsSigner() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	_, err := org.CreateRole(&protobuf.CommunityRole{
+		Id:           "channel-manager",
+		Name:         "Channel manager",
+		Capabilities: []protobuf.CommunityRole_Capability{protobuf.CommunityRole_MANAGE_CHANNELS},
+	})
+	s.Require().NoError(err)
+	_, err = org.AssignRole(&s.member1.PublicKey, "channel-manager", testChatID1)
+	s.Require().NoError(err)
+
+	events := func(events ...*CommunityEvent) *CommunityEventsMessage {
+		message := &CommunityEventsMessage{CommunityID: org.ID()}
+		for _, event := range events {
+			payload, err := proto.Marshal(event.ToProtobuf())
+			s.Require().NoError(err)
+			event.RawPayload = payload
+			message.Events = append(message.Events, *event)
+		}
+		return message
+	}
+
+	// The role is scoped to the channel
+	editChannel := org.ToEditChannelCommunityEvent(testChatID1, org.Chats()[testChatID1])
+	s.Require().NoError(org.ValidateEventsSigner(&s.member1.PublicKey, events(editChannel)))
+	s.Require().Equal(ErrNotAuthorized, org.ValidateEventsSigner(&s.member2.PublicKey, events(editChannel)))
+	s.Require().Equal(ErrNotAuthorized, org.ValidateEventsSigner(&s.member1.PublicKey, events(org.ToCreateChannelCommunityEvent("new-channel", org.Chats()[testChatID1]))))
+	s.Require().Equal(ErrNotAuthorized, org.ValidateEventsSigner(&s.member1.PublicKey, events(org.ToKickCommunityMemberCommunityEvent(s.member2Key))))
+
+	// Events only admins can send
+	s.Require().Equal(ErrNotAuthorized, org.ValidateEventsSigner(&s.member1.PublicKey, events(org.ToCommunityEditCommunityEvent(org.Description()))))
+
+	_, err = org.AddRoleToMember(&s.member2.PublicKey, protobuf.CommunityMember_ROLE_ADMIN)
+	s.Require().NoError(err)
+	s.Require().NoError(org.ValidateEventsSigner(&s.member2.PublicKey, events(org.ToCommunityEditCommunityEvent(org.Description()))))
+}
@@ -23,6 +23,7 @@ var ErrInvalidCommunityDescriptionChatIdentity = errors.New("invalid community c
 var ErrInvalidCommunityDescriptionDuplicatedName = errors.New("invalid community chat name, duplicated")
 var ErrInvalidCommunityDescriptionUnknownChatCategory = errors.New("invalid community category in chat")
 var ErrInvalidCommunityTags = errors.New("invalid community tags")
+var ErrInvalidCommunityDescriptionSlowMode = errors.New("invalid community chat slow mode interval")
 var ErrNotAdmin = errors.New("no admin privileges for this community")
 var ErrNotOwner = errors.New("no owner privileges for this community")
 var ErrNotControlNode = errors.New("no owner private key found for this community")
@@ -40,3 +41,8 @@ var ErrMemberWalletNotFound = errors.New("member wallet not found")
 var ErrNotEnoughPermissions = errors.New("not enough permissions for this community")
 var ErrCannotRemoveOwnerOrAdmin = errors.New("not allowed to remove admin or owner")
 var ErrCannotBanOwnerOrAdmin = errors.New("not allowed to ban admin or owner")
+var ErrRoleNotFound = errors.New("community role not found")
+var ErrRoleAlreadyExists = errors.New("community role already exists")
+var ErrRoleInUse = errors.New("community role is granted by a token permission")
+var ErrInvalidCommunityDescriptionRole = errors.New("invalid community role")
+var ErrInvalidCommunityDescriptionUnknownRole = errors.New("invalid community description unknown role")
@@ -0,0 +1,297 @@
+package communities
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/urls"
+)
+
+var ErrInviteLinkInvalid = errors.New("invalid community invite link")
+var ErrInviteLinkNotFound = errors.New("community invite link not found")
+var ErrInviteLinkExpired = errors.New("community invite link expired")
+var ErrInviteLinkRevoked = errors.New("community invite link revoked")
+var ErrInviteLinkExhausted = errors.New("community invite link has no uses left")
+
+type InviteLinkRedemption struct {
+	PublicKey string `json:"publicKey"`
+	// RedeemedAt is the time in seconds at which the member requested to join with the invite
+	RedeemedAt uint64 `json:"redeemedAt"`
+}
+
+// InviteLink is an invite signed by the control node, letting whoever holds it request to join the community,
+// even if it's invitation only
+type InviteLink struct {
+	ID          types.HexBytes `json:"id"`
+	CommunityID types.HexBytes `json:"communityId"`
+	Clock       uint64         `json:"clock"`
+	// ExpiresAt is the expiry time in seconds, zero if the invite never expires
+	ExpiresAt uint64 `json:"expiresAt"`
+	// MaxUses is the maximum number of members who can use the invite, zero if unlimited
+	MaxUses    uint32                         `json:"maxUses"`
+	Role       protobuf.CommunityMember_Roles `json:"role"`
+	AutoAccept bool                           `json:"autoAccept"`
+	Revoked    bool                           `json:"revoked"`
+	// URL is the link to share, built by the messenger from Data and Signature
+	URL         string                  `json:"url"`
+	Redemptions []*InviteLinkRedemption `json:"redemptions"`
+
+	// Data is the encoded CommunityInvite and Signature its encoded signature by the control node
+	Data      string `json:"-"`
+	Signature string `json:"-"`
+}
+
+func (l *InviteLink) ToProtobuf() *protobuf.CommunityInviteLink {
+	return &protobuf.CommunityInviteLink{
+		Data:      l.Data,
+		Signature: l.Signature,
+	}
+}
+
+// Expired returns whether the invite expired at time now, in seconds
+func (l *InviteLink) Expired(now uint64) bool {
+	return l.ExpiresAt != 0 && now >= l.ExpiresAt
+}
+
+// CreateInviteLink creates an invite link to the community, signed with its private key
+func (o *Community) CreateInviteLink(expiresAt uint64, maxUses uint32, role protobuf.CommunityMember_Roles, autoAccept bool) (*InviteLink, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	id := uuid.New()
+	invite := &protobuf.CommunityInvite{
+		CommunityId: crypto.CompressPubkey(o.config.ID),
+		InviteId:    id[:],
+		Clock:       uint64(time.Now().Unix()),
+		ExpiresAt:   expiresAt,
+		MaxUses:     maxUses,
+		Role:        role,
+		AutoAccept:  autoAccept,
+	}
+
+	payload, err := proto.Marshal(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := urls.EncodeDataURL(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.SignBytes([]byte(data), o.config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedSignature, err := urls.EncodeDataURL(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return inviteLinkFromProtobuf(invite, data, encodedSignature), nil
+}
+
+// DecodeInviteLink decodes an invite link and checks that it was signed by the control node of the community it invites to
+func DecodeInviteLink(link *protobuf.CommunityInviteLink) (*InviteLink, error) {
+	if link == nil {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	signature, err := urls.DecodeDataURL(link.Signature)
+	if err != nil {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256([]byte(link.Data)), signature)
+	if err != nil {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	payload, err := urls.DecodeDataURL(link.Data)
+	if err != nil {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	invite := &protobuf.CommunityInvite{}
+	if err := proto.Unmarshal(payload, invite); err != nil {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	if len(invite.InviteId) == 0 || !bytes.Equal(crypto.CompressPubkey(signer), invite.CommunityId) {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	return inviteLinkFromProtobuf(invite, link.Data, link.Signature), nil
+}
+
+func inviteLinkFromProtobuf(invite *protobuf.CommunityInvite, data string, signature string) *InviteLink {
+	return &InviteLink{
+		ID:          invite.InviteId,
+		CommunityID: invite.CommunityId,
+		Clock:       invite.Clock,
+		ExpiresAt:   invite.ExpiresAt,
+		MaxUses:     invite.MaxUses,
+		Role:        invite.Role,
+		AutoAccept:  invite.AutoAccept,
+		Data:        data,
+		Signature:   signature,
+	}
+}
+
+// validateInviteLink checks that the invite link was signed by the control node of the community and hasn't expired.
+// Whether it was revoked or used up is only known to the control node
+func (o *Community) validateInviteLink(link *protobuf.CommunityInviteLink) (*InviteLink, error) {
+	inviteLink, err := DecodeInviteLink(link)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(inviteLink.CommunityID, crypto.CompressPubkey(o.config.ID)) {
+		return nil, ErrInviteLinkInvalid
+	}
+
+	if inviteLink.Expired(uint64(time.Now().Unix())) {
+		return nil, ErrInviteLinkExpired
+	}
+
+	return inviteLink, nil
+}
+
+func (p *Persistence) SaveInviteLink(link *InviteLink) error {
+	_, err := p.db.Exec(`INSERT INTO communities_invite_links (id, community_id, clock, expires_at, max_uses, role, auto_accept, revoked, data, signature) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		link.ID, link.CommunityID, link.Clock, link.ExpiresAt, link.MaxUses, link.Role, link.AutoAccept, link.Revoked, link.Data, link.Signature)
+	return err
+}
+
+func (p *Persistence) RevokeInviteLink(communityID types.HexBytes, id types.HexBytes) error {
+	result, err := p.db.Exec(`UPDATE communities_invite_links SET revoked = TRUE WHERE community_id = ? AND id = ?`, communityID, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrInviteLinkNotFound
+	}
+	return nil
+}
+
+const inviteLinksBaseQuery = `SELECT id, community_id, clock, expires_at, max_uses, role, auto_accept, revoked, data, signature FROM communities_invite_links`
+
+func (p *Persistence) queryInviteLinks(query string, args ...interface{}) ([]*InviteLink, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*InviteLink
+	for rows.Next() {
+		link := &InviteLink{}
+		var id, communityID []byte
+		err := rows.Scan(&id, &communityID, &link.Clock, &link.ExpiresAt, &link.MaxUses, &link.Role, &link.AutoAccept, &link.Revoked, &link.Data, &link.Signature)
+		if err != nil {
+			return nil, err
+		}
+		link.ID = id
+		link.CommunityID = communityID
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Release the connection before querying the redemptions
+	_ = rows.Close()
+
+	for _, link := range links {
+		link.Redemptions, err = p.getInviteLinkRedemptions(link.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return links, nil
+}
+
+func (p *Persistence) GetInviteLink(id types.HexBytes) (*InviteLink, error) {
+	links, err := p.queryInviteLinks(inviteLinksBaseQuery+` WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+	return links[0], nil
+}
+
+func (p *Persistence) GetInviteLinks(communityID types.HexBytes) ([]*InviteLink, error) {
+	return p.queryInviteLinks(inviteLinksBaseQuery+` WHERE community_id = ? ORDER BY clock DESC`, communityID)
+}
+
+func (p *Persistence) getInviteLinkRedemptions(id types.HexBytes) ([]*InviteLinkRedemption, error) {
+	rows, err := p.db.Query(`SELECT public_key, redeemed_at FROM communities_invite_link_redemptions WHERE invite_id = ? ORDER BY redeemed_at ASC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	redemptions := []*InviteLinkRedemption{}
+	for rows.Next() {
+		redemption := &InviteLinkRedemption{}
+		if err := rows.Scan(&redemption.PublicKey, &redemption.RedeemedAt); err != nil {
+			return nil, err
+		}
+		redemptions = append(redemptions, redemption)
+	}
+	return redemptions, rows.Err()
+}
+
+// SaveInviteLinkRedemption records that member joined with the invite link.
+// A member redeeming the same invite again doesn't use it up further
+func (p *Persistence) SaveInviteLinkRedemption(link *InviteLink, member string) error {
+	_, err := p.db.Exec(`INSERT INTO communities_invite_link_redemptions (invite_id, community_id, public_key, redeemed_at) VALUES (?, ?, ?, ?)`,
+		link.ID, link.CommunityID, member, time.Now().Unix())
+	return err
+}
+
+// SaveInviteLinkRequest records that member requested to join with the invite link, replacing the invite link of
+// their previous request. The invite link is only redeemed once they are accepted
+func (p *Persistence) SaveInviteLinkRequest(link *InviteLink, member string) error {
+	_, err := p.db.Exec(`INSERT INTO communities_invite_link_requests (community_id, public_key, invite_id) VALUES (?, ?, ?)`,
+		link.CommunityID, member, link.ID)
+	return err
+}
+
+// GetInviteLinkRequest returns the invite link member requested to join with, nil if there's none
+func (p *Persistence) GetInviteLinkRequest(communityID types.HexBytes, member string) (*InviteLink, error) {
+	var id []byte
+	err := p.db.QueryRow(`SELECT invite_id FROM communities_invite_link_requests WHERE community_id = ? AND public_key = ?`, communityID, member).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.GetInviteLink(id)
+}
+
+func (p *Persistence) DeleteInviteLinkRequest(communityID types.HexBytes, member string) error {
+	_, err := p.db.Exec(`DELETE FROM communities_invite_link_requests WHERE community_id = ? AND public_key = ?`, communityID, member)
+	return err
+}
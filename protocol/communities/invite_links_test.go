@@ -0,0 +1,119 @@
+package communities
+
+import (
+	"time"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestInviteLinks() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.CommunityDescription.Permissions.Access = protobuf.CommunityPermissions_INVITATION_ONLY
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	signer := &key.PublicKey
+
+	request := &protobuf.CommunityRequestToJoin{CommunityId: s.communityID}
+	s.Require().Equal(ErrCantRequestAccess, org.ValidateRequestToJoin(signer, request))
+
+	inviteLink, err := org.CreateInviteLink(0, 1, protobuf.CommunityMember_ROLE_MODERATE_CONTENT, true)
+	s.Require().NoError(err)
+
+	decoded, err := DecodeInviteLink(inviteLink.ToProtobuf())
+	s.Require().NoError(err)
+	s.Require().Equal(inviteLink.ID, decoded.ID)
+	s.Require().Equal(org.ID(), decoded.CommunityID)
+	s.Require().Equal(uint32(1), decoded.MaxUses)
+	s.Require().Equal(protobuf.CommunityMember_ROLE_MODERATE_CONTENT, decoded.Role)
+	s.Require().True(decoded.AutoAccept)
+
+	request.InviteLink = inviteLink.ToProtobuf()
+	s.Require().NoError(org.ValidateRequestToJoin(signer, request))
+
+	// Tampered invites are rejected, the request is handled like one without a link
+	tampered, err := org.CreateInviteLink(0, 0, protobuf.CommunityMember_ROLE_NONE, false)
+	s.Require().NoError(err)
+	request.InviteLink = &protobuf.CommunityInviteLink{Data: tampered.Data, Signature: inviteLink.Signature}
+	_, err = org.validateInviteLink(request.InviteLink)
+	s.Require().Equal(ErrInviteLinkInvalid, err)
+	s.Require().Equal(ErrCantRequestAccess, org.ValidateRequestToJoin(signer, request))
+
+	expired, err := org.CreateInviteLink(uint64(time.Now().Unix())-1, 0, protobuf.CommunityMember_ROLE_NONE, false)
+	s.Require().NoError(err)
+	request.InviteLink = expired.ToProtobuf()
+	_, err = org.validateInviteLink(request.InviteLink)
+	s.Require().Equal(ErrInviteLinkExpired, err)
+	s.Require().Equal(ErrCantRequestAccess, org.ValidateRequestToJoin(signer, request))
+
+	org.config.CommunityDescription.Permissions.Access = protobuf.CommunityPermissions_ON_REQUEST
+	s.Require().NoError(org.ValidateRequestToJoin(signer, request))
+	org.config.CommunityDescription.Permissions.Access = protobuf.CommunityPermissions_INVITATION_ONLY
+
+	// Invites to other communities are rejected
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	other := s.buildCommunity(&otherKey.PublicKey)
+	other.config.PrivateKey = otherKey
+	otherInviteLink, err := other.CreateInviteLink(0, 0, protobuf.CommunityMember_ROLE_NONE, true)
+	s.Require().NoError(err)
+	request.InviteLink = otherInviteLink.ToProtobuf()
+	_, err = org.validateInviteLink(request.InviteLink)
+	s.Require().Equal(ErrInviteLinkInvalid, err)
+	s.Require().Equal(ErrCantRequestAccess, org.ValidateRequestToJoin(signer, request))
+
+	org.config.PrivateKey = nil
+	_, err = org.CreateInviteLink(0, 0, protobuf.CommunityMember_ROLE_NONE, false)
+	s.Require().Equal(ErrNotControlNode, err)
+}
+
+func (s *PersistenceSuite) TestInviteLinks() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	memberKey := common.PubkeyToHex(&member.PublicKey)
+
+	com := s.makeNewCommunity(identity)
+	inviteLink, err := com.CreateInviteLink(0, 2, protobuf.CommunityMember_ROLE_MODERATE_CONTENT, true)
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SaveInviteLink(inviteLink))
+
+	requested, err := s.db.GetInviteLinkRequest(com.ID(), memberKey)
+	s.Require().NoError(err)
+	s.Require().Nil(requested)
+
+	// The invite link of a request to join isn't used up until the member is accepted
+	s.Require().NoError(s.db.SaveInviteLinkRequest(inviteLink, memberKey))
+	requested, err = s.db.GetInviteLinkRequest(com.ID(), memberKey)
+	s.Require().NoError(err)
+	s.Require().Equal(inviteLink.ID, requested.ID)
+	s.Require().Len(requested.Redemptions, 0)
+
+	// Redeeming twice counts once
+	s.Require().NoError(s.db.SaveInviteLinkRedemption(inviteLink, memberKey))
+	s.Require().NoError(s.db.SaveInviteLinkRedemption(inviteLink, memberKey))
+
+	links, err := s.db.GetInviteLinks(com.ID())
+	s.Require().NoError(err)
+	s.Require().Len(links, 1)
+	s.Require().Equal(inviteLink.ID, links[0].ID)
+	s.Require().Equal(inviteLink.Data, links[0].Data)
+	s.Require().Equal(inviteLink.Signature, links[0].Signature)
+	s.Require().Len(links[0].Redemptions, 1)
+	s.Require().Equal(memberKey, links[0].Redemptions[0].PublicKey)
+
+	s.Require().NoError(s.db.DeleteInviteLinkRequest(com.ID(), memberKey))
+	requested, err = s.db.GetInviteLinkRequest(com.ID(), memberKey)
+	s.Require().NoError(err)
+	s.Require().Nil(requested)
+
+	s.Require().NoError(s.db.RevokeInviteLink(com.ID(), inviteLink.ID))
+	stored, err := s.db.GetInviteLink(inviteLink.ID)
+	s.Require().NoError(err)
+	s.Require().True(stored.Revoked)
+
+	s.Require().Equal(ErrInviteLinkNotFound, s.db.RevokeInviteLink(com.ID(), []byte{0x01}))
+}
@@ -1,6 +1,7 @@
 package communities
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
@@ -38,6 +39,7 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/protocol/v1"
 	"github.com/status-im/status-go/services/wallet/bigint"
 	walletcommon "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
@@ -81,6 +83,7 @@ type Manager struct {
 	historyArchiveTasksWaitGroup   sync.WaitGroup
 	historyArchiveTasks            sync.Map // stores `chan struct{}`
 	periodicMemberPermissionsTasks sync.Map // stores `chan struct{}`
+	calendarEventTokenGates        sync.Map // stores `map[string]bool`
 	torrentTasks                   map[string]metainfo.Hash
 	historyArchiveDownloadTasks    map[string]*HistoryArchiveDownloadTask
 	stopped                        bool
@@ -621,11 +624,14 @@ func (m *Manager) EditCommunityTokenPermission(request *requests.EditCommunityTo
 func (m *Manager) CheckMemberPermissions(community *Community, removeAdmins bool) error {
 	becomeMemberPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_MEMBER)
 	becomeAdminPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ADMIN)
+	becomeRolePermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ROLE)
 
 	adminPermissions := len(becomeAdminPermissions) > 0
 	memberPermissions := len(becomeMemberPermissions) > 0
+	// Roles granted by permissions which were since deleted need to be taken back too
+	rolePermissions := len(becomeRolePermissions) > 0 || community.HasTokenRoles()
 
-	if !adminPermissions && !memberPermissions && !removeAdmins {
+	if !adminPermissions && !memberPermissions && !rolePermissions && !removeAdmins {
 		return nil
 	}
 
@@ -654,6 +660,13 @@ func (m *Manager) CheckMemberPermissions(community *Community, removeAdmins bool
 
 		accountsAndChainIDs := revealedAccountsToAccountsAndChainIDsCombination(member.RevealedAccounts)
 
+		if rolePermissions {
+			err = m.updateMemberTokenRoles(community, memberPubKey, becomeRolePermissions, accountsAndChainIDs)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Check if user is still an admin or can become an admin and do update of member role
 		removeAdminRole := false
 		if adminPermissions {
@@ -706,6 +719,33 @@ func (m *Manager) CheckMemberPermissions(community *Community, removeAdmins bool
 	return nil
 }
 
+// updateMemberTokenRoles grants the member the roles of the BECOME_ROLE permissions they satisfy and takes back the others
+func (m *Manager) updateMemberTokenRoles(community *Community, member *ecdsa.PublicKey, permissions []*protobuf.CommunityTokenPermission, accountsAndChainIDs []*AccountChainIDsCombination) error {
+	var roleIDs []string
+	chatRoleIDs := make(map[string][]string)
+
+	for _, permission := range permissions {
+		permissionResponse, err := m.checkPermissionToJoin([]*protobuf.CommunityTokenPermission{permission}, accountsAndChainIDs, true)
+		if err != nil {
+			return err
+		}
+		if !permissionResponse.Satisfied {
+			continue
+		}
+
+		if len(permission.ChatIds) == 0 {
+			roleIDs = append(roleIDs, permission.RoleId)
+			continue
+		}
+		for _, chatID := range permission.ChatIds {
+			chatRoleIDs[chatID] = append(chatRoleIDs[chatID], permission.RoleId)
+		}
+	}
+
+	_, err := community.SetMemberTokenRoles(member, roleIDs, chatRoleIDs)
+	return err
+}
+
 func (m *Manager) CheckIfStopCheckingPermissionsPeriodically(community *Community) {
 	if cancel, exists := m.periodicMemberPermissionsTasks.Load(community.IDString()); exists &&
 		len(community.TokenPermissions()) == 0 {
@@ -1243,12 +1283,7 @@ func (m *Manager) handleCommunityDescriptionMessageCommon(community *Community,
 		}
 	}
 
-	err = m.persistence.DeleteCommunityEvents(community.ID())
-	if err != nil {
-		return nil, err
-	}
-
-	err = m.persistence.SaveCommunity(community)
+	err = m.persistence.SaveCommunityAndDeleteEvents(community)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,7 +1301,7 @@ func (m *Manager) handleCommunityDescriptionMessageCommon(community *Community,
 	}, nil
 }
 
-func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message *protobuf.CommunityEventsMessage) (*CommunityResponse, error) {
+func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message *protobuf.CommunityEventsMessage, payload []byte) (*CommunityResponse, error) {
 	if signer == nil {
 		return nil, errors.New("signer can't be nil")
 	}
@@ -1285,8 +1320,9 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 		return nil, ErrOrgNotFound
 	}
 
-	if !community.IsMemberAdmin(signer) {
-		return nil, errors.New("user is not an admin")
+	err = community.ValidateEventsSigner(signer, adminMessage)
+	if err != nil {
+		return nil, err
 	}
 
 	changes, err := community.UpdateCommunityByEvents(adminMessage)
@@ -1299,6 +1335,12 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 		return nil, err
 	}
 
+	// Events are recorded before the owner signs them into a new description, which supersedes them
+	err = m.persistence.SaveCommunityEventsAuditLogEntries(changes.Community, payload)
+	if err != nil {
+		return nil, err
+	}
+
 	// Owner cerifies admins events and publish changes
 	// all other users only apply changes to the community
 	if changes.Community.IsOwner() {
@@ -1557,6 +1599,26 @@ func (m *Manager) AcceptRequestToJoin(request *requests.AcceptRequestToJoinCommu
 		memberRoles = []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}
 	}
 
+	// The invite link the member requested to join with is only used up now that they are accepted
+	var inviteLink *InviteLink
+	if community.IsControlNode() {
+		inviteLink, err = m.persistence.GetInviteLinkRequest(dbRequest.CommunityID, dbRequest.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if inviteLink != nil && checkInviteLinkUses(inviteLink, dbRequest.PublicKey) != nil {
+			inviteLink = nil
+		}
+	}
+	if inviteLink != nil && inviteLink.Role != protobuf.CommunityMember_ROLE_NONE && !(isAdmin && inviteLink.Role == protobuf.CommunityMember_ROLE_ADMIN) {
+		memberRoles = append(memberRoles, inviteLink.Role)
+	}
+
+	// Moderators can't give roles, the control node grants the admin role on its next permissions check
+	if !community.IsOwnerOrAdmin() {
+		memberRoles = []protobuf.CommunityMember_Roles{}
+	}
+
 	pk, err := common.HexToPubkey(dbRequest.PublicKey)
 	if err != nil {
 		return nil, err
@@ -1576,6 +1638,19 @@ func (m *Manager) AcceptRequestToJoin(request *requests.AcceptRequestToJoinCommu
 		return nil, err
 	}
 
+	if inviteLink != nil {
+		err = m.persistence.SaveInviteLinkRedemption(inviteLink, dbRequest.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if community.IsControlNode() {
+		err = m.persistence.DeleteInviteLinkRequest(dbRequest.CommunityID, dbRequest.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return community, nil
 }
 
@@ -1599,6 +1674,11 @@ func (m *Manager) DeclineRequestToJoin(request *requests.DeclineRequestToJoinCom
 		return err
 	}
 
+	err = m.persistence.DeleteInviteLinkRequest(dbRequest.CommunityID, dbRequest.PublicKey)
+	if err != nil {
+		return err
+	}
+
 	err = community.DeclineRequestToJoin(dbRequest)
 	if err != nil {
 		return err
@@ -1732,10 +1812,38 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, request
 		}
 	}
 
+	// Only the control node knows whether an invite link was revoked or used up.
+	// The invite link of a previous request doesn't carry over to this one
+	acceptWithInviteLink := false
+	if community.IsControlNode() {
+		err = m.persistence.DeleteInviteLinkRequest(community.ID(), common.PubkeyToHex(signer))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if request.InviteLink != nil && community.IsControlNode() {
+		inviteLink, err := m.checkInviteLink(signer, community, request.InviteLink)
+		switch err {
+		case nil:
+			err = m.persistence.SaveInviteLinkRequest(inviteLink, common.PubkeyToHex(signer))
+			if err != nil {
+				return nil, err
+			}
+			acceptWithInviteLink = inviteLink.AutoAccept
+		case ErrInviteLinkExpired, ErrInviteLinkInvalid:
+			// Handled like a request without an invite link
+		case ErrInviteLinkNotFound, ErrInviteLinkRevoked, ErrInviteLinkExhausted:
+			requestToJoin.State = RequestToJoinStateDeclined
+			return requestToJoin, nil
+		default:
+			return nil, err
+		}
+	}
+
 	// If user is already a member, then accept request automatically
 	// It may happen when member removes itself from community and then tries to rejoin
 	// More specifically, CommunityRequestToLeave may be delivered later than CommunityRequestToJoin, or not delivered at all
-	acceptAutomatically := community.AcceptRequestToJoinAutomatically() || community.HasMember(signer)
+	acceptAutomatically := community.AcceptRequestToJoinAutomatically() || community.HasMember(signer) || acceptWithInviteLink
 	if acceptAutomatically {
 		err = m.markRequestToJoin(signer, community)
 		if err != nil {
@@ -2634,6 +2742,525 @@ func (m *Manager) AddRoleToMember(request *requests.AddRoleToMember) (*Community
 	return community, nil
 }
 
+func (m *Manager) CreateRole(request *requests.CreateCommunityRole) (*Community, *protobuf.CommunityRole, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	role := request.ToCommunityRole(uuid.New().String())
+	_, err = community.CreateRole(role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, role, nil
+}
+
+func (m *Manager) EditRole(request *requests.EditCommunityRole) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.EditRole(request.ToCommunityRole())
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+func (m *Manager) DeleteRole(request *requests.DeleteCommunityRole) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.DeleteRole(request.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+func (m *Manager) AssignRole(request *requests.AssignCommunityRole) (*Community, error) {
+	publicKey, err := common.HexToPubkey(request.User.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.AssignRole(publicKey, request.RoleID, strings.TrimPrefix(request.ChatID, request.CommunityID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+func (m *Manager) UnassignRole(request *requests.UnassignCommunityRole) (*Community, error) {
+	publicKey, err := common.HexToPubkey(request.User.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.UnassignRole(publicKey, request.RoleID, strings.TrimPrefix(request.ChatID, request.CommunityID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// SaveCalendarEvent stores an event of the community published by signer, returning it along with whether it
+// replaced what we knew of it
+func (m *Manager) SaveCalendarEvent(signer *ecdsa.PublicKey, event *protobuf.CommunityCalendarEvent) (*CalendarEvent, bool, error) {
+	community, err := m.GetByID(event.CommunityId)
+	if err != nil {
+		return nil, false, err
+	}
+	if community == nil {
+		return nil, false, ErrOrgNotFound
+	}
+
+	err = community.ValidateCalendarEvent(signer, event)
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := m.persistence.GetCalendarEvent(event.Id)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil && !bytes.Equal(existing.CommunityID, community.ID()) {
+		return nil, false, ErrCalendarEventInvalid
+	}
+
+	saved, err := m.persistence.SaveCalendarEvent(CalendarEventFromProtobuf(event, common.PubkeyToHex(signer)))
+	if err != nil {
+		return nil, false, err
+	}
+
+	calendarEvent, err := m.persistence.GetCalendarEvent(event.Id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return calendarEvent, saved, nil
+}
+
+// SaveCalendarEventRsvp stores the response of signer to an event, returning the event along with whether the
+// response replaced the previous one
+func (m *Manager) SaveCalendarEventRsvp(signer *ecdsa.PublicKey, rsvp *protobuf.CommunityCalendarEventRsvp) (*CalendarEvent, bool, error) {
+	community, err := m.GetByID(rsvp.CommunityId)
+	if err != nil {
+		return nil, false, err
+	}
+	if community == nil {
+		return nil, false, ErrOrgNotFound
+	}
+
+	err = community.ValidateCalendarEventRsvp(signer, rsvp)
+	if err != nil {
+		return nil, false, err
+	}
+
+	event, err := m.persistence.GetCalendarEvent(rsvp.EventId)
+	if err != nil {
+		return nil, false, err
+	}
+	if event == nil || !bytes.Equal(event.CommunityID, community.ID()) {
+		return nil, false, ErrCalendarEventNotFound
+	}
+
+	calendarEventRsvp := calendarEventRsvpFromProtobuf(signer, rsvp)
+	if calendarEventRsvp.Attending() {
+		if event.Cancelled {
+			return nil, false, ErrCalendarEventCancelled
+		}
+
+		// Responses of members whose tokens we haven't checked yet are kept
+		satisfied, checked := m.cachedCalendarEventTokenGate(community, signer, event)
+		if checked && !satisfied {
+			return nil, false, ErrCalendarEventTokenGate
+		}
+	}
+
+	saved, err := m.persistence.SaveCalendarEventRsvp(community.ID(), calendarEventRsvp)
+	if err != nil {
+		return nil, false, err
+	}
+
+	event, err = m.persistence.GetCalendarEvent(rsvp.EventId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return event, saved, nil
+}
+
+func calendarEventTokenGatesKey(communityID types.HexBytes, member *ecdsa.PublicKey) string {
+	return communityID.String() + common.PubkeyToHex(member)
+}
+
+// EvaluateCalendarEventTokenGates checks which of the upcoming token gated events of the community, at time now in
+// seconds, the member holds the tokens to attend in the accounts they revealed, and caches the result. Balances are
+// fetched once for all the events, token gates are only ever checked against the cache
+func (m *Manager) EvaluateCalendarEventTokenGates(community *Community, member *ecdsa.PublicKey, now uint64) error {
+	events, err := m.persistence.GetCalendarEvents(community.ID(), now)
+	if err != nil {
+		return err
+	}
+
+	var permissions []*protobuf.CommunityTokenPermission
+	for _, event := range events {
+		if len(event.TokenCriteria) > 0 {
+			permissions = append(permissions, &protobuf.CommunityTokenPermission{
+				Id:            event.ID,
+				Type:          protobuf.CommunityTokenPermission_BECOME_MEMBER,
+				TokenCriteria: event.TokenCriteria,
+			})
+		}
+	}
+
+	key := calendarEventTokenGatesKey(community.ID(), member)
+	communityMember := community.GetMember(member)
+	if len(permissions) == 0 || communityMember == nil || len(communityMember.RevealedAccounts) == 0 {
+		m.calendarEventTokenGates.Delete(key)
+		return nil
+	}
+
+	accountsAndChainIDs := revealedAccountsToAccountsAndChainIDsCombination(communityMember.RevealedAccounts)
+	response, err := m.checkPermissionToJoin(permissions, accountsAndChainIDs, false)
+	if err != nil {
+		return err
+	}
+
+	satisfied := make(map[string]bool)
+	for _, permission := range permissions {
+		result := response.Permissions[permission.Id]
+		met := result != nil && len(result.Criteria) == len(permission.TokenCriteria)
+		for i := 0; met && i < len(result.Criteria); i++ {
+			met = result.Criteria[i]
+		}
+		satisfied[permission.Id] = met
+	}
+	m.calendarEventTokenGates.Store(key, satisfied)
+
+	return nil
+}
+
+// cachedCalendarEventTokenGate returns whether the member holds the tokens required to attend the event, along with
+// whether they were checked by EvaluateCalendarEventTokenGates. Admins can attend any event
+func (m *Manager) cachedCalendarEventTokenGate(community *Community, member *ecdsa.PublicKey, event *CalendarEvent) (bool, bool) {
+	if len(event.TokenCriteria) == 0 || community.IsMemberOwnerOrAdmin(member) {
+		return true, true
+	}
+
+	communityMember := community.GetMember(member)
+	if communityMember == nil || len(communityMember.RevealedAccounts) == 0 {
+		return false, true
+	}
+
+	satisfied, ok := m.calendarEventTokenGates.Load(calendarEventTokenGatesKey(community.ID(), member))
+	if !ok {
+		return false, false
+	}
+	eventSatisfied, ok := satisfied.(map[string]bool)[event.ID]
+	return eventSatisfied, ok
+}
+
+// CalendarEventVisible returns whether the member can see the event, token gated events are only shown to the
+// members known to hold the tokens to attend them
+func (m *Manager) CalendarEventVisible(event *CalendarEvent, member *ecdsa.PublicKey) bool {
+	community, err := m.GetByID(event.CommunityID)
+	if err != nil || community == nil {
+		return false
+	}
+
+	satisfied, _ := m.cachedCalendarEventTokenGate(community, member, event)
+	return satisfied
+}
+
+// GetCalendarEvent returns the event if the member can see it
+func (m *Manager) GetCalendarEvent(id string, member *ecdsa.PublicKey) (*CalendarEvent, error) {
+	event, err := m.persistence.GetCalendarEvent(id)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	if !m.CalendarEventVisible(event, member) {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	return event, nil
+}
+
+// GetCalendarEvents returns the events of the community which haven't ended at time since, in seconds,
+// and which the member can see
+func (m *Manager) GetCalendarEvents(communityID types.HexBytes, member *ecdsa.PublicKey, since uint64) ([]*CalendarEvent, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	events, err := m.persistence.GetCalendarEvents(communityID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []*CalendarEvent
+	for _, event := range events {
+		if satisfied, _ := m.cachedCalendarEventTokenGate(community, member, event); satisfied {
+			visible = append(visible, event)
+		}
+	}
+
+	return visible, nil
+}
+
+func (m *Manager) GetUpcomingCalendarEvents(now uint64) ([]*CalendarEvent, error) {
+	return m.persistence.GetUpcomingCalendarEvents(now)
+}
+
+func (m *Manager) GetCalendarEventsToRemind(member string, now uint64, until uint64) ([]*CalendarEvent, error) {
+	return m.persistence.GetCalendarEventsToRemind(member, now, until)
+}
+
+func (m *Manager) MarkCalendarEventReminded(id string) error {
+	return m.persistence.MarkCalendarEventReminded(id)
+}
+
+func (m *Manager) SetModerationRules(request *requests.SetCommunityModerationRules) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.SetModerationRules(request.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// GetAuditLog returns a page of the audit log of the community, newest entries first.
+// Only owners and admins can read it
+func (m *Manager) GetAuditLog(request *requests.GetCommunityAuditLog) (*AuditLogPage, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwnerOrAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	var cursor uint64
+	if request.Cursor != "" {
+		cursor, err = strconv.ParseUint(request.Cursor, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := request.Limit
+	if limit == 0 {
+		limit = requests.DefaultCommunityAuditLogLimit
+	}
+
+	entries, err := m.persistence.GetAuditLog(community.ID(), cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AuditLogPage{Entries: entries}
+	if len(entries) == limit {
+		page.Cursor = strconv.FormatUint(entries[len(entries)-1].Seq, 10)
+	}
+
+	return page, nil
+}
+
+// VerifyAuditLog checks that the audit log of the community hasn't been tampered with
+func (m *Manager) VerifyAuditLog(communityID types.HexBytes) error {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return ErrOrgNotFound
+	}
+	if !community.IsOwnerOrAdmin() {
+		return ErrNotAdmin
+	}
+
+	return m.persistence.VerifyAuditLog(community)
+}
+
+func (m *Manager) CreateInviteLink(request *requests.CreateCommunityInviteLink) (*InviteLink, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	inviteLink, err := community.CreateInviteLink(request.ExpiresAt, request.MaxUses, request.Role, request.AutoAccept)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveInviteLink(inviteLink)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteLink.Redemptions = []*InviteLinkRedemption{}
+	return inviteLink, nil
+}
+
+func (m *Manager) GetInviteLinks(communityID types.HexBytes) ([]*InviteLink, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	return m.persistence.GetInviteLinks(community.ID())
+}
+
+func (m *Manager) RevokeInviteLink(request *requests.RevokeCommunityInviteLink) error {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return ErrOrgNotFound
+	}
+	if !community.IsControlNode() {
+		return ErrNotControlNode
+	}
+
+	return m.persistence.RevokeInviteLink(community.ID(), request.InviteID)
+}
+
+// checkInviteLink checks that the invite link signer requested to join with was created by us and can still be used.
+// It is only used up once signer is accepted
+func (m *Manager) checkInviteLink(signer *ecdsa.PublicKey, community *Community, link *protobuf.CommunityInviteLink) (*InviteLink, error) {
+	decoded, err := community.validateInviteLink(link)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteLink, err := m.persistence.GetInviteLink(decoded.ID)
+	if err != nil {
+		return nil, err
+	}
+	if inviteLink == nil || !bytes.Equal(inviteLink.CommunityID, community.ID()) {
+		return nil, ErrInviteLinkNotFound
+	}
+
+	err = checkInviteLinkUses(inviteLink, common.PubkeyToHex(signer))
+	if err != nil {
+		return nil, err
+	}
+
+	return inviteLink, nil
+}
+
+// checkInviteLinkUses checks that the invite link wasn't revoked nor used up by other members than member
+func checkInviteLinkUses(inviteLink *InviteLink, member string) error {
+	if inviteLink.Revoked {
+		return ErrInviteLinkRevoked
+	}
+
+	for _, redemption := range inviteLink.Redemptions {
+		if redemption.PublicKey == member {
+			return nil
+		}
+	}
+	if inviteLink.MaxUses > 0 && len(inviteLink.Redemptions) >= int(inviteLink.MaxUses) {
+		return ErrInviteLinkExhausted
+	}
+
+	return nil
+}
+
 func (m *Manager) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (*Community, error) {
 	id := request.CommunityID
 	publicKey, err := common.HexToPubkey(request.User.String())
@@ -4016,13 +4643,30 @@ func (m *Manager) saveAndPublish(community *Community) error {
 		return nil
 	}
 
-	if community.IsAdmin() {
+	if community.IsModerator() {
 		err := m.persistence.SaveCommunityEvents(community)
 		if err != nil {
 			return err
 		}
 
-		m.publish(&Subscription{CommunityEventsMessage: community.ToCommunityEventsMessage()})
+		eventsMessage := community.ToCommunityEventsMessage()
+		payload, err := eventsMessage.Marshal()
+		if err != nil {
+			return err
+		}
+
+		// Events are recorded from the message as it will be signed when published
+		signedMessage, err := protocol.WrapMessageV1(payload, protobuf.ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE, m.identity)
+		if err != nil {
+			return err
+		}
+
+		err = m.persistence.SaveCommunityEventsAuditLogEntries(community, signedMessage)
+		if err != nil {
+			return err
+		}
+
+		m.publish(&Subscription{CommunityEventsMessage: eventsMessage})
 		return nil
 	}
 
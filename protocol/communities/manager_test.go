@@ -3,6 +3,7 @@ package communities
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"image"
 	"image/png"
 	"io/ioutil"
@@ -108,6 +109,7 @@ func (m *testCollectiblesManager) FetchBalancesByOwnerAndContractAddress(chainID
 
 type testTokenManager struct {
 	response map[uint64]map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big
+	err      error
 }
 
 func (m *testTokenManager) setResponse(chainID uint64, walletAddress, tokenAddress gethcommon.Address, balance int64) {
@@ -133,6 +135,9 @@ func (m *testTokenManager) GetAllChainIDs() ([]uint64, error) {
 }
 
 func (m *testTokenManager) GetBalancesByChain(ctx context.Context, accounts, tokenAddresses []gethcommon.Address, chainIDs []uint64) (map[uint64]map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
 	return m.response, nil
 }
 
@@ -328,6 +333,84 @@ func (s *ManagerSuite) TestCreateCommunity_WithBanner() {
 	s.Require().Positive(len(testIdentityImage.Payload))
 }
 
+func (s *ManagerSuite) TestHandleRequestToJoinWithExpiredInviteLink() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_ON_REQUEST,
+	}
+
+	community, err := s.manager.CreateCommunity(request, true)
+	s.Require().NoError(err)
+
+	expired, err := community.CreateInviteLink(uint64(time.Now().Unix())-1, 0, protobuf.CommunityMember_ROLE_NONE, true)
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.persistence.SaveInviteLink(expired))
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	// The request is handled like one without an invite link
+	requestToJoin, err := s.manager.HandleCommunityRequestToJoin(&key.PublicKey, &protobuf.CommunityRequestToJoin{
+		Clock:       1,
+		CommunityId: community.ID(),
+		InviteLink:  expired.ToProtobuf(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(RequestToJoinStatePending, requestToJoin.State)
+
+	pending, err := s.manager.PendingRequestsToJoinForCommunity(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+}
+
+func (s *ManagerSuite) TestInviteLinkRedeemedOnceAccepted() {
+	community, err := s.manager.CreateCommunity(&requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_ON_REQUEST,
+	}, true)
+	s.Require().NoError(err)
+
+	inviteLink, err := community.CreateInviteLink(0, 1, protobuf.CommunityMember_ROLE_MODERATE_CONTENT, false)
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.persistence.SaveInviteLink(inviteLink))
+
+	requestToJoin := func(clock uint64) (*ecdsa.PublicKey, *RequestToJoin) {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		request, err := s.manager.HandleCommunityRequestToJoin(&key.PublicKey, &protobuf.CommunityRequestToJoin{
+			Clock:       clock,
+			CommunityId: community.ID(),
+			InviteLink:  inviteLink.ToProtobuf(),
+		})
+		s.Require().NoError(err)
+		return &key.PublicKey, request
+	}
+	redemptions := func() int {
+		stored, err := s.manager.persistence.GetInviteLink(inviteLink.ID)
+		s.Require().NoError(err)
+		return len(stored.Redemptions)
+	}
+
+	// Declined requests don't use up the invite link
+	_, declined := requestToJoin(1)
+	s.Require().Equal(RequestToJoinStatePending, declined.State)
+	s.Require().Equal(0, redemptions())
+	s.Require().NoError(s.manager.DeclineRequestToJoin(&requests.DeclineRequestToJoinCommunity{ID: declined.ID}))
+	s.Require().Equal(0, redemptions())
+
+	member, accepted := requestToJoin(2)
+	s.Require().Equal(RequestToJoinStatePending, accepted.State)
+	community, err = s.manager.AcceptRequestToJoin(&requests.AcceptRequestToJoinCommunity{ID: accepted.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(1, redemptions())
+	s.Require().Equal([]protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}, community.GetMember(member).Roles)
+
+	_, exhausted := requestToJoin(3)
+	s.Require().Equal(RequestToJoinStateDeclined, exhausted.State)
+}
+
 func (s *ManagerSuite) TestEditCommunity() {
 	//create community
 	createRequest := &requests.CreateCommunity{
@@ -0,0 +1,128 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// MaxSlowModeSeconds is the longest interval a channel can require between two messages of a member
+const MaxSlowModeSeconds = 6 * 60 * 60
+
+// ModerationViolation is the moderation rule a message posted in a community chat breaks
+type ModerationViolation string
+
+const (
+	ModerationViolationSlowMode        ModerationViolation = "slow-mode"
+	ModerationViolationNewMember       ModerationViolation = "new-member"
+	ModerationViolationTooManyLinks    ModerationViolation = "too-many-links"
+	ModerationViolationTooManyMentions ModerationViolation = "too-many-mentions"
+	ModerationViolationBannedWord      ModerationViolation = "banned-word"
+	ModerationViolationBannedPattern   ModerationViolation = "banned-pattern"
+)
+
+func (v ModerationViolation) Error() string {
+	return "message breaks the community moderation rules: " + string(v)
+}
+
+// ModeratedMessage is a message posted in a community chat, as checked against the moderation rules
+type ModeratedMessage struct {
+	Author *ecdsa.PublicKey
+	// ChatID is the ID of the chat within the community
+	ChatID   string
+	Text     string
+	Links    int
+	Mentions int
+	// Timestamp is the time in milliseconds at which the message was posted
+	Timestamp uint64
+	// LastPostedAt is the time in milliseconds of the previous message of the author in the chat, zero if none
+	LastPostedAt uint64
+}
+
+func (o *Community) ModerationRules() *protobuf.CommunityModerationRules {
+	return o.config.CommunityDescription.ModerationRules
+}
+
+func (o *Community) SetModerationRules(rules *protobuf.CommunityModerationRules) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	if err := requests.ValidateModerationRules(rules); err != nil {
+		return nil, err
+	}
+
+	o.config.CommunityDescription.ModerationRules = rules
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// CheckMessage returns the rule the message breaks, or an empty violation if it doesn't break any.
+// Owners and admins aren't subject to the moderation rules
+func (o *Community) CheckMessage(message *ModeratedMessage) ModerationViolation {
+	if o.IsMemberOwnerOrAdmin(message.Author) {
+		return ""
+	}
+
+	if chat, ok := o.config.CommunityDescription.Chats[message.ChatID]; ok && chat.SlowModeSeconds > 0 && message.LastPostedAt > 0 {
+		if message.Timestamp < message.LastPostedAt+uint64(chat.SlowModeSeconds)*1000 {
+			return ModerationViolationSlowMode
+		}
+	}
+
+	rules := o.config.CommunityDescription.ModerationRules
+	if rules == nil {
+		return ""
+	}
+
+	if member := o.getMember(message.Author); member != nil && member.JoinedAt > 0 && rules.NewMemberDelaySeconds > 0 {
+		if message.Timestamp/1000 < member.JoinedAt+uint64(rules.NewMemberDelaySeconds) {
+			return ModerationViolationNewMember
+		}
+	}
+
+	if rules.MaxLinks > 0 && message.Links > int(rules.MaxLinks) {
+		return ModerationViolationTooManyLinks
+	}
+
+	if rules.MaxMentions > 0 && message.Mentions > int(rules.MaxMentions) {
+		return ModerationViolationTooManyMentions
+	}
+
+	if len(rules.BannedWords) > 0 {
+		text := " " + normalizeModeratedText(message.Text) + " "
+		for _, word := range rules.BannedWords {
+			word = normalizeModeratedText(word)
+			if word != "" && strings.Contains(text, " "+word+" ") {
+				return ModerationViolationBannedWord
+			}
+		}
+	}
+
+	for _, pattern := range rules.BannedPatterns {
+		// Patterns are validated with the community description
+		re, err := regexp.Compile(pattern)
+		if err == nil && re.MatchString(message.Text) {
+			return ModerationViolationBannedPattern
+		}
+	}
+
+	return ""
+}
+
+// normalizeModeratedText lowercases text and separates its words with single spaces, so that banned words
+// only match whole words whatever the punctuation around them
+func normalizeModeratedText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
@@ -36,7 +36,53 @@ const communitiesBaseQuery = `
 	LEFT JOIN communities_requests_to_join r ON c.id = r.community_id AND r.public_key = ?
 	LEFT JOIN communities_events ae ON c.id = ae.id`
 
-func (p *Persistence) SaveCommunity(community *Community) error {
+func (p *Persistence) SaveCommunity(community *Community) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	return p.saveCommunity(tx, community)
+}
+
+// SaveCommunityAndDeleteEvents saves community, with a description signed by the control node over the
+// pending admin events, and deletes the events in the same transaction
+func (p *Persistence) SaveCommunityAndDeleteEvents(community *Community) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	// The community is saved first, so that the audit log entry of the description is evaluated against
+	// the description the events were made on
+	err = p.saveCommunity(tx, community)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM communities_events WHERE id = ?;`, community.ID())
+	return err
+}
+
+func (p *Persistence) saveCommunity(tx *sql.Tx, community *Community) error {
 	id := community.ID()
 	privateKey := community.PrivateKey()
 	description, err := community.ToBytes()
@@ -44,7 +90,12 @@ func (p *Persistence) SaveCommunity(community *Community) error {
 		return err
 	}
 
-	_, err = p.db.Exec(`
+	err = p.saveDescriptionAuditLogEntry(tx, community, description)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
 		INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified, muted, muted_till) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 		id, crypto.FromECDSA(privateKey), description, community.config.Joined, community.config.Spectated, community.config.Verified, community.config.Muted, community.config.MuteTill)
 	return err
@@ -76,7 +127,12 @@ func (p *Persistence) SaveCommunityEvents(community *Community) error {
 
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec(`DELETE FROM communities_communities WHERE id = ?;
-						 DELETE FROM communities_events WHERE id = ?;`, id, id)
+						 DELETE FROM communities_events WHERE id = ?;
+						 DELETE FROM communities_audit_log WHERE community_id = ?;
+						 DELETE FROM communities_invite_links WHERE community_id = ?;
+						 DELETE FROM communities_invite_link_redemptions WHERE community_id = ?;
+						 DELETE FROM communities_calendar_events WHERE community_id = ?;
+						 DELETE FROM communities_calendar_event_rsvps WHERE community_id = ?;`, id, id, id, id, id, id, id)
 	return err
 }
 
@@ -26,6 +26,22 @@ func validateCommunityChat(desc *protobuf.CommunityDescription, chat *protobuf.C
 		return ErrInvalidCommunityDescriptionChatIdentity
 	}
 
+	if chat.SlowModeSeconds > MaxSlowModeSeconds {
+		return ErrInvalidCommunityDescriptionSlowMode
+	}
+
+	for pk, roles := range chat.MemberRoles {
+		if _, ok := desc.Members[pk]; !ok {
+			return ErrInvalidCommunityDescriptionMemberInChatButNotInOrg
+		}
+		if err := validateRoleIDs(desc, roles.RoleIds); err != nil {
+			return err
+		}
+		if err := validateRoleIDs(desc, roles.TokenRoleIds); err != nil {
+			return err
+		}
+	}
+
 	for pk := range chat.Members {
 		if desc.Members == nil {
 			return ErrInvalidCommunityDescriptionMemberInChatButNotInOrg
@@ -39,6 +55,37 @@ func validateCommunityChat(desc *protobuf.CommunityDescription, chat *protobuf.C
 	return nil
 }
 
+func validateCommunityRole(role *protobuf.CommunityRole) error {
+	if role == nil || len(role.Id) == 0 || len(role.Name) == 0 {
+		return ErrInvalidCommunityDescriptionRole
+	}
+
+	for _, capability := range role.Capabilities {
+		if _, ok := protobuf.CommunityRole_Capability_name[int32(capability)]; !ok || capability == protobuf.CommunityRole_UNKNOWN_CAPABILITY {
+			return ErrInvalidCommunityDescriptionRole
+		}
+	}
+
+	return nil
+}
+
+func validateRoleIDs(desc *protobuf.CommunityDescription, roleIDs []string) error {
+	for _, roleID := range roleIDs {
+		if _, ok := desc.Roles[roleID]; !ok {
+			return ErrInvalidCommunityDescriptionUnknownRole
+		}
+	}
+	return nil
+}
+
+// permissionRoleIDs returns the role granted by a BECOME_ROLE permission
+func permissionRoleIDs(permission *protobuf.CommunityTokenPermission) []string {
+	if permission.Type != protobuf.CommunityTokenPermission_BECOME_ROLE {
+		return nil
+	}
+	return []string{permission.RoleId}
+}
+
 func validateCommunityCategory(category *protobuf.CommunityCategory) error {
 	if len(category.CategoryId) == 0 {
 		return ErrInvalidCommunityDescriptionCategoryNoID
@@ -67,6 +114,34 @@ func ValidateCommunityDescription(desc *protobuf.CommunityDescription) error {
 		return ErrInvalidCommunityTags
 	}
 
+	if err := requests.ValidateModerationRules(desc.ModerationRules); err != nil {
+		return err
+	}
+
+	for id, role := range desc.Roles {
+		if err := validateCommunityRole(role); err != nil {
+			return err
+		}
+		if role.Id != id {
+			return ErrInvalidCommunityDescriptionRole
+		}
+	}
+
+	for _, member := range desc.Members {
+		if err := validateRoleIDs(desc, member.RoleIds); err != nil {
+			return err
+		}
+		if err := validateRoleIDs(desc, member.TokenRoleIds); err != nil {
+			return err
+		}
+	}
+
+	for _, permission := range desc.TokenPermissions {
+		if err := validateRoleIDs(desc, permissionRoleIDs(permission)); err != nil {
+			return err
+		}
+	}
+
 	for _, category := range desc.Categories {
 		if err := validateCommunityCategory(category); err != nil {
 			return err
@@ -557,6 +557,100 @@ func (s *AdminMessengerCommunitiesSuite) TestAdminBanUnbanMember() {
 	s.adminUnbanAlice(unbanRequest)
 }
 
+func (s *AdminMessengerCommunitiesSuite) TestAdminActionsAuditLog() {
+	community := s.setUpCommunityAndRoles()
+
+	s.adminBanAlice(&requests.BanUserFromCommunity{
+		CommunityID: community.ID(),
+		User:        common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+	})
+
+	aliceKey := common.PubkeyToHex(&s.alice.identity.PublicKey)
+	request := &requests.GetCommunityAuditLog{CommunityID: community.ID()}
+
+	for _, user := range []*Messenger{s.owner, s.admin} {
+		page, err := user.GetCommunityAuditLog(request)
+		s.Require().NoError(err)
+
+		var banEvent, banDescription *communities.AuditLogEntry
+		for _, entry := range page.Entries {
+			if entry.Source == communities.AuditLogSourceEvent && entry.EventType == protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN {
+				banEvent = entry
+			}
+			if entry.Source == communities.AuditLogSourceDescription && len(entry.Changes.MembersBanned) > 0 {
+				banDescription = entry
+			}
+		}
+
+		s.Require().NotNil(banEvent)
+		s.Require().Equal(common.PubkeyToHex(&s.admin.identity.PublicKey), banEvent.Signer)
+		s.Require().Equal([]string{aliceKey}, banEvent.Changes.MembersBanned)
+		s.Require().Equal(communities.AuditLogStatusSuperseded, banEvent.Status)
+
+		s.Require().NotNil(banDescription)
+		s.Require().Equal(common.PubkeyToHex(community.PublicKey()), banDescription.Signer)
+		s.Require().Equal([]string{aliceKey}, banDescription.Changes.MembersBanned)
+		s.Require().Equal(banDescription.Clock, banEvent.SupersededByClock)
+
+		s.Require().NoError(user.VerifyCommunityAuditLog(community.ID()))
+	}
+
+	_, err := s.alice.GetCommunityAuditLog(request)
+	s.Require().Equal(communities.ErrNotAdmin, err)
+}
+
+func (s *AdminMessengerCommunitiesSuite) TestCustomRoleKickMember() {
+	// owner creates a community and chat
+	community := s.createCommunity(protobuf.CommunityPermissions_NO_MEMBERSHIP)
+	s.refreshMessengerResponses()
+
+	s.advertiseCommunityTo(community, s.admin)
+	s.advertiseCommunityTo(community, s.alice)
+	s.joinCommunity(community, s.admin)
+	s.joinCommunity(community, s.alice)
+
+	s.refreshMessengerResponses()
+
+	// only the owner manages roles
+	_, err := s.admin.CreateCommunityRole(&requests.CreateCommunityRole{CommunityID: community.ID(), Name: "Kicker"})
+	s.Require().ErrorIs(err, communities.ErrNotControlNode)
+
+	role, err := s.owner.CreateCommunityRole(&requests.CreateCommunityRole{
+		CommunityID:  community.ID(),
+		Name:         "Kicker",
+		Color:        "#000000",
+		Capabilities: []protobuf.CommunityRole_Capability{protobuf.CommunityRole_KICK_MEMBERS},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.owner.AssignCommunityRole(&requests.AssignCommunityRole{
+		CommunityID: community.ID(),
+		User:        common.PubkeyToHexBytes(&s.admin.identity.PublicKey),
+		RoleID:      role.Id,
+	})
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(s.admin, func(response *MessengerResponse) bool {
+		return len(response.Communities()) > 0 && response.Communities()[0].IsModerator()
+	}, "community description with the role not received")
+	s.Require().NoError(err)
+	_, err = WaitOnMessengerResponse(s.alice, func(response *MessengerResponse) bool {
+		return len(response.Communities()) > 0 && response.Communities()[0].IsMemberModerator(&s.admin.identity.PublicKey)
+	}, "community description with the role not received")
+	s.Require().NoError(err)
+
+	s.refreshMessengerResponses()
+
+	// the role lets the member kick but not ban
+	_, err = s.admin.BanUserFromCommunity(&requests.BanUserFromCommunity{
+		CommunityID: community.ID(),
+		User:        common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+	})
+	s.Require().ErrorIs(err, communities.ErrNotAdmin)
+
+	s.adminKickAlice(community.ID(), common.PubkeyToHex(&s.alice.identity.PublicKey))
+}
+
 func (s *AdminMessengerCommunitiesSuite) TestAdminDeleteAnyMessageInTheCommunity() {
 	community := s.setUpCommunityAndRoles()
 	chatID := community.ChatIDs()[0]
@@ -21,6 +21,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/discord"
@@ -2278,6 +2279,78 @@ func (s *MessengerCommunitiesSuite) TestBanUser() {
 	s.Require().False(community.IsBanned(&s.alice.identity.PublicKey))
 }
 
+func (s *MessengerCommunitiesSuite) TestCommunityModerationRules() {
+	community, chat := createCommunity(&s.Suite, s.admin)
+	s.advertiseCommunityTo(community, s.alice)
+	s.joinCommunity(community, s.alice)
+
+	response, err := s.alice.SetCommunityModerationRules(&requests.SetCommunityModerationRules{
+		CommunityID: community.ID(),
+		Rules:       &protobuf.CommunityModerationRules{BannedWords: []string{"spam"}},
+	})
+	s.Require().ErrorIs(err, communities.ErrNotControlNode)
+	s.Require().Nil(response)
+
+	response, err = s.admin.SetCommunityModerationRules(&requests.SetCommunityModerationRules{
+		CommunityID: community.ID(),
+		Rules:       &protobuf.CommunityModerationRules{BannedWords: []string{"spam"}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().Equal([]string{"spam"}, response.Communities()[0].ModerationRules().BannedWords)
+
+	// Alice doesn't know the rules yet, the message is dropped when received
+	sendChatMessage(&s.Suite, s.alice, chat.ID, "buy spam")
+
+	var notification *ActivityCenterNotification
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.admin.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		s.Require().Len(response.Messages(), 0)
+		for _, n := range response.ActivityCenterNotifications() {
+			if n.Type == ActivityCenterNotificationTypeCommunityModeration {
+				notification = n
+			}
+		}
+		if notification == nil {
+			return errors.New("moderation notification not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(communities.ModerationViolationBannedWord, notification.ModerationViolation)
+	s.Require().Equal(chat.ID, notification.ChatID)
+	s.Require().Equal("buy spam", notification.Message.Text)
+
+	saved, err := s.admin.persistence.GetActivityCenterNotificationByID(notification.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(communities.ModerationViolationBannedWord, saved.ModerationViolation)
+
+	// Once the rules are received, the message isn't sent
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(response.Communities()) == 0 || response.Communities()[0].ModerationRules() == nil {
+			return errors.New("moderation rules not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	_, err = s.alice.SendChatMessage(context.Background(), &common.Message{
+		ChatMessage: protobuf.ChatMessage{
+			ChatId:      chat.ID,
+			ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+			Text:        "more spam",
+		},
+	})
+	s.Require().ErrorIs(err, communities.ModerationViolationBannedWord)
+}
+
 func (s *MessengerCommunitiesSuite) TestSyncCommunitySettings() {
 	// Create new device
 	alicesOtherDevice := s.newMessengerWithKey(s.alice.identity)
@@ -3422,3 +3495,174 @@ func (s *MessengerCommunitiesSuite) TestGetCommunityIdFromKey() {
 	communityID = s.bob.GetCommunityIDFromKey(privateKey)
 	s.Require().Equal(communityID, publicKey)
 }
+
+func (s *MessengerCommunitiesSuite) TestCommunityInviteLink() {
+	response, err := s.admin.CreateCommunity(&requests.CreateCommunity{
+		Membership:  protobuf.CommunityPermissions_INVITATION_ONLY,
+		Name:        "status",
+		Color:       "#ffffff",
+		Description: "status community description",
+	}, true)
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	community := response.Communities()[0]
+
+	s.advertiseCommunityTo(community, s.alice)
+	s.advertiseCommunityTo(community, s.bob)
+
+	_, err = s.alice.CreateCommunityInviteLink(&requests.CreateCommunityInviteLink{CommunityID: community.ID()})
+	s.Require().ErrorIs(err, communities.ErrNotControlNode)
+
+	inviteLink, err := s.admin.CreateCommunityInviteLink(&requests.CreateCommunityInviteLink{
+		CommunityID: community.ID(),
+		MaxUses:     1,
+		Role:        protobuf.CommunityMember_ROLE_MODERATE_CONTENT,
+		AutoAccept:  true,
+	})
+	s.Require().NoError(err)
+
+	urlData, err := s.alice.ParseSharedURL(inviteLink.URL)
+	s.Require().NoError(err)
+	s.Require().NotNil(urlData.Invite)
+	s.Require().Equal(community.ID(), urlData.Invite.CommunityID)
+	s.Require().True(urlData.Invite.AutoAccept)
+	s.Require().Equal("status", urlData.Community.DisplayName)
+
+	// Alice is accepted automatically with the role of the invite
+	joinCommunity(&s.Suite, community, s.admin, s.alice, &requests.RequestToJoinCommunity{
+		CommunityID: community.ID(),
+		InviteLink:  inviteLink.URL,
+	})
+
+	community, err = s.admin.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	member := community.GetMember(&s.alice.identity.PublicKey)
+	s.Require().NotNil(member)
+	s.Require().Equal([]protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}, member.Roles)
+
+	inviteLinks, err := s.admin.GetCommunityInviteLinks(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(inviteLinks, 1)
+	s.Require().Equal(inviteLink.URL, inviteLinks[0].URL)
+	s.Require().Len(inviteLinks[0].Redemptions, 1)
+	s.Require().Equal(common.PubkeyToHex(&s.alice.identity.PublicKey), inviteLinks[0].Redemptions[0].PublicKey)
+
+	// The invite is used up, Bob is declined
+	_, err = s.bob.RequestToJoinCommunity(&requests.RequestToJoinCommunity{
+		CommunityID: community.ID(),
+		InviteLink:  inviteLink.URL,
+	})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.admin.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		declined, err := s.admin.DeclinedRequestsToJoinForCommunity(community.ID())
+		if err != nil {
+			return err
+		}
+		if len(declined) == 0 {
+			return errors.New("request to join not declined")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().False(community.HasMember(&s.bob.identity.PublicKey))
+
+	err = s.admin.RevokeCommunityInviteLink(&requests.RevokeCommunityInviteLink{
+		CommunityID: community.ID(),
+		InviteID:    inviteLink.ID,
+	})
+	s.Require().NoError(err)
+
+	inviteLinks, err = s.admin.GetCommunityInviteLinks(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(inviteLinks[0].Revoked)
+}
+
+func (s *MessengerCommunitiesSuite) TestCommunityCalendarEvents() {
+	community, chat := createCommunity(&s.Suite, s.admin)
+
+	s.advertiseCommunityTo(community, s.alice)
+	joinCommunity(&s.Suite, community, s.admin, s.alice, &requests.RequestToJoinCommunity{CommunityID: community.ID()})
+
+	startTime := uint64(time.Now().Add(time.Hour).Unix())
+	request := &requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		ChatID:      chat.ID,
+		Title:       "AMA",
+		StartTime:   startTime,
+		EndTime:     startTime + 3600,
+	}
+
+	// Only admins publish events
+	_, err := s.alice.CreateCommunityCalendarEvent(context.Background(), request)
+	s.Require().ErrorIs(err, communities.ErrNotAuthorized)
+
+	response, err := s.admin.CreateCommunityCalendarEvent(context.Background(), request)
+	s.Require().NoError(err)
+	s.Require().Len(response.CommunityCalendarEvents(), 1)
+	event := response.CommunityCalendarEvents()[0]
+	s.Require().Equal(chat.CommunityChatID(), event.ChatID)
+	s.Require().Equal(common.PubkeyToHex(&s.admin.identity.PublicKey), event.PublishedBy)
+
+	_, err = WaitOnMessengerResponse(s.alice, func(r *MessengerResponse) bool {
+		return len(r.CommunityCalendarEvents()) > 0
+	}, "calendar event not received")
+	s.Require().NoError(err)
+
+	_, err = s.alice.RsvpCommunityCalendarEvent(context.Background(), &requests.RsvpCommunityCalendarEvent{
+		EventID:  event.ID,
+		Response: protobuf.CommunityCalendarEventRsvp_GOING,
+	})
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(s.admin, func(r *MessengerResponse) bool {
+		return len(r.CommunityCalendarEvents()) > 0 && len(r.CommunityCalendarEvents()[0].Rsvps) > 0
+	}, "calendar event response not received")
+	s.Require().NoError(err)
+
+	events, err := s.admin.CommunityCalendarEvents(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Require().Equal(protobuf.CommunityCalendarEventRsvp_GOING, events[0].Rsvp(common.PubkeyToHex(&s.alice.identity.PublicKey)).Response)
+
+	ics, err := s.alice.ExportCommunityCalendarEvents(community.ID())
+	s.Require().NoError(err)
+	s.Require().Contains(ics, "SUMMARY:AMA\r\n")
+	s.Require().Contains(ics, "LOCATION:#status-core in status\r\n")
+
+	// Alice is reminded once of the event she is going to
+	err = s.alice.settings.SaveSettingField(settings.NotificationsEnabled, true)
+	s.Require().NoError(err)
+
+	notifications, err := s.alice.remindCommunityCalendarEvents(startTime - uint64(2*communityEventReminderLeadTime.Seconds()))
+	s.Require().NoError(err)
+	s.Require().Empty(notifications)
+
+	notifications, err = s.alice.remindCommunityCalendarEvents(startTime - 60)
+	s.Require().NoError(err)
+	s.Require().Len(notifications, 1)
+	s.Require().Equal("AMA", notifications[0].Title)
+
+	notifications, err = s.alice.remindCommunityCalendarEvents(startTime - 30)
+	s.Require().NoError(err)
+	s.Require().Empty(notifications)
+
+	// Cancelled events can't be attended
+	_, err = s.admin.CancelCommunityCalendarEvent(context.Background(), event.ID)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(s.alice, func(r *MessengerResponse) bool {
+		return len(r.CommunityCalendarEvents()) > 0 && r.CommunityCalendarEvents()[0].Cancelled
+	}, "calendar event cancellation not received")
+	s.Require().NoError(err)
+
+	_, err = s.alice.RsvpCommunityCalendarEvent(context.Background(), &requests.RsvpCommunityCalendarEvent{
+		EventID:  event.ID,
+		Response: protobuf.CommunityCalendarEventRsvp_MAYBE,
+	})
+	s.Require().ErrorIs(err, communities.ErrCalendarEventCancelled)
+}
@@ -3,8 +3,10 @@ package protocol
 import (
 	"crypto/ecdsa"
 	"encoding/json"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
@@ -12,10 +14,11 @@ import (
 )
 
 type NotificationBody struct {
-	Message   *common.Message        `json:"message"`
-	Contact   *Contact               `json:"contact"`
-	Chat      *Chat                  `json:"chat"`
-	Community *communities.Community `json:"community"`
+	Message       *common.Message            `json:"message"`
+	Contact       *Contact                   `json:"contact"`
+	Chat          *Chat                      `json:"chat"`
+	Community     *communities.Community     `json:"community"`
+	CalendarEvent *communities.CalendarEvent `json:"calendarEvent,omitempty"`
 }
 
 func showMessageNotification(publicKey ecdsa.PublicKey, message *common.Message, chat *Chat, responseTo *common.Message) bool {
@@ -84,6 +87,16 @@ func NewPrivateGroupInviteNotification(id string, chat *Chat, contact *Contact,
 	return body.toPrivateGroupInviteNotification(id, profilePicturesVisibility)
 }
 
+func NewCommunityEventReminderNotification(event *communities.CalendarEvent, community *communities.Community, chat *Chat) *localnotifications.Notification {
+	body := &NotificationBody{
+		Community:     community,
+		Chat:          chat,
+		CalendarEvent: event,
+	}
+
+	return body.toCommunityEventReminderNotification()
+}
+
 func (n NotificationBody) toMessageNotification(id string, contacts *contactMap, profilePicturesVisibility int) (*localnotifications.Notification, error) {
 	var title string
 	if n.Chat.PrivateGroupChat() || n.Chat.Public() || n.Chat.CommunityChat() {
@@ -163,3 +176,18 @@ func (n NotificationBody) toCommunityRequestToJoinNotification(id string) *local
 		Image:    "",
 	}
 }
+
+func (n NotificationBody) toCommunityEventReminderNotification() *localnotifications.Notification {
+	startTime := time.Unix(int64(n.CalendarEvent.StartTime), 0)
+	return &localnotifications.Notification{
+		ID:             gethcommon.BytesToHash(crypto.Keccak256([]byte(n.CalendarEvent.ID), []byte(startTime.String()))),
+		Body:           n,
+		Title:          n.CalendarEvent.Title,
+		Message:        n.CalendarEvent.Title + " starts at " + startTime.Format(time.Kitchen) + " in #" + n.Chat.Name,
+		BodyType:       localnotifications.TypeCommunityEvent,
+		Category:       localnotifications.CategoryCommunityEventReminder,
+		Deeplink:       n.Chat.DeepLink(),
+		ConversationID: n.Chat.ID,
+		Timestamp:      n.CalendarEvent.StartTime * 1000,
+	}
+}
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/sqlite"
 )
 
 var basicMessagesSelectQuery = `
@@ -29,6 +33,8 @@ LEFT JOIN discord_messages m2_dm
 ON        m2.discord_message_id = m2_dm.id
 LEFT JOIN discord_message_authors m2_dm_author
 ON        m2_dm.author_id = m2_dm_author.id
+LEFT JOIN message_threads mt
+ON        m1.id = mt.thread_id
 `
 
 var basicInsertDiscordMessageAuthorQuery = `INSERT OR REPLACE INTO discord_message_authors(id,name,discriminator,nickname,avatar_url, avatar_image_payload) VALUES (?,?,?,?,?,?)`
@@ -103,7 +109,9 @@ func (db sqlitePersistence) tableUserMessagesAllFields() string {
 		contact_verification_status,
 		mentioned,
 		replied,
-    discord_message_id`
+    discord_message_id,
+		poll_payload,
+		thread_id`
 }
 
 func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
@@ -157,6 +165,8 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
 		m1.mentioned,
 		m1.replied,
     COALESCE(m1.discord_message_id, ""),
+		m1.poll_payload,
+		m1.thread_id,
     COALESCE(dm.author_id, ""),
     COALESCE(dm.type, ""),
     COALESCE(dm.timestamp, ""),
@@ -188,7 +198,14 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
     COALESCE(m2.discord_message_id, ""),
 		COALESCE(m2_dm_author.name, ""),
 		COALESCE(m2_dm_author.nickname, ""),
-		COALESCE(m2_dm_author.avatar_url, "")`
+		COALESCE(m2_dm_author.avatar_url, ""),
+		m1.thread_replies_count,
+		m1.thread_unviewed_replies_count,
+		m1.thread_unviewed_mentions_count,
+		m1.thread_last_reply_id,
+		m1.thread_last_reply_from,
+		m1.thread_last_reply_clock,
+		COALESCE(mt.muted, 0)`
 }
 
 func (db sqlitePersistence) tableUserMessagesAllFieldsCount() int {
@@ -224,6 +241,8 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	var deletedForMe sql.NullBool
 	var contactRequestState sql.NullInt64
 	var contactVerificationState sql.NullInt64
+	var pollPayload []byte
+	thread := &common.ThreadSummary{}
 
 	sticker := &protobuf.StickerMessage{}
 	command := &common.CommandParameters{}
@@ -292,6 +311,8 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&message.Mentioned,
 		&message.Replied,
 		&discordMessage.Id,
+		&pollPayload,
+		&message.ThreadID,
 		&discordMessage.Author.Id,
 		&discordMessage.Type,
 		&discordMessage.Timestamp,
@@ -324,6 +345,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&quotedDiscordMessage.Author.Name,
 		&quotedDiscordMessage.Author.Nickname,
 		&quotedDiscordMessage.Author.AvatarUrl,
+		&thread.RepliesCount,
+		&thread.UnviewedRepliesCount,
+		&thread.UnviewedMentionsCount,
+		&thread.LastReplyID,
+		&thread.LastReplyFrom,
+		&thread.LastReplyClock,
+		&thread.Muted,
 	}
 	err := row.Scan(append(args, others...)...)
 	if err != nil {
@@ -380,6 +408,10 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	message.Alias = alias.String
 	message.Identicon = identicon.String
 
+	if thread.RepliesCount > 0 || thread.Muted {
+		message.Thread = thread
+	}
+
 	if gapFrom.Valid && gapTo.Valid {
 		message.GapParameters = &common.GapParameters{
 			From: uint32(gapFrom.Int64),
@@ -433,6 +465,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		message.Payload = &protobuf.ChatMessage_DiscordMessage{
 			DiscordMessage: discordMessage,
 		}
+
+	case protobuf.ChatMessage_POLL:
+		poll := &protobuf.PollMessage{}
+		if err := proto.Unmarshal(pollPayload, poll); err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_Poll{Poll: poll}
 	}
 
 	return nil
@@ -470,6 +509,15 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		}
 	}
 
+	var pollPayload []byte
+	if poll := message.GetPoll(); poll != nil {
+		var err error
+		pollPayload, err = proto.Marshal(poll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if message.GapParameters != nil {
 		gapFrom = message.GapParameters.From
 		gapTo = message.GapParameters.To
@@ -555,6 +603,8 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		message.Mentioned,
 		message.Replied,
 		discordMessage.Id,
+		pollPayload,
+		message.ThreadID,
 	}, nil
 }
 
@@ -1006,6 +1056,155 @@ func (db sqlitePersistence) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(com
 	return getMessagesFromScanRows(db, rows, true)
 }
 
+// SearchMessages returns the messages matching the request, ordered by relevance.
+// When the full-text search index is not available in the current build it
+// falls back to a LIKE scan ordered by clock value.
+// The returned cursor is the offset of the next page, empty if there are no more results.
+func (db sqlitePersistence) SearchMessages(request *MessageSearchRequest) ([]*MessageSearchResult, string, error) {
+	offset := 0
+	if request.Cursor != "" {
+		var err error
+		offset, err = strconv.Atoi(request.Cursor)
+		if err != nil || offset < 0 {
+			return nil, "", fmt.Errorf("invalid cursor %s", request.Cursor)
+		}
+	}
+	limit := request.limit()
+
+	var conditions []string
+	var args []interface{}
+
+	useIndex := sqlite.MessageSearchIndexAvailable(db.db)
+
+	extraFields := ""
+	join := ""
+	orderBy := ""
+	if useIndex {
+		extraFields = fmt.Sprintf(`snippet(user_messages_fts, 0, '%s', '%s', '...', 16), bm25(user_messages_fts)`, MessageSearchHighlightStart, MessageSearchHighlightEnd)
+		join = "JOIN user_messages_fts ON user_messages_fts.rowid = m1.rowid"
+		conditions = append(conditions, "user_messages_fts MATCH ?")
+		args = append(args, messageSearchMatchQuery(request.Term))
+		orderBy = "bm25(user_messages_fts), m1.clock_value DESC"
+	} else {
+		extraFields = "m1.text, 0.0"
+		for _, token := range messageSearchTokens(request.Term) {
+			conditions = append(conditions, `LOWER(m1.text) LIKE LOWER('%' || ? || '%') ESCAPE '\'`)
+			args = append(args, messageSearchLikePattern(token))
+		}
+		orderBy = "m1.clock_value DESC"
+	}
+
+	conditions = append(conditions, "NOT(m1.hide)", "NOT(COALESCE(m1.deleted, 0))", "NOT(COALESCE(m1.deleted_for_me, 0))")
+
+	inVector := func(n int) string {
+		return strings.Repeat("?, ", n-1) + "?"
+	}
+
+	var chatsConditions []string
+	if len(request.ChatIDs) > 0 {
+		chatsConditions = append(chatsConditions, fmt.Sprintf("m1.local_chat_id IN (%s)", inVector(len(request.ChatIDs))))
+		for _, id := range request.ChatIDs {
+			args = append(args, id)
+		}
+	}
+	if len(request.CommunityIDs) > 0 {
+		chatsConditions = append(chatsConditions, fmt.Sprintf("m1.local_chat_id IN (SELECT id FROM chats WHERE community_id IN (%s))", inVector(len(request.CommunityIDs))))
+		for _, id := range request.CommunityIDs {
+			args = append(args, id)
+		}
+	}
+	if len(chatsConditions) > 0 {
+		conditions = append(conditions, "("+strings.Join(chatsConditions, " OR ")+")")
+	}
+
+	if len(request.From) > 0 {
+		conditions = append(conditions, fmt.Sprintf("m1.source IN (%s)", inVector(len(request.From))))
+		for _, from := range request.From {
+			args = append(args, from)
+		}
+	}
+
+	if request.Since != 0 {
+		conditions = append(conditions, "m1.timestamp >= ?")
+		args = append(args, request.Since)
+	}
+
+	if request.Until != 0 {
+		conditions = append(conditions, "m1.timestamp <= ?")
+		args = append(args, request.Until)
+	}
+
+	if len(request.ContentTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("m1.content_type IN (%s)", inVector(len(request.ContentTypes))))
+		for _, contentType := range request.ContentTypes {
+			args = append(args, contentType)
+		}
+	}
+
+	// Fetch one more result to know whether there's a next page
+	args = append(args, limit+1, offset)
+
+	where := fmt.Sprintf(`
+			%s
+			WHERE
+				%s
+			ORDER BY %s
+			LIMIT ? OFFSET ?`, join, strings.Join(conditions, " AND "), orderBy)
+
+	rows, err := db.db.Query(db.buildMessagesQueryWithAdditionalFields(extraFields, where), args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	// Offsets are counted in rows, as messages with multiple discord
+	// attachments span several of them
+	consumedRows := 0
+	var newCursor string
+	resultIdx := make(map[string]*MessageSearchResult)
+	var results []*MessageSearchResult
+	for rows.Next() {
+		var message common.Message
+		var snippet sql.NullString
+		var rank float64
+		if err := db.tableUserMessagesScanAllFields(rows, &message, &snippet, &rank); err != nil {
+			return nil, "", err
+		}
+
+		if result, ok := resultIdx[message.ID]; ok {
+			if discordMessage := result.Message.GetDiscordMessage(); discordMessage != nil {
+				result.Message.Payload = getUpdatedChatMessagePayload(discordMessage, message.GetDiscordMessage())
+			}
+			consumedRows++
+			continue
+		}
+
+		if len(results) == limit {
+			newCursor = strconv.Itoa(offset + consumedRows)
+			break
+		}
+
+		result := &MessageSearchResult{
+			Message: &message,
+			Snippet: snippet.String,
+			Rank:    rank,
+		}
+		resultIdx[message.ID] = result
+		results = append(results, result)
+		consumedRows++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	// All fetched rows belonged to the current page, there might be more
+	if newCursor == "" && consumedRows == limit+1 {
+		newCursor = strconv.Itoa(offset + consumedRows)
+	}
+
+	return results, newCursor, nil
+}
+
 func (db sqlitePersistence) AllChatIDsByCommunity(communityID string) ([]string, error) {
 	rows, err := db.db.Query("SELECT id FROM chats WHERE community_id = ?", communityID)
 
@@ -1119,6 +1318,11 @@ func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor
 			 ON
 			 m2_dm.author_id = m2_dm_author.id
 
+			LEFT JOIN
+				message_threads mt
+			ON
+				m1.id = mt.thread_id
+
  			WHERE
  				pm.pinned = 1
  				AND NOT(m1.hide) AND m1.local_chat_id IN %s %s
@@ -1219,6 +1423,25 @@ func (db sqlitePersistence) OldestMessageWhisperTimestampByChatID(chatID string)
 	return whisperTimestamp, true, nil
 }
 
+// LastMessageWhisperTimestampFrom returns the whisper timestamp of the latest message of from in the chat sent up to before,
+// other than the message with id, zero if there is none
+func (db sqlitePersistence) LastMessageWhisperTimestampFrom(chatID string, from string, id string, before uint64) (uint64, error) {
+	var whisperTimestamp sql.NullInt64
+	err := db.db.QueryRow(
+		`
+			SELECT
+				MAX(whisper_timestamp)
+			FROM
+				user_messages
+			WHERE
+				local_chat_id = ? AND source = ? AND id != ? AND whisper_timestamp <= ? AND NOT deleted
+		`, chatID, from, id, before).Scan(&whisperTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(whisperTimestamp.Int64), nil
+}
+
 // EmojiReactionsByChatID returns the emoji reactions for the queried messages, up to a maximum of 100, as it's a potentially unbound number.
 // NOTE: This is not completely accurate, as the messages in the database might have change since the last call to `MessageByChatID`.
 func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor string, limit int) ([]*EmojiReaction, error) {
@@ -1443,7 +1666,25 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 		return
 	}
 
+	// Messages sent after disappearing messages have been enabled in the chat
+	// expire after the chat's timer. Edits keep the original expiration.
+	expirationStmt, err := tx.Prepare(`
+		INSERT INTO user_messages_expirations(message_id, local_chat_id, expires_at)
+		SELECT ?, id, ? + disappearing_messages_timer * 1000
+		FROM chats
+		WHERE id = ? AND disappearing_messages_timer > 0 AND disappearing_messages_clock <= ?`)
+	if err != nil {
+		return
+	}
+
 	for _, msg := range messages {
+		if msg.ResponseTo != "" && msg.ThreadID == "" {
+			msg.ThreadID, err = db.threadIDOf(tx, msg.ResponseTo)
+			if err != nil {
+				return
+			}
+		}
+
 		var allValues []interface{}
 		allValues, err = db.tableUserMessagesAllValues(msg)
 		if err != nil {
@@ -1454,10 +1695,124 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 		if err != nil {
 			return
 		}
+
+		// Replies to this message might have been received before it,
+		// move them to the thread of this message
+		if msg.ThreadID != "" {
+			_, err = tx.Exec(`UPDATE user_messages SET thread_id = ? WHERE thread_id = ?`, msg.ThreadID, msg.ID)
+			if err != nil {
+				return
+			}
+		}
+
+		if msg.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES {
+			continue
+		}
+
+		// The timer starts when the message is received, the timestamp
+		// set by the sender can't be trusted
+		receivedAt := msg.WhisperTimestamp
+		if receivedAt == 0 {
+			receivedAt = msg.Timestamp
+		}
+
+		_, err = expirationStmt.Exec(msg.ID, receivedAt, msg.LocalChatID, msg.Clock)
+		if err != nil {
+			return
+		}
 	}
 	return
 }
 
+// threadIDOf returns the id of the thread a reply to the given message belongs to,
+// that is the id of the first message of the reply chain.
+// If the message is not known yet, the thread is the message itself.
+func (db sqlitePersistence) threadIDOf(tx *sql.Tx, messageID string) (string, error) {
+	var threadID string
+	err := tx.QueryRow(`SELECT thread_id FROM user_messages WHERE id = ?`, messageID).Scan(&threadID)
+	if err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+
+	if threadID == "" {
+		return messageID, nil
+	}
+
+	return threadID, nil
+}
+
+// ExpiredMessagesIDsByChatID returns the ids of the disappearing messages which
+// expired before the given timestamp, grouped by chat id
+func (db sqlitePersistence) ExpiredMessagesIDsByChatID(now uint64) (map[string][]string, error) {
+	rows, err := db.db.Query(`SELECT message_id, local_chat_id FROM user_messages_expirations WHERE expires_at <= ?`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string][]string)
+	for rows.Next() {
+		var messageID, chatID string
+		if err := rows.Scan(&messageID, &chatID); err != nil {
+			return nil, err
+		}
+		result[chatID] = append(result[chatID], messageID)
+	}
+
+	return result, rows.Err()
+}
+
+// DeleteExpiredMessages deletes the given messages together with their media,
+// reactions, pins and edits, and recalculates the unviewed counters of the chat
+func (db sqlitePersistence) DeleteExpiredMessages(chatID string, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	idsArgs := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		idsArgs = append(idsArgs, id)
+	}
+	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
+
+	queries := []string{
+		"DELETE FROM discord_message_attachments WHERE discord_message_id IN (SELECT discord_message_id FROM user_messages WHERE id IN (" + inVector + "))", // nolint: gosec
+		"DELETE FROM discord_messages WHERE id IN (SELECT discord_message_id FROM user_messages WHERE id IN (" + inVector + "))",                            // nolint: gosec
+		"DELETE FROM user_messages WHERE id IN (" + inVector + ")",                                                                                          // nolint: gosec
+		"DELETE FROM emoji_reactions WHERE message_id IN (" + inVector + ")",                                                                                // nolint: gosec
+		"DELETE FROM pin_messages WHERE message_id IN (" + inVector + ")",                                                                                   // nolint: gosec
+		"DELETE FROM user_messages_edits WHERE message_id IN (" + inVector + ")",                                                                            // nolint: gosec
+		"DELETE FROM user_messages_expirations WHERE message_id IN (" + inVector + ")",                                                                      // nolint: gosec
+	}
+	for _, query := range queries {
+		_, err = tx.Exec(query, idsArgs...)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE chats
+		SET
+			unviewed_message_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id),
+			unviewed_mentions_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id AND (mentioned OR replied))
+		WHERE id = ?`, chatID)
+	return
+}
+
 type insertPinMessagesQueries struct {
 	selectStmt  string
 	insertStmt  *sql.Stmt
@@ -1860,11 +2215,126 @@ func (db sqlitePersistence) UpdateMessageOutgoingStatus(id string, newOutgoingSt
 	_, err := db.db.Exec(`
 		UPDATE user_messages
 		SET outgoing_status = ?
-		WHERE id = ? AND outgoing_status != ?
-	`, newOutgoingStatus, id, common.OutgoingStatusDelivered)
+		WHERE id = ? AND outgoing_status NOT IN (?, ?)
+	`, newOutgoingStatus, id, common.OutgoingStatusDelivered, common.OutgoingStatusRead)
 	return err
 }
 
+// MarkMessagesReadByRecipient records that `reader` has read the messages sent by `from` in the chat,
+// either because they are listed in `ids` or because their clock is up to `readClock`.
+// The outgoing status of a message is set to read once all of `readers` have read it.
+// It returns the ids of the messages that were updated.
+func (db sqlitePersistence) MarkMessagesReadByRecipient(chatID string, from string, reader string, readers []string, ids []string, readClock uint64) (updated []string, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	args := []interface{}{chatID, from, common.OutgoingStatusRead, readClock}
+	idsCondition := ""
+	if len(ids) > 0 {
+		idsCondition = "OR id IN (" + strings.Repeat("?, ", len(ids)-1) + "?)"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	rows, err := tx.Query(fmt.Sprintf(`SELECT id FROM user_messages WHERE local_chat_id = ? AND source = ? AND outgoing_status != ? AND (clock_value <= ? %s)`, idsCondition), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var read []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		read = append(read, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(read) == 0 {
+		return nil, nil
+	}
+
+	insert, err := tx.Prepare(`INSERT OR IGNORE INTO user_messages_read_receipts (message_id, public_key) VALUES (?, ?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer insert.Close()
+
+	for _, id := range read {
+		if _, err = insert.Exec(id, reader); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(readers) == 0 {
+		readers = []string{reader}
+	}
+
+	args = make([]interface{}, 0, len(read)+len(readers)+1)
+	for _, id := range read {
+		args = append(args, id)
+	}
+	for _, r := range readers {
+		args = append(args, r)
+	}
+	args = append(args, len(readers))
+
+	readByAll, err := tx.Query(`
+		SELECT message_id FROM user_messages_read_receipts
+		WHERE message_id IN (`+strings.Repeat("?, ", len(read)-1)+`?) AND public_key IN (`+strings.Repeat("?, ", len(readers)-1)+`?)
+		GROUP BY message_id HAVING COUNT(*) = ?`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer readByAll.Close()
+
+	for readByAll.Next() {
+		var id string
+		if err = readByAll.Scan(&id); err != nil {
+			return nil, err
+		}
+		updated = append(updated, id)
+	}
+	if err = readByAll.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(updated) == 0 {
+		return nil, nil
+	}
+
+	inVector := strings.Repeat("?, ", len(updated)-1) + "?"
+	args = make([]interface{}, 0, len(updated)+1)
+	args = append(args, common.OutgoingStatusRead)
+	for _, id := range updated {
+		args = append(args, id)
+	}
+
+	_, err = tx.Exec(`UPDATE user_messages SET outgoing_status = ? WHERE id IN (`+inVector+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The receipts of each member aren't needed anymore once everyone has read the message
+	_, err = tx.Exec(`DELETE FROM user_messages_read_receipts WHERE message_id IN (`+inVector+`)`, args[1:]...)
+	return updated, err
+}
+
 // BlockContact updates a contact, deletes all the messages and 1-to-1 chat, updates the unread messages count and returns a map with the new count
 func (db sqlitePersistence) BlockContact(contact *Contact, isDesktopFunc bool) ([]*Chat, error) {
 	var chats []*Chat
@@ -0,0 +1,104 @@
+package protocol
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// MessageSearchHighlightStart and MessageSearchHighlightEnd surround the
+	// matched terms in MessageSearchResult.Snippet
+	MessageSearchHighlightStart = "<b>"
+	MessageSearchHighlightEnd   = "</b>"
+
+	defaultMessageSearchLimit = 50
+	maxMessageSearchLimit     = 500
+)
+
+var ErrMessageSearchEmptyTerm = errors.New("message-search: empty search term")
+var ErrMessageSearchInvalidDateRange = errors.New("message-search: invalid date range")
+
+type MessageSearchRequest struct {
+	Term string `json:"term"`
+	// ChatIDs and CommunityIDs restrict the search to the given chats and to
+	// all channels of the given communities. Empty means all chats.
+	ChatIDs      []string `json:"chatIds"`
+	CommunityIDs []string `json:"communityIds"`
+	// From restricts the search to messages sent by the given public keys
+	From []string `json:"from"`
+	// Since and Until restrict the search to messages whose timestamp (in ms)
+	// falls within the range, 0 means unbounded
+	Since        uint64                             `json:"since"`
+	Until        uint64                             `json:"until"`
+	ContentTypes []protobuf.ChatMessage_ContentType `json:"contentTypes"`
+	Cursor       string                             `json:"cursor"`
+	Limit        int                                `json:"limit"`
+}
+
+type MessageSearchResult struct {
+	Message *common.Message `json:"message"`
+	// Snippet is an excerpt of the message text with the matched terms highlighted
+	Snippet string `json:"snippet"`
+	// Rank is the bm25 relevance of the match, lower is more relevant
+	Rank float64 `json:"rank"`
+}
+
+type MessageSearchResponse struct {
+	Results []*MessageSearchResult `json:"results"`
+	Cursor  string                 `json:"cursor"`
+}
+
+func (r *MessageSearchRequest) Validate() error {
+	if len(messageSearchTokens(r.Term)) == 0 {
+		return ErrMessageSearchEmptyTerm
+	}
+
+	if r.Since != 0 && r.Until != 0 && r.Since > r.Until {
+		return ErrMessageSearchInvalidDateRange
+	}
+
+	return nil
+}
+
+func (r *MessageSearchRequest) limit() int {
+	if r.Limit <= 0 {
+		return defaultMessageSearchLimit
+	}
+	if r.Limit > maxMessageSearchLimit {
+		return maxMessageSearchLimit
+	}
+	return r.Limit
+}
+
+// messageSearchTokens splits the search term in words, dropping the
+// characters that have a special meaning in FTS5 queries
+func messageSearchTokens(term string) []string {
+	return strings.FieldsFunc(term, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '"' || r == '*'
+	})
+}
+
+var messageSearchLikeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// messageSearchLikePattern escapes the wildcards of LIKE in token, to be
+// matched literally with ESCAPE '\'
+func messageSearchLikePattern(token string) string {
+	return messageSearchLikeEscaper.Replace(token)
+}
+
+// messageSearchMatchQuery builds an FTS5 query matching messages that contain
+// all the words of the term, the last one being matched as a prefix so that
+// results can be shown while typing
+func messageSearchMatchQuery(term string) string {
+	tokens := messageSearchTokens(term)
+	for i, token := range tokens {
+		tokens[i] = `"` + token + `"`
+	}
+	if len(tokens) > 0 {
+		tokens[len(tokens)-1] += "*"
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,156 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+// MessageThread is the local state of a thread, as opposed to the
+// replies count and metadata which are computed from the messages
+type MessageThread struct {
+	ThreadID    string `json:"threadId"`
+	LocalChatID string `json:"localChatId"`
+	Muted       bool   `json:"muted"`
+	Clock       uint64 `json:"clock"`
+}
+
+// ThreadReplies returns the replies of a thread, latest first
+func (db sqlitePersistence) ThreadReplies(threadID string, currCursor string, limit int) ([]*common.Message, string, error) {
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?" //nolint: goconst
+	}
+	args := []interface{}{threadID}
+	if currCursor != "" {
+		args = append(args, currCursor)
+	}
+	where := fmt.Sprintf(`
+            WHERE
+                NOT(m1.hide) AND m1.thread_id = ? %s
+            ORDER BY cursor DESC
+            LIMIT ?`, cursorWhere)
+
+	query := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
+
+	rows, err := db.db.Query(
+		query,
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	result, cursors, err := getMessagesAndCursorsFromScanRows(db, rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
+// MarkThreadRead marks the replies of a thread up to the given clock as seen
+// and updates the unviewed counters of the chat.
+// It returns the number of replies marked as seen and how many of them mentioned us.
+func (db sqlitePersistence) MarkThreadRead(chatID string, threadID string, clock uint64) (count uint64, countWithMentions uint64, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	err = tx.QueryRow(`
+		SELECT COUNT(1), COALESCE(SUM(mentioned OR replied), 0)
+		FROM user_messages
+		WHERE local_chat_id = ? AND thread_id = ? AND clock_value <= ? AND NOT(seen)`,
+		chatID, threadID, clock).Scan(&count, &countWithMentions)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, err = tx.Exec(`UPDATE user_messages SET seen = 1 WHERE local_chat_id = ? AND thread_id = ? AND clock_value <= ? AND NOT(seen)`, chatID, threadID, clock)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Update denormalized count
+	_, err = tx.Exec(
+		`UPDATE chats
+		SET unviewed_message_count =
+		   (SELECT COUNT(1)
+		   FROM user_messages
+		   WHERE local_chat_id = ? AND seen = 0),
+		   unviewed_mentions_count =
+		   (SELECT COUNT(1)
+		   FROM user_messages
+		   WHERE local_chat_id = ? AND seen = 0 AND (mentioned OR replied))
+		WHERE id = ?`, chatID, chatID, chatID)
+	return count, countWithMentions, err
+}
+
+// SaveMessageThread stores the state of a thread, unless a more recent one has been stored already.
+// It returns whether the state has been stored.
+func (db sqlitePersistence) SaveMessageThread(thread *MessageThread) (bool, error) {
+	result, err := db.db.Exec(`
+		INSERT INTO message_threads(thread_id, local_chat_id, muted, clock)
+		SELECT ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM message_threads WHERE thread_id = ? AND clock >= ?)`,
+		thread.ThreadID, thread.LocalChatID, thread.Muted, thread.Clock, thread.ThreadID, thread.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (db sqlitePersistence) MessageThread(threadID string) (*MessageThread, error) {
+	thread := &MessageThread{}
+	err := db.db.QueryRow(`SELECT thread_id, local_chat_id, muted, clock FROM message_threads WHERE thread_id = ?`, threadID).
+		Scan(&thread.ThreadID, &thread.LocalChatID, &thread.Muted, &thread.Clock)
+	if err == sql.ErrNoRows {
+		return nil, common.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+func (db sqlitePersistence) MutedMessageThreads() ([]*MessageThread, error) {
+	rows, err := db.db.Query(`SELECT thread_id, local_chat_id, muted, clock FROM message_threads WHERE muted`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var threads []*MessageThread
+	for rows.Next() {
+		thread := &MessageThread{}
+		if err := rows.Scan(&thread.ThreadID, &thread.LocalChatID, &thread.Muted, &thread.Clock); err != nil {
+			return nil, err
+		}
+		threads = append(threads, thread)
+	}
+
+	return threads, rows.Err()
+}
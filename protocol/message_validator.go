@@ -12,6 +12,8 @@ import (
 
 const maxChatMessageTextLength = 4096
 const maxStatusMessageText = 128
+const maxPollOptions = 10
+const maxPollOptionTextLength = 256
 
 // maxWhisperDrift is how many milliseconds we allow the clock value to differ
 // from whisperTimestamp
@@ -280,6 +282,11 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		if image.Type == protobuf.ImageType_UNKNOWN_IMAGE_TYPE {
 			return errors.New("image type unknown")
 		}
+
+	case protobuf.ChatMessage_POLL:
+		if err := ValidatePoll(message.GetPoll()); err != nil {
+			return err
+		}
 	}
 
 	if message.ContentType == protobuf.ChatMessage_AUDIO {
@@ -309,6 +316,10 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		return errors.New("mutual state event system message content type not allowed")
 	}
 
+	if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES {
+		return errors.New("disappearing messages system message content type not allowed")
+	}
+
 	if err := ValidateDisplayName(&message.DisplayName); err != nil {
 		return err
 	}
@@ -316,6 +327,104 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 	return nil
 }
 
+func ValidatePoll(poll *protobuf.PollMessage) error {
+	if poll == nil {
+		return errors.New("no poll content")
+	}
+
+	if err := ValidateText(poll.Question); err != nil {
+		return err
+	}
+
+	if len(poll.Options) < 2 {
+		return errors.New("poll needs at least 2 options")
+	}
+
+	if len(poll.Options) > maxPollOptions {
+		return fmt.Errorf("poll can't have more than %d options", maxPollOptions)
+	}
+
+	ids := make(map[string]bool)
+	for _, option := range poll.Options {
+		if len(option.Id) == 0 {
+			return errors.New("poll option id can't be empty")
+		}
+		if ids[option.Id] {
+			return errors.New("poll option ids must be unique")
+		}
+		ids[option.Id] = true
+
+		if len(strings.TrimSpace(option.Text)) == 0 {
+			return errors.New("poll option text can't be empty")
+		}
+		if len([]rune(option.Text)) > maxPollOptionTextLength {
+			return fmt.Errorf("poll option text shouldn't be longer than %d", maxPollOptionTextLength)
+		}
+	}
+
+	return nil
+}
+
+func ValidateReceivedPollVote(vote *protobuf.PollVote, whisperTimestamp uint64) error {
+	if err := validateClockValue(vote.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(vote.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if len(vote.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if vote.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE || vote.MessageType == protobuf.MessageType_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	if len(vote.OptionIds) > maxPollOptions {
+		return errors.New("too many poll options")
+	}
+
+	return nil
+}
+
+func ValidateReceivedTypingIndicator(indicator *protobuf.TypingIndicator, whisperTimestamp uint64) error {
+	if err := validateClockValue(indicator.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(indicator.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if indicator.MessageType != protobuf.MessageType_ONE_TO_ONE && indicator.MessageType != protobuf.MessageType_PRIVATE_GROUP {
+		return errors.New("typing indicators are only allowed in one-to-one and private group chats")
+	}
+
+	return nil
+}
+
+func ValidateReceivedReadReceipt(receipt *protobuf.ReadReceipt, whisperTimestamp uint64) error {
+	if err := validateClockValue(receipt.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(receipt.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if receipt.MessageType != protobuf.MessageType_ONE_TO_ONE && receipt.MessageType != protobuf.MessageType_PRIVATE_GROUP {
+		return errors.New("read receipts are only allowed in one-to-one and private group chats")
+	}
+
+	if len(receipt.MessageIds) == 0 && receipt.ReadClock == 0 {
+		return errors.New("read receipt must contain message-ids or a read clock")
+	}
+
+	return nil
+}
+
 func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimestamp uint64) error {
 	if err := validateClockValue(emoji.Clock, whisperTimestamp); err != nil {
 		return err
@@ -595,3 +595,67 @@ func (s *MessageValidatorSuite) TestValidateEmojiReaction() {
 	}
 
 }
+
+func (s *MessageValidatorSuite) TestValidatePoll() {
+	options := func(texts ...string) []*protobuf.PollOption {
+		var result []*protobuf.PollOption
+		for i, text := range texts {
+			result = append(result, &protobuf.PollOption{Id: strings.Repeat("a", i+1), Text: text})
+		}
+		return result
+	}
+
+	testCases := []struct {
+		Name  string
+		Valid bool
+		Poll  *protobuf.PollMessage
+	}{
+		{
+			Name:  "valid poll",
+			Valid: true,
+			Poll:  &protobuf.PollMessage{Question: "question", Options: options("yes", "no")},
+		},
+		{
+			Name:  "missing poll",
+			Valid: false,
+		},
+		{
+			Name:  "missing question",
+			Valid: false,
+			Poll:  &protobuf.PollMessage{Options: options("yes", "no")},
+		},
+		{
+			Name:  "not enough options",
+			Valid: false,
+			Poll:  &protobuf.PollMessage{Question: "question", Options: options("yes")},
+		},
+		{
+			Name:  "too many options",
+			Valid: false,
+			Poll:  &protobuf.PollMessage{Question: "question", Options: options("1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11")},
+		},
+		{
+			Name:  "empty option",
+			Valid: false,
+			Poll:  &protobuf.PollMessage{Question: "question", Options: options("yes", " ")},
+		},
+		{
+			Name:  "duplicated option ids",
+			Valid: false,
+			Poll: &protobuf.PollMessage{Question: "question", Options: []*protobuf.PollOption{
+				{Id: "a", Text: "yes"},
+				{Id: "a", Text: "no"},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidatePoll(tc.Poll)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
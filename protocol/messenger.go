@@ -766,6 +766,9 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.watchChatsAndCommunitiesToUnmute()
 	m.watchCommunitiesToUnmute()
 	m.watchExpiredMessages()
+	m.watchDisappearingMessages()
+	m.watchScheduledMessages()
+	m.watchCommunityCalendarEvents()
 	m.watchIdentityImageChanges()
 	m.watchWalletBalances()
 	m.watchPendingCommunityRequestToJoin()
@@ -2194,6 +2197,16 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		if err != nil {
 			return nil, err
 		}
+	} else if message.ContentType == protobuf.ChatMessage_POLL {
+		err := ValidatePoll(message.GetPoll())
+		if err != nil {
+			return nil, err
+		}
+		// The question is used as text so that clients not supporting polls
+		// still display something meaningful
+		if len(message.Text) == 0 {
+			message.Text = message.GetPoll().Question
+		}
 	}
 
 	unfurledLinks, err := message.ConvertLinkPreviewsToProto()
@@ -2223,6 +2236,13 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	if chat.CommunityChat() {
+		err = m.moderateSentCommunityMessage(chat, message)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = m.addContactRequestPropagatedState(message)
 	if err != nil {
 		return nil, err
@@ -2470,12 +2490,44 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 			}
 		}
 
+		if (chat.OneToOne() || chat.PrivateGroupChat()) && chat.Active && chat.DisappearingMessagesClock > 0 {
+			err = m.syncDisappearingMessages(ctx, chat, rawMessageHandler)
+			if err != nil {
+				return false
+			}
+		}
+
 		return true
 	})
 	if err != nil {
 		return err
 	}
 
+	mutedThreads, err := m.persistence.MutedMessageThreads()
+	if err != nil {
+		return err
+	}
+	for _, thread := range mutedThreads {
+		if err = m.syncMessageThread(ctx, thread, 0, rawMessageHandler); err != nil {
+			return err
+		}
+	}
+
+	scheduledMessages, err := m.persistence.PendingScheduledMessages("")
+	if err != nil {
+		return err
+	}
+	for _, scheduledMessage := range scheduledMessages {
+		if err = m.syncScheduledMessage(ctx, scheduledMessage, rawMessageHandler); err != nil {
+			return err
+		}
+	}
+
+	err = m.syncCommunityCalendarEvents(ctx, rawMessageHandler)
+	if err != nil {
+		return err
+	}
+
 	m.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
 		if contact.ID != myID &&
 			(contact.LocalNickname != "" || contact.added() || contact.Blocked) {
@@ -3640,6 +3692,111 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.DisappearingMessages:
+						disappearingMessages := msg.ParsedMessage.Interface().(protobuf.DisappearingMessages)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, disappearingMessages)
+						err = m.HandleDisappearingMessages(messageState, disappearingMessages)
+						if err != nil {
+							logger.Warn("failed to handle DisappearingMessages", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.PollVote:
+						pollVote := msg.ParsedMessage.Interface().(protobuf.PollVote)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, pollVote)
+						err = m.HandlePollVote(messageState, pollVote)
+						if err != nil {
+							logger.Warn("failed to handle PollVote", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.CommunityCalendarEvent:
+						event := msg.ParsedMessage.Interface().(protobuf.CommunityCalendarEvent)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, event)
+						err = m.HandleCommunityCalendarEvent(messageState, event)
+						if err != nil {
+							logger.Warn("failed to handle CommunityCalendarEvent", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.CommunityCalendarEventRsvp:
+						rsvp := msg.ParsedMessage.Interface().(protobuf.CommunityCalendarEventRsvp)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, rsvp)
+						err = m.HandleCommunityCalendarEventRsvp(messageState, rsvp)
+						if err != nil {
+							logger.Warn("failed to handle CommunityCalendarEventRsvp", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.TypingIndicator:
+						typingIndicator := msg.ParsedMessage.Interface().(protobuf.TypingIndicator)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, typingIndicator)
+						err = m.HandleTypingIndicator(messageState, typingIndicator)
+						if err != nil {
+							logger.Warn("failed to handle TypingIndicator", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.ReadReceipt:
+						readReceipt := msg.ParsedMessage.Interface().(protobuf.ReadReceipt)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, readReceipt)
+						err = m.HandleReadReceipt(messageState, readReceipt)
+						if err != nil {
+							logger.Warn("failed to handle ReadReceipt", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncScheduledMessage:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncScheduledMessage)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						err = m.HandleSyncScheduledMessage(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncScheduledMessage", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncCommunityCalendarEvent:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncCommunityCalendarEvent)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						err = m.HandleSyncCommunityCalendarEvent(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncCommunityCalendarEvent", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncMessageThread:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncMessageThread)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						err = m.HandleSyncMessageThread(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncMessageThread", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.PairInstallation:
 						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
 							logger.Warn("not coming from us, ignoring")
@@ -4343,7 +4500,7 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 						logger.Debug("Handling CommunityEventsMessage")
 						message := msg.ParsedMessage.Interface().(protobuf.CommunityEventsMessage)
 						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
-						err = m.handleCommunityEventsMessage(messageState, publicKey, message)
+						err = m.handleCommunityEventsMessage(messageState, publicKey, message, msg.DecryptedPayload)
 						if err != nil {
 							logger.Warn("failed to handle CommunityEvent", zap.Error(err))
 							allMessagesProcessed = false
@@ -4663,12 +4820,30 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		return nil, err
 	}
 
+	// Refresh the replies count of the threads that received new replies
+	for _, message := range messagesWithResponses {
+		if _, ok := newMessagesIds[message.ID]; ok && message.ThreadID != "" {
+			if err = m.addThreadRootToResponse(messageState.Response, message.ThreadID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	m.prepareMessages(messageState.Response.messages)
 
 	for _, message := range messageState.Response.messages {
 		if _, ok := newMessagesIds[message.ID]; ok {
 			message.New = true
 
+			// Replies in muted threads only notify when mentioning us
+			muted, err := m.isThreadMuted(message)
+			if err != nil {
+				return nil, err
+			}
+			if muted && !message.Mentioned {
+				continue
+			}
+
 			if notificationsEnabled {
 				// Create notification body to be eventually passed to `localnotifications.SendMessageNotifications()`
 				if err = messageState.addNewMessageNotification(m.identity.PublicKey, message, messagesByID[message.ResponseTo], profilePicturesVisibility); err != nil {
@@ -4869,6 +5044,24 @@ func (m *Messenger) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(communityId
 	return m.persistence.AllMessagesFromChatsAndCommunitiesWhichMatchTerm(communityIds, chatIds, searchTerm, caseSensitive)
 }
 
+// SearchMessages performs a ranked full-text search over the messages,
+// see MessageSearchRequest for the available filters
+func (m *Messenger) SearchMessages(request *MessageSearchRequest) (*MessageSearchResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, cursor, err := m.persistence.SearchMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageSearchResponse{
+		Results: results,
+		Cursor:  cursor,
+	}, nil
+}
+
 func (m *Messenger) SaveMessages(messages []*common.Message) error {
 	return m.persistence.SaveMessages(messages)
 }
@@ -4894,6 +5087,14 @@ func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) (uint64, uint6
 		return 0, 0, err
 	}
 	m.allChats.Store(chatID, chat)
+
+	if count > 0 {
+		err = m.sendReadReceipt(context.Background(), chatID, ids, 0)
+		if err != nil {
+			m.logger.Error("MarkMessagesSeen, failed to send read receipt", zap.Error(err))
+		}
+	}
+
 	return count, countWithMentions, nil
 }
 
@@ -4975,7 +5176,23 @@ func (m *Messenger) MarkAllRead(chatID string) error {
 		clock, _ = chat.NextClockAndTimestamp(m.getTimesource())
 	}
 
-	return m.markAllRead(chatID, clock, true)
+	err = m.markAllRead(chatID, clock, true)
+	if err != nil {
+		return err
+	}
+
+	// All the messages received so far have been read, not only those up to the latest incoming one
+	readClock := clock
+	if chat, ok := m.allChats.Load(chatID); ok && chat.LastClockValue > readClock {
+		readClock = chat.LastClockValue
+	}
+
+	err = m.sendReadReceipt(context.Background(), chatID, nil, readClock)
+	if err != nil {
+		m.logger.Error("MarkAllRead, failed to send read receipt", zap.Error(err))
+	}
+
+	return nil
 }
 
 func (m *Messenger) MarkAllReadInCommunity(communityID string) ([]string, error) {
@@ -5897,6 +6114,10 @@ func (m *Messenger) getCurrentTimeInMillis() uint64 {
 	return m.getTimesource().GetCurrentTime()
 }
 
+func (m *Messenger) getCurrentTimeInSeconds() uint64 {
+	return m.getCurrentTimeInMillis() / 1000
+}
+
 // AddPushNotificationsServer adds a push notification server
 func (m *Messenger) AddPushNotificationsServer(ctx context.Context, publicKey *ecdsa.PublicKey, serverType pushnotificationclient.ServerType) error {
 	if m.pushNotificationClient == nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -475,6 +476,7 @@ func convertActivityCenterNotificationToProtobuf(n ActivityCenterNotification) (
 		ContactVerificationStatus: protobuf.SyncActivityCenterNotification_ContactVerificationStatus(n.ContactVerificationStatus),
 		Deleted:                   n.Deleted,
 		UpdatedAt:                 n.UpdatedAt,
+		ModerationViolation:       string(n.ModerationViolation),
 	}
 	return
 }
@@ -498,6 +500,7 @@ func convertActivityCenterNotificationFromProtobuf(proto *protobuf.SyncActivityC
 		Deleted:                   proto.Deleted,
 		ContactVerificationStatus: verification.RequestStatus(proto.ContactVerificationStatus),
 		UpdatedAt:                 proto.UpdatedAt,
+		ModerationViolation:       communities.ModerationViolation(proto.ModerationViolation),
 	}
 
 	if len(proto.Message) > 0 {
@@ -0,0 +1,229 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxReceiptsGroupSize is the number of members above which private group chats
+// don't send typing indicators nor read receipts
+const maxReceiptsGroupSize = 20
+
+// typingIndicatorTimeout is the time in milliseconds after which a typing indicator is stale,
+// clients are expected to send it again while the user keeps typing
+const typingIndicatorTimeout = 15000
+
+var ErrReceiptsNotSupported = errors.New("typing indicators and read receipts are only supported in one-to-one and small private group chats")
+
+func supportsReceipts(chat *Chat) bool {
+	return chat.OneToOne() || (chat.PrivateGroupChat() && len(chat.Members) <= maxReceiptsGroupSize)
+}
+
+// receiptReaders returns the members of the chat who have to read our messages
+// before they are reported as read
+func (m *Messenger) receiptReaders(chat *Chat) []string {
+	if chat.OneToOne() {
+		return []string{chat.ID}
+	}
+
+	var readers []string
+	for _, member := range chat.Members {
+		if member.ID != m.myHexIdentity() {
+			readers = append(readers, member.ID)
+		}
+	}
+	return readers
+}
+
+// SendTypingIndicator lets the other members of the chat know whether we are typing,
+// it's a no-op unless enabled in the settings
+func (m *Messenger) SendTypingIndicator(ctx context.Context, chatID string, typing bool) error {
+	enabled, err := m.settings.SendTypingIndicators()
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	if !supportsReceipts(chat) {
+		return ErrReceiptsNotSupported
+	}
+
+	timestamp := m.getTimesource().GetCurrentTime()
+
+	indicator := &TypingIndicator{
+		TypingIndicator: protobuf.TypingIndicator{
+			Clock:  timestamp,
+			ChatId: chat.ID,
+			Typing: typing,
+		},
+		From:        m.myHexIdentity(),
+		SigPubKey:   &m.identity.PublicKey,
+		LocalChatID: chat.ID,
+		Timestamp:   timestamp,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, indicator)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_TYPING_INDICATOR,
+		Ephemeral:            true,
+	})
+	return err
+}
+
+// sendReadReceipt lets the other members of the chat know that we have read
+// the given messages, or all the messages up to readClock.
+// It's a no-op unless enabled in the settings
+func (m *Messenger) sendReadReceipt(ctx context.Context, chatID string, messageIDs []string, readClock uint64) error {
+	if len(messageIDs) == 0 && readClock == 0 {
+		return nil
+	}
+
+	enabled, err := m.settings.SendReadReceipts()
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	if !chat.Active || !supportsReceipts(chat) {
+		return nil
+	}
+
+	receipt := &ReadReceipt{
+		ReadReceipt: protobuf.ReadReceipt{
+			Clock:      m.getTimesource().GetCurrentTime(),
+			ChatId:     chat.ID,
+			MessageIds: messageIDs,
+			ReadClock:  readClock,
+		},
+		From:        m.myHexIdentity(),
+		SigPubKey:   &m.identity.PublicKey,
+		LocalChatID: chat.ID,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, receipt)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_READ_RECEIPT,
+	})
+	return err
+}
+
+func (m *Messenger) HandleTypingIndicator(state *ReceivedMessageState, pbIndicator protobuf.TypingIndicator) error {
+	logger := m.logger.With(zap.String("site", "HandleTypingIndicator"))
+	if err := ValidateReceivedTypingIndicator(&pbIndicator, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Error("invalid typing indicator", zap.Error(err))
+		return err
+	}
+
+	// Our own devices are typing in the same chats as we do
+	if common.IsPubKeyEqual(state.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+		return nil
+	}
+
+	// Typing indicators are ephemeral, but might still be delayed
+	if state.CurrentMessageState.WhisperTimestamp+typingIndicatorTimeout < m.getTimesource().GetCurrentTime() {
+		return nil
+	}
+
+	indicator := &TypingIndicator{
+		TypingIndicator: pbIndicator,
+		From:            state.CurrentMessageState.Contact.ID,
+		SigPubKey:       state.CurrentMessageState.PublicKey,
+		Timestamp:       state.CurrentMessageState.WhisperTimestamp,
+	}
+
+	chat, err := m.matchChatEntity(indicator)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	if !supportsReceipts(chat) {
+		return ErrReceiptsNotSupported
+	}
+
+	indicator.LocalChatID = chat.ID
+	state.Response.AddTypingIndicator(indicator)
+
+	return nil
+}
+
+func (m *Messenger) HandleReadReceipt(state *ReceivedMessageState, pbReceipt protobuf.ReadReceipt) error {
+	logger := m.logger.With(zap.String("site", "HandleReadReceipt"))
+	if err := ValidateReceivedReadReceipt(&pbReceipt, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Error("invalid read receipt", zap.Error(err))
+		return err
+	}
+
+	// Messages read on our own devices are synced with SyncChatMessagesRead
+	if common.IsPubKeyEqual(state.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+		return nil
+	}
+
+	receipt := &ReadReceipt{
+		ReadReceipt: pbReceipt,
+		From:        state.CurrentMessageState.Contact.ID,
+		SigPubKey:   state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(receipt)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	if !supportsReceipts(chat) {
+		return ErrReceiptsNotSupported
+	}
+
+	receipt.LocalChatID = chat.ID
+
+	ids, err := m.persistence.MarkMessagesReadByRecipient(chat.ID, m.myHexIdentity(), receipt.From, m.receiptReaders(chat), receipt.MessageIds, receipt.ReadClock)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) > 0 {
+		messages, err := m.persistence.MessagesByIDs(ids)
+		if err != nil {
+			return err
+		}
+		state.Response.AddMessages(messages)
+	}
+
+	state.Response.AddReadReceipt(receipt)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+func TestMessengerChatReceiptsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerChatReceiptsSuite))
+}
+
+type MessengerChatReceiptsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerChatReceiptsSuite) TestTypingIndicator() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	// Typing indicators are opt-in, nothing is sent by default
+	err = theirMessenger.SendTypingIndicator(context.Background(), theirChat.ID, true)
+	s.Require().NoError(err)
+
+	err = theirMessenger.settings.SaveSettingField(settings.SendTypingIndicators, true)
+	s.Require().NoError(err)
+
+	err = theirMessenger.SendTypingIndicator(context.Background(), theirChat.ID, true)
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.TypingIndicators()) > 0 },
+		"no typing indicator received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.TypingIndicators(), 1)
+
+	indicator := response.TypingIndicators()[0]
+	s.Require().Equal(ourChat.ID, indicator.LocalChatID)
+	s.Require().Equal(theirMessenger.myHexIdentity(), indicator.From)
+	s.Require().True(indicator.Typing)
+
+	publicChat := CreatePublicChat("status", theirMessenger.transport)
+	err = theirMessenger.SaveChat(publicChat)
+	s.Require().NoError(err)
+
+	err = theirMessenger.SendTypingIndicator(context.Background(), publicChat.ID, true)
+	s.Require().Equal(ErrReceiptsNotSupported, err)
+}
+
+func (s *MessengerChatReceiptsSuite) TestReadReceipts() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	err = theirMessenger.settings.SaveSettingField(settings.SendReadReceipts, true)
+	s.Require().NoError(err)
+
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*ourChat))
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no message received",
+	)
+	s.Require().NoError(err)
+
+	count, _, err := theirMessenger.MarkMessagesSeen(theirChat.ID, []string{messageID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), count)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.ReadReceipts()) > 0 },
+		"no read receipt received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.ReadReceipts(), 1)
+	s.Require().Equal(ourChat.ID, response.ReadReceipts()[0].LocalChatID)
+	s.Require().Equal(theirMessenger.myHexIdentity(), response.ReadReceipts()[0].From)
+
+	message := response.GetMessage(messageID)
+	s.Require().NotNil(message)
+	s.Require().Equal(common.OutgoingStatusRead, message.OutgoingStatus)
+
+	message, err = s.m.MessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(common.OutgoingStatusRead, message.OutgoingStatus)
+
+	// Marking the whole chat as read covers all the messages received so far
+	sendResponse, err = s.m.SendChatMessage(context.Background(), buildTestMessage(*ourChat))
+	s.Require().NoError(err)
+	messageID = sendResponse.Messages()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no message received",
+	)
+	s.Require().NoError(err)
+
+	err = theirMessenger.MarkAllRead(theirChat.ID)
+	s.Require().NoError(err)
+
+	response, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return r.GetMessage(messageID) != nil },
+		"no read receipt received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(common.OutgoingStatusRead, response.GetMessage(messageID).OutgoingStatus)
+}
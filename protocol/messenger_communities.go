@@ -849,6 +849,14 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		RevealedAccounts: make([]*protobuf.RevealedAccount, 0),
 	}
 
+	if request.InviteLink != "" {
+		inviteLink, err := m.prepareCommunityInviteLink(community, request.InviteLink)
+		if err != nil {
+			return nil, err
+		}
+		requestToJoinProto.InviteLink = inviteLink
+	}
+
 	// find wallet accounts and attach wallet addresses and
 	// signatures to request
 	if request.Password != "" {
@@ -907,8 +915,8 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		return nil, err
 	}
 
-	// send request to join also to community admins
-	communityAdmins := community.GetMemberAdmins()
+	// send request to join also to community admins and members who can accept requests
+	communityAdmins := community.GetMembersWithCapability(protobuf.CommunityRole_ACCEPT_REQUESTS)
 	for _, communityAdmin := range communityAdmins {
 		_, err := m.sender.SendPrivate(context.Background(), communityAdmin, &rawMessage)
 		if err != nil {
@@ -2080,6 +2088,100 @@ func (m *Messenger) AddRoleToMember(request *requests.AddRoleToMember) (*Messeng
 	return response, nil
 }
 
+// CreateCommunityRole creates a custom role with the given capabilities, which the owner can then assign to members
+func (m *Messenger) CreateCommunityRole(request *requests.CreateCommunityRole) (*protobuf.CommunityRole, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	_, role, err := m.communitiesManager.CreateRole(request)
+	return role, err
+}
+
+func (m *Messenger) EditCommunityRole(request *requests.EditCommunityRole) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.EditRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) DeleteCommunityRole(request *requests.DeleteCommunityRole) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.DeleteRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// AssignCommunityRole assigns a custom role to a member, in the whole community or in a single channel
+func (m *Messenger) AssignCommunityRole(request *requests.AssignCommunityRole) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.AssignRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) UnassignCommunityRole(request *requests.UnassignCommunityRole) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.UnassignRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// SetCommunityModerationRules replaces the automated moderation rules the messages posted in the community are checked against
+func (m *Messenger) SetCommunityModerationRules(request *requests.SetCommunityModerationRules) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.SetModerationRules(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// GetCommunityAuditLog returns a page of the log of the changes made to the community by its owner and admins
+func (m *Messenger) GetCommunityAuditLog(request *requests.GetCommunityAuditLog) (*communities.AuditLogPage, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	return m.communitiesManager.GetAuditLog(request)
+}
+
+// VerifyCommunityAuditLog checks that the audit log of the community hasn't been tampered with
+func (m *Messenger) VerifyCommunityAuditLog(communityID types.HexBytes) error {
+	return m.communitiesManager.VerifyAuditLog(communityID)
+}
+
 func (m *Messenger) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -2455,9 +2557,9 @@ func (m *Messenger) handleCommunityResponse(state *ReceivedMessageState, communi
 	return nil
 }
 
-func (m *Messenger) handleCommunityEventsMessage(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.CommunityEventsMessage) error {
+func (m *Messenger) handleCommunityEventsMessage(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.CommunityEventsMessage, payload []byte) error {
 
-	communityResponse, err := m.communitiesManager.HandleCommunityEventsMessage(signer, &message)
+	communityResponse, err := m.communitiesManager.HandleCommunityEventsMessage(signer, &message, payload)
 	if err != nil {
 		return err
 	}
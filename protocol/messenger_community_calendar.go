@@ -0,0 +1,471 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	localnotifications "github.com/status-im/status-go/services/local-notifications"
+)
+
+// communityEventReminderLeadTime is how long before the events we attend start we are reminded of them
+const communityEventReminderLeadTime = 15 * time.Minute
+
+// communityEventTokenGatesCheckInterval is how often the token gates of upcoming events are checked again
+const communityEventTokenGatesCheckInterval = 1 * time.Hour
+
+// CreateCommunityCalendarEvent publishes an event in a channel of the community, only admins can publish events
+func (m *Messenger) CreateCommunityCalendarEvent(ctx context.Context, request *requests.CreateCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	event := request.ToCommunityCalendarEvent(uuid.New().String(), m.getTimesource().GetCurrentTime())
+	event.ChatId = strings.TrimPrefix(event.ChatId, request.CommunityID.String())
+
+	return m.publishCommunityCalendarEvent(ctx, event)
+}
+
+// EditCommunityCalendarEvent publishes the new details of an event, replacing the previous ones
+func (m *Messenger) EditCommunityCalendarEvent(ctx context.Context, request *requests.EditCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := m.activeCommunityCalendarEvent(request.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(existing.CommunityID, request.CommunityID) {
+		return nil, communities.ErrCalendarEventNotFound
+	}
+
+	event := request.ToCommunityCalendarEvent(existing.ID, m.nextCommunityCalendarClock(existing.Clock))
+	event.ChatId = strings.TrimPrefix(event.ChatId, request.CommunityID.String())
+
+	return m.publishCommunityCalendarEvent(ctx, event)
+}
+
+// CancelCommunityCalendarEvent publishes that the event won't take place
+func (m *Messenger) CancelCommunityCalendarEvent(ctx context.Context, eventID string) (*MessengerResponse, error) {
+	existing, err := m.activeCommunityCalendarEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := existing.ToProtobuf()
+	event.Cancelled = true
+	event.Clock = m.nextCommunityCalendarClock(existing.Clock)
+
+	return m.publishCommunityCalendarEvent(ctx, event)
+}
+
+// RsvpCommunityCalendarEvent sends our response to an event to the channel it takes place in.
+// We are reminded of the events we are going or might go to shortly before they start
+func (m *Messenger) RsvpCommunityCalendarEvent(ctx context.Context, request *requests.RsvpCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	event, err := m.communitiesManager.GetCalendarEvent(request.EventID, &m.identity.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var clock uint64
+	if rsvp := event.Rsvp(m.myHexIdentity()); rsvp != nil {
+		clock = rsvp.Clock
+	}
+
+	rsvp := &protobuf.CommunityCalendarEventRsvp{
+		Clock:       m.nextCommunityCalendarClock(clock),
+		CommunityId: event.CommunityID,
+		EventId:     event.ID,
+		Response:    request.Response,
+	}
+
+	event, _, err = m.communitiesManager.SaveCalendarEventRsvp(&m.identity.PublicKey, rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := proto.Marshal(rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.dispatchCommunityCalendarMessage(ctx, event, encodedMessage, protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncCommunityCalendarEvent(ctx, event, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunityCalendarEvent(event)
+
+	return response, nil
+}
+
+// CommunityCalendarEvents returns the events of the community which haven't ended yet, along with the responses of
+// members. Token gated events are only returned if we hold the tokens to attend them
+func (m *Messenger) CommunityCalendarEvents(communityID types.HexBytes) ([]*communities.CalendarEvent, error) {
+	return m.communitiesManager.GetCalendarEvents(communityID, &m.identity.PublicKey, m.getCurrentTimeInSeconds())
+}
+
+// ExportCommunityCalendarEvents exports the events of the community which haven't ended yet as an iCalendar
+// document, to be imported into calendar apps
+func (m *Messenger) ExportCommunityCalendarEvents(communityID types.HexBytes) (string, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return "", err
+	}
+	if community == nil {
+		return "", communities.ErrOrgNotFound
+	}
+
+	events, err := m.communitiesManager.GetCalendarEvents(community.ID(), &m.identity.PublicKey, m.getCurrentTimeInSeconds())
+	if err != nil {
+		return "", err
+	}
+
+	return community.CalendarICS(events), nil
+}
+
+// ExportCommunityCalendarEvent exports a single event as an iCalendar document
+func (m *Messenger) ExportCommunityCalendarEvent(eventID string) (string, error) {
+	event, err := m.communitiesManager.GetCalendarEvent(eventID, &m.identity.PublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	community, err := m.communitiesManager.GetByID(event.CommunityID)
+	if err != nil {
+		return "", err
+	}
+	if community == nil {
+		return "", communities.ErrOrgNotFound
+	}
+
+	return community.CalendarICS([]*communities.CalendarEvent{event}), nil
+}
+
+func (m *Messenger) activeCommunityCalendarEvent(eventID string) (*communities.CalendarEvent, error) {
+	event, err := m.communitiesManager.GetCalendarEvent(eventID, &m.identity.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Cancelled {
+		return nil, communities.ErrCalendarEventCancelled
+	}
+
+	return event, nil
+}
+
+func (m *Messenger) nextCommunityCalendarClock(clock uint64) uint64 {
+	next := m.getTimesource().GetCurrentTime()
+	if next <= clock {
+		next = clock + 1
+	}
+	return next
+}
+
+func (m *Messenger) publishCommunityCalendarEvent(ctx context.Context, event *protobuf.CommunityCalendarEvent) (*MessengerResponse, error) {
+	calendarEvent, _, err := m.communitiesManager.SaveCalendarEvent(&m.identity.PublicKey, event)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.dispatchCommunityCalendarMessage(ctx, calendarEvent, encodedMessage, protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunityCalendarEvent(calendarEvent)
+
+	return response, nil
+}
+
+// dispatchCommunityCalendarMessage sends an event or a response to it to the channel the event takes place in,
+// so that only members who can see the channel receive it
+func (m *Messenger) dispatchCommunityCalendarMessage(ctx context.Context, event *communities.CalendarEvent, payload []byte, messageType protobuf.ApplicationMetadataMessage_Type) error {
+	chat, ok := m.allChats.Load(event.CommunityID.String() + event.ChatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	_, err := m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              payload,
+		MessageType:          messageType,
+		SkipGroupMessageWrap: true,
+		ResendAutomatically:  true,
+	})
+	return err
+}
+
+func (m *Messenger) syncCommunityCalendarEvent(ctx context.Context, event *communities.CalendarEvent, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	message := &protobuf.SyncCommunityCalendarEvent{
+		Event:       event.ToProtobuf(),
+		PublishedBy: event.PublishedBy,
+	}
+	if rsvp := event.Rsvp(m.myHexIdentity()); rsvp != nil {
+		message.Rsvp = rsvp.ToProtobuf(event.CommunityID)
+	}
+
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_CALENDAR_EVENT,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// syncCommunityCalendarEvents sends the events which haven't ended yet to our other devices
+func (m *Messenger) syncCommunityCalendarEvents(ctx context.Context, rawMessageHandler RawMessageHandler) error {
+	events, err := m.communitiesManager.GetUpcomingCalendarEvents(m.getCurrentTimeInSeconds())
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err = m.syncCommunityCalendarEvent(ctx, event, rawMessageHandler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleCommunityCalendarEvent(state *ReceivedMessageState, message protobuf.CommunityCalendarEvent) error {
+	event, saved, err := m.communitiesManager.SaveCalendarEvent(state.CurrentMessageState.PublicKey, &message)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		m.addVisibleCommunityCalendarEvent(state.Response, event)
+		if len(event.TokenCriteria) > 0 {
+			go m.checkCommunityCalendarEventTokenGates(event.CommunityID)
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleCommunityCalendarEventRsvp(state *ReceivedMessageState, message protobuf.CommunityCalendarEventRsvp) error {
+	event, saved, err := m.communitiesManager.SaveCalendarEventRsvp(state.CurrentMessageState.PublicKey, &message)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		m.addVisibleCommunityCalendarEvent(state.Response, event)
+	}
+
+	return nil
+}
+
+// addVisibleCommunityCalendarEvent adds the event to the response unless it is token gated and we aren't known
+// to hold the tokens to attend it
+func (m *Messenger) addVisibleCommunityCalendarEvent(response *MessengerResponse, event *communities.CalendarEvent) {
+	if m.communitiesManager.CalendarEventVisible(event, &m.identity.PublicKey) {
+		response.AddCommunityCalendarEvent(event)
+	}
+}
+
+// checkCommunityCalendarEventTokenGates checks which of the upcoming token gated events of the community we hold
+// the tokens to attend, and sends those to the client. Owners and admins check the tokens of the members too,
+// to reject the responses of the members who don't hold them
+func (m *Messenger) checkCommunityCalendarEventTokenGates(communityID types.HexBytes) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil || community == nil {
+		return
+	}
+
+	now := m.getCurrentTimeInSeconds()
+	err = m.communitiesManager.EvaluateCalendarEventTokenGates(community, &m.identity.PublicKey, now)
+	if err != nil {
+		m.logger.Debug("failed to check community calendar event token gates", zap.Error(err))
+		return
+	}
+
+	if community.IsOwnerOrAdmin() {
+		for memberKey := range community.Members() {
+			member, err := common.HexToPubkey(memberKey)
+			if err != nil || member.Equal(&m.identity.PublicKey) {
+				continue
+			}
+			err = m.communitiesManager.EvaluateCalendarEventTokenGates(community, member, now)
+			if err != nil {
+				m.logger.Debug("failed to check community calendar event token gates", zap.Error(err))
+			}
+		}
+	}
+
+	events, err := m.communitiesManager.GetCalendarEvents(communityID, &m.identity.PublicKey, now)
+	if err != nil {
+		m.logger.Debug("failed to get community calendar events", zap.Error(err))
+		return
+	}
+
+	response := &MessengerResponse{}
+	for _, event := range events {
+		if len(event.TokenCriteria) > 0 {
+			response.AddCommunityCalendarEvent(event)
+		}
+	}
+	if !response.IsEmpty() && m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.MessengerResponse(response)
+	}
+}
+
+func (m *Messenger) checkJoinedCommunitiesCalendarEventTokenGates() {
+	joined, err := m.communitiesManager.Joined()
+	if err != nil {
+		m.logger.Error("failed to get joined communities", zap.Error(err))
+		return
+	}
+
+	for _, community := range joined {
+		m.checkCommunityCalendarEventTokenGates(community.ID())
+	}
+}
+
+func (m *Messenger) handleSyncCommunityCalendarEvent(state *ReceivedMessageState, message protobuf.SyncCommunityCalendarEvent) error {
+	if message.Event == nil {
+		return errors.New("invalid sync community calendar event")
+	}
+
+	publisher, err := common.HexToPubkey(message.PublishedBy)
+	if err != nil {
+		return err
+	}
+
+	// The event is checked against the community as if we received it from the admin who published it
+	event, saved, err := m.communitiesManager.SaveCalendarEvent(publisher, message.Event)
+	if err != nil {
+		return err
+	}
+
+	if message.Rsvp != nil {
+		var rsvpSaved bool
+		event, rsvpSaved, err = m.communitiesManager.SaveCalendarEventRsvp(&m.identity.PublicKey, message.Rsvp)
+		if err != nil {
+			return err
+		}
+		saved = saved || rsvpSaved
+	}
+
+	if saved {
+		state.Response.AddCommunityCalendarEvent(event)
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleSyncCommunityCalendarEvent(state *ReceivedMessageState, message protobuf.SyncCommunityCalendarEvent) error {
+	return m.handleSyncCommunityCalendarEvent(state, message)
+}
+
+func (m *Messenger) watchCommunityCalendarEvents() {
+	m.logger.Debug("watching community calendar events")
+	go func() {
+		m.checkJoinedCommunitiesCalendarEventTokenGates()
+
+		tokenGatesTicker := time.NewTicker(communityEventTokenGatesCheckInterval)
+		defer tokenGatesTicker.Stop()
+
+		for {
+			select {
+			case <-tokenGatesTicker.C:
+				m.checkJoinedCommunitiesCalendarEventTokenGates()
+			case <-time.After(30 * time.Second):
+				notifications, err := m.remindCommunityCalendarEvents(m.getCurrentTimeInSeconds())
+				if err != nil {
+					m.logger.Error("failed to remind community calendar events", zap.Error(err))
+					continue
+				}
+
+				localnotifications.PushMessages(notifications)
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// remindCommunityCalendarEvents returns a notification for each event we attend starting within
+// communityEventReminderLeadTime of now, in seconds. Each event is only reminded once, unless its start time changes
+func (m *Messenger) remindCommunityCalendarEvents(now uint64) ([]*localnotifications.Notification, error) {
+	until := now + uint64(communityEventReminderLeadTime.Seconds())
+	events, err := m.communitiesManager.GetCalendarEventsToRemind(m.myHexIdentity(), now, until)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationsEnabled, err := m.settings.GetNotificationsEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*localnotifications.Notification
+	for _, event := range events {
+		community, err := m.communitiesManager.GetByID(event.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+
+		visible := m.communitiesManager.CalendarEventVisible(event, &m.identity.PublicKey)
+
+		chat, ok := m.allChats.Load(event.CommunityID.String() + event.ChatID)
+		if notificationsEnabled && community != nil && community.Joined() && ok && visible {
+			notifications = append(notifications, NewCommunityEventReminderNotification(event, community, chat))
+		}
+
+		err = m.communitiesManager.MarkCalendarEventReminded(event.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,116 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+const communityInviteURLPrefix = baseShareURL + "/ci/"
+
+func communityInviteURL(inviteLink *communities.InviteLink) string {
+	return fmt.Sprintf("%s%s#%s", communityInviteURLPrefix, inviteLink.Data, inviteLink.Signature)
+}
+
+// decodeCommunityInviteURL extracts the invite from an invite link and checks it was signed by the community
+func decodeCommunityInviteURL(url string) (*communities.InviteLink, error) {
+	if !strings.HasPrefix(url, communityInviteURLPrefix) {
+		return nil, communities.ErrInviteLinkInvalid
+	}
+
+	urlContents := strings.SplitN(strings.TrimPrefix(url, communityInviteURLPrefix), "#", 2)
+	if len(urlContents) != 2 {
+		return nil, communities.ErrInviteLinkInvalid
+	}
+
+	return communities.DecodeInviteLink(&protobuf.CommunityInviteLink{Data: urlContents[0], Signature: urlContents[1]})
+}
+
+// CreateCommunityInviteLink creates an invite link to the community, signed by its owner. The link can expire,
+// be limited to a number of uses, give a role to the members joining with it and accept them automatically
+func (m *Messenger) CreateCommunityInviteLink(request *requests.CreateCommunityInviteLink) (*communities.InviteLink, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	inviteLink, err := m.communitiesManager.CreateInviteLink(request)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteLink.URL = communityInviteURL(inviteLink)
+	return inviteLink, nil
+}
+
+// GetCommunityInviteLinks returns the invite links created for the community, along with who used them
+func (m *Messenger) GetCommunityInviteLinks(communityID types.HexBytes) ([]*communities.InviteLink, error) {
+	inviteLinks, err := m.communitiesManager.GetInviteLinks(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, inviteLink := range inviteLinks {
+		inviteLink.URL = communityInviteURL(inviteLink)
+	}
+	return inviteLinks, nil
+}
+
+// RevokeCommunityInviteLink stops accepting requests to join made with the invite link
+func (m *Messenger) RevokeCommunityInviteLink(request *requests.RevokeCommunityInviteLink) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	return m.communitiesManager.RevokeInviteLink(request)
+}
+
+// prepareCommunityInviteLink checks that the invite link is a valid invite to the community, to be sent along with
+// our request to join
+func (m *Messenger) prepareCommunityInviteLink(community *communities.Community, url string) (*protobuf.CommunityInviteLink, error) {
+	inviteLink, err := decodeCommunityInviteURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(inviteLink.CommunityID, community.ID()) {
+		return nil, communities.ErrInviteLinkInvalid
+	}
+
+	if inviteLink.Expired(uint64(time.Now().Unix())) {
+		return nil, communities.ErrInviteLinkExpired
+	}
+
+	return inviteLink.ToProtobuf(), nil
+}
+
+func (m *Messenger) parseCommunityInviteURL(data string, signature string) (*URLDataResponse, error) {
+	inviteLink, err := communities.DecodeInviteLink(&protobuf.CommunityInviteLink{Data: data, Signature: signature})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &URLDataResponse{
+		Invite: &CommunityInviteURLData{
+			CommunityID: inviteLink.CommunityID,
+			ExpiresAt:   inviteLink.ExpiresAt,
+			Role:        inviteLink.Role,
+			AutoAccept:  inviteLink.AutoAccept,
+		},
+	}
+
+	community, err := m.communitiesManager.GetByID(inviteLink.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community != nil {
+		response.Community = m.prepareCommunityData(community)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,106 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+)
+
+// checkCommunityModeration returns the moderation rule of the community the message posted by author in chat breaks,
+// if any. pending are the messages received along with it, not yet saved
+func (m *Messenger) checkCommunityModeration(community *communities.Community, chat *Chat, message *common.Message, author *ecdsa.PublicKey, pending []*common.Message) (communities.ModerationViolation, error) {
+	if message.ParsedTextAst == nil {
+		if err := message.PrepareContent(m.myHexIdentity()); err != nil {
+			return "", err
+		}
+	}
+
+	lastPostedAt, err := m.persistence.LastMessageWhisperTimestampFrom(chat.ID, message.From, message.ID, message.WhisperTimestamp)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range pending {
+		if p.LocalChatID == chat.ID && p.From == message.From && p.ID != message.ID &&
+			p.WhisperTimestamp <= message.WhisperTimestamp && p.WhisperTimestamp > lastPostedAt {
+			lastPostedAt = p.WhisperTimestamp
+		}
+	}
+
+	return community.CheckMessage(&communities.ModeratedMessage{
+		Author:       author,
+		ChatID:       chat.CommunityChatID(),
+		Text:         message.Text,
+		Links:        len(message.Links),
+		Mentions:     len(message.Mentions),
+		Timestamp:    message.WhisperTimestamp,
+		LastPostedAt: lastPostedAt,
+	}), nil
+}
+
+// moderateReceivedCommunityMessage checks a message received in a community chat against the moderation rules
+// of the community. It returns the rule the message breaks, in which case the message must be dropped, and
+// records the violation in the activity center when we moderate the community
+func (m *Messenger) moderateReceivedCommunityMessage(state *ReceivedMessageState, chat *Chat, message *common.Message) (communities.ModerationViolation, error) {
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return "", err
+	}
+	if community == nil {
+		return "", communities.ErrOrgNotFound
+	}
+
+	violation, err := m.checkCommunityModeration(community, chat, message, message.SigPubKey, state.Response.Messages())
+	if err != nil || violation == "" {
+		return violation, err
+	}
+
+	m.logger.Info("dropping message breaking the community moderation rules",
+		zap.String("messageID", message.ID),
+		zap.String("communityID", chat.CommunityID),
+		zap.String("violation", string(violation)))
+
+	if community.IsOwnerOrAdmin() {
+		notification := &ActivityCenterNotification{
+			ID:                  types.FromHex(message.ID),
+			Name:                chat.Name,
+			Message:             message,
+			Type:                ActivityCenterNotificationTypeCommunityModeration,
+			Timestamp:           message.WhisperTimestamp,
+			ChatID:              chat.ID,
+			CommunityID:         chat.CommunityID,
+			Author:              message.From,
+			ModerationViolation: violation,
+			UpdatedAt:           m.getCurrentTimeInMillis(),
+		}
+		if err := m.addActivityCenterNotification(state.Response, notification); err != nil {
+			m.logger.Warn("failed to create community moderation notification", zap.Error(err))
+		}
+	}
+
+	return violation, nil
+}
+
+// moderateSentCommunityMessage checks a message we are about to send in a community chat against the moderation
+// rules of the community, so that it isn't sent only to be dropped by the other members
+func (m *Messenger) moderateSentCommunityMessage(chat *Chat, message *common.Message) error {
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	violation, err := m.checkCommunityModeration(community, chat, message, &m.identity.PublicKey, nil)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		return violation
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/signal"
+)
+
+var ErrInvalidDisappearingMessagesChat = errors.New("disappearing messages are only available in one-to-one and private group chats")
+
+const disappearingMessagesDisabledText = "@%s turned off disappearing messages"
+const disappearingMessagesEnabledText = "@%s set disappearing messages to %s"
+
+// SetDisappearingMessages sets the timer after which new messages in a one-to-one
+// or private group chat are deleted. The setting is sent to the other members of the chat
+// and to our paired devices.
+func (m *Messenger) SetDisappearingMessages(ctx context.Context, request *requests.SetDisappearingMessages) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	if !chat.OneToOne() && !chat.PrivateGroupChat() {
+		return nil, ErrInvalidDisappearingMessagesChat
+	}
+
+	if chat.PrivateGroupChat() && !chat.HasMember(m.myHexIdentity()) {
+		return nil, errors.New("not a member of the chat")
+	}
+
+	clock, timestamp := chat.NextClockAndTimestamp(m.getTimesource())
+
+	setting := &common.DisappearingMessages{
+		DisappearingMessages: protobuf.DisappearingMessages{
+			Clock:  clock,
+			ChatId: chat.ID,
+			Timer:  request.Timer,
+		},
+		From:      m.myHexIdentity(),
+		SigPubKey: &m.identity.PublicKey,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, setting)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		MessageType:          protobuf.ApplicationMetadataMessage_DISAPPEARING_MESSAGES,
+		SkipGroupMessageWrap: true,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	err = m.applyDisappearingMessages(chat, setting, timestamp, response)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveMessages(response.Messages())
+	if err != nil {
+		return nil, err
+	}
+
+	m.prepareMessages(response.messages)
+
+	return response, nil
+}
+
+func (m *Messenger) HandleDisappearingMessages(state *ReceivedMessageState, message protobuf.DisappearingMessages) error {
+	return m.handleDisappearingMessages(state.CurrentMessageState.Contact.ID, state.CurrentMessageState.PublicKey, state.CurrentMessageState.WhisperTimestamp, state.Response, message)
+}
+
+func (m *Messenger) handleDisappearingMessages(from string, sigPubKey *ecdsa.PublicKey, whisperTimestamp uint64, response *MessengerResponse, message protobuf.DisappearingMessages) error {
+	if message.MessageType != protobuf.MessageType_ONE_TO_ONE && message.MessageType != protobuf.MessageType_PRIVATE_GROUP {
+		return ErrInvalidDisappearingMessagesChat
+	}
+
+	setting := &common.DisappearingMessages{
+		DisappearingMessages: message,
+		From:                 from,
+		SigPubKey:            sigPubKey,
+	}
+
+	chat, err := m.matchChatEntity(setting)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	if chat.PrivateGroupChat() && !chat.HasMember(from) {
+		return errors.New("not a member of the chat")
+	}
+
+	return m.applyDisappearingMessages(chat, setting, whisperTimestamp, response)
+}
+
+// applyDisappearingMessages updates the chat with the new timer and adds
+// a system message to the response, unless a more recent setting has already been applied
+func (m *Messenger) applyDisappearingMessages(chat *Chat, setting *common.DisappearingMessages, timestamp uint64, response *MessengerResponse) error {
+	if chat.DisappearingMessagesClock >= setting.Clock {
+		m.logger.Debug("ignoring outdated disappearing messages setting", zap.String("chatID", chat.ID))
+		return nil
+	}
+
+	chat.DisappearingMessagesTimer = setting.Timer
+	chat.DisappearingMessagesClock = setting.Clock
+	if chat.LastClockValue < setting.Clock {
+		chat.LastClockValue = setting.Clock
+	}
+
+	// The chat is saved right away so that the following messages
+	// are stored with the right expiration
+	err := m.saveChat(chat)
+	if err != nil {
+		return err
+	}
+
+	messageType := protobuf.MessageType_ONE_TO_ONE
+	if chat.PrivateGroupChat() {
+		messageType = protobuf.MessageType_PRIVATE_GROUP
+	}
+
+	text := fmt.Sprintf(disappearingMessagesDisabledText, setting.From)
+	if setting.Timer > 0 {
+		text = fmt.Sprintf(disappearingMessagesEnabledText, setting.From, formatDisappearingMessagesTimer(setting.Timer))
+	}
+
+	outgoing := setting.From == m.myHexIdentity()
+	message := &common.Message{
+		ChatMessage: protobuf.ChatMessage{
+			ChatId:      chat.ID,
+			Text:        text,
+			MessageType: messageType,
+			ContentType: protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES,
+			Clock:       setting.Clock,
+			Timestamp:   timestamp,
+		},
+		From:             setting.From,
+		WhisperTimestamp: timestamp,
+		LocalChatID:      chat.ID,
+		Seen:             outgoing,
+		ID:               types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%s%d", setting.From, chat.ID, "disappearing-messages", setting.Clock)))),
+	}
+
+	response.AddMessage(message)
+	response.AddChat(chat)
+	return nil
+}
+
+func formatDisappearingMessagesTimer(timer uint64) string {
+	units := []struct {
+		name    string
+		seconds uint64
+	}{
+		{"week", uint64(7 * 24 * time.Hour / time.Second)},
+		{"day", uint64(24 * time.Hour / time.Second)},
+		{"hour", uint64(time.Hour / time.Second)},
+		{"minute", uint64(time.Minute / time.Second)},
+		{"second", 1},
+	}
+
+	for _, unit := range units {
+		if timer%unit.seconds == 0 {
+			count := timer / unit.seconds
+			if count == 1 {
+				return fmt.Sprintf("1 %s", unit.name)
+			}
+			return fmt.Sprintf("%d %ss", count, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d seconds", timer)
+}
+
+func (m *Messenger) syncDisappearingMessages(ctx context.Context, chat *Chat, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, selfChat := m.getLastClockWithRelatedChat()
+
+	messageType := protobuf.MessageType_ONE_TO_ONE
+	if chat.PrivateGroupChat() {
+		messageType = protobuf.MessageType_PRIVATE_GROUP
+	}
+
+	syncMessage := &protobuf.DisappearingMessages{
+		Clock:       chat.DisappearingMessagesClock,
+		ChatId:      chat.ID,
+		MessageType: messageType,
+		Timer:       chat.DisappearingMessagesTimer,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         selfChat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_DISAPPEARING_MESSAGES,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	selfChat.LastClockValue = clock
+	return m.saveChat(selfChat)
+}
+
+// watchDisappearingMessages regularly deletes the messages whose timer expired
+func (m *Messenger) watchDisappearingMessages() {
+	m.logger.Debug("watching disappearing messages")
+	go func() {
+		for {
+			select {
+			case <-time.After(time.Second):
+				response, err := m.deleteExpiredMessages()
+				if err != nil {
+					m.logger.Error("failed to delete expired messages", zap.Error(err))
+					continue
+				}
+
+				if !response.IsEmpty() {
+					signal.SendNewMessages(response)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) deleteExpiredMessages() (*MessengerResponse, error) {
+	response := &MessengerResponse{}
+
+	now := m.getCurrentTimeInMillis()
+	expired, err := m.persistence.ExpiredMessagesIDsByChatID(now)
+	if err != nil {
+		return nil, err
+	}
+
+	for chatID, messageIDs := range expired {
+		err = m.persistence.DeleteExpiredMessages(chatID, messageIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, messageID := range messageIDs {
+			notifications, err := m.persistence.DeleteActivityCenterNotificationForMessage(chatID, messageID, now)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, notification := range notifications {
+				response.AddActivityCenterNotification(notification)
+			}
+
+			response.AddRemovedMessage(&RemovedMessage{MessageID: messageID, ChatID: chatID})
+		}
+
+		chat, ok := m.allChats.Load(chatID)
+		if !ok {
+			continue
+		}
+
+		// Reload the chat to get the recalculated unviewed counters
+		updatedChat, err := m.persistence.Chat(chatID)
+		if err != nil {
+			return nil, err
+		}
+		if updatedChat != nil {
+			chat.UnviewedMessagesCount = updatedChat.UnviewedMessagesCount
+			chat.UnviewedMentionsCount = updatedChat.UnviewedMentionsCount
+		}
+
+		if chat.LastMessage != nil {
+			for _, messageID := range messageIDs {
+				if chat.LastMessage.ID != messageID {
+					continue
+				}
+
+				messages, err := m.persistence.LatestMessageByChatID(chatID)
+				if err != nil {
+					return nil, err
+				}
+				chat.LastMessage = nil
+				if len(messages) > 0 {
+					chat.LastMessage = messages[0]
+				}
+				break
+			}
+		}
+
+		err = m.saveChat(chat)
+		if err != nil {
+			return nil, err
+		}
+
+		response.AddChat(chat)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,122 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestMessengerDisappearingMessagesSuite(t *testing.T) {
+	suite.Run(t, new(MessengerDisappearingMessagesSuite))
+}
+
+type MessengerDisappearingMessagesSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerDisappearingMessagesSuite) TestSetDisappearingMessages() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SetDisappearingMessages(context.Background(), &requests.SetDisappearingMessages{
+		ChatID: theirChat.ID,
+		Timer:  1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Chats(), 1)
+	s.Require().Equal(uint64(1), sendResponse.Chats()[0].DisappearingMessagesTimer)
+	s.Require().Len(sendResponse.Messages(), 1)
+	s.Require().Equal(protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES, sendResponse.Messages()[0].ContentType)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"disappearing messages setting not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	s.Require().Equal(ourChat.ID, response.Chats()[0].ID)
+	s.Require().Equal(uint64(1), response.Chats()[0].DisappearingMessagesTimer)
+
+	systemMessage := FindFirstByContentType(response.Messages(), protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES)
+	s.Require().NotNil(systemMessage)
+
+	inputMessage := buildTestMessage(*theirChat)
+	sendResponse, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no messages",
+	)
+	s.Require().NoError(err)
+
+	// The message is deleted on both sides once the timer expires
+	err = tt.RetryWithBackOff(func() error {
+		for _, messenger := range []*Messenger{s.m, theirMessenger} {
+			_, err := messenger.persistence.MessageByID(inputMessage.ID)
+			if err != common.ErrRecordNotFound {
+				return errors.New("message not deleted")
+			}
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// The system message itself does not expire
+	_, err = s.m.persistence.MessageByID(systemMessage.ID)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerDisappearingMessagesSuite) TestSetDisappearingMessagesOutdated() {
+	ourChat := CreateOneToOneChat("Our 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	ourChat.DisappearingMessagesTimer = 60
+	ourChat.DisappearingMessagesClock = 10
+	err := s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	response := &MessengerResponse{}
+	err = s.m.handleDisappearingMessages(s.m.myHexIdentity(), &s.privateKey.PublicKey, 1, response, protobuf.DisappearingMessages{
+		Clock:       5,
+		ChatId:      ourChat.ID,
+		MessageType: protobuf.MessageType_ONE_TO_ONE,
+		Timer:       0,
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.IsEmpty())
+
+	chat, ok := s.m.allChats.Load(ourChat.ID)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(60), chat.DisappearingMessagesTimer)
+}
+
+func (s *MessengerDisappearingMessagesSuite) TestSetDisappearingMessagesPublicChat() {
+	chat := CreatePublicChat("status", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	_, err = s.m.SetDisappearingMessages(context.Background(), &requests.SetDisappearingMessages{
+		ChatID: chat.ID,
+		Timer:  60,
+	})
+	s.Require().Equal(ErrInvalidDisappearingMessagesChat, err)
+}
@@ -1752,7 +1752,7 @@ func (m *Messenger) HandleDeleteMessage(state *ReceivedMessageState, deleteMessa
 			return err
 		}
 		if chat.ChatType == ChatTypeCommunityChat {
-			canDeleteMessageForEveryone = m.CanDeleteMessageForEveryoneInCommunity(chat.CommunityID, fromPublicKey)
+			canDeleteMessageForEveryone = m.CanDeleteMessageForEveryoneInCommunity(chat.CommunityID, chat.CommunityChatID(), fromPublicKey)
 			if !canDeleteMessageForEveryone {
 				return ErrInvalidDeletePermission
 			}
@@ -1844,6 +1844,11 @@ func (m *Messenger) HandleDeleteMessage(state *ReceivedMessageState, deleteMessa
 			return err
 		}
 		state.Response.AddMessages(updatedMessages)
+
+		err = m.addThreadRootToResponse(state.Response, messageToDelete.ThreadID)
+		if err != nil {
+			return err
+		}
 	}
 
 	state.Response.AddChat(chat)
@@ -1917,6 +1922,11 @@ func (m *Messenger) HandleDeleteForMeMessage(state *ReceivedMessageState, delete
 		}
 
 		state.Response.AddMessage(messageToDelete)
+
+		err = m.addThreadRootToResponse(state.Response, messageToDelete.ThreadID)
+		if err != nil {
+			return err
+		}
 	}
 	state.Response.AddChat(chat)
 
@@ -2025,6 +2035,17 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 		return nil
 	}
 
+	// Our own messages were checked when sent
+	if chat.CommunityChat() && !isSyncMessage {
+		violation, err := m.moderateReceivedCommunityMessage(state, chat, receivedMessage)
+		if err != nil {
+			return err
+		}
+		if violation != "" {
+			return violation
+		}
+	}
+
 	// Set the LocalChatID for the message
 	receivedMessage.LocalChatID = chat.ID
 
@@ -2572,12 +2593,15 @@ func (m *Messenger) matchChatEntity(chatEntity common.ChatEntity) (*Chat, error)
 
 		var emojiReaction bool
 		var pinMessage bool
-		// We allow emoji reactions from anyone
+		var pollVote bool
+		// We allow emoji reactions and poll votes from anyone
 		switch chatEntity.(type) {
 		case *EmojiReaction:
 			emojiReaction = true
 		case *common.PinMessage:
 			pinMessage = true
+		case *PollVote:
+			pollVote = true
 		}
 
 		canPost, err := m.communitiesManager.CanPost(chatEntity.GetSigPubKey(), chat.CommunityID, chat.CommunityChatID(), chatEntity.GetGrant())
@@ -2590,10 +2614,9 @@ func (m *Messenger) matchChatEntity(chatEntity common.ChatEntity) (*Chat, error)
 			return nil, err
 		}
 
-		isMemberOwnerOrAdmin := community.IsMemberOwnerOrAdmin(chatEntity.GetSigPubKey())
-		pinMessageAllowed := community.AllowsAllMembersToPinMessage()
+		canPinMessage := community.CanPinMessage(chatEntity.GetSigPubKey(), chat.CommunityChatID())
 
-		if (pinMessage && !isMemberOwnerOrAdmin && !pinMessageAllowed) || (!emojiReaction && !canPost) {
+		if (pinMessage && !canPinMessage) || (!emojiReaction && !pollVote && !canPost) {
 			return nil, errors.New("user can't post")
 		}
 
@@ -106,14 +106,14 @@ func (m *Messenger) EditMessage(ctx context.Context, request *requests.EditMessa
 	return response, nil
 }
 
-func (m *Messenger) CanDeleteMessageForEveryoneInCommunity(communityID string, publicKey *ecdsa.PublicKey) bool {
+func (m *Messenger) CanDeleteMessageForEveryoneInCommunity(communityID string, chatID string, publicKey *ecdsa.PublicKey) bool {
 	if communityID != "" {
 		community, err := m.communitiesManager.GetByIDString(communityID)
 		if err != nil {
 			m.logger.Error("failed to find community", zap.String("communityID", communityID), zap.Error(err))
 			return false
 		}
-		return community.CanDeleteMessageForEveryone(publicKey)
+		return community.CanDeleteMessageForEveryoneInChat(publicKey, chatID)
 	}
 	return false
 }
@@ -145,7 +145,7 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 	if message.From != common.PubkeyToHex(&m.identity.PublicKey) {
 		if message.MessageType == protobuf.MessageType_COMMUNITY_CHAT {
 			communityID := chat.CommunityID
-			canDeleteMessageForEveryone = m.CanDeleteMessageForEveryoneInCommunity(communityID, &m.identity.PublicKey)
+			canDeleteMessageForEveryone = m.CanDeleteMessageForEveryoneInCommunity(communityID, chat.CommunityChatID(), &m.identity.PublicKey)
 			if !canDeleteMessageForEveryone {
 				return nil, ErrInvalidDeletePermission
 			}
@@ -231,6 +231,11 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		}
 
 		response.AddMessages(updatedMessages)
+
+		err = m.addThreadRootToResponse(response, messageToDelete.ThreadID)
+		if err != nil {
+			return nil, err
+		}
 		m.prepareMessages(response.messages)
 	}
 
@@ -291,6 +296,11 @@ func (m *Messenger) DeleteMessageForMeAndSync(ctx context.Context, localChatID s
 
 		response.AddMessages(updatedMessages)
 
+		err = m.addThreadRootToResponse(response, messageToDelete.ThreadID)
+		if err != nil {
+			return nil, err
+		}
+
 		m.prepareMessages(response.messages)
 
 	}
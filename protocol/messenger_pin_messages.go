@@ -36,10 +36,7 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 		if err != nil {
 			return nil, err
 		}
-		isMemberOwnerOrAdmin := community.IsMemberOwnerOrAdmin(&m.identity.PublicKey)
-		pinMessageAllowed := community.AllowsAllMembersToPinMessage()
-
-		if !pinMessageAllowed && !isMemberOwnerOrAdmin {
+		if !community.CanPinMessage(&m.identity.PublicKey, chat.CommunityChatID()) {
 			return nil, errors.New("member can't pin message")
 		}
 	}
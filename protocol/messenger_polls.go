@@ -0,0 +1,195 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrNotAPoll = errors.New("message is not a poll")
+var ErrPollClosed = errors.New("poll is closed")
+var ErrInvalidPollVote = errors.New("invalid poll vote")
+
+// SendPollVote votes for the given options of a poll, replacing our previous vote
+func (m *Messenger) SendPollVote(ctx context.Context, request *requests.SendPollVote) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	message, err := m.persistence.MessageByID(request.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	poll := message.GetPoll()
+	if message.ContentType != protobuf.ChatMessage_POLL || poll == nil {
+		return nil, ErrNotAPoll
+	}
+
+	if isPollClosed(poll, m.getCurrentTimeInMillis()) {
+		return nil, ErrPollClosed
+	}
+
+	if !validPollVote(poll, request.OptionIDs) {
+		return nil, ErrInvalidPollVote
+	}
+
+	chat, ok := m.allChats.Load(message.LocalChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, timestamp := chat.NextClockAndTimestamp(m.getTimesource())
+
+	vote := &PollVote{
+		PollVote: protobuf.PollVote{
+			Clock:     clock,
+			ChatId:    chat.ID,
+			MessageId: message.ID,
+			OptionIds: request.OptionIDs,
+		},
+		From:        m.myHexIdentity(),
+		SigPubKey:   &m.identity.PublicKey,
+		LocalChatID: chat.ID,
+		Timestamp:   timestamp,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, vote)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_POLL_VOTE,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SavePollVote(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := m.pollResults(message)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddPollResult(results)
+	response.AddChat(chat)
+
+	return response, nil
+}
+
+// PollResults returns the tally of the votes of a poll
+func (m *Messenger) PollResults(messageID string) (*PollResults, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.ContentType != protobuf.ChatMessage_POLL || message.GetPoll() == nil {
+		return nil, ErrNotAPoll
+	}
+
+	return m.pollResults(message)
+}
+
+func (m *Messenger) pollResults(message *common.Message) (*PollResults, error) {
+	votes, err := m.persistence.PollVotes(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPollResults(message, votes, m.myHexIdentity(), m.getCurrentTimeInMillis()), nil
+}
+
+func (m *Messenger) HandlePollVote(state *ReceivedMessageState, pbVote protobuf.PollVote) error {
+	logger := m.logger.With(zap.String("site", "HandlePollVote"))
+	if err := ValidateReceivedPollVote(&pbVote, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Error("invalid poll vote", zap.Error(err))
+		return err
+	}
+
+	vote := &PollVote{
+		PollVote:  pbVote,
+		From:      state.CurrentMessageState.Contact.ID,
+		SigPubKey: state.CurrentMessageState.PublicKey,
+		Timestamp: state.CurrentMessageState.WhisperTimestamp,
+	}
+
+	existingVote, err := m.persistence.PollVoteByVoter(vote.MessageId, vote.From)
+	if err != common.ErrRecordNotFound && err != nil {
+		return err
+	}
+
+	// Votes sent from any of the voter's devices replace the previous ones,
+	// only the latest is kept
+	if existingVote != nil && existingVote.Clock >= vote.Clock {
+		return nil
+	}
+
+	chat, err := m.matchChatEntity(vote)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	vote.LocalChatID = chat.ID
+
+	// Votes might be received before the poll itself, in which case
+	// they are checked when tallying
+	message, err := m.persistence.MessageByID(vote.MessageId)
+	if err != common.ErrRecordNotFound && err != nil {
+		return err
+	}
+
+	if message != nil {
+		poll := message.GetPoll()
+		if message.LocalChatID != chat.ID || poll == nil {
+			return ErrNotAPoll
+		}
+
+		if isPollClosed(poll, vote.Timestamp) {
+			return ErrPollClosed
+		}
+
+		if !validPollVote(poll, vote.OptionIds) {
+			return ErrInvalidPollVote
+		}
+	}
+
+	logger.Debug("Handling poll vote")
+
+	if chat.LastClockValue < vote.Clock {
+		chat.LastClockValue = vote.Clock
+	}
+
+	state.Response.AddChat(chat)
+	state.AllChats.Store(chat.ID, chat)
+
+	err = m.persistence.SavePollVote(vote)
+	if err != nil {
+		return err
+	}
+
+	if message != nil {
+		results, err := m.pollResults(message)
+		if err != nil {
+			return err
+		}
+		state.Response.AddPollResult(results)
+	}
+
+	return nil
+}
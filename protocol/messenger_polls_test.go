@@ -0,0 +1,163 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func TestMessengerPollsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPollsSuite))
+}
+
+type MessengerPollsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func buildTestPollMessage(chat Chat, closesAt uint64) *common.Message {
+	message := buildTestMessage(chat)
+	message.Text = ""
+	message.ContentType = protobuf.ChatMessage_POLL
+	message.Payload = &protobuf.ChatMessage_Poll{
+		Poll: &protobuf.PollMessage{
+			Question: "Next meetup?",
+			Options: []*protobuf.PollOption{
+				{Id: "monday", Text: "Monday"},
+				{Id: "friday", Text: "Friday"},
+			},
+			ClosesAt: closesAt,
+		},
+	}
+	return message
+}
+
+func (s *MessengerPollsSuite) TestPollVote() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestPollMessage(*theirChat, 0))
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+	pollID := sendResponse.Messages()[0].ID
+	s.Require().Equal("Next meetup?", sendResponse.Messages()[0].Text)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no poll received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	s.Require().Equal(protobuf.ChatMessage_POLL, response.Messages()[0].ContentType)
+
+	// The poll is persisted with the message
+	message, err := s.m.MessageByID(pollID)
+	s.Require().NoError(err)
+	s.Require().NotNil(message.GetPoll())
+	s.Require().Len(message.GetPoll().Options, 2)
+
+	_, err = s.m.SendPollVote(context.Background(), &requests.SendPollVote{MessageID: pollID, OptionIDs: []string{"monday", "friday"}})
+	s.Require().Equal(ErrInvalidPollVote, err)
+
+	voteResponse, err := s.m.SendPollVote(context.Background(), &requests.SendPollVote{MessageID: pollID, OptionIDs: []string{"monday"}})
+	s.Require().NoError(err)
+	s.Require().Len(voteResponse.PollResults(), 1)
+	s.Require().Equal([]string{"monday"}, voteResponse.PollResults()[0].MyVote)
+
+	response, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.PollResults()) > 0 },
+		"no poll vote received",
+	)
+	s.Require().NoError(err)
+
+	results := response.PollResults()[0]
+	s.Require().Equal(pollID, results.MessageID)
+	s.Require().Equal(1, results.Voters)
+	s.Require().Equal("monday", results.Options[0].ID)
+	s.Require().Equal(1, results.Options[0].Votes)
+	s.Require().Equal(0, results.Options[1].Votes)
+
+	// Changing the vote replaces the previous one
+	_, err = s.m.SendPollVote(context.Background(), &requests.SendPollVote{MessageID: pollID, OptionIDs: []string{"friday"}})
+	s.Require().NoError(err)
+
+	response, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.PollResults()) > 0 },
+		"no poll vote received",
+	)
+	s.Require().NoError(err)
+
+	results = response.PollResults()[0]
+	s.Require().Equal(1, results.Voters)
+	s.Require().Equal(0, results.Options[0].Votes)
+	s.Require().Equal(1, results.Options[1].Votes)
+
+	results, err = theirMessenger.PollResults(pollID)
+	s.Require().NoError(err)
+	s.Require().Equal(1, results.Voters)
+	s.Require().Empty(results.MyVote)
+}
+
+func (s *MessengerPollsSuite) TestClosedPoll() {
+	chat := CreatePublicChat("polls", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestPollMessage(*chat, 1))
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+	pollID := sendResponse.Messages()[0].ID
+
+	_, err = s.m.SendPollVote(context.Background(), &requests.SendPollVote{MessageID: pollID, OptionIDs: []string{"monday"}})
+	s.Require().Equal(ErrPollClosed, err)
+
+	// Votes received late are not counted
+	state := s.m.buildMessageState()
+	state.CurrentMessageState = &CurrentMessageState{
+		Contact:          &Contact{ID: s.m.myHexIdentity()},
+		PublicKey:        &s.privateKey.PublicKey,
+		WhisperTimestamp: s.m.getCurrentTimeInMillis(),
+	}
+	err = s.m.HandlePollVote(state, protobuf.PollVote{
+		Clock:       s.m.getCurrentTimeInMillis(),
+		ChatId:      chat.ID,
+		MessageId:   pollID,
+		MessageType: protobuf.MessageType_PUBLIC_GROUP,
+		OptionIds:   []string{"monday"},
+	})
+	s.Require().Equal(ErrPollClosed, err)
+
+	results, err := s.m.PollResults(pollID)
+	s.Require().NoError(err)
+	s.Require().True(results.Closed)
+	s.Require().Equal(0, results.Voters)
+}
+
+func (s *MessengerPollsSuite) TestNotAPoll() {
+	chat := CreatePublicChat("polls", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+
+	_, err = s.m.SendPollVote(context.Background(), &requests.SendPollVote{MessageID: sendResponse.Messages()[0].ID, OptionIDs: []string{"monday"}})
+	s.Require().Equal(ErrNotAPoll, err)
+}
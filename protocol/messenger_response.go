@@ -75,6 +75,11 @@ type MessengerResponse struct {
 	verificationRequests        map[string]*verification.Request
 	trustStatus                 map[string]verification.TrustStatus
 	emojiReactions              map[string]*EmojiReaction
+	pollResults                 map[string]*PollResults
+	typingIndicators            map[string]*TypingIndicator
+	readReceipts                []*ReadReceipt
+	scheduledMessages           map[string]*ScheduledMessage
+	communityCalendarEvents     map[string]*communities.CalendarEvent
 	savedAddresses              map[string]*wallet.SavedAddress
 	SocialLinksInfo             *identity.SocialLinksInfo
 	ensUsernameDetails          []*ensservice.UsernameDetail
@@ -90,6 +95,11 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		Installations           []*multidevice.Installation         `json:"installations,omitempty"`
 		PinMessages             []*common.PinMessage                `json:"pinMessages,omitempty"`
 		EmojiReactions          []*EmojiReaction                    `json:"emojiReactions,omitempty"`
+		PollResults             []*PollResults                      `json:"pollResults,omitempty"`
+		TypingIndicators        []*TypingIndicator                  `json:"typingIndicators,omitempty"`
+		ReadReceipts            []*ReadReceipt                      `json:"readReceipts,omitempty"`
+		ScheduledMessages       []*ScheduledMessage                 `json:"scheduledMessages,omitempty"`
+		CommunityCalendarEvents []*communities.CalendarEvent        `json:"communityCalendarEvents,omitempty"`
 		Invitations             []*GroupChatInvitation              `json:"invitations,omitempty"`
 		CommunityChanges        []*communities.CommunityChanges     `json:"communityChanges,omitempty"`
 		RequestsToJoinCommunity []*communities.RequestToJoin        `json:"requestsToJoinCommunity,omitempty"`
@@ -151,6 +161,11 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		ActivityCenterState:           r.ActivityCenterState(),
 		PinMessages:                   r.PinMessages(),
 		EmojiReactions:                r.EmojiReactions(),
+		PollResults:                   r.PollResults(),
+		TypingIndicators:              r.TypingIndicators(),
+		ReadReceipts:                  r.ReadReceipts(),
+		ScheduledMessages:             r.ScheduledMessages(),
+		CommunityCalendarEvents:       r.CommunityCalendarEvents(),
 		StatusUpdates:                 r.StatusUpdates(),
 		DiscordCategories:             r.DiscordCategories,
 		DiscordChannels:               r.DiscordChannels,
@@ -267,6 +282,11 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.Installations)+
 		len(r.Invitations)+
 		len(r.emojiReactions)+
+		len(r.pollResults)+
+		len(r.typingIndicators)+
+		len(r.readReceipts)+
+		len(r.scheduledMessages)+
+		len(r.communityCalendarEvents)+
 		len(r.communities)+
 		len(r.CommunityChanges)+
 		len(r.removedChats)+
@@ -313,6 +333,11 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.AddActivityCenterNotifications(response.ActivityCenterNotifications())
 	r.SetActivityCenterState(response.ActivityCenterState())
 	r.AddEmojiReactions(response.EmojiReactions())
+	r.AddPollResults(response.PollResults())
+	r.AddTypingIndicators(response.TypingIndicators())
+	r.AddReadReceipts(response.ReadReceipts())
+	r.AddScheduledMessages(response.ScheduledMessages())
+	r.AddCommunityCalendarEvents(response.CommunityCalendarEvents())
 	r.AddInstallations(response.Installations)
 	r.AddSavedAddresses(response.SavedAddresses())
 	r.AddEnsUsernameDetails(response.EnsUsernameDetails())
@@ -445,6 +470,112 @@ func (r *MessengerResponse) EmojiReactions() []*EmojiReaction {
 	return ers
 }
 
+func (r *MessengerResponse) AddPollResults(prs []*PollResults) {
+	for _, pr := range prs {
+		r.AddPollResult(pr)
+	}
+}
+
+func (r *MessengerResponse) AddPollResult(pr *PollResults) {
+	if r.pollResults == nil {
+		r.pollResults = make(map[string]*PollResults)
+	}
+
+	r.pollResults[pr.MessageID] = pr
+}
+
+func (r *MessengerResponse) PollResults() []*PollResults {
+	var prs []*PollResults
+	for _, pr := range r.pollResults {
+		prs = append(prs, pr)
+	}
+	return prs
+}
+
+func (r *MessengerResponse) AddTypingIndicators(tis []*TypingIndicator) {
+	for _, ti := range tis {
+		r.AddTypingIndicator(ti)
+	}
+}
+
+// AddTypingIndicator keeps only the latest typing indicator of each user in a chat
+func (r *MessengerResponse) AddTypingIndicator(ti *TypingIndicator) {
+	if r.typingIndicators == nil {
+		r.typingIndicators = make(map[string]*TypingIndicator)
+	}
+
+	key := ti.LocalChatID + ti.From
+	if existing, ok := r.typingIndicators[key]; ok && existing.Clock > ti.Clock {
+		return
+	}
+
+	r.typingIndicators[key] = ti
+}
+
+func (r *MessengerResponse) TypingIndicators() []*TypingIndicator {
+	var tis []*TypingIndicator
+	for _, ti := range r.typingIndicators {
+		tis = append(tis, ti)
+	}
+	return tis
+}
+
+func (r *MessengerResponse) AddReadReceipts(rrs []*ReadReceipt) {
+	r.readReceipts = append(r.readReceipts, rrs...)
+}
+
+func (r *MessengerResponse) AddReadReceipt(rr *ReadReceipt) {
+	r.readReceipts = append(r.readReceipts, rr)
+}
+
+func (r *MessengerResponse) ReadReceipts() []*ReadReceipt {
+	return r.readReceipts
+}
+
+func (r *MessengerResponse) AddScheduledMessages(sms []*ScheduledMessage) {
+	for _, sm := range sms {
+		r.AddScheduledMessage(sm)
+	}
+}
+
+func (r *MessengerResponse) AddScheduledMessage(sm *ScheduledMessage) {
+	if r.scheduledMessages == nil {
+		r.scheduledMessages = make(map[string]*ScheduledMessage)
+	}
+
+	r.scheduledMessages[sm.ID] = sm
+}
+
+func (r *MessengerResponse) ScheduledMessages() []*ScheduledMessage {
+	var sms []*ScheduledMessage
+	for _, sm := range r.scheduledMessages {
+		sms = append(sms, sm)
+	}
+	return sms
+}
+
+func (r *MessengerResponse) AddCommunityCalendarEvents(events []*communities.CalendarEvent) {
+	for _, event := range events {
+		r.AddCommunityCalendarEvent(event)
+	}
+}
+
+func (r *MessengerResponse) AddCommunityCalendarEvent(event *communities.CalendarEvent) {
+	if r.communityCalendarEvents == nil {
+		r.communityCalendarEvents = make(map[string]*communities.CalendarEvent)
+	}
+
+	r.communityCalendarEvents[event.ID] = event
+}
+
+func (r *MessengerResponse) CommunityCalendarEvents() []*communities.CalendarEvent {
+	var events []*communities.CalendarEvent
+	for _, event := range r.communityCalendarEvents {
+		events = append(events, event)
+	}
+	return events
+}
+
 func (r *MessengerResponse) AddSavedAddresses(ers []*wallet.SavedAddress) {
 	for _, e := range ers {
 		r.AddSavedAddress(e)
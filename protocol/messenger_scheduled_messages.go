@@ -0,0 +1,285 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/signal"
+)
+
+var ErrScheduledMessageNotPending = errors.New("scheduled message is not pending")
+
+// Failing to send a scheduled message is retried with an exponential backoff,
+// the message is marked as failed after scheduledMessageMaxSendCount attempts
+const scheduledMessageResendMinDelay = 30
+const scheduledMessageMaxSendCount = 5
+
+// ScheduleMessage adds a text message to the outbox, to be sent to the chat at the scheduled time
+func (m *Messenger) ScheduleMessage(ctx context.Context, request *requests.ScheduleMessage) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	message := &ScheduledMessage{
+		ID:             uuid.New().String(),
+		ChatID:         chat.ID,
+		Text:           request.Text,
+		ResponseTo:     request.ResponseTo,
+		ScheduledAt:    request.ScheduledAt,
+		InstallationID: m.installationID,
+		State:          protobuf.SyncScheduledMessage_PENDING,
+		Clock:          m.getTimesource().GetCurrentTime(),
+	}
+
+	return m.saveAndSyncScheduledMessage(ctx, message)
+}
+
+// ScheduledMessages returns the messages waiting to be sent in a chat, or in all chats if chatID is empty
+func (m *Messenger) ScheduledMessages(chatID string) ([]*ScheduledMessage, error) {
+	return m.persistence.PendingScheduledMessages(chatID)
+}
+
+// EditScheduledMessage changes the text and time of a pending scheduled message,
+// this installation becomes responsible for sending it
+func (m *Messenger) EditScheduledMessage(ctx context.Context, request *requests.EditScheduledMessage) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	message, err := m.pendingScheduledMessage(request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Text = request.Text
+	message.ScheduledAt = request.ScheduledAt
+	message.InstallationID = m.installationID
+	message.Clock = m.nextScheduledMessageClock(message)
+
+	return m.saveAndSyncScheduledMessage(ctx, message)
+}
+
+// CancelScheduledMessage removes a pending message from the outbox
+func (m *Messenger) CancelScheduledMessage(ctx context.Context, id string) (*MessengerResponse, error) {
+	message, err := m.pendingScheduledMessage(id)
+	if err != nil {
+		return nil, err
+	}
+
+	message.State = protobuf.SyncScheduledMessage_CANCELLED
+	message.Clock = m.nextScheduledMessageClock(message)
+
+	return m.saveAndSyncScheduledMessage(ctx, message)
+}
+
+func (m *Messenger) pendingScheduledMessage(id string) (*ScheduledMessage, error) {
+	message, err := m.persistence.ScheduledMessage(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !message.Pending() {
+		return nil, ErrScheduledMessageNotPending
+	}
+
+	return message, nil
+}
+
+func (m *Messenger) nextScheduledMessageClock(message *ScheduledMessage) uint64 {
+	clock := m.getTimesource().GetCurrentTime()
+	if clock <= message.Clock {
+		clock = message.Clock + 1
+	}
+	return clock
+}
+
+func (m *Messenger) saveAndSyncScheduledMessage(ctx context.Context, message *ScheduledMessage) (*MessengerResponse, error) {
+	_, err := m.persistence.SaveScheduledMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncScheduledMessage(ctx, message, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddScheduledMessage(message)
+
+	return response, nil
+}
+
+func (m *Messenger) watchScheduledMessages() {
+	m.logger.Debug("watching scheduled messages")
+	go func() {
+		for {
+			select {
+			case <-time.After(time.Second):
+				// Messages due while offline are sent once back online
+				if !m.online() {
+					continue
+				}
+
+				response, err := m.sendDueScheduledMessages()
+				if err != nil {
+					m.logger.Error("failed to send scheduled messages", zap.Error(err))
+					continue
+				}
+
+				if !response.IsEmpty() {
+					signal.SendNewMessages(response)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// sendDueScheduledMessages sends the messages this installation is responsible for
+// and whose time has come, including those missed while the app was not running
+func (m *Messenger) sendDueScheduledMessages() (*MessengerResponse, error) {
+	response := &MessengerResponse{}
+
+	now := m.getCurrentTimeInMillis()
+	due, err := m.persistence.DueScheduledMessages(m.installationID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scheduled := range due {
+		if !shouldSendScheduledMessage(scheduled, now) {
+			continue
+		}
+
+		sendResponse, err := m.sendScheduledMessage(scheduled)
+		if err != nil {
+			m.logger.Error("failed to send scheduled message", zap.String("id", scheduled.ID), zap.Uint64("sendCount", scheduled.sendCount+1), zap.Error(err))
+			scheduled.sendCount++
+			scheduled.lastSent = now
+
+			if scheduled.sendCount < scheduledMessageMaxSendCount {
+				err = m.persistence.UpdateScheduledMessageSendCount(scheduled)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			scheduled.State = protobuf.SyncScheduledMessage_FAILED
+		} else {
+			scheduled.State = protobuf.SyncScheduledMessage_SENT
+			scheduled.MessageID = sendResponse.Messages()[0].ID
+
+			if err = response.Merge(sendResponse); err != nil {
+				return nil, err
+			}
+		}
+
+		scheduled.Clock = m.nextScheduledMessageClock(scheduled)
+		_, err = m.persistence.SaveScheduledMessage(scheduled)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.syncScheduledMessage(context.Background(), scheduled, m.dispatchMessage)
+		if err != nil {
+			return nil, err
+		}
+
+		response.AddScheduledMessage(scheduled)
+	}
+
+	return response, nil
+}
+
+func shouldSendScheduledMessage(message *ScheduledMessage, now uint64) bool {
+	if message.sendCount == 0 {
+		return true
+	}
+
+	//exponential backoff depends on how many attempts to send message already made
+	backoff := uint64(math.Pow(2, float64(message.sendCount-1))) * scheduledMessageResendMinDelay * uint64(time.Second.Milliseconds())
+	return now > message.lastSent+backoff
+}
+
+func (m *Messenger) sendScheduledMessage(scheduled *ScheduledMessage) (*MessengerResponse, error) {
+	if _, ok := m.allChats.Load(scheduled.ChatID); !ok {
+		return nil, ErrChatNotFound
+	}
+
+	message := &common.Message{
+		ChatMessage: protobuf.ChatMessage{
+			ChatId:      scheduled.ChatID,
+			Text:        scheduled.Text,
+			ResponseTo:  scheduled.ResponseTo,
+			ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+		},
+		LocalChatID: scheduled.ChatID,
+	}
+
+	return m.SendChatMessage(context.Background(), message)
+}
+
+func (m *Messenger) syncScheduledMessage(ctx context.Context, message *ScheduledMessage, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	encodedMessage, err := proto.Marshal(message.toSyncProtobuf())
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_SCHEDULED_MESSAGE,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+func (m *Messenger) handleSyncScheduledMessage(state *ReceivedMessageState, message protobuf.SyncScheduledMessage) error {
+	if len(message.Id) == 0 || len(message.ChatId) == 0 || len(message.InstallationId) == 0 {
+		return errors.New("invalid sync scheduled message")
+	}
+
+	scheduled := scheduledMessageFromSyncProtobuf(&message)
+	saved, err := m.persistence.SaveScheduledMessage(scheduled)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		state.Response.AddScheduledMessage(scheduled)
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleSyncScheduledMessage(state *ReceivedMessageState, message protobuf.SyncScheduledMessage) error {
+	return m.handleSyncScheduledMessage(state, message)
+}
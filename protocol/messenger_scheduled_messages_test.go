@@ -0,0 +1,209 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestMessengerScheduledMessagesSuite(t *testing.T) {
+	suite.Run(t, new(MessengerScheduledMessagesSuite))
+}
+
+type MessengerScheduledMessagesSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerScheduledMessagesSuite) TestScheduleEditAndCancel() {
+	chat := CreatePublicChat("announcements", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	scheduledAt := s.m.getCurrentTimeInMillis() + 3600000
+
+	response, err := s.m.ScheduleMessage(context.Background(), &requests.ScheduleMessage{
+		ChatID:      chat.ID,
+		Text:        "meetup at noon",
+		ScheduledAt: scheduledAt,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.ScheduledMessages(), 1)
+
+	scheduled := response.ScheduledMessages()[0]
+	s.Require().Equal(protobuf.SyncScheduledMessage_PENDING, scheduled.State)
+	s.Require().Equal(s.m.installationID, scheduled.InstallationID)
+
+	messages, err := s.m.ScheduledMessages(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal("meetup at noon", messages[0].Text)
+
+	// Not due yet
+	response, err = s.m.sendDueScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().True(response.IsEmpty())
+
+	_, err = s.m.EditScheduledMessage(context.Background(), &requests.EditScheduledMessage{
+		ID:          scheduled.ID,
+		Text:        "meetup at one",
+		ScheduledAt: scheduledAt + 3600000,
+	})
+	s.Require().NoError(err)
+
+	messages, err = s.m.ScheduledMessages("")
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal("meetup at one", messages[0].Text)
+	s.Require().Equal(scheduledAt+3600000, messages[0].ScheduledAt)
+
+	response, err = s.m.CancelScheduledMessage(context.Background(), scheduled.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.SyncScheduledMessage_CANCELLED, response.ScheduledMessages()[0].State)
+
+	messages, err = s.m.ScheduledMessages(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 0)
+
+	_, err = s.m.CancelScheduledMessage(context.Background(), scheduled.ID)
+	s.Require().Equal(ErrScheduledMessageNotPending, err)
+}
+
+func (s *MessengerScheduledMessagesSuite) TestSendDueScheduledMessages() {
+	chat := CreatePublicChat("announcements", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	now := s.m.getCurrentTimeInMillis()
+
+	// Messages missed while the app was not running are sent as soon as possible
+	response, err := s.m.ScheduleMessage(context.Background(), &requests.ScheduleMessage{
+		ChatID:      chat.ID,
+		Text:        "missed",
+		ScheduledAt: now - 1000,
+	})
+	s.Require().NoError(err)
+	scheduledID := response.ScheduledMessages()[0].ID
+
+	// Messages scheduled on other devices are sent by them
+	_, err = s.m.persistence.SaveScheduledMessage(&ScheduledMessage{
+		ID:             "other-device",
+		ChatID:         chat.ID,
+		Text:           "from another device",
+		ScheduledAt:    now - 1000,
+		InstallationID: "other-installation",
+		Clock:          now,
+	})
+	s.Require().NoError(err)
+
+	// The scheduler sends the due messages
+	var scheduled *ScheduledMessage
+	err = tt.RetryWithBackOff(func() error {
+		scheduled, err = s.m.persistence.ScheduledMessage(scheduledID)
+		if err != nil {
+			return err
+		}
+		if scheduled.Pending() {
+			return errors.New("scheduled message not sent")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.SyncScheduledMessage_SENT, scheduled.State)
+
+	message, err := s.m.MessageByID(scheduled.MessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(chat.ID, message.LocalChatID)
+	s.Require().Equal("missed", message.Text)
+
+	messages, err := s.m.ScheduledMessages(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal("other-device", messages[0].ID)
+
+	// Sent messages are not sent again
+	response, err = s.m.sendDueScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().True(response.IsEmpty())
+}
+
+func (s *MessengerScheduledMessagesSuite) TestRetryFailedScheduledMessages() {
+	now := s.m.getCurrentTimeInMillis()
+
+	// Messages to unknown chats can't be sent
+	_, err := s.m.persistence.SaveScheduledMessage(&ScheduledMessage{
+		ID:             "unknown-chat",
+		ChatID:         "unknown",
+		Text:           "lost",
+		ScheduledAt:    now - 1000,
+		InstallationID: s.m.installationID,
+		Clock:          now,
+	})
+	s.Require().NoError(err)
+
+	// A failed attempt leaves the message pending
+	_, err = s.m.sendDueScheduledMessages()
+	s.Require().NoError(err)
+
+	scheduled, err := s.m.persistence.ScheduledMessage("unknown-chat")
+	s.Require().NoError(err)
+	s.Require().True(scheduled.Pending())
+	s.Require().Equal(uint64(1), scheduled.sendCount)
+
+	// and it's not retried before the backoff elapsed
+	response, err := s.m.sendDueScheduledMessages()
+	s.Require().NoError(err)
+	s.Require().True(response.IsEmpty())
+
+	scheduled, err = s.m.persistence.ScheduledMessage("unknown-chat")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), scheduled.sendCount)
+
+	// The message fails after the last attempt
+	scheduled.sendCount = scheduledMessageMaxSendCount - 1
+	scheduled.lastSent = 0
+	err = s.m.persistence.UpdateScheduledMessageSendCount(scheduled)
+	s.Require().NoError(err)
+
+	_, err = s.m.sendDueScheduledMessages()
+	s.Require().NoError(err)
+
+	scheduled, err = s.m.persistence.ScheduledMessage("unknown-chat")
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.SyncScheduledMessage_FAILED, scheduled.State)
+}
+
+func (s *MessengerScheduledMessagesSuite) TestHandleSyncScheduledMessage() {
+	state := &ReceivedMessageState{Response: &MessengerResponse{}}
+
+	message := protobuf.SyncScheduledMessage{
+		Clock:          2,
+		Id:             "scheduled-id",
+		ChatId:         "announcements",
+		Text:           "edited",
+		ScheduledAt:    1000,
+		InstallationId: "other-installation",
+	}
+
+	err := s.m.HandleSyncScheduledMessage(state, message)
+	s.Require().NoError(err)
+	s.Require().Len(state.Response.ScheduledMessages(), 1)
+
+	// Older versions are ignored
+	state = &ReceivedMessageState{Response: &MessengerResponse{}}
+	message.Clock = 1
+	message.Text = "original"
+
+	err = s.m.HandleSyncScheduledMessage(state, message)
+	s.Require().NoError(err)
+	s.Require().Len(state.Response.ScheduledMessages(), 0)
+
+	scheduled, err := s.m.persistence.ScheduledMessage("scheduled-id")
+	s.Require().NoError(err)
+	s.Require().Equal("edited", scheduled.Text)
+}
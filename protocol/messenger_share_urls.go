@@ -38,10 +38,18 @@ type ContactURLData struct {
 	Description string `json:"description"`
 }
 
+type CommunityInviteURLData struct {
+	CommunityID types.HexBytes                 `json:"communityId"`
+	ExpiresAt   uint64                         `json:"expiresAt"`
+	Role        protobuf.CommunityMember_Roles `json:"role"`
+	AutoAccept  bool                           `json:"autoAccept"`
+}
+
 type URLDataResponse struct {
 	Community CommunityURLData        `json:"community"`
 	Channel   CommunityChannelURLData `json:"channel"`
 	Contact   ContactURLData          `json:"contact"`
+	Invite    *CommunityInviteURLData `json:"invite,omitempty"`
 }
 
 const baseShareURL = "https://status.app"
@@ -494,6 +502,10 @@ func (m *Messenger) ParseSharedURL(url string) (*URLDataResponse, error) {
 		return m.parseCommunityURLWithData(strings.TrimPrefix(urlContents[0], "c/"), urlContents[1])
 	}
 
+	if strings.HasPrefix(urlContents[0], "ci/") {
+		return m.parseCommunityInviteURL(strings.TrimPrefix(urlContents[0], "ci/"), urlContents[1])
+	}
+
 	if strings.HasPrefix(urlContents[0], "cc/") {
 		first := strings.TrimPrefix(urlContents[0], "cc/")
 
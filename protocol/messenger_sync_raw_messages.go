@@ -104,6 +104,50 @@ func (m *Messenger) HandleSyncRawMessages(rawMessages []*protobuf.RawMessage) er
 				m.logger.Error("failed to handleSyncClearHistory when HandleSyncRawMessages", zap.Error(err))
 				continue
 			}
+		case protobuf.ApplicationMetadataMessage_DISAPPEARING_MESSAGES:
+			var message protobuf.DisappearingMessages
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.handleDisappearingMessages(m.myHexIdentity(), &m.identity.PublicKey, m.getTimesource().GetCurrentTime(), state.Response, message)
+			if err != nil {
+				m.logger.Error("failed to handleDisappearingMessages when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
+		case protobuf.ApplicationMetadataMessage_SYNC_SCHEDULED_MESSAGE:
+			var message protobuf.SyncScheduledMessage
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.handleSyncScheduledMessage(state, message)
+			if err != nil {
+				m.logger.Error("failed to handleSyncScheduledMessage when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
+		case protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_CALENDAR_EVENT:
+			var message protobuf.SyncCommunityCalendarEvent
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.handleSyncCommunityCalendarEvent(state, message)
+			if err != nil {
+				m.logger.Error("failed to handleSyncCommunityCalendarEvent when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
+		case protobuf.ApplicationMetadataMessage_SYNC_MESSAGE_THREAD:
+			var message protobuf.SyncMessageThread
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.handleSyncMessageThread(state, message)
+			if err != nil {
+				m.logger.Error("failed to handleSyncMessageThread when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
 		case protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT:
 			var message protobuf.SyncInstallationContactV2
 			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
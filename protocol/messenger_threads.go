@@ -0,0 +1,256 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrThreadNotFound = errors.New("thread not found")
+
+// ThreadReplies returns the replies of the thread started by the message threadID, latest first
+func (m *Messenger) ThreadReplies(threadID, cursor string, limit int) ([]*common.Message, string, error) {
+	msgs, nextCursor, err := m.persistence.ThreadReplies(threadID, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if m.httpServer != nil {
+		for idx := range msgs {
+			m.prepareMessage(msgs[idx], m.httpServer)
+		}
+	}
+
+	return msgs, nextCursor, nil
+}
+
+// MarkThreadRead marks all the replies of a thread as seen
+func (m *Messenger) MarkThreadRead(ctx context.Context, threadID string) (*MessengerResponse, error) {
+	root, err := m.threadRoot(threadID)
+	if err != nil {
+		return nil, err
+	}
+
+	var readClock uint64
+	if root.Thread != nil {
+		readClock = root.Thread.LastReplyClock
+	}
+
+	response := &MessengerResponse{}
+	err = m.markThreadRead(root.LocalChatID, threadID, readClock, response)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := m.messageThread(root)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncMessageThread(ctx, thread, readClock, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// MuteThread stops notifications for the replies of a thread, except for those mentioning us
+func (m *Messenger) MuteThread(ctx context.Context, threadID string) (*MessengerResponse, error) {
+	return m.setThreadMuted(ctx, threadID, true)
+}
+
+func (m *Messenger) UnmuteThread(ctx context.Context, threadID string) (*MessengerResponse, error) {
+	return m.setThreadMuted(ctx, threadID, false)
+}
+
+func (m *Messenger) setThreadMuted(ctx context.Context, threadID string, muted bool) (*MessengerResponse, error) {
+	root, err := m.threadRoot(threadID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(root.LocalChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	thread := &MessageThread{
+		ThreadID:    threadID,
+		LocalChatID: chat.ID,
+		Muted:       muted,
+		Clock:       clock,
+	}
+
+	_, err = m.persistence.SaveMessageThread(thread)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncMessageThread(ctx, thread, 0, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	err = m.addThreadRootToResponse(response, threadID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) threadRoot(threadID string) (*common.Message, error) {
+	root, err := m.persistence.MessageByID(threadID)
+	if err == common.ErrRecordNotFound {
+		return nil, ErrThreadNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if root.ThreadID != "" {
+		return nil, ErrThreadNotFound
+	}
+
+	return root, nil
+}
+
+// messageThread returns the stored state of the thread started by root
+func (m *Messenger) messageThread(root *common.Message) (*MessageThread, error) {
+	thread, err := m.persistence.MessageThread(root.ID)
+	if err == common.ErrRecordNotFound {
+		return &MessageThread{ThreadID: root.ID, LocalChatID: root.LocalChatID}, nil
+	}
+	return thread, err
+}
+
+func (m *Messenger) markThreadRead(chatID, threadID string, clock uint64, response *MessengerResponse) error {
+	count, _, err := m.persistence.MarkThreadRead(chatID, threadID, clock)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		chat, err := m.persistence.Chat(chatID)
+		if err != nil {
+			return err
+		}
+
+		if chat != nil {
+			if c, ok := m.allChats.Load(chatID); ok {
+				c.UnviewedMessagesCount = chat.UnviewedMessagesCount
+				c.UnviewedMentionsCount = chat.UnviewedMentionsCount
+				chat = c
+			}
+			response.AddChat(chat)
+		}
+	}
+
+	return m.addThreadRootToResponse(response, threadID)
+}
+
+// addThreadRootToResponse adds the first message of a thread to the response,
+// so that its replies count and metadata are up to date
+func (m *Messenger) addThreadRootToResponse(response *MessengerResponse, threadID string) error {
+	if threadID == "" {
+		return nil
+	}
+
+	root, err := m.persistence.MessageByID(threadID)
+	if err == common.ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	response.AddMessage(root)
+	m.prepareMessages(response.messages)
+	return nil
+}
+
+// isThreadMuted returns whether the message is a reply in a muted thread
+func (m *Messenger) isThreadMuted(message *common.Message) (bool, error) {
+	if message.ThreadID == "" {
+		return false, nil
+	}
+
+	thread, err := m.persistence.MessageThread(message.ThreadID)
+	if err == common.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return thread.Muted, nil
+}
+
+func (m *Messenger) syncMessageThread(ctx context.Context, thread *MessageThread, readClock uint64, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, selfChat := m.getLastClockWithRelatedChat()
+
+	syncMessage := &protobuf.SyncMessageThread{
+		Clock:     thread.Clock,
+		ChatId:    thread.LocalChatID,
+		ThreadId:  thread.ThreadID,
+		Muted:     thread.Muted,
+		ReadClock: readClock,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         selfChat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_MESSAGE_THREAD,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	selfChat.LastClockValue = clock
+	return m.saveChat(selfChat)
+}
+
+func (m *Messenger) handleSyncMessageThread(state *ReceivedMessageState, message protobuf.SyncMessageThread) error {
+	if len(message.ThreadId) == 0 || len(message.ChatId) == 0 {
+		return errors.New("invalid sync message thread")
+	}
+
+	if message.Clock > 0 {
+		_, err := m.persistence.SaveMessageThread(&MessageThread{
+			ThreadID:    message.ThreadId,
+			LocalChatID: message.ChatId,
+			Muted:       message.Muted,
+			Clock:       message.Clock,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if message.ReadClock > 0 {
+		return m.markThreadRead(message.ChatId, message.ThreadId, message.ReadClock, state.Response)
+	}
+
+	return m.addThreadRootToResponse(state.Response, message.ThreadId)
+}
+
+func (m *Messenger) HandleSyncMessageThread(state *ReceivedMessageState, message protobuf.SyncMessageThread) error {
+	return m.handleSyncMessageThread(state, message)
+}
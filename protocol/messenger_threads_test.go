@@ -0,0 +1,117 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMessengerThreadsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerThreadsSuite))
+}
+
+type MessengerThreadsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerThreadsSuite) TestThreadReplies() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), buildTestMessage(*theirChat))
+	s.Require().NoError(err)
+	rootID := sendResponse.Messages()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no message received",
+	)
+	s.Require().NoError(err)
+
+	reply := buildTestMessage(*theirChat)
+	reply.ResponseTo = rootID
+	sendResponse, err = theirMessenger.SendChatMessage(context.Background(), reply)
+	s.Require().NoError(err)
+	replyID := s.replyID(sendResponse, rootID)
+
+	// The first message of the thread is sent along with the reply
+	// so that its replies count is up to date
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return r.GetMessage(replyID) != nil },
+		"no reply received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(rootID, response.GetMessage(replyID).ThreadID)
+
+	root := response.GetMessage(rootID)
+	s.Require().NotNil(root)
+	s.Require().NotNil(root.Thread)
+	s.Require().Equal(uint64(1), root.Thread.RepliesCount)
+	s.Require().Equal(uint64(1), root.Thread.UnviewedRepliesCount)
+	s.Require().Equal(replyID, root.Thread.LastReplyID)
+
+	replies, _, err := s.m.ThreadReplies(rootID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(replies, 1)
+	s.Require().Equal(replyID, replies[0].ID)
+
+	response, err = s.m.MarkThreadRead(context.Background(), rootID)
+	s.Require().NoError(err)
+	root = response.GetMessage(rootID)
+	s.Require().NotNil(root)
+	s.Require().Equal(uint64(0), root.Thread.UnviewedRepliesCount)
+
+	response, err = s.m.MuteThread(context.Background(), rootID)
+	s.Require().NoError(err)
+	s.Require().True(response.GetMessage(rootID).Thread.Muted)
+
+	_, err = s.m.MuteThread(context.Background(), replyID)
+	s.Require().Equal(ErrThreadNotFound, err)
+
+	// Replies in muted threads don't notify
+	reply = buildTestMessage(*theirChat)
+	reply.ResponseTo = replyID
+	sendResponse, err = theirMessenger.SendChatMessage(context.Background(), reply)
+	s.Require().NoError(err)
+	replyID = s.replyID(sendResponse, replyID)
+
+	response, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return r.GetMessage(replyID) != nil },
+		"no reply received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(rootID, response.GetMessage(replyID).ThreadID)
+	s.Require().Len(response.ActivityCenterNotifications(), 0)
+	s.Require().Len(response.Notifications(), 0)
+	s.Require().Equal(uint64(2), response.GetMessage(rootID).Thread.RepliesCount)
+
+	response, err = s.m.UnmuteThread(context.Background(), rootID)
+	s.Require().NoError(err)
+	s.Require().False(response.GetMessage(rootID).Thread.Muted)
+}
+
+// replyID returns the id of the reply to responseTo in response,
+// which carries the first message of the thread as well
+func (s *MessengerThreadsSuite) replyID(response *MessengerResponse, responseTo string) string {
+	for _, message := range response.Messages() {
+		if message.ResponseTo == responseTo {
+			return message.ID
+		}
+	}
+	s.Require().FailNow("no reply in response")
+	return ""
+}
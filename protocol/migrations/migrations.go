@@ -99,6 +99,17 @@
 // 1687959987_modify_community_tokens_supply_as_string.up.sql (77B)
 // 1689258900_add_airdrop_address_to_revealed_addresses.up.sql (99B)
 // 1689266326_create_communities_events_table.up.sql (164B)
+// 1689700000_add_disappearing_messages.up.sql (413B)
+// 1689800000_add_polls.up.sql (321B)
+// 1689900000_add_message_threads.up.sql (1.158kB)
+// 1690000000_add_scheduled_messages.up.sql (462B)
+// 1690100000_add_activity_center_moderation_violation.up.sql (100B)
+// 1690110000_add_communities_audit_log.up.sql (725B)
+// 1690120000_add_communities_invite_links.up.sql (1.077kB)
+// 1690130000_add_communities_calendar_events.up.sql (961B)
+// 1690150000_add_message_thread_summaries.up.sql (4.245kB)
+// 1690160000_add_scheduled_messages_send_count.up.sql (153B)
+// 1690170000_add_user_messages_read_receipts.up.sql (161B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -2149,6 +2160,226 @@ func _1689266326_create_communities_events_tableUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1689700000_add_disappearing_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x8e\x3d\x0b\xc2\x30\x14\x45\xf7\xfe\x8a\x3b\x2a\x38\xb8\x3b\x3d\xdb\xa8\xc1\x98\x4a\x48\x45\xa7\x10\xda\xa0\xc1\xaf\xd2\x54\xf0\xe7\x1b\x45\xa9\x0e\x1d\x1c\xde\xf4\xee\x3d\xf7\x90\xd0\x4c\x41\xd3\x54\x30\x94\x07\xdb\x06\x50\x96\x21\xcd\x45\xb1\x92\xa8\x7c\xb0\x75\xed\x6c\xe3\x2f\x7b\x73\x76\x21\xd8\xbd\x0b\xa6\xf5\x67\xd7\x80\x4b\x0d\x99\xc7\x2b\x84\x40\xc6\x66\x54\x08\x8d\xf1\x24\xa1\xff\x81\xe5\xe9\x5a\x1e\x7b\x81\x49\xaa\x18\x69\xf6\x46\xde\x82\x6b\xba\xa6\xbb\xd7\xbe\xb1\xad\xbf\x5e\x02\x06\x09\xf0\x7e\x18\x5f\x61\x43\x2a\x5d\x90\xc2\x5a\xf1\x15\xa9\x1d\x96\x6c\x87\x5c\x46\x11\x39\x13\x3c\xd5\xe0\x73\x99\x2b\x36\x8a\xa5\xb8\x6e\x4f\xe6\xe9\xfa\xdd\xfb\xa8\x3c\x13\xaf\x99\x38\x67\xdb\x1f\xcb\x64\xd8\xd9\x71\x99\xb1\x6d\xbf\x9d\xf9\x42\x44\x8b\xde\xdc\xa0\xcb\x45\xf8\x03\xea\xe5\xaa\x59\x9d\x01\x00\x00")
+
+func _1689700000_add_disappearing_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689700000_add_disappearing_messagesUpSql,
+		"1689700000_add_disappearing_messages.up.sql",
+	)
+}
+
+func _1689700000_add_disappearing_messagesUpSql() (*asset, error) {
+	bytes, err := _1689700000_add_disappearing_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689700000_add_disappearing_messages.up.sql", size: 413, mode: os.FileMode(0644), modTime: time.Unix(1792320315, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc, 0x21, 0x9c, 0x66, 0x36, 0x13, 0xe2, 0xde, 0xde, 0x39, 0x21, 0x56, 0xaa, 0xa4, 0x5f, 0x0, 0x4a, 0x83, 0x8e, 0xea, 0x45, 0xd7, 0xfa, 0x0, 0x2f, 0xa6, 0x35, 0x81, 0xb2, 0x6a, 0xbb, 0x52}}
+	return a, nil
+}
+
+var __1689800000_add_pollsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x90\xc1\x8a\xc2\x30\x10\x86\xef\x79\x8a\xff\xa6\x82\x6f\xe0\x69\x9a\x46\x2c\x8e\xa9\x84\x74\xc1\x53\x09\xb5\x68\xd9\xb8\x29\x26\x0a\xbe\xbd\x71\xf5\xb2\xb8\x1e\x87\xef\xfb\x7f\x66\x86\xd8\x2a\x03\x4b\x05\x2b\x5c\x62\x7f\x6e\x4f\x7d\x8c\xee\xd0\x47\x50\x59\x42\xd6\xdc\x6c\x34\xc6\xe0\x7d\x3b\xba\x9b\x0f\x6e\x8f\x82\xeb\x62\x21\x84\x34\x8a\xac\x7a\x25\x7f\x85\x6b\x48\x39\x36\x15\x78\x8e\xc3\x1e\x5f\x64\xe4\x8a\x0c\x74\x6d\xa1\x1b\xe6\x79\x66\x0f\xeb\xfc\x2f\xf1\xa1\x73\xbe\xed\x8e\x2e\x7d\xca\x76\x59\xf9\x6e\xaf\xce\x5f\x7a\x54\xda\xfe\x61\x69\xc8\x9b\x27\x77\x1a\xdf\x48\x18\xd3\x10\x7e\x72\x67\x7c\x2b\x45\xa9\x96\xd4\xb0\xc5\x64\xf2\x30\xb7\xa6\xda\x90\xd9\x61\xad\x76\x98\xbe\x8e\x98\x3f\x37\x9e\xa1\xd6\xf9\x1d\x7a\xc9\x95\xb4\x30\x6a\xcb\x24\x95\x98\x2d\xc4\x1d\x7c\x52\xd2\x9e\x41\x01\x00\x00")
+
+func _1689800000_add_pollsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689800000_add_pollsUpSql,
+		"1689800000_add_polls.up.sql",
+	)
+}
+
+func _1689800000_add_pollsUpSql() (*asset, error) {
+	bytes, err := _1689800000_add_pollsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689800000_add_polls.up.sql", size: 321, mode: os.FileMode(0644), modTime: time.Unix(1792320944, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x39, 0x41, 0xe6, 0x73, 0x1, 0xc9, 0xa3, 0x92, 0x95, 0x85, 0xe4, 0x1c, 0xec, 0xdb, 0x86, 0x7b, 0x3d, 0x98, 0xba, 0x26, 0x2, 0x89, 0x55, 0x8, 0x7, 0x6, 0xec, 0x35, 0x98, 0x2e, 0x8f, 0xfa}}
+	return a, nil
+}
+
+var __1689900000_add_message_threadsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x94\x41\x6f\x82\x40\x10\x85\xef\xfc\x8a\xe9\x49\x4d\xd0\xf4\xde\x78\x58\x61\x88\xa6\x2b\x98\x05\xda\x7a\x22\x54\x16\x25\x5d\x5d\xe2\xa2\xd6\x7f\xdf\x5d\x4a\x55\xd4\x43\x6f\xbd\xf4\x06\xec\x7b\xdf\x9b\x9d\xe4\x41\x68\x84\x0c\x22\x32\xa2\x08\x3b\xc5\xb7\xc9\x9a\x2b\x95\x2e\xb9\x02\xe2\xba\xe0\x04\x34\x9e\xfa\x50\xad\xb6\x3c\xcd\x92\x22\x83\x17\xc2\x9c\x31\x61\xe0\x07\x11\xf8\x31\xa5\xe0\xa2\x47\x62\x1a\x41\xa7\xf3\x64\x59\x0e\x43\x12\x21\x4c\x7c\x17\xdf\xda\xb4\xe4\x84\x48\x16\x42\x2e\x3e\x92\x7d\x2a\x76\x1c\x02\xbf\x2d\xeb\x9e\x64\x36\x5c\xe8\x7a\x9a\xdd\xef\x03\xe3\xa5\x28\xf4\x64\xef\x5c\xc8\xcd\x12\x2a\xa9\x07\xe3\xcd\x70\x20\xf3\xfa\xad\x21\x99\xe7\x23\x6c\xb9\x2a\xe5\x26\xd3\x4a\xdb\xf8\x73\x29\x84\x3c\xd4\xb2\xad\x46\x1d\x61\xb1\x4a\x8b\x8d\x82\x14\x72\x7e\x00\xc1\xf7\x5c\x28\x43\xcd\x0b\xe3\x31\x2a\x29\x2b\x03\xe6\x9f\x85\xaa\x0a\x13\x59\x67\x29\x2b\x9e\xb9\xe6\xa2\xed\x85\x85\x18\x5d\x6c\x6a\xd8\xa4\x2b\x9e\x68\xe4\xeb\x18\x19\xea\x75\x12\x8a\xa1\x83\xdd\x8b\x23\x5b\xaf\xae\x07\x0f\xc3\x7a\x83\xbf\xe2\x76\x43\xa4\xe8\x44\x50\x0e\xce\x5f\x3d\x16\x4c\xaf\x6c\x65\x13\x5a\x0e\x6a\x57\xeb\xf0\xec\xec\x35\xaa\x33\x6a\xe2\x9f\x12\xee\x83\xaf\x0d\xf5\xec\xbd\xff\xe1\xff\x6e\xf8\x9f\xe2\x7d\xd7\xb8\x91\x37\x95\x53\xd0\xb5\xe0\x4e\x83\x67\x6c\x32\x25\x6c\x0e\xcf\x38\x37\x35\x74\x02\xdf\xa3\x13\x9d\xcc\x70\x46\x89\x83\xb6\x36\xe9\x02\xa6\x22\xd1\x25\xa9\xee\x55\xdf\x28\xd6\xbb\x8a\x67\x30\x0a\x02\x8a\xc4\xbf\xfd\x29\x78\x84\x86\x35\xa9\xee\xb2\xbe\x5f\x74\xab\x79\xb4\xf4\x05\xbe\x00\x7a\x78\x91\x49\x86\x04\x00\x00")
+
+func _1689900000_add_message_threadsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1689900000_add_message_threadsUpSql,
+		"1689900000_add_message_threads.up.sql",
+	)
+}
+
+func _1689900000_add_message_threadsUpSql() (*asset, error) {
+	bytes, err := _1689900000_add_message_threadsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1689900000_add_message_threads.up.sql", size: 1158, mode: os.FileMode(0644), modTime: time.Unix(1792321616, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb0, 0xcf, 0x4b, 0xeb, 0x9b, 0x6e, 0x18, 0x8d, 0xe6, 0x59, 0x52, 0xd5, 0xbc, 0xc1, 0xb8, 0xf7, 0x9b, 0x6e, 0xa1, 0xf3, 0x85, 0x89, 0x14, 0x81, 0x39, 0x2d, 0xf8, 0x18, 0xa6, 0xc4, 0x38, 0xfa}}
+	return a, nil
+}
+
+var __1690000000_add_scheduled_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x90\x31\x0f\x82\x30\x14\x84\x77\x7e\xc5\xdb\xd0\x84\xc1\xdd\xa9\x96\x1a\x89\xb5\x98\xa6\x18\x9d\x9a\x06\x1a\x21\x56\x30\xb6\x26\xfe\x7c\x0b\x1a\x15\x95\x38\xbf\xef\xee\xdd\x1d\xe6\x04\x09\x02\x02\xcd\x28\x01\x9b\x97\xba\xb8\x18\x5d\xc8\xa3\xb6\x56\xed\xb5\x85\x51\x00\x50\x15\xb0\x41\x1c\x2f\x10\x87\x35\x4f\x56\x88\xef\x60\x49\x76\x90\x32\xc0\x29\x9b\xd3\x04\x0b\xe0\x64\x4d\x11\x26\x91\xa7\x4d\x93\x2b\x23\xf3\x52\x39\xf9\x26\x64\xa9\x00\x96\x51\xda\x12\x4e\x5f\xdd\xd7\x01\x62\x32\x47\x19\x15\x10\x86\x2d\x73\xd6\xf6\xd4\xd4\x56\x4b\xd7\xfc\x43\x5f\xa9\x95\x83\x84\x89\xde\xaf\xaa\xb6\x4e\x19\xa3\x5c\xd5\xd4\x43\x79\x3c\xe1\x74\x4f\xf9\xfc\x30\x69\xef\x8f\x31\x7e\xc9\x3f\xa2\xe4\xbe\xfc\x61\xc0\x29\x18\x4f\x83\x00\xdf\xe7\x4e\x58\x4c\xb6\x3f\xe6\x96\x5d\x14\xd9\x6b\xe4\x67\xfe\x06\x47\x1d\x18\xf5\xba\x7b\xff\x1b\x5c\x0a\xd0\x62\xce\x01\x00\x00")
+
+func _1690000000_add_scheduled_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690000000_add_scheduled_messagesUpSql,
+		"1690000000_add_scheduled_messages.up.sql",
+	)
+}
+
+func _1690000000_add_scheduled_messagesUpSql() (*asset, error) {
+	bytes, err := _1690000000_add_scheduled_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690000000_add_scheduled_messages.up.sql", size: 462, mode: os.FileMode(0644), modTime: time.Unix(1792323091, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe7, 0x2c, 0x8, 0x21, 0xa, 0xc8, 0x39, 0x6b, 0x9c, 0x4b, 0xea, 0xdb, 0x94, 0x52, 0x27, 0x2d, 0x8a, 0xf6, 0x43, 0x6d, 0xc0, 0xa5, 0x64, 0x2c, 0xa7, 0x13, 0x1e, 0x76, 0x71, 0x8a, 0x67, 0xf7}}
+	return a, nil
+}
+
+var __1690100000_add_activity_center_moderation_violationUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\x4c\x2e\xc9\x2c\xcb\x2c\xa9\x8c\x4f\x4e\xcd\x2b\x49\x2d\x8a\xcf\xcb\x2f\xc9\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xcd\x4f\x49\x2d\x02\x0b\xc7\x97\x65\xe6\xe7\x80\x59\x0a\x21\xae\x11\x21\x0a\x7e\xfe\x40\x1c\xea\xe3\xa3\xe0\xe2\xea\xe6\x18\xea\x13\xa2\xa0\xae\x6e\xcd\x05\x00\x72\xac\xcc\x68\x64\x00\x00\x00")
+
+func _1690100000_add_activity_center_moderation_violationUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690100000_add_activity_center_moderation_violationUpSql,
+		"1690100000_add_activity_center_moderation_violation.up.sql",
+	)
+}
+
+func _1690100000_add_activity_center_moderation_violationUpSql() (*asset, error) {
+	bytes, err := _1690100000_add_activity_center_moderation_violationUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690100000_add_activity_center_moderation_violation.up.sql", size: 100, mode: os.FileMode(0644), modTime: time.Unix(1792333790, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x6c, 0x58, 0x3a, 0xf5, 0x19, 0x70, 0xf5, 0xb, 0xa3, 0xa3, 0xd, 0xec, 0x97, 0xda, 0x2d, 0x8c, 0xa1, 0xfb, 0x4a, 0xfb, 0xd9, 0xa1, 0x8a, 0xf8, 0x8e, 0xa4, 0xa4, 0x2c, 0x53, 0xf2, 0x51}}
+	return a, nil
+}
+
+var __1690110000_add_communities_audit_logUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x92\x4f\x6f\x82\x40\x10\xc5\xef\x7c\x8a\xe9\x49\x4d\x3c\xf4\x6e\x7a\x00\x1c\xdb\x4d\x71\x69\x71\x49\xf5\xb4\x59\x61\x82\xa4\xf2\xa7\xec\x62\xe2\xb7\x2f\xd8\x8a\x12\x39\xe8\x71\x66\x7e\x3b\x6f\x5e\xde\xba\x01\xda\x02\x41\xd8\x8e\x87\xc0\x16\xc0\x7d\x01\xb8\x66\x2b\xb1\x82\xa8\xc8\xb2\x3a\x4f\x4d\x4a\x5a\xaa\x3a\x4e\x8d\xdc\x17\x09\x8c\x2d\x00\x4d\x3f\xc0\xb8\xc0\x57\x0c\xe0\x23\x60\x4b\x3b\xd8\xc0\x3b\x6e\xc0\x0e\x85\xcf\xb8\x1b\xe0\x12\xb9\x98\x36\xe0\x79\xc5\x51\xa6\x31\x38\x9e\xef\x9c\xf6\xf3\xd0\xf3\xda\xa9\x4e\x93\x9c\x2a\x10\xb8\x16\xfd\x7e\x51\x57\x11\xdd\xf6\xe9\x40\xb9\x91\xe6\x58\x52\xab\xde\x8d\x60\x8e\x0b\x3b\xf4\x04\x3c\x5f\xa0\x46\xae\xf7\xbc\x63\x46\xa3\xd3\x5d\xfb\x22\xfa\xee\x2d\x69\xbb\x5b\xa5\x49\x0e\x8f\xa2\x9d\xca\x13\xd2\x27\x0f\x6d\x6d\xd2\x8c\xb4\x51\x59\x79\x43\x96\x15\x1d\xe4\x4e\xe9\x5d\xc7\x76\x45\xdf\xa4\x51\xa6\xd6\x03\xe6\xeb\x92\x2a\x4d\x31\xc5\x72\x7b\x1c\xb8\xa6\xef\xb6\xb9\x42\xab\x84\xe4\xad\x86\x35\x99\x59\x96\xfb\x97\x2e\xe3\x73\x5c\xdf\x93\xae\xbc\x0e\x4c\xb6\x31\xfb\x7c\x98\x1c\x5f\x93\xd3\xf6\x47\x34\x7a\xff\x72\x21\x67\x9f\xe1\x23\xaa\x5d\x66\x77\xaa\x9d\xf9\x09\x7c\xbd\x61\x80\x97\xcc\x9f\x5e\x9a\x80\x67\xd6\x2f\xc6\x96\x87\xa6\xd5\x02\x00\x00")
+
+func _1690110000_add_communities_audit_logUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690110000_add_communities_audit_logUpSql,
+		"1690110000_add_communities_audit_log.up.sql",
+	)
+}
+
+func _1690110000_add_communities_audit_logUpSql() (*asset, error) {
+	bytes, err := _1690110000_add_communities_audit_logUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690110000_add_communities_audit_log.up.sql", size: 725, mode: os.FileMode(0644), modTime: time.Unix(1792348426, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0x20, 0xaa, 0x78, 0x33, 0xee, 0xd7, 0xb, 0xca, 0xa8, 0x8e, 0xdc, 0x71, 0x96, 0xa7, 0xb8, 0x3c, 0xbf, 0x56, 0x82, 0x67, 0x75, 0x5a, 0x16, 0x5a, 0x87, 0xbb, 0xcd, 0xe9, 0x57, 0xfc, 0x7b}}
+	return a, nil
+}
+
+var __1690120000_add_communities_invite_linksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x92\x41\x6f\x82\x30\x18\x86\xef\xfc\x8a\xef\xa8\x89\x87\xdd\x77\x02\x2c\x4b\xb3\xae\x18\xac\x89\x9e\x9a\x0a\x5f\x96\x06\x0a\x0c\x8a\xd1\x7f\x3f\xc1\x6d\xa1\xba\x11\xe7\xb5\xef\xd3\x7e\xed\xf3\x36\x4c\x88\x2f\x08\x08\x3f\x60\x04\x68\x04\x3c\x16\x40\xb6\x74\x2d\xd6\x90\x56\xc6\x74\xa5\xb6\x1a\x5b\xa9\xcb\x83\xb6\x28\x0b\x5d\xe6\x2d\xcc\x3c\x00\x9d\x41\xc0\xe2\x60\xe0\xf9\x86\x31\x58\x25\xf4\xcd\x4f\x76\xf0\x4a\x76\x8b\x73\xfe\xbd\xf9\x24\xaf\xc9\x21\x2d\xaa\x34\x07\xca\x85\xb3\x8a\xc7\x5a\x37\xe7\x61\xca\x3a\x11\x2c\x49\xe4\x6f\x98\x80\xa7\x1e\x32\xea\x28\xbb\x16\xdb\x09\xa4\xa9\x0a\x9c\x88\x55\x67\x2b\xa9\xd2\x14\x6b\x0b\x41\x1c\x33\xe2\xf3\x5b\x32\xf2\xd9\x9a\x0c\x87\xe1\xa1\xca\x31\xbb\x83\xcc\x94\x55\x20\xc8\xd6\x7d\x54\xab\xdf\x4b\x65\xbb\x06\xdd\xc8\x9b\x3f\x7b\x5e\x78\x91\x4f\xf9\x92\x6c\xef\x94\x2f\x1d\xb1\x31\xff\x13\x9c\x8d\xc1\xd1\xb0\x7f\x34\x2d\x1b\xcc\xd0\xd4\x56\x57\xe5\x57\xe9\x97\xec\xd7\x46\x27\xfb\xae\xbb\x7d\xa1\x53\x99\xe3\xe9\x56\x50\x3f\x04\x0d\x66\xd7\xb5\xf7\xd9\xe8\x53\xc1\xec\x67\xfa\x62\x74\xde\xbc\x77\x10\xc6\x3c\x62\x34\x14\x40\x5f\x78\x9c\x90\x07\xdd\x8e\x9f\x2b\x0d\x9a\x3d\x36\x13\x82\xc7\xb4\xe3\xda\xb9\xdd\xa3\xde\x3f\x3a\x6c\xed\x45\xfa\xe3\x66\x27\xea\x72\xc4\xfe\x79\x7b\xc7\x6d\x42\x56\xcc\x0f\x07\xb9\x9f\x13\x61\xa9\xef\x35\x04\x00\x00")
+
+func _1690120000_add_communities_invite_linksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690120000_add_communities_invite_linksUpSql,
+		"1690120000_add_communities_invite_links.up.sql",
+	)
+}
+
+func _1690120000_add_communities_invite_linksUpSql() (*asset, error) {
+	bytes, err := _1690120000_add_communities_invite_linksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690120000_add_communities_invite_links.up.sql", size: 1077, mode: os.FileMode(0644), modTime: time.Unix(1792348931, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2d, 0x9c, 0x53, 0x68, 0x1a, 0x86, 0x41, 0xf4, 0xd5, 0xaa, 0xd2, 0x47, 0x2f, 0xe8, 0x20, 0xed, 0x1, 0xfe, 0x3d, 0x22, 0xb8, 0xae, 0xc2, 0xe, 0x95, 0x69, 0x2, 0x1a, 0x31, 0x42, 0x29, 0x65}}
+	return a, nil
+}
+
+var __1690130000_add_communities_calendar_eventsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x52\xc1\x8a\xc2\x30\x10\xbd\xf7\x2b\xe6\xa6\x82\x87\xbd\xef\xa9\xd6\x14\xca\x66\x53\xa9\x11\xf4\x14\x6a\x3b\x60\xb0\x4d\x4b\x12\x05\xff\x7e\xb3\x29\x2e\x29\x15\xd7\xdd\x63\x32\xf3\xe6\xcd\x9b\xf7\x92\x82\xc4\x9c\x00\x8f\x57\x94\x40\x96\x02\xcb\x39\x90\x7d\xb6\xe5\x5b\xa8\xba\xb6\xbd\x28\x69\x25\x1a\x51\x95\x0d\xaa\xba\xd4\x02\xaf\xa8\xac\x81\x79\x04\x20\x6b\xe0\x64\xcf\x61\x53\x64\x9f\x71\x71\x80\x0f\x72\x80\x9c\x41\x92\xb3\x94\x66\x09\x87\x82\x6c\x68\x9c\x90\xa5\x6b\xbd\x8f\xba\x09\x07\x5a\xd1\x7c\xe5\x79\xd8\x8e\x52\x5f\x3d\x95\x56\xdc\xa7\x8d\x0a\x4d\x57\x9d\x21\x63\xe3\x5f\x2b\x6d\x83\xd3\xe6\x1a\x4d\xa5\x65\x6f\x65\xa7\xc6\x45\x58\x93\x34\xde\x51\x0e\xb3\xd9\x77\x9f\xb1\xa5\xb6\xc2\xca\x16\x27\x93\x9d\xc4\x69\xe1\x07\xfe\xe6\xc9\xbb\x33\x2a\xe1\x88\x2c\x6a\x59\x7a\x2d\x7e\xd3\x52\x55\xd8\x34\xe8\xd4\xe5\x39\x25\x31\x9b\xc2\xd3\x98\x6e\xfd\x31\xfa\xcb\xb1\x91\xe6\x84\xb5\x38\xde\xa6\x32\x34\xb6\x52\xd5\xbf\x0e\x8a\x16\xef\x51\x94\x0c\xe6\x65\x6c\x4d\xf6\xaf\x9b\x27\x42\x37\x44\x70\x0e\x67\xde\x13\xd8\x3c\x84\x2d\x83\x33\x06\x9b\xfc\x2d\x46\x42\x9b\x6b\x3f\x64\x69\x78\x3f\xcc\xc0\xd3\xe8\xf8\x5b\x56\xe2\x8c\xb7\x57\xd3\xa3\xd1\xf4\x9d\x32\x53\xf3\xc3\x18\xcf\xef\xfb\x2c\x03\x86\xc5\xa3\x74\xff\xdf\x87\x41\xfd\xc8\x8c\xe7\x0e\x0c\x80\x91\x0d\x8e\xfc\x0b\x01\x38\xc7\x1d\xc1\x03\x00\x00")
+
+func _1690130000_add_communities_calendar_eventsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690130000_add_communities_calendar_eventsUpSql,
+		"1690130000_add_communities_calendar_events.up.sql",
+	)
+}
+
+func _1690130000_add_communities_calendar_eventsUpSql() (*asset, error) {
+	bytes, err := _1690130000_add_communities_calendar_eventsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690130000_add_communities_calendar_events.up.sql", size: 961, mode: os.FileMode(0644), modTime: time.Unix(1792340046, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0xe9, 0xe5, 0x43, 0x6d, 0xae, 0x65, 0x32, 0x5d, 0x3e, 0xda, 0x71, 0x84, 0x8f, 0x6f, 0xe0, 0xe4, 0x40, 0x12, 0x78, 0x2a, 0x8c, 0xc9, 0xe4, 0x2c, 0x44, 0xe9, 0x55, 0x10, 0xf3, 0xf8, 0x4d}}
+	return a, nil
+}
+
+var __1690150000_add_message_thread_summariesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x56\x4d\x6f\xda\x40\x10\xbd\xfb\x57\x4c\x4f\xb1\x55\xc7\x4a\xce\x69\x0e\x0e\x98\xc4\x95\x31\x95\x31\x49\x7b\x42\x1b\xbc\x04\x0b\xe3\xb5\x76\xed\x20\xfe\x7d\x67\x3f\x20\x36\x81\xb4\xa9\x92\x4a\x91\x82\x84\xb0\x77\xe7\xe3\xcd\xec\x9b\xb7\x9c\x9e\xc2\x80\x15\x05\x5b\x43\xbd\xa0\xc0\x69\x55\x6c\x60\xb6\x20\x79\x29\x80\x14\x85\x5a\x5c\x93\x0d\x34\x15\xd4\x0c\xe6\x79\x99\x69\x3b\xc6\x6a\x60\x73\x7c\xe6\x94\x64\xc2\xb5\x4e\x4f\x95\x6f\x4e\x85\xb4\x6b\xca\x65\xc9\xd6\x25\xac\xa8\x10\xe4\x01\xd7\xee\x69\xc1\xca\x07\xb9\x25\xbd\xb5\x97\xf6\x27\xf5\xd6\xca\x9a\xfc\xe8\xfb\x69\x00\x8d\xa0\x7c\xba\xf3\x1c\x07\xa9\xb1\x9f\xe6\x19\x5c\x42\x6f\xe4\x47\xc1\xb8\x17\xd8\xb6\x05\x70\x17\xa6\x37\x90\x04\xbd\x49\x32\x0e\x6f\x03\x8d\xdb\xce\x33\x17\x2a\xc2\x69\x59\xbb\x90\xd1\xaa\x5e\x38\xe0\x8f\x41\x9a\x03\x46\x8b\x82\x5e\x0a\x95\x27\x8d\x76\xa1\x2a\x8f\x53\x51\xb1\x52\xd0\x69\xcd\x5c\x38\x39\x71\x5c\x38\x87\x41\x32\x1a\xee\x81\xa9\xe0\xee\x26\x48\x02\xe5\x8f\x58\x3a\x9b\xed\x18\x2a\xd7\x24\x0e\x47\x31\xf8\x51\xf4\xca\xcc\xaa\x0a\x4f\x21\x87\xaf\xc7\x70\x7c\x1f\x85\xb1\xb6\x04\x4c\x62\xf0\x68\x4f\x5d\xbb\x41\xda\x0e\xf6\x0d\xce\xcf\xf0\x83\x70\x1c\x6b\x07\xa8\xe7\x8f\x03\x69\x1b\x9b\x9e\xc1\x97\x4b\x84\x01\x69\x6b\x25\x88\xd0\x04\x13\x04\x71\x5f\x83\x31\x79\x93\x7e\x90\xc0\xd5\x2f\xdd\x64\xe8\x63\x41\x10\x85\xc3\x30\x85\x73\x0b\xcb\x68\x15\xe6\x58\x1a\xcc\xae\xec\xfd\xa2\x75\xd2\x0b\x4b\xd2\x28\x31\x34\x9a\xb1\x06\x73\x13\x24\x5c\x41\x44\x6d\x98\x89\x94\x21\x5b\xfa\x20\x38\x58\x62\x6a\x60\x25\xe4\xb5\x40\x72\x72\xf1\x44\x26\x3f\x4a\x11\x5d\xea\x5f\x45\xfb\x8c\xf2\xfb\x7d\x44\x12\x4d\x86\xf1\x96\x58\x86\xb9\x53\x9d\x32\x8c\x53\x88\x47\xf8\x9d\x44\x11\x56\x35\xf0\x27\x51\x0a\x67\x17\xaf\x8a\xd8\x94\x8f\x39\x5d\xd3\x77\x0d\xbd\xc2\xb3\xc9\xb1\x8b\x6f\x18\x5b\x76\x5a\x41\xde\xc8\x61\xbb\xf5\x93\xde\x8d\x9f\x3c\x8f\x2a\x8f\xea\x1f\xc3\xce\x39\x5b\xbd\x4b\xe0\x59\xc1\x66\xcb\xa3\x4d\x90\xc4\x4a\x51\x79\x44\xb3\x5a\x11\xbe\xd1\xd2\xd3\x16\x22\x62\x08\x96\x0b\x7c\x98\x23\x3f\x17\x34\x83\xf5\x82\x96\xf4\x91\xf2\x96\x34\xe2\x3e\x4a\x23\xe5\x35\xcd\xd4\xa4\x96\x0f\x68\xc7\x38\xce\x40\x41\x71\xcd\x93\x89\x42\x65\x90\x4b\xbd\x43\xbf\x0e\x2d\x3b\x04\xde\x26\x12\x8a\xbe\x52\x2f\x0d\x3c\x17\x88\x50\xf9\xc8\x4c\x46\x41\x6c\x28\xcf\x68\x4a\x6b\x69\xea\x6d\x8b\x69\xcd\xc5\x8c\x15\xcd\x4a\x4a\x36\xce\x44\x4d\x96\xb4\x04\xd5\x68\x2d\xd5\x6b\x58\xe7\x38\xa0\xf2\x65\x91\x3f\x60\xbe\x1a\x54\xb7\x54\x9a\x2a\x9f\x2d\xb1\x84\xfb\x0d\x0c\xfd\x9f\xb6\x63\xf5\x92\x40\x8a\x70\x9a\x84\xd7\xd7\x78\x0e\x9d\x13\x98\x9a\xb6\x1b\x98\x53\x32\xaf\x71\x57\xf7\x03\xfc\x81\x3c\xb7\x30\x1e\x07\x49\x2a\x35\xa9\xe3\x69\x5d\x05\xd7\x61\x8c\xaa\x73\x4c\xe3\x95\x46\xda\x87\xe6\xd1\x7d\x79\xa6\xdc\x3f\xcc\x85\x7b\x90\xdb\xee\x11\x6a\xba\xc7\x98\xe5\xa0\xbc\xea\x2b\xe4\x49\x39\x47\x93\x38\xb5\xcf\x9d\x96\x9c\x8f\x27\x43\x1b\xe9\x67\x73\x4f\x50\x5a\x3a\xb8\x75\xf6\xc2\x36\xf8\x28\xa8\xf8\x62\x00\xe3\x29\x8c\x12\xe0\x9e\x2e\x2f\x7b\xe6\xcd\xd5\xd5\xa1\xae\x88\xd6\x9a\x60\x0d\x9f\xd1\xfd\x75\x79\x96\xdc\x53\xc8\xa7\x8f\xa4\x68\xa8\x0a\x66\xf0\x1f\xb8\x50\xb8\xd9\xd2\x3a\xcd\xbd\xf6\x8d\xdb\xbd\xe5\x70\x49\xc2\xd6\x65\x2c\xf2\x8c\x3a\xad\x77\x33\x05\x07\x96\xa6\x73\x26\x83\x38\x12\x83\x4e\x82\x81\xf0\x0a\xb3\x4b\xba\x56\x75\xc9\xdf\x5d\x56\xe7\xc2\xc2\xcb\x06\xc7\xf6\xf5\x6c\x6c\xaa\x8c\xd4\xd4\xb0\xd1\xb0\x6d\x34\x78\xfa\x0b\xe1\x42\xab\x2b\x2e\xc8\x83\x70\x61\x77\x02\xae\xf9\x17\x83\x0f\xb2\x36\x77\x3b\xd7\xbb\x07\x53\xc7\x73\x82\xab\x2b\xb4\x53\x84\xb9\x49\xf1\x4c\x59\x91\xed\x2f\x7f\x0e\xc4\xe7\x40\x1c\x1e\x88\x0e\x59\xde\x6e\x2e\x74\x5a\x33\x17\x7d\x3c\x30\x39\x17\x07\x49\xfc\xc9\xd6\x4f\xb6\xfe\x15\x5b\x2f\xbb\x54\xd9\x72\xf3\x05\x8e\x7c\x08\x86\x7c\x6c\x7e\x1c\x65\xc7\x7f\xe0\x46\x47\xc7\x4c\x1b\x9f\xd2\x1d\x40\xa6\x1d\xf6\xc4\x06\x45\xee\x37\xdc\xf3\x10\x4f\x95\x10\x00\x00")
+
+func _1690150000_add_message_thread_summariesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690150000_add_message_thread_summariesUpSql,
+		"1690150000_add_message_thread_summaries.up.sql",
+	)
+}
+
+func _1690150000_add_message_thread_summariesUpSql() (*asset, error) {
+	bytes, err := _1690150000_add_message_thread_summariesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690150000_add_message_thread_summaries.up.sql", size: 4245, mode: os.FileMode(0644), modTime: time.Unix(1792343498, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6d, 0x87, 0x79, 0xdc, 0xb1, 0xb4, 0xd2, 0x92, 0xf0, 0x28, 0xc8, 0x6f, 0xb7, 0xc1, 0x5c, 0xc5, 0xbc, 0xf4, 0xa1, 0xd1, 0xcc, 0x11, 0x31, 0x40, 0xe2, 0xdf, 0x13, 0x2a, 0xc2, 0xa4, 0x4f, 0xbe}}
+	return a, nil
+}
+
+var __1690160000_add_scheduled_messages_send_countUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\xce\x48\x4d\x29\xcd\x49\x4d\x89\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x4e\xcd\x4b\x89\x4f\xce\x2f\xcd\x2b\x51\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x47\xa2\x0d\xcb\x49\x2c\x2e\x89\x07\x9a\x88\xdb\x2c\x00\x07\x9f\x4a\x63\x99\x00\x00\x00")
+
+func _1690160000_add_scheduled_messages_send_countUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690160000_add_scheduled_messages_send_countUpSql,
+		"1690160000_add_scheduled_messages_send_count.up.sql",
+	)
+}
+
+func _1690160000_add_scheduled_messages_send_countUpSql() (*asset, error) {
+	bytes, err := _1690160000_add_scheduled_messages_send_countUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690160000_add_scheduled_messages_send_count.up.sql", size: 153, mode: os.FileMode(0644), modTime: time.Unix(1792344154, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x96, 0x62, 0xf7, 0x7c, 0x93, 0x71, 0xa0, 0x92, 0x51, 0x6b, 0x46, 0xe5, 0xb9, 0x8e, 0xd4, 0x61, 0x53, 0xc1, 0x40, 0x8f, 0xa2, 0x24, 0x27, 0x8c, 0xd4, 0x95, 0x8a, 0xa0, 0x58, 0x2b, 0x91}}
+	return a, nil
+}
+
+var __1690170000_add_user_messages_read_receiptsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\x0e\x72\x75\x0c\x71\x55\x08\x71\x74\xf2\x71\x55\xf0\x74\x53\xf0\xf3\x0f\x51\x70\x8d\xf0\x0c\x0e\x09\x56\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x8e\x2f\x4a\x4d\x4c\x01\x12\xc9\xa9\x99\x05\x25\xc5\x0a\x1a\x5c\x0a\x0a\x50\xa9\xf8\xcc\x14\x85\x30\xc7\x20\x67\x0f\xc7\x20\xb0\x6e\xbf\x50\x1f\x1f\x1d\xa0\x74\x41\x69\x52\x4e\x66\x72\x7c\x76\x6a\x25\x56\xe9\x80\x20\x4f\x5f\xc7\xa0\x48\x05\x6f\xd7\x48\x05\x0d\x84\x51\x3a\x48\xfa\x34\xb9\x34\xad\xb9\x00\x46\x7e\x0f\x8c\xa1\x00\x00\x00")
+
+func _1690170000_add_user_messages_read_receiptsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1690170000_add_user_messages_read_receiptsUpSql,
+		"1690170000_add_user_messages_read_receipts.up.sql",
+	)
+}
+
+func _1690170000_add_user_messages_read_receiptsUpSql() (*asset, error) {
+	bytes, err := _1690170000_add_user_messages_read_receiptsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1690170000_add_user_messages_read_receipts.up.sql", size: 161, mode: os.FileMode(0644), modTime: time.Unix(1792345461, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x7f, 0x60, 0x9e, 0x5c, 0x26, 0x8b, 0x11, 0x63, 0x6, 0xd0, 0x32, 0x47, 0xc9, 0xc4, 0x6c, 0x98, 0x4e, 0x91, 0x46, 0xe2, 0x84, 0x73, 0x85, 0x6d, 0x4e, 0xfe, 0x8, 0x52, 0xa1, 0x21, 0x89}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -2478,6 +2709,28 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1689266326_create_communities_events_table.up.sql": _1689266326_create_communities_events_tableUpSql,
 
+	"1689700000_add_disappearing_messages.up.sql": _1689700000_add_disappearing_messagesUpSql,
+
+	"1689800000_add_polls.up.sql": _1689800000_add_pollsUpSql,
+
+	"1689900000_add_message_threads.up.sql": _1689900000_add_message_threadsUpSql,
+
+	"1690000000_add_scheduled_messages.up.sql": _1690000000_add_scheduled_messagesUpSql,
+
+	"1690100000_add_activity_center_moderation_violation.up.sql": _1690100000_add_activity_center_moderation_violationUpSql,
+
+	"1690110000_add_communities_audit_log.up.sql": _1690110000_add_communities_audit_logUpSql,
+
+	"1690120000_add_communities_invite_links.up.sql": _1690120000_add_communities_invite_linksUpSql,
+
+	"1690130000_add_communities_calendar_events.up.sql": _1690130000_add_communities_calendar_eventsUpSql,
+
+	"1690150000_add_message_thread_summaries.up.sql": _1690150000_add_message_thread_summariesUpSql,
+
+	"1690160000_add_scheduled_messages_send_count.up.sql": _1690160000_add_scheduled_messages_send_countUpSql,
+
+	"1690170000_add_user_messages_read_receipts.up.sql": _1690170000_add_user_messages_read_receiptsUpSql,
+
 	"README.md": readmeMd,
 
 	"doc.go": docGo,
@@ -2623,6 +2876,17 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1687959987_modify_community_tokens_supply_as_string.up.sql":                  &bintree{_1687959987_modify_community_tokens_supply_as_stringUpSql, map[string]*bintree{}},
 	"1689258900_add_airdrop_address_to_revealed_addresses.up.sql":                 &bintree{_1689258900_add_airdrop_address_to_revealed_addressesUpSql, map[string]*bintree{}},
 	"1689266326_create_communities_events_table.up.sql":                           &bintree{_1689266326_create_communities_events_tableUpSql, map[string]*bintree{}},
+	"1689700000_add_disappearing_messages.up.sql":                                 &bintree{_1689700000_add_disappearing_messagesUpSql, map[string]*bintree{}},
+	"1689800000_add_polls.up.sql":                                                 &bintree{_1689800000_add_pollsUpSql, map[string]*bintree{}},
+	"1689900000_add_message_threads.up.sql":                                       &bintree{_1689900000_add_message_threadsUpSql, map[string]*bintree{}},
+	"1690000000_add_scheduled_messages.up.sql":                                    &bintree{_1690000000_add_scheduled_messagesUpSql, map[string]*bintree{}},
+	"1690100000_add_activity_center_moderation_violation.up.sql":                  &bintree{_1690100000_add_activity_center_moderation_violationUpSql, map[string]*bintree{}},
+	"1690110000_add_communities_audit_log.up.sql":                                 &bintree{_1690110000_add_communities_audit_logUpSql, map[string]*bintree{}},
+	"1690120000_add_communities_invite_links.up.sql":                              &bintree{_1690120000_add_communities_invite_linksUpSql, map[string]*bintree{}},
+	"1690130000_add_communities_calendar_events.up.sql":                           &bintree{_1690130000_add_communities_calendar_eventsUpSql, map[string]*bintree{}},
+	"1690150000_add_message_thread_summaries.up.sql":                              &bintree{_1690150000_add_message_thread_summariesUpSql, map[string]*bintree{}},
+	"1690160000_add_scheduled_messages_send_count.up.sql":                         &bintree{_1690160000_add_scheduled_messages_send_countUpSql, map[string]*bintree{}},
+	"1690170000_add_user_messages_read_receipts.up.sql":                           &bintree{_1690170000_add_user_messages_read_receiptsUpSql, map[string]*bintree{}},
 	"README.md": &bintree{readmeMd, map[string]*bintree{}},
 	"doc.go":    &bintree{docGo, map[string]*bintree{}},
 }}
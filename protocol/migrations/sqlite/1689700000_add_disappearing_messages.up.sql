@@ -0,0 +1,10 @@
+ALTER TABLE chats ADD COLUMN disappearing_messages_timer INT NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN disappearing_messages_clock INT NOT NULL DEFAULT 0;
+
+CREATE TABLE user_messages_expirations (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT IGNORE,
+  local_chat_id VARCHAR NOT NULL,
+  expires_at INT NOT NULL
+);
+
+CREATE INDEX user_messages_expirations_expires_at ON user_messages_expirations(expires_at);
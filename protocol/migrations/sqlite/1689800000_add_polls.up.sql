@@ -0,0 +1,11 @@
+ALTER TABLE user_messages ADD COLUMN poll_payload BLOB;
+
+CREATE TABLE poll_votes (
+  poll_id VARCHAR NOT NULL,
+  voter VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  clock_value INT NOT NULL,
+  timestamp INT NOT NULL,
+  option_ids VARCHAR NOT NULL DEFAULT '',
+  PRIMARY KEY (poll_id, voter) ON CONFLICT REPLACE
+);
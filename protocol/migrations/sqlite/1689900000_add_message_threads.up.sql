@@ -0,0 +1,17 @@
+ALTER TABLE user_messages ADD COLUMN thread_id VARCHAR NOT NULL DEFAULT '';
+
+CREATE INDEX user_messages_thread_id_clock_value ON user_messages(thread_id, clock_value);
+
+-- Replies belong to the thread of the message they respond to,
+-- follow the reply chains a few levels to find the root of existing threads
+UPDATE user_messages SET thread_id = response_to WHERE COALESCE(response_to, '') != '';
+UPDATE user_messages SET thread_id = (SELECT p.thread_id FROM user_messages p WHERE p.id = user_messages.thread_id) WHERE thread_id IN (SELECT id FROM user_messages WHERE thread_id != '');
+UPDATE user_messages SET thread_id = (SELECT p.thread_id FROM user_messages p WHERE p.id = user_messages.thread_id) WHERE thread_id IN (SELECT id FROM user_messages WHERE thread_id != '');
+UPDATE user_messages SET thread_id = (SELECT p.thread_id FROM user_messages p WHERE p.id = user_messages.thread_id) WHERE thread_id IN (SELECT id FROM user_messages WHERE thread_id != '');
+
+CREATE TABLE message_threads (
+  thread_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  local_chat_id VARCHAR NOT NULL,
+  muted BOOLEAN NOT NULL DEFAULT FALSE,
+  clock INT NOT NULL DEFAULT 0
+);
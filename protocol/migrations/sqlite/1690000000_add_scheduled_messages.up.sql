@@ -0,0 +1,13 @@
+CREATE TABLE scheduled_messages (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  local_chat_id VARCHAR NOT NULL,
+  text VARCHAR NOT NULL DEFAULT '',
+  response_to VARCHAR NOT NULL DEFAULT '',
+  scheduled_at INT NOT NULL,
+  installation_id VARCHAR NOT NULL,
+  state INT NOT NULL DEFAULT 0,
+  message_id VARCHAR NOT NULL DEFAULT '',
+  clock INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX scheduled_messages_state_scheduled_at ON scheduled_messages(state, scheduled_at);
@@ -0,0 +1 @@
+ALTER TABLE activity_center_notifications ADD COLUMN moderation_violation TEXT NOT NULL DEFAULT '';
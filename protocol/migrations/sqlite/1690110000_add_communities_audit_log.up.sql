@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS communities_audit_log (
+  seq INTEGER PRIMARY KEY AUTOINCREMENT,
+  community_id BLOB NOT NULL,
+  signer TEXT NOT NULL,
+  source TEXT NOT NULL,
+  event_type INT NOT NULL DEFAULT 0,
+  event_id TEXT NOT NULL DEFAULT '',
+  clock INT NOT NULL,
+  base_clock INT NOT NULL,
+  changes BLOB,
+  timestamp INT NOT NULL,
+  prev_hash BLOB,
+  hash BLOB NOT NULL,
+  status TEXT NOT NULL,
+  superseded_by_clock INT NOT NULL DEFAULT 0,
+  message_hash BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS communities_audit_log_community_id_seq ON communities_audit_log(community_id, seq);
+CREATE UNIQUE INDEX IF NOT EXISTS communities_audit_log_event_id ON communities_audit_log(community_id, event_id) WHERE event_id != '';
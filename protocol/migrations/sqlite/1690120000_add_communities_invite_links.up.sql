@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS communities_invite_links (
+  id BLOB NOT NULL PRIMARY KEY,
+  community_id BLOB NOT NULL,
+  clock INT NOT NULL,
+  expires_at INT NOT NULL DEFAULT 0,
+  max_uses INT NOT NULL DEFAULT 0,
+  role INT NOT NULL DEFAULT 0,
+  auto_accept BOOLEAN NOT NULL DEFAULT FALSE,
+  revoked BOOLEAN NOT NULL DEFAULT FALSE,
+  data TEXT NOT NULL,
+  signature TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS communities_invite_links_community_id ON communities_invite_links(community_id);
+
+CREATE TABLE IF NOT EXISTS communities_invite_link_redemptions (
+  invite_id BLOB NOT NULL,
+  community_id BLOB NOT NULL,
+  public_key TEXT NOT NULL,
+  redeemed_at INT NOT NULL,
+  PRIMARY KEY (invite_id, public_key) ON CONFLICT IGNORE
+);
+
+CREATE INDEX IF NOT EXISTS communities_invite_link_redemptions_member ON communities_invite_link_redemptions(community_id, public_key);
+
+CREATE TABLE IF NOT EXISTS communities_invite_link_requests (
+  community_id BLOB NOT NULL,
+  public_key TEXT NOT NULL,
+  invite_id BLOB NOT NULL,
+  PRIMARY KEY (community_id, public_key) ON CONFLICT REPLACE
+);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS communities_calendar_events (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  community_id BLOB NOT NULL,
+  chat_id TEXT NOT NULL,
+  clock INT NOT NULL,
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  start_time INT NOT NULL,
+  end_time INT NOT NULL DEFAULT 0,
+  token_criteria BLOB,
+  cancelled BOOLEAN NOT NULL DEFAULT FALSE,
+  published_by TEXT NOT NULL,
+  reminded BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS communities_calendar_events_community_id_start_time ON communities_calendar_events(community_id, start_time);
+
+CREATE TABLE IF NOT EXISTS communities_calendar_event_rsvps (
+  event_id TEXT NOT NULL,
+  community_id BLOB NOT NULL,
+  public_key TEXT NOT NULL,
+  clock INT NOT NULL,
+  response INT NOT NULL,
+  PRIMARY KEY (event_id, public_key) ON CONFLICT REPLACE
+);
+
+CREATE INDEX IF NOT EXISTS communities_calendar_event_rsvps_community_id ON communities_calendar_event_rsvps(community_id);
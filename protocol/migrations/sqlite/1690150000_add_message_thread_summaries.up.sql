@@ -0,0 +1,61 @@
+-- Follow the reply chains all the way up to find the root of threads,
+-- replies to unknown messages belong to the thread of that message
+UPDATE user_messages SET thread_id = COALESCE((
+  WITH RECURSIVE chain(id, parent, depth) AS (
+    SELECT p.id, COALESCE(p.response_to, ''), 1 FROM user_messages p WHERE p.id = user_messages.response_to
+    UNION ALL
+    SELECT p.id, COALESCE(p.response_to, ''), chain.depth + 1 FROM user_messages p JOIN chain ON p.id = chain.parent WHERE chain.depth < 10000
+  )
+  SELECT CASE WHEN parent != '' THEN parent ELSE id END FROM chain ORDER BY depth DESC LIMIT 1
+), response_to)
+WHERE COALESCE(response_to, '') != '';
+
+-- Replies count and last reply of a thread are kept on its first message
+ALTER TABLE user_messages ADD COLUMN thread_replies_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN thread_unviewed_replies_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN thread_unviewed_mentions_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN thread_last_reply_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE user_messages ADD COLUMN thread_last_reply_from VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE user_messages ADD COLUMN thread_last_reply_clock INT NOT NULL DEFAULT 0;
+
+-- The summary of the thread of a reply is refreshed whenever the reply is inserted, changed or deleted.
+-- Inserting the first message of a thread refreshes its own summary, as replacing a row resets it.
+-- The last reply columns are taken from the row with the highest clock, as picked by MAX()
+CREATE TRIGGER user_messages_thread_summary_after_insert AFTER INSERT ON user_messages
+BEGIN
+  UPDATE user_messages SET
+    (thread_replies_count, thread_unviewed_replies_count, thread_unviewed_mentions_count, thread_last_reply_id, thread_last_reply_from, thread_last_reply_clock) = (
+      SELECT COUNT(1), COALESCE(SUM(NOT(r.seen)), 0), COALESCE(SUM(NOT(r.seen) AND (r.mentioned OR r.replied)), 0), COALESCE(r.id, ''), COALESCE(r.source, ''), COALESCE(MAX(r.clock_value), 0)
+      FROM user_messages r
+      WHERE r.thread_id = user_messages.id AND NOT(r.hide) AND NOT(r.deleted) AND NOT(r.deleted_for_me))
+  WHERE id IN (new.id, new.thread_id);
+END;
+
+CREATE TRIGGER user_messages_thread_summary_after_update AFTER UPDATE OF thread_id, clock_value, seen, mentioned, replied, hide, deleted, deleted_for_me ON user_messages
+WHEN new.thread_id != '' OR old.thread_id != ''
+BEGIN
+  UPDATE user_messages SET
+    (thread_replies_count, thread_unviewed_replies_count, thread_unviewed_mentions_count, thread_last_reply_id, thread_last_reply_from, thread_last_reply_clock) = (
+      SELECT COUNT(1), COALESCE(SUM(NOT(r.seen)), 0), COALESCE(SUM(NOT(r.seen) AND (r.mentioned OR r.replied)), 0), COALESCE(r.id, ''), COALESCE(r.source, ''), COALESCE(MAX(r.clock_value), 0)
+      FROM user_messages r
+      WHERE r.thread_id = user_messages.id AND NOT(r.hide) AND NOT(r.deleted) AND NOT(r.deleted_for_me))
+  WHERE id IN (old.thread_id, new.thread_id);
+END;
+
+CREATE TRIGGER user_messages_thread_summary_after_delete AFTER DELETE ON user_messages
+WHEN old.thread_id != ''
+BEGIN
+  UPDATE user_messages SET
+    (thread_replies_count, thread_unviewed_replies_count, thread_unviewed_mentions_count, thread_last_reply_id, thread_last_reply_from, thread_last_reply_clock) = (
+      SELECT COUNT(1), COALESCE(SUM(NOT(r.seen)), 0), COALESCE(SUM(NOT(r.seen) AND (r.mentioned OR r.replied)), 0), COALESCE(r.id, ''), COALESCE(r.source, ''), COALESCE(MAX(r.clock_value), 0)
+      FROM user_messages r
+      WHERE r.thread_id = user_messages.id AND NOT(r.hide) AND NOT(r.deleted) AND NOT(r.deleted_for_me))
+  WHERE id = old.thread_id;
+END;
+
+UPDATE user_messages SET
+  (thread_replies_count, thread_unviewed_replies_count, thread_unviewed_mentions_count, thread_last_reply_id, thread_last_reply_from, thread_last_reply_clock) = (
+    SELECT COUNT(1), COALESCE(SUM(NOT(r.seen)), 0), COALESCE(SUM(NOT(r.seen) AND (r.mentioned OR r.replied)), 0), COALESCE(r.id, ''), COALESCE(r.source, ''), COALESCE(MAX(r.clock_value), 0)
+    FROM user_messages r
+    WHERE r.thread_id = user_messages.id AND NOT(r.hide) AND NOT(r.deleted) AND NOT(r.deleted_for_me))
+WHERE id IN (SELECT thread_id FROM user_messages WHERE thread_id != '');
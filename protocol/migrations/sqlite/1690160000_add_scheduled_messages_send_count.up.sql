@@ -0,0 +1,2 @@
+ALTER TABLE scheduled_messages ADD COLUMN send_count INT NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_messages ADD COLUMN last_sent INT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS user_messages_read_receipts (
+  message_id VARCHAR NOT NULL,
+  public_key VARCHAR NOT NULL,
+  PRIMARY KEY (message_id, public_key)
+);
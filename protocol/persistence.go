@@ -138,8 +138,8 @@ func (db sqlitePersistence) saveChat(tx *sql.Tx, chat Chat) error {
 	}
 
 	// Insert record
-	stmt, err := tx.Prepare(`INSERT INTO chats(id, name, color, emoji, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, unviewed_mentions_count, last_clock_value, last_message, members, membership_updates, muted, muted_till, invitation_admin, profile, community_id, joined, synced_from, synced_to, first_message_timestamp, description, highlight, read_messages_at_clock_value, received_invitation_admin, image_payload)
-	    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,?, ?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+	stmt, err := tx.Prepare(`INSERT INTO chats(id, name, color, emoji, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, unviewed_mentions_count, last_clock_value, last_message, members, membership_updates, muted, muted_till, invitation_admin, profile, community_id, joined, synced_from, synced_to, first_message_timestamp, description, highlight, read_messages_at_clock_value, received_invitation_admin, image_payload, disappearing_messages_timer, disappearing_messages_clock)
+	    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,?, ?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
 	if err != nil {
 		return err
 	}
@@ -182,6 +182,8 @@ func (db sqlitePersistence) saveChat(tx *sql.Tx, chat Chat) error {
 		chat.ReadMessagesAtClockValue,
 		chat.ReceivedInvitationAdmin,
 		imagePayload,
+		chat.DisappearingMessagesTimer,
+		chat.DisappearingMessagesClock,
 	)
 
 	if err != nil {
@@ -281,7 +283,9 @@ func (db sqlitePersistence) chats(tx *sql.Tx) (chats []*Chat, err error) {
 			contacts.alias,
 			chats.highlight,
 			chats.received_invitation_admin,
-			chats.image_payload
+			chats.image_payload,
+			chats.disappearing_messages_timer,
+			chats.disappearing_messages_clock
 		FROM chats LEFT JOIN contacts ON chats.id = contacts.id
 		ORDER BY chats.timestamp DESC
 	`)
@@ -335,6 +339,8 @@ func (db sqlitePersistence) chats(tx *sql.Tx) (chats []*Chat, err error) {
 			&chat.Highlight,
 			&chat.ReceivedInvitationAdmin,
 			&imagePayload,
+			&chat.DisappearingMessagesTimer,
+			&chat.DisappearingMessagesClock,
 		)
 
 		if err != nil {
@@ -450,7 +456,9 @@ func (db sqlitePersistence) Chat(chatID string) (*Chat, error) {
 			synced_from,
 			synced_to,
 			first_message_timestamp,
-			image_payload
+			image_payload,
+			disappearing_messages_timer,
+			disappearing_messages_clock
 		FROM chats
 		WHERE id = ?
 	`, chatID).Scan(&chat.ID,
@@ -481,6 +489,8 @@ func (db sqlitePersistence) Chat(chatID string) (*Chat, error) {
 		&syncedTo,
 		&firstMessageTimestamp,
 		&imagePayload,
+		&chat.DisappearingMessagesTimer,
+		&chat.DisappearingMessagesClock,
 	)
 	switch err {
 	case sql.ErrNoRows:
@@ -1744,3 +1744,403 @@ func TestCountActiveChattersInCommunity(t *testing.T) {
 	checker(7, 1)
 	checker(8, 0)
 }
+
+func TestSearchMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	messages := []*common.Message{
+		{
+			ID:          "id-1",
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{Text: "the quick brown fox", Clock: 1, Timestamp: 100, ContentType: protobuf.ChatMessage_TEXT_PLAIN},
+			From:        "alice",
+		},
+		{
+			ID:          "id-2",
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{Text: "a lazy brown dog", Clock: 2, Timestamp: 200, ContentType: protobuf.ChatMessage_TEXT_PLAIN},
+			From:        "bob",
+		},
+		{
+			ID:          "id-3",
+			LocalChatID: "other-chat",
+			ChatMessage: protobuf.ChatMessage{Text: "Brown bears everywhere", Clock: 3, Timestamp: 300, ContentType: protobuf.ChatMessage_EMOJI},
+			From:        "alice",
+		},
+		{
+			ID:          "id-4",
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{Text: "nothing to see here", Clock: 4, Timestamp: 400, ContentType: protobuf.ChatMessage_TEXT_PLAIN},
+			From:        "bob",
+		},
+	}
+	require.NoError(t, p.SaveMessages(messages))
+
+	resultIDs := func(request *MessageSearchRequest) []string {
+		results, _, err := p.SearchMessages(request)
+		require.NoError(t, err)
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r.Message.ID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	require.Equal(t, []string{"id-1", "id-2", "id-3"}, resultIDs(&MessageSearchRequest{Term: "brown"}))
+	require.Equal(t, []string{"id-1", "id-2"}, resultIDs(&MessageSearchRequest{Term: "brown", ChatIDs: []string{testPublicChatID}}))
+	require.Equal(t, []string{"id-1", "id-3"}, resultIDs(&MessageSearchRequest{Term: "brown", From: []string{"alice"}}))
+	require.Equal(t, []string{"id-2", "id-3"}, resultIDs(&MessageSearchRequest{Term: "brown", Since: 150, Until: 350}))
+	require.Equal(t, []string{"id-3"}, resultIDs(&MessageSearchRequest{Term: "brown", ContentTypes: []protobuf.ChatMessage_ContentType{protobuf.ChatMessage_EMOJI}}))
+	require.Equal(t, []string{"id-2"}, resultIDs(&MessageSearchRequest{Term: "brown do"}))
+
+	// Pagination
+	results, cursor, err := p.SearchMessages(&MessageSearchRequest{Term: "brown", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.NotEmpty(t, cursor)
+
+	results, cursor, err = p.SearchMessages(&MessageSearchRequest{Term: "brown", Limit: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Empty(t, cursor)
+
+	// Edits are reflected
+	_, err = p.db.Exec(`UPDATE user_messages SET text = 'a lazy black dog' WHERE id = 'id-2'`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id-1", "id-3"}, resultIDs(&MessageSearchRequest{Term: "brown"}))
+	require.Equal(t, []string{"id-2"}, resultIDs(&MessageSearchRequest{Term: "black"}))
+
+	// Replaced messages are not duplicated
+	messages[0].Text = "the quick brown cat"
+	require.NoError(t, p.SaveMessages(messages[:1]))
+	require.Equal(t, []string{"id-1", "id-3"}, resultIDs(&MessageSearchRequest{Term: "brown"}))
+
+	// Hidden, deleted and deleted for me messages are excluded
+	require.NoError(t, p.HideMessage("id-1"))
+	_, err = p.db.Exec(`UPDATE user_messages SET deleted_for_me = 1 WHERE id = 'id-3'`)
+	require.NoError(t, err)
+	require.Empty(t, resultIDs(&MessageSearchRequest{Term: "brown"}))
+
+	require.NoError(t, p.DeleteMessage("id-2"))
+	require.Empty(t, resultIDs(&MessageSearchRequest{Term: "black"}))
+
+	if sqlite.MessageSearchIndexAvailable(db) {
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM user_messages_fts`).Scan(&count))
+		require.Equal(t, 1, count)
+	}
+}
+
+func TestSearchMessagesSnippet(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	if !sqlite.MessageSearchIndexAvailable(db) {
+		t.Skip("full-text search index not available, build with the fts5 tag")
+	}
+
+	require.NoError(t, insertMinimalMessage(p, "id-1"))
+	require.NoError(t, p.SaveMessages([]*common.Message{{
+		ID:          "id-2",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{Text: "some text, some more text and some other text"},
+		From:        testPK,
+	}}))
+
+	results, _, err := p.SearchMessages(&MessageSearchRequest{Term: "text"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	// More occurrences rank higher
+	require.Equal(t, "id-2", results[0].Message.ID)
+	require.Equal(t, "some-<b>text</b>", results[1].Snippet)
+}
+
+func TestSearchMessagesLikeWildcards(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	if sqlite.MessageSearchIndexAvailable(db) {
+		t.Skip("the LIKE scan is only used without the full-text search index")
+	}
+
+	var messages []*common.Message
+	for i, text := range []string{"50% off", "500 sats", "a_b", "aXb", `c:\d`} {
+		messages = append(messages, &common.Message{
+			ID:          fmt.Sprintf("id-%d", i),
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{Text: text, Clock: uint64(i + 1)},
+			From:        testPK,
+		})
+	}
+	require.NoError(t, p.SaveMessages(messages))
+
+	testCases := []struct {
+		term string
+		ids  []string
+	}{
+		{"50%", []string{"id-0"}},
+		{"a_b", []string{"id-2"}},
+		{`c:\d`, []string{"id-4"}},
+		{"%", []string{"id-0"}},
+	}
+
+	for _, tc := range testCases {
+		results, _, err := p.SearchMessages(&MessageSearchRequest{Term: tc.term})
+		require.NoError(t, err)
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r.Message.ID)
+		}
+		require.Equal(t, tc.ids, ids, tc.term)
+	}
+}
+
+func TestMessageSearchMatchQuery(t *testing.T) {
+	require.Equal(t, `"hello" "wor"*`, messageSearchMatchQuery(` hello  wor`))
+	require.Equal(t, `"OR" "NEAR(a"*`, messageSearchMatchQuery(`"OR" NEAR(a*`))
+	require.Equal(t, ErrMessageSearchEmptyTerm, (&MessageSearchRequest{Term: ` "*" `}).Validate())
+}
+
+func TestExpiredMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	chat := CreatePublicChat("disappearing", &testTimeSource{})
+	chat.DisappearingMessagesTimer = 60
+	chat.DisappearingMessagesClock = 2
+	require.NoError(t, p.SaveChat(*chat))
+
+	messages := []*common.Message{
+		{
+			ID:          "sent-before-setting",
+			LocalChatID: chat.ID,
+			ChatMessage: protobuf.ChatMessage{Text: "stays", Clock: 1, Timestamp: 1000, ContentType: protobuf.ChatMessage_TEXT_PLAIN},
+		},
+		{
+			ID:          "setting",
+			LocalChatID: chat.ID,
+			ChatMessage: protobuf.ChatMessage{Text: "system", Clock: 2, Timestamp: 2000, ContentType: protobuf.ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES},
+		},
+		{
+			// Expires after the timer from the time it's received, whatever its timestamp says
+			ID:               "disappearing",
+			LocalChatID:      chat.ID,
+			WhisperTimestamp: 3000,
+			ChatMessage: protobuf.ChatMessage{
+				Text:        "goes away",
+				Clock:       3,
+				Timestamp:   1,
+				ContentType: protobuf.ChatMessage_DISCORD_MESSAGE,
+				Payload:     &protobuf.ChatMessage_DiscordMessage{DiscordMessage: &protobuf.DiscordMessage{Id: "discord-message"}},
+			},
+		},
+	}
+	require.NoError(t, p.SaveMessages(messages))
+	require.NoError(t, p.SaveDiscordMessages([]*protobuf.DiscordMessage{{Id: "discord-message", Author: &protobuf.DiscordMessageAuthor{}}}))
+	require.NoError(t, p.SaveDiscordMessageAttachments([]*protobuf.DiscordMessageAttachment{
+		{Id: "attachment", MessageId: "discord-message", Payload: []byte{1}},
+	}))
+	hasPayload, err := p.HasDiscordMessageAttachmentPayload("attachment", "discord-message")
+	require.NoError(t, err)
+	require.True(t, hasPayload)
+
+	expired, err := p.ExpiredMessagesIDsByChatID(62999)
+	require.NoError(t, err)
+	require.Len(t, expired, 0)
+
+	expired, err = p.ExpiredMessagesIDsByChatID(63000)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{chat.ID: {"disappearing"}}, expired)
+
+	require.NoError(t, p.DeleteExpiredMessages(chat.ID, expired[chat.ID]))
+
+	_, err = p.MessageByID("disappearing")
+	require.Equal(t, common.ErrRecordNotFound, err)
+	_, err = p.MessageByID("sent-before-setting")
+	require.NoError(t, err)
+
+	// Along with its media
+	hasPayload, err = p.HasDiscordMessageAttachmentPayload("attachment", "discord-message")
+	require.NoError(t, err)
+	require.False(t, hasPayload)
+	var discordMessages int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(1) FROM discord_messages WHERE id = ?`, "discord-message").Scan(&discordMessages))
+	require.Equal(t, 0, discordMessages)
+
+	expired, err = p.ExpiredMessagesIDsByChatID(63000)
+	require.NoError(t, err)
+	require.Len(t, expired, 0)
+}
+
+func TestMessageThreads(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	chat := CreatePublicChat(testPublicChatID, &testTimeSource{})
+	err = p.SaveChat(*chat)
+	require.NoError(t, err)
+
+	newMessage := func(id string, responseTo string, clock uint64) *common.Message {
+		return &common.Message{
+			ID:          id,
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{
+				Text:       "some-text",
+				Clock:      clock,
+				ResponseTo: responseTo,
+			},
+			From: testPK,
+		}
+	}
+
+	// A reply to a reply is part of the thread of the first message,
+	// even when received before the message it responds to
+	err = p.SaveMessages([]*common.Message{
+		newMessage("root", "", 1),
+		newMessage("reply-2", "reply-1", 3),
+	})
+	require.NoError(t, err)
+
+	err = p.SaveMessages([]*common.Message{newMessage("reply-1", "root", 2)})
+	require.NoError(t, err)
+
+	for _, id := range []string{"reply-1", "reply-2"} {
+		m, err := p.MessageByID(id)
+		require.NoError(t, err)
+		require.Equal(t, "root", m.ThreadID)
+	}
+
+	root, err := p.MessageByID("root")
+	require.NoError(t, err)
+	require.Empty(t, root.ThreadID)
+	require.NotNil(t, root.Thread)
+	require.Equal(t, uint64(2), root.Thread.RepliesCount)
+	require.Equal(t, uint64(2), root.Thread.UnviewedRepliesCount)
+	require.Equal(t, "reply-2", root.Thread.LastReplyID)
+	require.Equal(t, uint64(3), root.Thread.LastReplyClock)
+
+	replies, cursor, err := p.ThreadReplies("root", "", 1)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	require.Equal(t, "reply-2", replies[0].ID)
+	require.NotEmpty(t, cursor)
+
+	replies, cursor, err = p.ThreadReplies("root", cursor, 1)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	require.Equal(t, "reply-1", replies[0].ID)
+	require.Empty(t, cursor)
+
+	count, _, err := p.MarkThreadRead(testPublicChatID, "root", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	root, err = p.MessageByID("root")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), root.Thread.UnviewedRepliesCount)
+
+	// Deleted replies are not counted
+	err = p.SaveMessages([]*common.Message{{
+		ID:          "reply-2",
+		Deleted:     true,
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{Clock: 3, ResponseTo: "reply-1"},
+		From:        testPK,
+	}})
+	require.NoError(t, err)
+
+	root, err = p.MessageByID("root")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), root.Thread.RepliesCount)
+	require.Equal(t, "reply-1", root.Thread.LastReplyID)
+
+	// Saving the first message again keeps the summary of its thread
+	err = p.SaveMessages([]*common.Message{newMessage("root", "", 1)})
+	require.NoError(t, err)
+
+	root, err = p.MessageByID("root")
+	require.NoError(t, err)
+	require.NotNil(t, root.Thread)
+	require.Equal(t, uint64(1), root.Thread.RepliesCount)
+
+	// Hidden replies are not counted either
+	err = p.HideMessage("reply-1")
+	require.NoError(t, err)
+
+	root, err = p.MessageByID("root")
+	require.NoError(t, err)
+	require.Nil(t, root.Thread)
+
+	saved, err := p.SaveMessageThread(&MessageThread{ThreadID: "root", LocalChatID: testPublicChatID, Muted: true, Clock: 2})
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	// Older states are ignored
+	saved, err = p.SaveMessageThread(&MessageThread{ThreadID: "root", LocalChatID: testPublicChatID, Muted: false, Clock: 1})
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	root, err = p.MessageByID("root")
+	require.NoError(t, err)
+	require.True(t, root.Thread.Muted)
+
+	muted, err := p.MutedMessageThreads()
+	require.NoError(t, err)
+	require.Len(t, muted, 1)
+}
+
+func TestMarkMessagesReadByRecipient(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	for i, id := range []string{"1", "2"} {
+		err = p.SaveMessages([]*common.Message{{
+			ID:             id,
+			LocalChatID:    testPublicChatID,
+			ChatMessage:    protobuf.ChatMessage{Text: "some-text", Clock: uint64(i + 1)},
+			From:           testPK,
+			OutgoingStatus: common.OutgoingStatusSent,
+		}})
+		require.NoError(t, err)
+	}
+
+	readers := []string{"0x01", "0x02"}
+
+	// Messages are only read once every member has read them
+	updated, err := p.MarkMessagesReadByRecipient(testPublicChatID, testPK, "0x01", readers, []string{"1"}, 0)
+	require.NoError(t, err)
+	require.Len(t, updated, 0)
+
+	message, err := p.MessageByID("1")
+	require.NoError(t, err)
+	require.Equal(t, common.OutgoingStatusSent, message.OutgoingStatus)
+
+	// Reading twice doesn't count for another member
+	updated, err = p.MarkMessagesReadByRecipient(testPublicChatID, testPK, "0x01", readers, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, updated, 0)
+
+	updated, err = p.MarkMessagesReadByRecipient(testPublicChatID, testPK, "0x02", readers, []string{"1"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, updated)
+
+	message, err = p.MessageByID("1")
+	require.NoError(t, err)
+	require.Equal(t, common.OutgoingStatusRead, message.OutgoingStatus)
+
+	message, err = p.MessageByID("2")
+	require.NoError(t, err)
+	require.Equal(t, common.OutgoingStatusSent, message.OutgoingStatus)
+
+	updated, err = p.MarkMessagesReadByRecipient(testPublicChatID, testPK, "0x02", readers, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, updated)
+}
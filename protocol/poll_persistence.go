@@ -0,0 +1,75 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+func (db sqlitePersistence) SavePollVote(vote *PollVote) error {
+	optionIDs, err := json.Marshal(vote.OptionIds)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO poll_votes(poll_id, voter, local_chat_id, clock_value, timestamp, option_ids) VALUES (?,?,?,?,?,?)`,
+		vote.MessageId,
+		vote.From,
+		vote.LocalChatID,
+		vote.Clock,
+		vote.Timestamp,
+		string(optionIDs),
+	)
+	return err
+}
+
+func (db sqlitePersistence) PollVoteByVoter(pollID string, voter string) (*PollVote, error) {
+	rows, err := db.db.Query(`SELECT poll_id, voter, local_chat_id, clock_value, timestamp, option_ids FROM poll_votes WHERE poll_id = ? AND voter = ?`, pollID, voter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	votes, err := db.scanPollVotes(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(votes) == 0 {
+		return nil, common.ErrRecordNotFound
+	}
+
+	return votes[0], nil
+}
+
+func (db sqlitePersistence) PollVotes(pollID string) ([]*PollVote, error) {
+	rows, err := db.db.Query(`SELECT poll_id, voter, local_chat_id, clock_value, timestamp, option_ids FROM poll_votes WHERE poll_id = ?`, pollID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.scanPollVotes(rows)
+}
+
+func (db sqlitePersistence) scanPollVotes(rows *sql.Rows) ([]*PollVote, error) {
+	var votes []*PollVote
+	for rows.Next() {
+		vote := &PollVote{}
+		var optionIDs string
+		err := rows.Scan(&vote.MessageId, &vote.From, &vote.LocalChatID, &vote.Clock, &vote.Timestamp, &optionIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal([]byte(optionIDs), &vote.OptionIds); err != nil {
+			return nil, err
+		}
+
+		vote.ChatId = vote.LocalChatID
+		votes = append(votes, vote)
+	}
+
+	return votes, rows.Err()
+}
@@ -0,0 +1,165 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+
+	accountJson "github.com/status-im/status-go/account/json"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PollVote represents the vote of a user on a poll message.
+// Only the latest vote of each voter is kept.
+type PollVote struct {
+	protobuf.PollVote
+
+	// From is a public key of the voter
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the voter
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// LocalChatID is the chatID of the local chat (one-to-one are not symmetric)
+	LocalChatID string `json:"localChatId"`
+
+	// Timestamp is the whisper timestamp of the vote, votes sent after
+	// the poll closed are not counted
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (v PollVote) GetSigPubKey() *ecdsa.PublicKey {
+	return v.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (v PollVote) GetProtobuf() proto.Message {
+	return &v.PollVote
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (v *PollVote) SetMessageType(messageType protobuf.MessageType) {
+	v.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (v PollVote) WrapGroupMessage() bool {
+	return false
+}
+
+func (v PollVote) MarshalJSON() ([]byte, error) {
+	item := struct {
+		Clock       uint64   `json:"clock,omitempty"`
+		ChatID      string   `json:"chatId,omitempty"`
+		LocalChatID string   `json:"localChatId,omitempty"`
+		From        string   `json:"from"`
+		MessageID   string   `json:"messageId,omitempty"`
+		OptionIDs   []string `json:"optionIds"`
+		Timestamp   uint64   `json:"timestamp,omitempty"`
+	}{
+		Clock:       v.Clock,
+		ChatID:      v.ChatId,
+		LocalChatID: v.LocalChatID,
+		From:        v.From,
+		MessageID:   v.MessageId,
+		OptionIDs:   v.OptionIds,
+		Timestamp:   v.Timestamp,
+	}
+
+	ext, err := accountJson.ExtendStructWithPubKeyData(item.From, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ext)
+}
+
+type PollOptionResult struct {
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+	Votes int    `json:"votes"`
+}
+
+// PollResults is the tally of the votes of a poll
+type PollResults struct {
+	MessageID string              `json:"messageId"`
+	ChatID    string              `json:"chatId"`
+	Options   []*PollOptionResult `json:"options"`
+	// Voters is the number of users who voted for at least one option
+	Voters int `json:"voters"`
+	// MyVote are the options we voted for
+	MyVote []string `json:"myVote"`
+	Closed bool     `json:"closed"`
+}
+
+func isPollClosed(poll *protobuf.PollMessage, timestamp uint64) bool {
+	return poll.ClosesAt != 0 && timestamp > poll.ClosesAt
+}
+
+// validPollVote returns whether the options of the vote exist in the poll and
+// whether their number is allowed by the poll
+func validPollVote(poll *protobuf.PollMessage, optionIDs []string) bool {
+	if !poll.MultipleChoice && len(optionIDs) > 1 {
+		return false
+	}
+
+	options := make(map[string]bool)
+	for _, option := range poll.Options {
+		options[option.Id] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range optionIDs {
+		if !options[id] || seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+
+	return true
+}
+
+// newPollResults tallies the votes of the poll in message.
+// Votes sent after the poll closed or not matching the poll are ignored.
+func newPollResults(message *common.Message, votes []*PollVote, myID string, now uint64) *PollResults {
+	poll := message.GetPoll()
+
+	results := &PollResults{
+		MessageID: message.ID,
+		ChatID:    message.LocalChatID,
+		MyVote:    []string{},
+		Closed:    isPollClosed(poll, now),
+	}
+
+	counts := make(map[string]int)
+	for _, vote := range votes {
+		if len(vote.OptionIds) == 0 || isPollClosed(poll, vote.Timestamp) || !validPollVote(poll, vote.OptionIds) {
+			continue
+		}
+
+		results.Voters++
+		for _, id := range vote.OptionIds {
+			counts[id]++
+		}
+
+		if vote.From == myID {
+			results.MyVote = vote.OptionIds
+		}
+	}
+
+	for _, option := range poll.Options {
+		results.Options = append(results.Options, &PollOptionResult{
+			ID:    option.Id,
+			Text:  option.Text,
+			Votes: counts[option.Id],
+		})
+	}
+
+	return results
+}
@@ -93,6 +93,15 @@ const (
 	ApplicationMetadataMessage_COMMUNITY_EDIT_SHARED_ADDRESSES         ApplicationMetadataMessage_Type = 68
 	ApplicationMetadataMessage_SYNC_ACCOUNT_CUSTOMIZATION_COLOR        ApplicationMetadataMessage_Type = 69
 	ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS                 ApplicationMetadataMessage_Type = 70
+	ApplicationMetadataMessage_DISAPPEARING_MESSAGES                   ApplicationMetadataMessage_Type = 71
+	ApplicationMetadataMessage_POLL_VOTE                               ApplicationMetadataMessage_Type = 72
+	ApplicationMetadataMessage_SYNC_MESSAGE_THREAD                     ApplicationMetadataMessage_Type = 73
+	ApplicationMetadataMessage_TYPING_INDICATOR                        ApplicationMetadataMessage_Type = 74
+	ApplicationMetadataMessage_READ_RECEIPT                            ApplicationMetadataMessage_Type = 75
+	ApplicationMetadataMessage_SYNC_SCHEDULED_MESSAGE                  ApplicationMetadataMessage_Type = 76
+	ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT                ApplicationMetadataMessage_Type = 77
+	ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP           ApplicationMetadataMessage_Type = 78
+	ApplicationMetadataMessage_SYNC_COMMUNITY_CALENDAR_EVENT           ApplicationMetadataMessage_Type = 79
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -166,6 +175,15 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	68: "COMMUNITY_EDIT_SHARED_ADDRESSES",
 	69: "SYNC_ACCOUNT_CUSTOMIZATION_COLOR",
 	70: "SYNC_ACCOUNTS_POSITIONS",
+	71: "DISAPPEARING_MESSAGES",
+	72: "POLL_VOTE",
+	73: "SYNC_MESSAGE_THREAD",
+	74: "TYPING_INDICATOR",
+	75: "READ_RECEIPT",
+	76: "SYNC_SCHEDULED_MESSAGE",
+	77: "COMMUNITY_CALENDAR_EVENT",
+	78: "COMMUNITY_CALENDAR_EVENT_RSVP",
+	79: "SYNC_COMMUNITY_CALENDAR_EVENT",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -239,6 +257,15 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"COMMUNITY_EDIT_SHARED_ADDRESSES":         68,
 	"SYNC_ACCOUNT_CUSTOMIZATION_COLOR":        69,
 	"SYNC_ACCOUNTS_POSITIONS":                 70,
+	"DISAPPEARING_MESSAGES":                   71,
+	"POLL_VOTE":                               72,
+	"SYNC_MESSAGE_THREAD":                     73,
+	"TYPING_INDICATOR":                        74,
+	"READ_RECEIPT":                            75,
+	"SYNC_SCHEDULED_MESSAGE":                  76,
+	"COMMUNITY_CALENDAR_EVENT":                77,
+	"COMMUNITY_CALENDAR_EVENT_RSVP":           78,
+	"SYNC_COMMUNITY_CALENDAR_EVENT":           79,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -317,69 +344,75 @@ func init() {
 }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x6d, 0x73, 0x13, 0x37,
-	0x10, 0x6e, 0x20, 0x4d, 0x40, 0x79, 0xdb, 0x88, 0xbc, 0x38, 0xef, 0x89, 0x81, 0x10, 0xa0, 0x35,
-	0x2d, 0xb4, 0x9d, 0xb6, 0x94, 0xb6, 0xb2, 0xb4, 0x89, 0x85, 0xef, 0xa4, 0x43, 0xd2, 0xb9, 0x63,
-	0xbe, 0x68, 0x4c, 0x71, 0x99, 0xcc, 0x00, 0xf1, 0x10, 0xf3, 0x21, 0xbf, 0xa0, 0xbf, 0xb7, 0xff,
-	0xa0, 0xa3, 0xb3, 0xef, 0xce, 0x49, 0x1c, 0xf2, 0x29, 0xf1, 0x3e, 0x8f, 0x56, 0xda, 0x67, 0x9f,
-	0x5d, 0x9b, 0x54, 0x3b, 0xbd, 0xde, 0xfb, 0xe3, 0xbf, 0x3b, 0xfd, 0xe3, 0x93, 0x8f, 0xfe, 0x43,
-	0xb7, 0xdf, 0x79, 0xdb, 0xe9, 0x77, 0xfc, 0x87, 0xee, 0xe9, 0x69, 0xe7, 0x5d, 0xb7, 0xd6, 0xfb,
-	0x74, 0xd2, 0x3f, 0xa1, 0xb7, 0xb2, 0x3f, 0x6f, 0x3e, 0xff, 0x53, 0xfd, 0x77, 0x91, 0xac, 0xb3,
-	0xf2, 0x40, 0x3c, 0xe4, 0xc7, 0x03, 0x3a, 0xdd, 0x24, 0xb7, 0x4f, 0x8f, 0xdf, 0x7d, 0xec, 0xf4,
-	0x3f, 0x7f, 0xea, 0x56, 0x26, 0x76, 0x27, 0x0e, 0x66, 0x4d, 0x19, 0xa0, 0x15, 0x32, 0xdd, 0xeb,
-	0x9c, 0xbd, 0x3f, 0xe9, 0xbc, 0xad, 0xdc, 0xc8, 0xb0, 0xfc, 0x23, 0x7d, 0x41, 0x26, 0xfb, 0x67,
-	0xbd, 0x6e, 0xe5, 0xe6, 0xee, 0xc4, 0xc1, 0xfc, 0xd3, 0x87, 0xb5, 0xfc, 0xbe, 0xda, 0xd5, 0x77,
-	0xd5, 0xdc, 0x59, 0xaf, 0x6b, 0xb2, 0x63, 0xd5, 0xff, 0x16, 0xc8, 0x64, 0xf8, 0x48, 0x67, 0xc8,
-	0x74, 0xaa, 0x9a, 0x4a, 0xff, 0xa5, 0xe0, 0x2b, 0x0a, 0x64, 0x96, 0x37, 0x98, 0xf3, 0x31, 0x5a,
-	0xcb, 0x8e, 0x10, 0x26, 0x28, 0x25, 0xf3, 0x5c, 0x2b, 0xc7, 0xb8, 0xf3, 0x69, 0x22, 0x98, 0x43,
-	0xb8, 0x41, 0xb7, 0xc8, 0x5a, 0x8c, 0x71, 0x1d, 0x8d, 0x6d, 0xc8, 0x64, 0x18, 0x2e, 0x8e, 0xdc,
-	0xa4, 0xcb, 0x64, 0x31, 0x61, 0xd2, 0x78, 0xa9, 0xac, 0x63, 0x51, 0xc4, 0x9c, 0xd4, 0x0a, 0x26,
-	0x43, 0xd8, 0xb6, 0x15, 0x3f, 0x1f, 0xfe, 0x9a, 0xde, 0x25, 0x3b, 0x06, 0x5f, 0xa5, 0x68, 0x9d,
-	0x67, 0x42, 0x18, 0xb4, 0xd6, 0x1f, 0x6a, 0xe3, 0x9d, 0x61, 0xca, 0x32, 0x9e, 0x91, 0xa6, 0xe8,
-	0x23, 0xb2, 0xcf, 0x38, 0xc7, 0xc4, 0xf9, 0xeb, 0xb8, 0xd3, 0xf4, 0x31, 0x79, 0x20, 0x90, 0x47,
-	0x52, 0xe1, 0xb5, 0xe4, 0x5b, 0x74, 0x95, 0xdc, 0xc9, 0x49, 0xa3, 0xc0, 0x6d, 0xba, 0x44, 0xc0,
-	0xa2, 0x12, 0xe7, 0xa2, 0x84, 0xee, 0x90, 0x8d, 0x8b, 0xb9, 0x47, 0x09, 0x33, 0x41, 0x9a, 0x4b,
-	0x45, 0xfa, 0xa1, 0x80, 0x30, 0x3b, 0x1e, 0x66, 0x9c, 0xeb, 0x54, 0x39, 0x98, 0xa3, 0x7b, 0x64,
-	0xeb, 0x32, 0x9c, 0xa4, 0xf5, 0x48, 0x72, 0x1f, 0xfa, 0x02, 0xf3, 0x74, 0x9b, 0xac, 0xe7, 0xfd,
-	0xe0, 0x5a, 0xa0, 0x67, 0xa2, 0x85, 0xc6, 0x49, 0x8b, 0x31, 0x2a, 0x07, 0x0b, 0xb4, 0x4a, 0xb6,
-	0x93, 0xd4, 0x36, 0xbc, 0xd2, 0x4e, 0x1e, 0x4a, 0x3e, 0x48, 0x61, 0xf0, 0x48, 0x5a, 0x67, 0x06,
-	0x92, 0x43, 0x50, 0xe8, 0xcb, 0x1c, 0x6f, 0xd0, 0x26, 0x5a, 0x59, 0x84, 0x45, 0xba, 0x41, 0x56,
-	0x2f, 0x93, 0x5f, 0xa5, 0x68, 0xda, 0x40, 0xe9, 0x3d, 0xb2, 0x7b, 0x05, 0x58, 0xa6, 0xb8, 0x13,
-	0xaa, 0x1e, 0x77, 0x5f, 0xa6, 0x1f, 0x2c, 0x85, 0x92, 0xc6, 0xc1, 0xc3, 0xe3, 0xcb, 0xc1, 0x82,
-	0x18, 0xeb, 0x97, 0xd2, 0x1b, 0x1c, 0xea, 0xbc, 0x42, 0xd7, 0xc8, 0xf2, 0x91, 0xd1, 0x69, 0x92,
-	0xc9, 0xe2, 0xa5, 0x6a, 0x49, 0x37, 0xa8, 0x6e, 0x95, 0x2e, 0x92, 0xb9, 0x41, 0x50, 0xa0, 0x72,
-	0xd2, 0xb5, 0xa1, 0x12, 0xd8, 0x5c, 0xc7, 0x71, 0xaa, 0xa4, 0x6b, 0x7b, 0x81, 0x96, 0x1b, 0x99,
-	0x64, 0xec, 0x35, 0x5a, 0x21, 0x4b, 0x25, 0x34, 0x92, 0x67, 0x3d, 0xbc, 0xba, 0x44, 0x8a, 0x6e,
-	0x6b, 0xff, 0x52, 0x4b, 0x05, 0x1b, 0x74, 0x81, 0xcc, 0x24, 0x52, 0x15, 0xb6, 0xdf, 0x0c, 0xb3,
-	0x83, 0x42, 0x96, 0xb3, 0xb3, 0x15, 0x5e, 0x62, 0x1d, 0x73, 0xa9, 0xcd, 0x47, 0x67, 0x3b, 0xd4,
-	0x22, 0x30, 0xc2, 0x91, 0x79, 0xd9, 0x09, 0xa6, 0x1a, 0xe7, 0x99, 0xe1, 0xd5, 0xb0, 0x4b, 0xd7,
-	0xc9, 0x0a, 0x53, 0x5a, 0xb5, 0x63, 0x9d, 0x5a, 0x1f, 0xa3, 0x33, 0x92, 0xfb, 0x3a, 0x73, 0xbc,
-	0x01, 0x7b, 0xc5, 0x54, 0x65, 0x25, 0x1b, 0x8c, 0x75, 0x0b, 0x05, 0x54, 0x43, 0xd7, 0xca, 0xf0,
-	0xf0, 0x2a, 0x1b, 0x04, 0x14, 0x70, 0x97, 0x12, 0x32, 0x55, 0x67, 0xbc, 0x99, 0x26, 0x70, 0xaf,
-	0x70, 0x64, 0x50, 0xb6, 0x15, 0x2a, 0xe5, 0xa8, 0x1c, 0x9a, 0x01, 0xf5, 0x7e, 0xe1, 0xc8, 0x8b,
-	0xf0, 0x60, 0x1a, 0x51, 0xc0, 0x7e, 0x70, 0xdc, 0x58, 0x8a, 0x90, 0x36, 0x96, 0xd6, 0xa2, 0x80,
-	0x07, 0x99, 0x12, 0x81, 0x53, 0xd7, 0xba, 0x19, 0x33, 0xd3, 0x84, 0x03, 0xba, 0x42, 0xe8, 0xe0,
-	0x85, 0x11, 0x32, 0xe3, 0x1b, 0xd2, 0x3a, 0x6d, 0xda, 0xf0, 0x30, 0xc8, 0x98, 0xc5, 0x2d, 0x3a,
-	0x27, 0xd5, 0x11, 0x3c, 0xa2, 0xbb, 0x64, 0xb3, 0x6c, 0x04, 0x33, 0xbc, 0x21, 0x5b, 0xe8, 0x63,
-	0x76, 0xa4, 0xd0, 0x45, 0x52, 0x35, 0xe1, 0x71, 0x68, 0x62, 0x76, 0x26, 0x31, 0xfa, 0x50, 0x46,
-	0xe8, 0x13, 0xc9, 0x5d, 0x6a, 0x10, 0xbe, 0x29, 0xb2, 0xe5, 0x33, 0xf6, 0x6d, 0x26, 0xe6, 0x60,
-	0x95, 0xe4, 0x73, 0x94, 0x3b, 0xb1, 0x16, 0x54, 0x33, 0xe8, 0xcc, 0x60, 0xb8, 0xce, 0x83, 0x4f,
-	0xe8, 0x3e, 0xa9, 0x5e, 0xe9, 0x87, 0xd2, 0xae, 0xdf, 0x95, 0xd2, 0x17, 0xe4, 0x61, 0x29, 0x16,
-	0xbe, 0x0f, 0xb5, 0xe4, 0x47, 0xf3, 0x1b, 0x5a, 0x68, 0x0a, 0xdb, 0xc3, 0xd3, 0xe0, 0x86, 0x0b,
-	0xef, 0x3b, 0x47, 0x78, 0x16, 0x52, 0xe4, 0x3b, 0x68, 0x2c, 0xe3, 0x87, 0xc2, 0x13, 0xce, 0xa4,
-	0xd6, 0xa1, 0xf0, 0xa9, 0x45, 0x03, 0x3f, 0x16, 0xad, 0x1e, 0x65, 0x17, 0xf5, 0xfd, 0x54, 0xb4,
-	0xfa, 0x42, 0xe5, 0x5e, 0x20, 0x97, 0x36, 0x24, 0xfe, 0x79, 0xb0, 0x7c, 0xc6, 0x48, 0x10, 0x21,
-	0x6b, 0x21, 0xfc, 0x12, 0xf0, 0x2c, 0xc5, 0xd0, 0xe2, 0x61, 0xdd, 0xc6, 0xa5, 0xd3, 0x7f, 0x2d,
-	0x7a, 0x6e, 0x59, 0x0b, 0x45, 0xbe, 0x95, 0xe1, 0x79, 0x58, 0x23, 0x65, 0x5e, 0xce, 0x14, 0xc7,
-	0xe8, 0xd2, 0xc4, 0xfd, 0x16, 0x94, 0x19, 0x62, 0x63, 0xeb, 0x7e, 0x51, 0x34, 0xbb, 0x89, 0xed,
-	0xf0, 0x05, 0x04, 0xbf, 0x17, 0x4a, 0x58, 0xcd, 0x25, 0x8b, 0x7c, 0xb0, 0x8b, 0x85, 0x3f, 0xe8,
-	0x26, 0xa9, 0x64, 0x61, 0x54, 0x36, 0x13, 0x47, 0xb1, 0x18, 0xbd, 0x40, 0xc7, 0x64, 0x04, 0x7f,
-	0xd2, 0xfb, 0x64, 0x6f, 0xac, 0xa1, 0x47, 0xf7, 0x13, 0xb0, 0xb0, 0x45, 0xaf, 0xa5, 0xf9, 0x30,
-	0xff, 0x08, 0xf5, 0x60, 0x8a, 0x11, 0x0f, 0x8b, 0x78, 0x64, 0x73, 0xf0, 0xf0, 0x15, 0x58, 0x82,
-	0xd9, 0x0e, 0xb1, 0x0d, 0x66, 0x4a, 0x85, 0xd0, 0x82, 0x08, 0x1a, 0x8d, 0x3a, 0xd9, 0xf3, 0xd4,
-	0x3a, 0x1d, 0xcb, 0xd7, 0xf9, 0xba, 0x88, 0xb4, 0x01, 0x2c, 0xcc, 0x37, 0x64, 0x59, 0x9f, 0x68,
-	0x2b, 0x03, 0xc3, 0xc2, 0x61, 0x7d, 0xee, 0xf5, 0x4c, 0xed, 0xc9, 0xf3, 0xfc, 0x87, 0xc2, 0x9b,
-	0xa9, 0xec, 0xbf, 0x67, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x9b, 0xda, 0x33, 0xcf, 0x08,
-	0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x6b, 0x73, 0x53, 0x37,
+	0x13, 0x7e, 0x03, 0xbc, 0x5c, 0x14, 0x02, 0x8a, 0xb8, 0x39, 0xf7, 0xc4, 0x40, 0x08, 0xd0, 0x9a,
+	0x16, 0xda, 0x4e, 0x5b, 0x4a, 0x5b, 0x59, 0xda, 0xd8, 0x8a, 0xcf, 0x91, 0x0e, 0x92, 0x8e, 0x3b,
+	0xe6, 0x8b, 0xc6, 0x14, 0x97, 0xc9, 0x0c, 0x10, 0x0f, 0x31, 0x1f, 0xf2, 0x5f, 0xfb, 0x2b, 0xfa,
+	0x0b, 0x3a, 0x7b, 0xae, 0x4e, 0xe2, 0x34, 0x9f, 0x92, 0xb3, 0xfb, 0xec, 0x4a, 0xfb, 0xec, 0xb3,
+	0x2b, 0x93, 0xe6, 0x70, 0x3c, 0xfe, 0xb0, 0xff, 0xe7, 0x70, 0xb2, 0x7f, 0xf0, 0x29, 0x7c, 0x1c,
+	0x4d, 0x86, 0xef, 0x86, 0x93, 0x61, 0xf8, 0x38, 0x3a, 0x3c, 0x1c, 0xbe, 0x1f, 0xb5, 0xc6, 0x9f,
+	0x0f, 0x26, 0x07, 0xec, 0x6a, 0xf6, 0xe7, 0xed, 0x97, 0xbf, 0x9a, 0xff, 0x30, 0xb2, 0xcc, 0xeb,
+	0x80, 0xb8, 0xc0, 0xc7, 0x39, 0x9c, 0xad, 0x92, 0x6b, 0x87, 0xfb, 0xef, 0x3f, 0x0d, 0x27, 0x5f,
+	0x3e, 0x8f, 0x1a, 0x73, 0x9b, 0x73, 0x3b, 0xd7, 0x6d, 0x6d, 0x60, 0x0d, 0x72, 0x65, 0x3c, 0x3c,
+	0xfa, 0x70, 0x30, 0x7c, 0xd7, 0xb8, 0x90, 0xf9, 0xca, 0x4f, 0xf6, 0x8a, 0x5c, 0x9a, 0x1c, 0x8d,
+	0x47, 0x8d, 0x8b, 0x9b, 0x73, 0x3b, 0x37, 0x9e, 0x3f, 0x6e, 0x95, 0xe7, 0xb5, 0xce, 0x3e, 0xab,
+	0xe5, 0x8f, 0xc6, 0x23, 0x9b, 0x85, 0x35, 0xff, 0x5e, 0x24, 0x97, 0xf0, 0x93, 0xcd, 0x93, 0x2b,
+	0xa9, 0xee, 0x69, 0xf3, 0x87, 0xa6, 0xff, 0x63, 0x94, 0x5c, 0x17, 0x5d, 0xee, 0x43, 0x0c, 0xce,
+	0xf1, 0x0e, 0xd0, 0x39, 0xc6, 0xc8, 0x0d, 0x61, 0xb4, 0xe7, 0xc2, 0x87, 0x34, 0x91, 0xdc, 0x03,
+	0xbd, 0xc0, 0xd6, 0xc8, 0x52, 0x0c, 0x71, 0x1b, 0xac, 0xeb, 0xaa, 0xa4, 0x30, 0x57, 0x21, 0x17,
+	0xd9, 0x1d, 0xb2, 0x98, 0x70, 0x65, 0x83, 0xd2, 0xce, 0xf3, 0x28, 0xe2, 0x5e, 0x19, 0x4d, 0x2f,
+	0xa1, 0xd9, 0x0d, 0xb4, 0x38, 0x6e, 0xfe, 0x3f, 0xbb, 0x4f, 0x36, 0x2c, 0xbc, 0x4e, 0xc1, 0xf9,
+	0xc0, 0xa5, 0xb4, 0xe0, 0x5c, 0xd8, 0x35, 0x36, 0x78, 0xcb, 0xb5, 0xe3, 0x22, 0x03, 0x5d, 0x66,
+	0x4f, 0xc8, 0x36, 0x17, 0x02, 0x12, 0x1f, 0xce, 0xc3, 0x5e, 0x61, 0x4f, 0xc9, 0x23, 0x09, 0x22,
+	0x52, 0x1a, 0xce, 0x05, 0x5f, 0x65, 0xf7, 0xc8, 0xad, 0x12, 0x34, 0xed, 0xb8, 0xc6, 0x6e, 0x13,
+	0xea, 0x40, 0xcb, 0x63, 0x56, 0xc2, 0x36, 0xc8, 0xca, 0xc9, 0xdc, 0xd3, 0x80, 0x79, 0xa4, 0xe6,
+	0x54, 0x91, 0xa1, 0x20, 0x90, 0x5e, 0x9f, 0xed, 0xe6, 0x42, 0x98, 0x54, 0x7b, 0xba, 0xc0, 0xb6,
+	0xc8, 0xda, 0x69, 0x77, 0x92, 0xb6, 0x23, 0x25, 0x02, 0xf6, 0x85, 0xde, 0x60, 0xeb, 0x64, 0xb9,
+	0xec, 0x87, 0x30, 0x12, 0x02, 0x97, 0x7d, 0xb0, 0x5e, 0x39, 0x88, 0x41, 0x7b, 0x7a, 0x93, 0x35,
+	0xc9, 0x7a, 0x92, 0xba, 0x6e, 0xd0, 0xc6, 0xab, 0x5d, 0x25, 0xf2, 0x14, 0x16, 0x3a, 0xca, 0x79,
+	0x9b, 0x53, 0x4e, 0x91, 0xa1, 0xff, 0xc6, 0x04, 0x0b, 0x2e, 0x31, 0xda, 0x01, 0x5d, 0x64, 0x2b,
+	0xe4, 0xde, 0x69, 0xf0, 0xeb, 0x14, 0xec, 0x80, 0x32, 0xf6, 0x80, 0x6c, 0x9e, 0xe1, 0xac, 0x53,
+	0xdc, 0xc2, 0xaa, 0x67, 0x9d, 0x97, 0xf1, 0x47, 0x6f, 0x63, 0x49, 0xb3, 0xdc, 0x45, 0xf8, 0x1d,
+	0x94, 0x20, 0xc4, 0x66, 0x4f, 0x05, 0x0b, 0x05, 0xcf, 0x77, 0xd9, 0x12, 0xb9, 0xd3, 0xb1, 0x26,
+	0x4d, 0x32, 0x5a, 0x82, 0xd2, 0x7d, 0xe5, 0xf3, 0xea, 0xee, 0xb1, 0x45, 0xb2, 0x90, 0x1b, 0x25,
+	0x68, 0xaf, 0xfc, 0x80, 0x36, 0x10, 0x2d, 0x4c, 0x1c, 0xa7, 0x5a, 0xf9, 0x41, 0x90, 0xe0, 0x84,
+	0x55, 0x49, 0x86, 0x5e, 0x62, 0x0d, 0x72, 0xbb, 0x76, 0x4d, 0xe5, 0x59, 0xc6, 0x5b, 0xd7, 0x9e,
+	0xaa, 0xdb, 0x26, 0xec, 0x19, 0xa5, 0xe9, 0x0a, 0xbb, 0x49, 0xe6, 0x13, 0xa5, 0x2b, 0xd9, 0xaf,
+	0xe2, 0xec, 0x80, 0x54, 0xf5, 0xec, 0xac, 0xe1, 0x4d, 0x9c, 0xe7, 0x3e, 0x75, 0xe5, 0xe8, 0xac,
+	0x63, 0x2d, 0x12, 0x22, 0x98, 0x9a, 0x97, 0x0d, 0x14, 0xd5, 0x2c, 0xcd, 0x14, 0x47, 0xd3, 0x4d,
+	0xb6, 0x4c, 0xee, 0x72, 0x6d, 0xf4, 0x20, 0x36, 0xa9, 0x0b, 0x31, 0x78, 0xab, 0x44, 0x68, 0x73,
+	0x2f, 0xba, 0x74, 0xab, 0x9a, 0xaa, 0xac, 0x64, 0x0b, 0xb1, 0xe9, 0x83, 0xa4, 0x4d, 0xec, 0x5a,
+	0x6d, 0x2e, 0x8e, 0x72, 0x48, 0xa0, 0xa4, 0xf7, 0x19, 0x21, 0x97, 0xdb, 0x5c, 0xf4, 0xd2, 0x84,
+	0x3e, 0xa8, 0x14, 0x89, 0xcc, 0xf6, 0xb1, 0x52, 0x01, 0xda, 0x83, 0xcd, 0xa1, 0x0f, 0x2b, 0x45,
+	0x9e, 0x74, 0xe7, 0xd3, 0x08, 0x92, 0x6e, 0xa3, 0xe2, 0x66, 0x42, 0xa4, 0x72, 0xb1, 0x72, 0x0e,
+	0x24, 0x7d, 0x94, 0x31, 0x81, 0x98, 0xb6, 0x31, 0xbd, 0x98, 0xdb, 0x1e, 0xdd, 0x61, 0x77, 0x09,
+	0xcb, 0x6f, 0x18, 0x01, 0xb7, 0xa1, 0xab, 0x9c, 0x37, 0x76, 0x40, 0x1f, 0x23, 0x8d, 0x99, 0xdd,
+	0x81, 0xf7, 0x4a, 0x77, 0xe8, 0x13, 0xb6, 0x49, 0x56, 0xeb, 0x46, 0x70, 0x2b, 0xba, 0xaa, 0x0f,
+	0x21, 0xe6, 0x1d, 0x0d, 0x3e, 0x52, 0xba, 0x47, 0x9f, 0x62, 0x13, 0xb3, 0x98, 0xc4, 0x9a, 0x5d,
+	0x15, 0x41, 0x48, 0x94, 0xf0, 0xa9, 0x05, 0xfa, 0x55, 0x95, 0xad, 0x9c, 0xb1, 0xaf, 0x33, 0x32,
+	0xf3, 0x55, 0x52, 0xce, 0x51, 0xa9, 0xc4, 0x16, 0xb2, 0x66, 0xc1, 0xdb, 0x7c, 0xb8, 0x8e, 0x3b,
+	0x9f, 0xb1, 0x6d, 0xd2, 0x3c, 0x53, 0x0f, 0xb5, 0x5c, 0xbf, 0xa9, 0xa9, 0xaf, 0xc0, 0x45, 0x29,
+	0x8e, 0x7e, 0x8b, 0xb5, 0x94, 0xa1, 0xe5, 0x09, 0x7d, 0xb0, 0x95, 0xec, 0xe9, 0x73, 0x54, 0xc3,
+	0x89, 0xfb, 0x1d, 0x03, 0xbc, 0xc0, 0x14, 0xe5, 0x0e, 0x9a, 0x89, 0xf8, 0xae, 0xd2, 0x84, 0xb7,
+	0xa9, 0xf3, 0x20, 0x43, 0xea, 0xc0, 0xd2, 0xef, 0xab, 0x56, 0x4f, 0xa3, 0xab, 0xfa, 0x7e, 0xa8,
+	0x5a, 0x7d, 0xa2, 0xf2, 0x20, 0x41, 0x28, 0x87, 0x89, 0x7f, 0xcc, 0x97, 0xcf, 0x0c, 0x0a, 0x22,
+	0xe0, 0x7d, 0xa0, 0x3f, 0xa1, 0x3f, 0x4b, 0x51, 0x48, 0x1c, 0xd7, 0x6d, 0x5c, 0x2b, 0xfd, 0xe7,
+	0xaa, 0xe7, 0x8e, 0xf7, 0x41, 0x96, 0x5b, 0x99, 0xbe, 0xc4, 0x35, 0x52, 0xe7, 0x15, 0x5c, 0x0b,
+	0x88, 0x4e, 0x4d, 0xdc, 0x2f, 0xc8, 0x4c, 0xe1, 0x9b, 0x59, 0xf7, 0xab, 0xaa, 0xd9, 0x3d, 0x18,
+	0xe0, 0x03, 0x44, 0x7f, 0xad, 0x98, 0x70, 0x46, 0x28, 0x1e, 0x05, 0x94, 0x8b, 0xa3, 0xbf, 0xb1,
+	0x55, 0xd2, 0xc8, 0xcc, 0xa0, 0x5d, 0x46, 0x8e, 0xe6, 0x31, 0x04, 0x09, 0x9e, 0xab, 0x88, 0xfe,
+	0xce, 0x1e, 0x92, 0xad, 0x99, 0x82, 0x9e, 0xde, 0x4f, 0x94, 0xe3, 0x16, 0x3d, 0x17, 0x16, 0x70,
+	0xfe, 0x81, 0xb6, 0x51, 0x14, 0x53, 0x1a, 0x96, 0xf1, 0xd4, 0xe6, 0x10, 0xf8, 0x04, 0xd6, 0xce,
+	0x6c, 0x87, 0xb8, 0x2e, 0xb7, 0x35, 0x43, 0xe0, 0xa8, 0x44, 0x8e, 0xa6, 0x95, 0x1c, 0x44, 0xea,
+	0xbc, 0x89, 0xd5, 0x9b, 0x72, 0x5d, 0x44, 0xc6, 0x52, 0xa8, 0xc4, 0x57, 0xa0, 0x5c, 0x48, 0x8c,
+	0x53, 0x88, 0x70, 0x74, 0x17, 0xd7, 0xa0, 0x54, 0x8e, 0x27, 0x09, 0x70, 0xab, 0x74, 0xa7, 0xda,
+	0x0b, 0xb4, 0xc3, 0x16, 0xc8, 0xb5, 0xc4, 0x44, 0x51, 0xe8, 0x1b, 0x0f, 0xb4, 0x8b, 0xcf, 0x62,
+	0x96, 0xa6, 0x40, 0x04, 0xdf, 0xcd, 0xf6, 0x81, 0xc2, 0x67, 0xd1, 0x0f, 0x12, 0x0c, 0x56, 0x5a,
+	0x62, 0x91, 0xc6, 0xd2, 0x3d, 0x24, 0x1e, 0xfd, 0xc1, 0x82, 0x00, 0x95, 0x78, 0xda, 0xc3, 0x29,
+	0xcb, 0x89, 0x17, 0x5d, 0x90, 0x69, 0x04, 0xb2, 0x2a, 0x37, 0x42, 0xf6, 0xa7, 0xbb, 0x1d, 0x81,
+	0x96, 0xdc, 0x06, 0xe8, 0xe3, 0x03, 0x16, 0xa3, 0x0c, 0xcf, 0xf2, 0x06, 0xeb, 0xfa, 0x09, 0xd5,
+	0x53, 0x4a, 0x3d, 0x23, 0x8b, 0x69, 0x2f, 0xbc, 0x99, 0x6f, 0x3d, 0x7b, 0x59, 0xfe, 0x26, 0x7a,
+	0x7b, 0x39, 0xfb, 0xef, 0xc5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x69, 0x16, 0xa3, 0xba,
+	0x09, 0x00, 0x00,
 }
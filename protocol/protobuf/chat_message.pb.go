@@ -75,6 +75,9 @@ const (
 	ChatMessage_SYSTEM_MESSAGE_MUTUAL_EVENT_ACCEPTED ChatMessage_ContentType = 16
 	// Only local
 	ChatMessage_SYSTEM_MESSAGE_MUTUAL_EVENT_REMOVED ChatMessage_ContentType = 17
+	// Only local
+	ChatMessage_SYSTEM_MESSAGE_DISAPPEARING_MESSAGES ChatMessage_ContentType = 18
+	ChatMessage_POLL                                 ChatMessage_ContentType = 19
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -96,6 +99,8 @@ var ChatMessage_ContentType_name = map[int32]string{
 	15: "SYSTEM_MESSAGE_MUTUAL_EVENT_SENT",
 	16: "SYSTEM_MESSAGE_MUTUAL_EVENT_ACCEPTED",
 	17: "SYSTEM_MESSAGE_MUTUAL_EVENT_REMOVED",
+	18: "SYSTEM_MESSAGE_DISAPPEARING_MESSAGES",
+	19: "POLL",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -117,6 +122,8 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"SYSTEM_MESSAGE_MUTUAL_EVENT_SENT":     15,
 	"SYSTEM_MESSAGE_MUTUAL_EVENT_ACCEPTED": 16,
 	"SYSTEM_MESSAGE_MUTUAL_EVENT_REMOVED":  17,
+	"SYSTEM_MESSAGE_DISAPPEARING_MESSAGES": 18,
+	"POLL":                                 19,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -970,6 +977,7 @@ type ChatMessage struct {
 	//	*ChatMessage_Audio
 	//	*ChatMessage_Community
 	//	*ChatMessage_DiscordMessage
+	//	*ChatMessage_Poll
 	Payload isChatMessage_Payload `protobuf_oneof:"payload"`
 	// Grant for community chat messages
 	Grant []byte `protobuf:"bytes,13,opt,name=grant,proto3" json:"grant,omitempty"`
@@ -1087,6 +1095,10 @@ type ChatMessage_DiscordMessage struct {
 	DiscordMessage *DiscordMessage `protobuf:"bytes,99,opt,name=discord_message,json=discordMessage,proto3,oneof"`
 }
 
+type ChatMessage_Poll struct {
+	Poll *PollMessage `protobuf:"bytes,17,opt,name=poll,proto3,oneof"`
+}
+
 func (*ChatMessage_Sticker) isChatMessage_Payload() {}
 
 func (*ChatMessage_Image) isChatMessage_Payload() {}
@@ -1097,6 +1109,8 @@ func (*ChatMessage_Community) isChatMessage_Payload() {}
 
 func (*ChatMessage_DiscordMessage) isChatMessage_Payload() {}
 
+func (*ChatMessage_Poll) isChatMessage_Payload() {}
+
 func (m *ChatMessage) GetPayload() isChatMessage_Payload {
 	if m != nil {
 		return m.Payload
@@ -1139,6 +1153,13 @@ func (m *ChatMessage) GetDiscordMessage() *DiscordMessage {
 	return nil
 }
 
+func (m *ChatMessage) GetPoll() *PollMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Poll); ok {
+		return x.Poll
+	}
+	return nil
+}
+
 func (m *ChatMessage) GetGrant() []byte {
 	if m != nil {
 		return m.Grant
@@ -1175,6 +1196,7 @@ func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 		(*ChatMessage_Audio)(nil),
 		(*ChatMessage_Community)(nil),
 		(*ChatMessage_DiscordMessage)(nil),
+		(*ChatMessage_Poll)(nil),
 	}
 }
 
@@ -1200,96 +1222,99 @@ func init() {
 }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x13, 0xb7, 0xbe, 0xcd, 0xd1, 0x87, 0x99, 0x8d, 0x93, 0x30, 0x41, 0x9c, 0x28, 0xfa, 0x07, 0x88,
-	0xff, 0x68, 0xe1, 0x02, 0x69, 0x5a, 0x04, 0x28, 0x8a, 0x82, 0x96, 0x18, 0x9b, 0x4d, 0xf4, 0xd1,
-	0x15, 0xe5, 0xd4, 0xbd, 0x10, 0x34, 0xb9, 0xb6, 0x08, 0x53, 0xa4, 0x4a, 0xae, 0xda, 0xaa, 0xf7,
-	0xbe, 0x52, 0x4f, 0x7d, 0x80, 0x1e, 0x7a, 0xed, 0x21, 0x2f, 0xd0, 0x27, 0xe8, 0x03, 0x14, 0xbb,
-	0xcb, 0x4f, 0xd5, 0x76, 0x8a, 0x9c, 0xb8, 0x33, 0x9c, 0x99, 0x9d, 0xf9, 0xcd, 0xec, 0xcc, 0x00,
-	0xb2, 0xe7, 0x16, 0x35, 0x17, 0x24, 0x8a, 0xac, 0x0b, 0x72, 0xb0, 0x0c, 0x03, 0x1a, 0xa0, 0x6d,
-	0xfe, 0x39, 0x5b, 0x9d, 0x3f, 0x68, 0x12, 0x7f, 0xb5, 0x88, 0x04, 0xfb, 0x41, 0xdb, 0x0e, 0x7c,
-	0x6a, 0xd9, 0x54, 0x90, 0xbd, 0x97, 0xd0, 0x99, 0x52, 0xd7, 0xbe, 0x24, 0xe1, 0x50, 0x68, 0x23,
-	0x04, 0xd5, 0xb9, 0x15, 0xcd, 0x95, 0x52, 0xb7, 0xb4, 0x2f, 0x61, 0x7e, 0x66, 0xbc, 0xa5, 0x65,
-	0x5f, 0x2a, 0xe5, 0x6e, 0x69, 0xbf, 0x86, 0xf9, 0xb9, 0xf7, 0x7b, 0x09, 0x5a, 0xfa, 0xc2, 0xba,
-	0x20, 0x89, 0xa2, 0x02, 0x8d, 0xa5, 0xb5, 0xf6, 0x02, 0xcb, 0xe1, 0xba, 0x2d, 0x9c, 0x90, 0xe8,
-	0x19, 0x54, 0xe9, 0x7a, 0x49, 0xb8, 0x7a, 0xe7, 0xf9, 0xed, 0x83, 0xc4, 0xb3, 0x03, 0xae, 0x6f,
-	0xac, 0x97, 0x04, 0x73, 0x01, 0x74, 0x1f, 0xb6, 0x2d, 0xef, 0x6c, 0xb5, 0x30, 0x5d, 0x47, 0xa9,
-	0xf0, 0xfb, 0x1b, 0x9c, 0xd6, 0x1d, 0xb4, 0x0b, 0xb5, 0x1f, 0x5d, 0x87, 0xce, 0x95, 0x6a, 0xb7,
-	0xb4, 0xdf, 0xc6, 0x82, 0x40, 0x77, 0xa1, 0x3e, 0x27, 0xee, 0xc5, 0x9c, 0x2a, 0x35, 0xce, 0x8e,
-	0x29, 0xf4, 0x31, 0xa0, 0xd8, 0x10, 0xbb, 0x21, 0x32, 0xed, 0x60, 0xe5, 0x53, 0xa5, 0xce, 0x65,
-	0x64, 0x61, 0x92, 0xff, 0xe8, 0x33, 0x7e, 0xef, 0xd7, 0x12, 0xb4, 0xd4, 0x95, 0xe3, 0x06, 0xef,
-	0x0f, 0xe5, 0x45, 0x21, 0x94, 0x6e, 0x16, 0x4a, 0x5e, 0x5f, 0x10, 0xb9, 0xb8, 0x1e, 0x43, 0xd3,
-	0x59, 0x85, 0x16, 0x75, 0x03, 0xdf, 0x5c, 0x44, 0x3c, 0xb4, 0x2a, 0x86, 0x84, 0x35, 0x8c, 0x7a,
-	0x9f, 0x81, 0x94, 0xea, 0xa0, 0xbb, 0x80, 0x66, 0xa3, 0xd7, 0xa3, 0xf1, 0xdb, 0x91, 0xa9, 0xce,
-	0x06, 0xfa, 0xd8, 0x34, 0x4e, 0x27, 0x9a, 0xbc, 0x85, 0x1a, 0x50, 0x51, 0xd5, 0xbe, 0x5c, 0xe2,
-	0x87, 0x21, 0x96, 0xcb, 0xbd, 0x5f, 0xca, 0xd0, 0xd4, 0x1c, 0x97, 0x26, 0x7e, 0xef, 0x42, 0xcd,
-	0xf6, 0x02, 0xfb, 0x92, 0x7b, 0x5d, 0xc5, 0x82, 0x60, 0xd9, 0xa3, 0xe4, 0x27, 0xca, 0x7d, 0x96,
-	0x30, 0x3f, 0xa3, 0x7b, 0xd0, 0xe0, 0x35, 0x93, 0x02, 0x5d, 0x67, 0xa4, 0xee, 0xa0, 0x3d, 0x80,
-	0xb8, 0x8e, 0xd8, 0xbf, 0x2a, 0xff, 0x27, 0xc5, 0x1c, 0x91, 0x86, 0x8b, 0xd0, 0xf2, 0x05, 0xde,
-	0x2d, 0x2c, 0x08, 0xf4, 0x12, 0x5a, 0x89, 0x12, 0x47, 0xa7, 0xce, 0xd1, 0xb9, 0x93, 0xa1, 0x13,
-	0x3b, 0xc8, 0x21, 0x69, 0x2e, 0x32, 0x02, 0x0d, 0xa0, 0xc5, 0x0a, 0x92, 0xf8, 0x54, 0x68, 0x36,
-	0xb8, 0xe6, 0x93, 0x4c, 0xb3, 0x3f, 0xb7, 0x92, 0xf0, 0x0e, 0xfa, 0x42, 0x52, 0x58, 0xb1, 0x33,
-	0xa2, 0xf7, 0x47, 0x09, 0xda, 0x03, 0xe2, 0x11, 0x4a, 0x6e, 0x46, 0x22, 0x17, 0x75, 0xf9, 0x86,
-	0xa8, 0x2b, 0xd7, 0x46, 0x5d, 0xbd, 0x29, 0xea, 0xda, 0x7f, 0x8e, 0x7a, 0x0f, 0xc0, 0xe1, 0xee,
-	0x3a, 0xe6, 0xd9, 0x9a, 0xa3, 0x25, 0x61, 0x29, 0xe6, 0x1c, 0xae, 0x7b, 0x3a, 0x20, 0x11, 0xcd,
-	0xab, 0x20, 0x1c, 0xbe, 0x27, 0xa4, 0xa2, 0xe7, 0xe5, 0x0d, 0xcf, 0x7b, 0x7f, 0x96, 0xa1, 0x33,
-	0x70, 0x23, 0x3b, 0x08, 0x9d, 0xc4, 0x4e, 0x07, 0xca, 0xae, 0x13, 0x3f, 0xef, 0xb2, 0xeb, 0xf0,
-	0xf2, 0x48, 0x4a, 0x5a, 0x8a, 0x0b, 0xf6, 0x21, 0x48, 0xd4, 0x5d, 0x90, 0x88, 0x5a, 0x8b, 0x65,
-	0x02, 0x47, 0xca, 0x40, 0xfb, 0xb0, 0x93, 0x12, 0xac, 0xfc, 0x48, 0x52, 0x28, 0x9b, 0x6c, 0xf6,
-	0x90, 0xe2, 0x3c, 0x71, 0x74, 0x24, 0x9c, 0x90, 0xe8, 0x73, 0xa8, 0x5b, 0x2b, 0x3a, 0x0f, 0x42,
-	0x1e, 0x7e, 0xf3, 0xf9, 0xa3, 0x0c, 0xb6, 0xa2, 0xbf, 0x2a, 0x97, 0xc2, 0xb1, 0x34, 0xfa, 0x0a,
-	0xa4, 0x90, 0x9c, 0x93, 0x90, 0xf8, 0xb6, 0xa8, 0x96, 0x66, 0xbe, 0x5a, 0x8a, 0xaa, 0x38, 0x11,
-	0xc4, 0x99, 0x0e, 0x1a, 0x40, 0xd3, 0xa2, 0xd4, 0xb2, 0xe7, 0x0b, 0xe2, 0xd3, 0x48, 0xd9, 0xee,
-	0x56, 0xf6, 0x9b, 0xcf, 0x7b, 0xd7, 0xde, 0x9e, 0x8a, 0xe2, 0xbc, 0x5a, 0xef, 0xaf, 0x12, 0xec,
-	0x5e, 0xe5, 0xe7, 0x55, 0xe8, 0xfa, 0xd6, 0x22, 0x45, 0x97, 0x9d, 0xd1, 0x53, 0x68, 0x3b, 0x6e,
-	0x64, 0x87, 0xee, 0xc2, 0xf5, 0x2d, 0x1a, 0x84, 0x31, 0xc2, 0x45, 0x26, 0x7a, 0x00, 0xdb, 0xbe,
-	0x6b, 0x5f, 0x72, 0x6d, 0x01, 0x6f, 0x4a, 0xb3, 0xfc, 0x58, 0x3f, 0x58, 0xd4, 0x0a, 0x67, 0xa1,
-	0x17, 0x23, 0x9b, 0x31, 0xd0, 0x01, 0x20, 0x41, 0xf0, 0x26, 0x37, 0x89, 0x3b, 0x59, 0x9d, 0xd7,
-	0xee, 0x15, 0x7f, 0xd8, 0x4d, 0x5e, 0x60, 0x5b, 0x1e, 0x33, 0xd6, 0x10, 0x37, 0x25, 0x74, 0x2f,
-	0x80, 0x7b, 0xd7, 0x80, 0xca, 0x9c, 0x48, 0x0b, 0x2d, 0x8e, 0x38, 0xf7, 0x66, 0x1e, 0x82, 0x64,
-	0xcf, 0x2d, 0xdf, 0x27, 0x9e, 0x9e, 0xd6, 0x65, 0xca, 0x60, 0x85, 0x71, 0xb1, 0x72, 0x3d, 0x47,
-	0x4f, 0x1b, 0x7d, 0x4c, 0xf6, 0xfe, 0x2e, 0x81, 0x72, 0x5d, 0x0e, 0xfe, 0x85, 0x6e, 0xc1, 0x85,
-	0xcd, 0xe2, 0x47, 0x32, 0x54, 0x56, 0xa1, 0x17, 0x5f, 0xc0, 0x8e, 0x2c, 0xd2, 0x73, 0xd7, 0x23,
-	0xa3, 0x1c, 0xa6, 0x09, 0xcd, 0xb2, 0xc2, 0xce, 0x53, 0xf7, 0x67, 0x72, 0xb8, 0xa6, 0x24, 0xe2,
-	0xb8, 0x56, 0x71, 0x91, 0x89, 0xba, 0x90, 0xef, 0x3c, 0xf1, 0xdb, 0xcd, 0xb3, 0xf2, 0xc3, 0xa3,
-	0x51, 0x1c, 0x1e, 0x79, 0x9c, 0xb7, 0x37, 0x70, 0x7e, 0x57, 0x82, 0xd6, 0xcc, 0x3f, 0x5f, 0x85,
-	0x1e, 0x71, 0xde, 0xb8, 0xfe, 0x65, 0xe2, 0x7c, 0x29, 0x73, 0x7e, 0x17, 0x6a, 0xd4, 0xa5, 0x5e,
-	0x52, 0x4b, 0x82, 0x60, 0x0e, 0x39, 0x84, 0xd5, 0xcd, 0x92, 0xcd, 0x92, 0x38, 0xd8, 0x3c, 0x0b,
-	0x7d, 0x04, 0xb7, 0xe8, 0x7c, 0xb5, 0x38, 0xf3, 0x2d, 0xd7, 0x33, 0x13, 0xd7, 0x44, 0x27, 0x93,
-	0xd3, 0x1f, 0x93, 0x74, 0x56, 0xef, 0x64, 0xc2, 0x62, 0xe2, 0x8a, 0xd1, 0xda, 0x49, 0xd9, 0x6f,
-	0xf9, 0xe8, 0xfd, 0x3f, 0x64, 0xca, 0x66, 0x3c, 0x84, 0xc5, 0x80, 0xcd, 0x0c, 0x1c, 0x73, 0x76,
-	0xef, 0x9d, 0x04, 0xcd, 0x5c, 0x1f, 0xbf, 0xa6, 0x93, 0x15, 0x7a, 0x4e, 0x99, 0xff, 0xc9, 0xf5,
-	0x9c, 0x64, 0x88, 0x55, 0x72, 0x43, 0xec, 0x31, 0x34, 0x43, 0x12, 0x2d, 0x03, 0x3f, 0x22, 0x26,
-	0x0d, 0xe2, 0x84, 0x42, 0xc2, 0x32, 0x02, 0xb6, 0x4f, 0x10, 0x3f, 0x32, 0xf9, 0x13, 0x8a, 0xfb,
-	0x0f, 0xf1, 0x23, 0x9e, 0xed, 0xdc, 0x28, 0xa8, 0x17, 0x46, 0xc1, 0x66, 0x57, 0x6f, 0x7c, 0xf0,
-	0x2c, 0xdb, 0xfe, 0x90, 0x59, 0x86, 0x5e, 0x40, 0x23, 0x12, 0x1b, 0x99, 0x22, 0xf1, 0xf6, 0xa6,
-	0x64, 0x06, 0x8a, 0xab, 0xda, 0xf1, 0x16, 0x4e, 0x44, 0xd1, 0x01, 0xd4, 0xf8, 0xaa, 0xa3, 0x00,
-	0xd7, 0xb9, 0xbb, 0xb1, 0x63, 0x65, 0x1a, 0x42, 0x8c, 0xc9, 0x5b, 0x6c, 0xe1, 0x50, 0x9a, 0x9b,
-	0xf2, 0xf9, 0x45, 0x86, 0xc9, 0x73, 0x31, 0xf4, 0x08, 0x24, 0x3b, 0x58, 0x2c, 0x56, 0xbe, 0x4b,
-	0xd7, 0x4a, 0x8b, 0xd5, 0xce, 0xf1, 0x16, 0xce, 0x58, 0xa8, 0x0f, 0x3b, 0x8e, 0x78, 0xb4, 0xc9,
-	0x1a, 0xaa, 0xd8, 0x9b, 0xde, 0x17, 0x5f, 0xf5, 0xf1, 0x16, 0xee, 0x38, 0xc5, 0xc9, 0x94, 0x8e,
-	0xd9, 0x76, 0x7e, 0xcc, 0x3e, 0x81, 0x96, 0xe3, 0x46, 0x4b, 0xcf, 0x5a, 0x8b, 0x44, 0x76, 0xe2,
-	0x0a, 0x17, 0x3c, 0x9e, 0xcc, 0x25, 0x74, 0xe3, 0xb5, 0xd6, 0x0c, 0xc9, 0xf7, 0x2b, 0x12, 0x51,
-	0x73, 0x19, 0x06, 0x4b, 0xeb, 0xc2, 0x62, 0x23, 0x36, 0xa2, 0x16, 0x25, 0xca, 0x0e, 0x77, 0xe7,
-	0x59, 0x2e, 0x1b, 0x42, 0x03, 0x0b, 0x85, 0x49, 0x2a, 0x3f, 0x65, 0xe2, 0x78, 0xcf, 0xbe, 0xe9,
-	0x37, 0xfa, 0x12, 0x3a, 0xab, 0xf8, 0xb5, 0x9a, 0x9e, 0xeb, 0x5f, 0x46, 0x8a, 0xcc, 0x07, 0x49,
-	0x0e, 0xc8, 0xfc, 0x6b, 0xc6, 0xed, 0x55, 0x8e, 0x8a, 0x7a, 0xbf, 0x55, 0xa0, 0xd9, 0x2f, 0xf4,
-	0x8c, 0xdd, 0x64, 0xe5, 0xeb, 0x8f, 0x47, 0x86, 0x36, 0x32, 0x92, 0xa5, 0xaf, 0x03, 0x60, 0x68,
-	0xdf, 0x1a, 0xe6, 0xe4, 0x8d, 0xaa, 0x8f, 0xe4, 0x12, 0x6a, 0x42, 0x63, 0x6a, 0xe8, 0xfd, 0xd7,
-	0x1a, 0x96, 0xcb, 0x08, 0xa0, 0x3e, 0x35, 0x54, 0x63, 0x36, 0x95, 0x2b, 0x48, 0x82, 0x9a, 0x36,
-	0x1c, 0x7f, 0xad, 0xcb, 0x55, 0x74, 0x0f, 0x6e, 0x1b, 0x58, 0x1d, 0x4d, 0xd5, 0xbe, 0xa1, 0x8f,
-	0x99, 0xc5, 0xe1, 0x50, 0x1d, 0x0d, 0xe4, 0x1a, 0xda, 0x87, 0xa7, 0xd3, 0xd3, 0xa9, 0xa1, 0x0d,
-	0xcd, 0xa1, 0x36, 0x9d, 0xaa, 0x47, 0x5a, 0x7a, 0xdb, 0x04, 0xeb, 0x27, 0xaa, 0xa1, 0x99, 0x47,
-	0x78, 0x3c, 0x9b, 0xc8, 0x75, 0x66, 0x4d, 0x1f, 0xaa, 0x47, 0x9a, 0xdc, 0x60, 0x47, 0xbe, 0x86,
-	0xca, 0xdb, 0xa8, 0x0d, 0x12, 0x33, 0x36, 0x1b, 0xe9, 0xc6, 0xa9, 0x2c, 0xb1, 0x45, 0x75, 0xc3,
-	0xdc, 0x91, 0x3a, 0x91, 0x01, 0xdd, 0x86, 0x1d, 0x66, 0x57, 0xed, 0x1b, 0x26, 0xd6, 0xbe, 0x99,
-	0x69, 0x53, 0x43, 0x6e, 0x32, 0xe6, 0x40, 0x9f, 0xf6, 0xc7, 0x78, 0x90, 0x48, 0xcb, 0x2d, 0x74,
-	0x1f, 0xee, 0xe8, 0x03, 0x6d, 0x64, 0xe8, 0xc6, 0xa9, 0x79, 0xa2, 0x61, 0xfd, 0x95, 0xde, 0x57,
-	0x99, 0xcf, 0x72, 0x1b, 0x3d, 0x81, 0xbd, 0x0d, 0xe3, 0x13, 0x7d, 0x34, 0xd2, 0x32, 0xed, 0x0e,
-	0x7a, 0x0a, 0xdd, 0x0d, 0x91, 0xe1, 0xcc, 0x98, 0xa9, 0x6f, 0x4c, 0xed, 0x84, 0xc5, 0x34, 0xd5,
-	0x46, 0x86, 0xbc, 0x73, 0x45, 0xd0, 0x05, 0x29, 0xb5, 0xdf, 0xd7, 0x26, 0x86, 0x36, 0x90, 0x65,
-	0xf4, 0x0c, 0xfe, 0x77, 0x93, 0x24, 0xd6, 0x86, 0xe3, 0x13, 0x6d, 0x20, 0xdf, 0x3a, 0x94, 0xd2,
-	0x16, 0x7f, 0xd8, 0xfe, 0xae, 0x79, 0xf0, 0xc9, 0x17, 0x49, 0xd2, 0xcf, 0xea, 0xfc, 0xf4, 0xe9,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xb5, 0x91, 0x0c, 0x92, 0x0d, 0x00, 0x00,
+	// 1503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0xb7, 0xfe, 0x8b, 0x43, 0x49, 0x66, 0xd6, 0x4e, 0xc2, 0x04, 0x71, 0xa2, 0xe8, 0x0b, 0x10,
+	0x7f, 0xc8, 0x07, 0x7f, 0x40, 0x9a, 0x16, 0x01, 0x8a, 0xa2, 0xa0, 0x25, 0xc6, 0x66, 0x63, 0xfd,
+	0xe9, 0x92, 0x72, 0xea, 0x5e, 0x08, 0x9a, 0x5c, 0x5b, 0x84, 0x29, 0x52, 0x25, 0x57, 0x6d, 0xd5,
+	0x7b, 0x5f, 0xa9, 0x87, 0x3e, 0x41, 0x0f, 0xbd, 0xf6, 0xd0, 0x17, 0xe8, 0xb9, 0x87, 0x3e, 0x40,
+	0xb1, 0xcb, 0xbf, 0x52, 0x6d, 0xa7, 0xc8, 0x89, 0x3b, 0xb3, 0x33, 0xc3, 0x99, 0xdf, 0xcc, 0xce,
+	0x0c, 0x20, 0x7b, 0x66, 0x51, 0x73, 0x4e, 0xa2, 0xc8, 0xba, 0x24, 0x07, 0x8b, 0x30, 0xa0, 0x01,
+	0x6a, 0xf2, 0xcf, 0xf9, 0xf2, 0xe2, 0xa1, 0x48, 0xfc, 0xe5, 0x3c, 0x8a, 0xd9, 0x0f, 0xdb, 0x76,
+	0xe0, 0x53, 0xcb, 0xa6, 0x09, 0x29, 0x2e, 0x02, 0xcf, 0x4b, 0xee, 0x7a, 0xaf, 0xa1, 0xa3, 0x53,
+	0xd7, 0xbe, 0x22, 0xe1, 0x30, 0x36, 0x85, 0x10, 0x54, 0x67, 0x56, 0x34, 0x93, 0x4b, 0xdd, 0xd2,
+	0xbe, 0x80, 0xf9, 0x99, 0xf1, 0x16, 0x96, 0x7d, 0x25, 0x97, 0xbb, 0xa5, 0xfd, 0x1a, 0xe6, 0xe7,
+	0xde, 0x2f, 0x25, 0x68, 0x69, 0x73, 0xeb, 0x92, 0xa4, 0x8a, 0x32, 0x34, 0x16, 0xd6, 0xca, 0x0b,
+	0x2c, 0x87, 0xeb, 0xb6, 0x70, 0x4a, 0xa2, 0xe7, 0x50, 0xa5, 0xab, 0x05, 0xe1, 0xea, 0x9d, 0x97,
+	0x3b, 0x07, 0xa9, 0x9b, 0x07, 0x5c, 0xdf, 0x58, 0x2d, 0x08, 0xe6, 0x02, 0xe8, 0x01, 0x34, 0x2d,
+	0xef, 0x7c, 0x39, 0x37, 0x5d, 0x47, 0xae, 0xf0, 0xff, 0x37, 0x38, 0xad, 0x39, 0x68, 0x17, 0x6a,
+	0xdf, 0xb9, 0x0e, 0x9d, 0xc9, 0xd5, 0x6e, 0x69, 0xbf, 0x8d, 0x63, 0x02, 0xdd, 0x83, 0xfa, 0x8c,
+	0xb8, 0x97, 0x33, 0x2a, 0xd7, 0x38, 0x3b, 0xa1, 0xd0, 0xff, 0x00, 0x25, 0x86, 0xd8, 0x1f, 0x22,
+	0xd3, 0x0e, 0x96, 0x3e, 0x95, 0xeb, 0x5c, 0x46, 0x8a, 0x4d, 0xf2, 0x8b, 0x3e, 0xe3, 0xf7, 0x7e,
+	0x2a, 0x41, 0x4b, 0x59, 0x3a, 0x6e, 0xf0, 0xfe, 0x50, 0x5e, 0xad, 0x85, 0xd2, 0xcd, 0x43, 0x29,
+	0xea, 0xc7, 0x44, 0x21, 0xae, 0x27, 0x20, 0x3a, 0xcb, 0xd0, 0xa2, 0x6e, 0xe0, 0x9b, 0xf3, 0x88,
+	0x87, 0x56, 0xc5, 0x90, 0xb2, 0x86, 0x51, 0xef, 0x63, 0x10, 0x32, 0x1d, 0x74, 0x0f, 0xd0, 0x74,
+	0xf4, 0x76, 0x34, 0x7e, 0x37, 0x32, 0x95, 0xe9, 0x40, 0x1b, 0x9b, 0xc6, 0xd9, 0x44, 0x95, 0xb6,
+	0x50, 0x03, 0x2a, 0x8a, 0xd2, 0x97, 0x4a, 0xfc, 0x30, 0xc4, 0x52, 0xb9, 0xf7, 0x63, 0x19, 0x44,
+	0xd5, 0x71, 0x69, 0xea, 0xf7, 0x2e, 0xd4, 0x6c, 0x2f, 0xb0, 0xaf, 0xb8, 0xd7, 0x55, 0x1c, 0x13,
+	0x2c, 0x7b, 0x94, 0x7c, 0x4f, 0xb9, 0xcf, 0x02, 0xe6, 0x67, 0x74, 0x1f, 0x1a, 0xbc, 0x80, 0x32,
+	0xa0, 0xeb, 0x8c, 0xd4, 0x1c, 0xb4, 0x07, 0x90, 0x14, 0x15, 0xbb, 0xab, 0xf2, 0x3b, 0x21, 0xe1,
+	0xc4, 0x69, 0xb8, 0x0c, 0x2d, 0x3f, 0xc6, 0xbb, 0x85, 0x63, 0x02, 0xbd, 0x86, 0x56, 0xaa, 0xc4,
+	0xd1, 0xa9, 0x73, 0x74, 0xee, 0xe6, 0xe8, 0x24, 0x0e, 0x72, 0x48, 0xc4, 0x79, 0x4e, 0xa0, 0x01,
+	0xb4, 0x58, 0x75, 0x12, 0x9f, 0xc6, 0x9a, 0x0d, 0xae, 0xf9, 0x34, 0xd7, 0xec, 0xcf, 0xac, 0x34,
+	0xbc, 0x83, 0x7e, 0x2c, 0x19, 0x5b, 0xb1, 0x73, 0xa2, 0xf7, 0x6b, 0x09, 0xda, 0x03, 0xe2, 0x11,
+	0x4a, 0x6e, 0x47, 0xa2, 0x10, 0x75, 0xf9, 0x96, 0xa8, 0x2b, 0x37, 0x46, 0x5d, 0xbd, 0x2d, 0xea,
+	0xda, 0xbf, 0x8e, 0x7a, 0x0f, 0xc0, 0xe1, 0xee, 0x3a, 0xe6, 0xf9, 0x8a, 0xa3, 0x25, 0x60, 0x21,
+	0xe1, 0x1c, 0xae, 0x7a, 0x1a, 0xa0, 0x38, 0x9a, 0x37, 0x41, 0x38, 0x7c, 0x4f, 0x48, 0xeb, 0x9e,
+	0x97, 0x37, 0x3c, 0xef, 0xfd, 0x56, 0x86, 0xce, 0xc0, 0x8d, 0xec, 0x20, 0x74, 0x52, 0x3b, 0x1d,
+	0x28, 0xbb, 0x4e, 0xf2, 0xbc, 0xcb, 0xae, 0xc3, 0xcb, 0x23, 0x2d, 0x69, 0x21, 0x29, 0xd8, 0x47,
+	0x20, 0x50, 0x77, 0x4e, 0x22, 0x6a, 0xcd, 0x17, 0x29, 0x1c, 0x19, 0x03, 0xed, 0xc3, 0x76, 0x46,
+	0xb0, 0xf2, 0x23, 0x69, 0xa1, 0x6c, 0xb2, 0xd9, 0x43, 0x4a, 0xf2, 0xc4, 0xd1, 0x11, 0x70, 0x4a,
+	0xa2, 0x4f, 0xa0, 0x6e, 0x2d, 0xe9, 0x2c, 0x08, 0x79, 0xf8, 0xe2, 0xcb, 0xc7, 0x39, 0x6c, 0xeb,
+	0xfe, 0x2a, 0x5c, 0x0a, 0x27, 0xd2, 0xe8, 0x73, 0x10, 0x42, 0x72, 0x41, 0x42, 0xe2, 0xdb, 0x71,
+	0xb5, 0x88, 0xc5, 0x6a, 0x59, 0x57, 0xc5, 0xa9, 0x20, 0xce, 0x75, 0xd0, 0x00, 0x44, 0x8b, 0x52,
+	0xcb, 0x9e, 0xcd, 0x89, 0x4f, 0x23, 0xb9, 0xd9, 0xad, 0xec, 0x8b, 0x2f, 0x7b, 0x37, 0xfe, 0x3d,
+	0x13, 0xc5, 0x45, 0xb5, 0xde, 0x1f, 0x25, 0xd8, 0xbd, 0xce, 0xcf, 0xeb, 0xd0, 0xf5, 0xad, 0x79,
+	0x86, 0x2e, 0x3b, 0xa3, 0x67, 0xd0, 0x76, 0xdc, 0xc8, 0x0e, 0xdd, 0xb9, 0xeb, 0x5b, 0x34, 0x08,
+	0x13, 0x84, 0xd7, 0x99, 0xe8, 0x21, 0x34, 0x7d, 0xd7, 0xbe, 0xe2, 0xda, 0x31, 0xbc, 0x19, 0xcd,
+	0xf2, 0x63, 0x7d, 0x6b, 0x51, 0x2b, 0x9c, 0x86, 0x5e, 0x82, 0x6c, 0xce, 0x40, 0x07, 0x80, 0x62,
+	0x82, 0x37, 0xb9, 0x49, 0xd2, 0xc9, 0xea, 0xbc, 0x76, 0xaf, 0xb9, 0x61, 0x7f, 0xf2, 0x02, 0xdb,
+	0xf2, 0x98, 0xb1, 0x46, 0xfc, 0xa7, 0x94, 0xee, 0x05, 0x70, 0xff, 0x06, 0x50, 0x99, 0x13, 0x59,
+	0xa1, 0x25, 0x11, 0x17, 0xde, 0xcc, 0x23, 0x10, 0xec, 0x99, 0xe5, 0xfb, 0xc4, 0xd3, 0xb2, 0xba,
+	0xcc, 0x18, 0xac, 0x30, 0x2e, 0x97, 0xae, 0xe7, 0x68, 0x59, 0xa3, 0x4f, 0xc8, 0xde, 0x5f, 0x25,
+	0x90, 0x6f, 0xca, 0xc1, 0x3f, 0xd0, 0x5d, 0x73, 0x61, 0xb3, 0xf8, 0x91, 0x04, 0x95, 0x65, 0xe8,
+	0x25, 0x3f, 0x60, 0x47, 0x16, 0xe9, 0x85, 0xeb, 0x91, 0x51, 0x01, 0xd3, 0x94, 0x66, 0x59, 0x61,
+	0x67, 0xdd, 0xfd, 0x81, 0x1c, 0xae, 0x28, 0x89, 0x38, 0xae, 0x55, 0xbc, 0xce, 0x44, 0x5d, 0x28,
+	0x76, 0x9e, 0xe4, 0xed, 0x16, 0x59, 0xc5, 0xe1, 0xd1, 0x58, 0x1f, 0x1e, 0x45, 0x9c, 0x9b, 0x1b,
+	0x38, 0xff, 0x5e, 0x82, 0xd6, 0xd4, 0xbf, 0x58, 0x86, 0x1e, 0x71, 0x4e, 0x5c, 0xff, 0x2a, 0x75,
+	0xbe, 0x94, 0x3b, 0xbf, 0x0b, 0x35, 0xea, 0x52, 0x2f, 0xad, 0xa5, 0x98, 0x60, 0x0e, 0x39, 0x84,
+	0xd5, 0xcd, 0x82, 0xcd, 0x92, 0x24, 0xd8, 0x22, 0x0b, 0xbd, 0x80, 0x3b, 0x74, 0xb6, 0x9c, 0x9f,
+	0xfb, 0x96, 0xeb, 0x99, 0xa9, 0x6b, 0x71, 0x27, 0x93, 0xb2, 0x8b, 0x49, 0x36, 0xab, 0xb7, 0x73,
+	0xe1, 0x78, 0xe2, 0xc6, 0xa3, 0xb5, 0x93, 0xb1, 0xdf, 0xf1, 0xd1, 0xfb, 0x5f, 0xc8, 0x95, 0xcd,
+	0x64, 0x08, 0xc7, 0x03, 0x36, 0x37, 0x70, 0xcc, 0xd9, 0xbd, 0x9f, 0x01, 0xc4, 0x42, 0x1f, 0xbf,
+	0xa1, 0x93, 0xad, 0xf5, 0x9c, 0x32, 0xbf, 0x29, 0xf4, 0x9c, 0x74, 0x88, 0x55, 0x0a, 0x43, 0xec,
+	0x09, 0x88, 0x21, 0x89, 0x16, 0x81, 0x1f, 0x11, 0x93, 0x06, 0x49, 0x42, 0x21, 0x65, 0x19, 0x01,
+	0xdb, 0x27, 0x88, 0x1f, 0x99, 0xfc, 0x09, 0x25, 0xfd, 0x87, 0xf8, 0x11, 0xcf, 0x76, 0x61, 0x14,
+	0xd4, 0xd7, 0x46, 0xc1, 0x66, 0x57, 0x6f, 0x7c, 0xf0, 0x2c, 0x6b, 0x7e, 0xc8, 0x2c, 0x43, 0xaf,
+	0xa0, 0x11, 0xc5, 0x1b, 0x99, 0x2c, 0xf0, 0xf6, 0x26, 0xe7, 0x06, 0xd6, 0x57, 0xb5, 0xe3, 0x2d,
+	0x9c, 0x8a, 0xa2, 0x03, 0xa8, 0xf1, 0x55, 0x47, 0x06, 0xae, 0x73, 0x6f, 0x63, 0xc7, 0xca, 0x35,
+	0x62, 0x31, 0x26, 0x6f, 0xb1, 0x85, 0x43, 0x16, 0x37, 0xe5, 0x8b, 0x8b, 0x0c, 0x93, 0xe7, 0x62,
+	0xe8, 0x31, 0x08, 0x76, 0x30, 0x9f, 0x2f, 0x7d, 0x97, 0xae, 0xe4, 0x16, 0xab, 0x9d, 0xe3, 0x2d,
+	0x9c, 0xb3, 0x50, 0x1f, 0xb6, 0x9d, 0xf8, 0xd1, 0xa6, 0x3b, 0xa9, 0x6c, 0x6f, 0x7a, 0xbf, 0xfe,
+	0xaa, 0x8f, 0xb7, 0x70, 0xc7, 0x59, 0x9f, 0x4c, 0x2f, 0xa0, 0xca, 0x76, 0x53, 0xf9, 0x0e, 0xd7,
+	0x2c, 0x40, 0x3e, 0x09, 0x3c, 0x2f, 0x57, 0xe3, 0x42, 0xf9, 0x4c, 0x6e, 0x17, 0x67, 0xf2, 0x53,
+	0x68, 0x39, 0x6e, 0xb4, 0xf0, 0xac, 0x55, 0x9c, 0xf5, 0x4e, 0xf2, 0x1c, 0x62, 0x1e, 0xcf, 0xfc,
+	0x02, 0xba, 0xc9, 0x42, 0x6c, 0x86, 0xe4, 0x9b, 0x25, 0x89, 0xa8, 0xb9, 0x08, 0x83, 0x85, 0x75,
+	0x69, 0xb1, 0x79, 0x1c, 0x51, 0x8b, 0x12, 0x79, 0x9b, 0x7b, 0xf0, 0xbc, 0x90, 0xba, 0x58, 0x03,
+	0xc7, 0x0a, 0x93, 0x4c, 0x5e, 0x67, 0xe2, 0x78, 0xcf, 0xbe, 0xed, 0x1a, 0x7d, 0x06, 0x9d, 0x65,
+	0xf2, 0xb4, 0x4d, 0xcf, 0xf5, 0xaf, 0x22, 0x59, 0xe2, 0x53, 0xa7, 0x80, 0x7a, 0xf1, 0xe9, 0xe3,
+	0xf6, 0xb2, 0x40, 0x45, 0xbd, 0x3f, 0x2b, 0x20, 0xf6, 0xd7, 0x1a, 0xcc, 0x6e, 0xba, 0x1f, 0xf6,
+	0xc7, 0x23, 0x43, 0x1d, 0x19, 0xe9, 0x86, 0xd8, 0x01, 0x30, 0xd4, 0xaf, 0x0c, 0x73, 0x72, 0xa2,
+	0x68, 0x23, 0xa9, 0x84, 0x44, 0x68, 0xe8, 0x86, 0xd6, 0x7f, 0xab, 0x62, 0xa9, 0x8c, 0x00, 0xea,
+	0xba, 0xa1, 0x18, 0x53, 0x5d, 0xaa, 0x20, 0x01, 0x6a, 0xea, 0x70, 0xfc, 0x85, 0x26, 0x55, 0xd1,
+	0x7d, 0xd8, 0x31, 0xb0, 0x32, 0xd2, 0x95, 0xbe, 0xa1, 0x8d, 0x99, 0xc5, 0xe1, 0x50, 0x19, 0x0d,
+	0xa4, 0x1a, 0xda, 0x87, 0x67, 0xfa, 0x99, 0x6e, 0xa8, 0x43, 0x73, 0xa8, 0xea, 0xba, 0x72, 0xa4,
+	0x66, 0x7f, 0x9b, 0x60, 0xed, 0x54, 0x31, 0x54, 0xf3, 0x08, 0x8f, 0xa7, 0x13, 0xa9, 0xce, 0xac,
+	0x69, 0x43, 0xe5, 0x48, 0x95, 0x1a, 0xec, 0xc8, 0x77, 0x56, 0xa9, 0x89, 0xda, 0x20, 0x30, 0x63,
+	0xd3, 0x91, 0x66, 0x9c, 0x49, 0x02, 0xdb, 0x6a, 0x37, 0xcc, 0x1d, 0x29, 0x13, 0x09, 0xd0, 0x0e,
+	0x6c, 0x33, 0xbb, 0x4a, 0xdf, 0x30, 0xb1, 0xfa, 0xe5, 0x54, 0xd5, 0x0d, 0x49, 0x64, 0xcc, 0x81,
+	0xa6, 0xf7, 0xc7, 0x78, 0x90, 0x4a, 0x4b, 0x2d, 0xf4, 0x00, 0xee, 0x6a, 0x03, 0x75, 0x64, 0x68,
+	0xc6, 0x99, 0x79, 0xaa, 0x62, 0xed, 0x8d, 0xd6, 0x57, 0x98, 0xcf, 0x52, 0x1b, 0x3d, 0x85, 0xbd,
+	0x0d, 0xe3, 0x13, 0x6d, 0x34, 0x52, 0x73, 0xed, 0x0e, 0x7a, 0x06, 0xdd, 0x0d, 0x91, 0xe1, 0xd4,
+	0x98, 0x2a, 0x27, 0xa6, 0x7a, 0xca, 0x62, 0xd2, 0xd5, 0x91, 0x21, 0x6d, 0x5f, 0x13, 0xf4, 0x9a,
+	0x94, 0xd2, 0xef, 0xab, 0x13, 0x43, 0x1d, 0x48, 0x12, 0x7a, 0x0e, 0xff, 0xb9, 0x4d, 0x12, 0xab,
+	0xc3, 0xf1, 0xa9, 0x3a, 0x90, 0xee, 0x5c, 0x63, 0x72, 0xa0, 0xe9, 0xca, 0x64, 0xa2, 0x2a, 0x58,
+	0x1b, 0x1d, 0xa5, 0x4c, 0x5d, 0x42, 0xa8, 0x09, 0xd5, 0xc9, 0xf8, 0xe4, 0x44, 0xda, 0x39, 0x14,
+	0xb2, 0x19, 0x72, 0xd8, 0xfe, 0x5a, 0x3c, 0xf8, 0xff, 0xa7, 0x69, 0xa1, 0x9c, 0xd7, 0xf9, 0xe9,
+	0xa3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x35, 0xc3, 0x5d, 0x00, 0x0e, 0x00, 0x00,
 }
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: chat_receipts.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// TypingIndicator is sent ephemerally while composing a message,
+// it's not stored by mailservers
+type TypingIndicator struct {
+	Clock       uint64      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId      string      `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageType MessageType `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	// Whether the user started or stopped typing
+	Typing               bool     `protobuf:"varint,4,opt,name=typing,proto3" json:"typing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TypingIndicator) Reset()         { *m = TypingIndicator{} }
+func (m *TypingIndicator) String() string { return proto.CompactTextString(m) }
+func (*TypingIndicator) ProtoMessage()    {}
+func (*TypingIndicator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d8324b8a3b47851, []int{0}
+}
+
+func (m *TypingIndicator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypingIndicator.Unmarshal(m, b)
+}
+func (m *TypingIndicator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypingIndicator.Marshal(b, m, deterministic)
+}
+func (m *TypingIndicator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypingIndicator.Merge(m, src)
+}
+func (m *TypingIndicator) XXX_Size() int {
+	return xxx_messageInfo_TypingIndicator.Size(m)
+}
+func (m *TypingIndicator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypingIndicator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypingIndicator proto.InternalMessageInfo
+
+func (m *TypingIndicator) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *TypingIndicator) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *TypingIndicator) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *TypingIndicator) GetTyping() bool {
+	if m != nil {
+		return m.Typing
+	}
+	return false
+}
+
+type ReadReceipt struct {
+	Clock       uint64      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId      string      `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageType MessageType `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	// Ids of the messages that have been read
+	MessageIds []string `protobuf:"bytes,4,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	// All the messages up to this clock value have been read, 0 if only message_ids
+	ReadClock            uint64   `protobuf:"varint,5,opt,name=read_clock,json=readClock,proto3" json:"read_clock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadReceipt) Reset()         { *m = ReadReceipt{} }
+func (m *ReadReceipt) String() string { return proto.CompactTextString(m) }
+func (*ReadReceipt) ProtoMessage()    {}
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d8324b8a3b47851, []int{1}
+}
+
+func (m *ReadReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadReceipt.Unmarshal(m, b)
+}
+func (m *ReadReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadReceipt.Marshal(b, m, deterministic)
+}
+func (m *ReadReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadReceipt.Merge(m, src)
+}
+func (m *ReadReceipt) XXX_Size() int {
+	return xxx_messageInfo_ReadReceipt.Size(m)
+}
+func (m *ReadReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadReceipt proto.InternalMessageInfo
+
+func (m *ReadReceipt) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ReadReceipt) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *ReadReceipt) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *ReadReceipt) GetMessageIds() []string {
+	if m != nil {
+		return m.MessageIds
+	}
+	return nil
+}
+
+func (m *ReadReceipt) GetReadClock() uint64 {
+	if m != nil {
+		return m.ReadClock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TypingIndicator)(nil), "protobuf.TypingIndicator")
+	proto.RegisterType((*ReadReceipt)(nil), "protobuf.ReadReceipt")
+}
+
+func init() {
+	proto.RegisterFile("chat_receipts.proto", fileDescriptor_8d8324b8a3b47851)
+}
+
+var fileDescriptor_8d8324b8a3b47851 = []byte{
+	// 243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0xce, 0x48, 0x2c,
+	0x89, 0x2f, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x28, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0xe2, 0x00, 0x53, 0x49, 0xa5, 0x69, 0x52, 0xdc, 0xa9, 0x79, 0xa5, 0xb9, 0x50, 0x61, 0xa5, 0x49,
+	0x8c, 0x5c, 0xfc, 0x21, 0x95, 0x05, 0x99, 0x79, 0xe9, 0x9e, 0x79, 0x29, 0x99, 0xc9, 0x89, 0x25,
+	0xf9, 0x45, 0x42, 0x22, 0x5c, 0xac, 0xc9, 0x39, 0xf9, 0xc9, 0xd9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x2c, 0x41, 0x10, 0x8e, 0x90, 0x38, 0x17, 0x3b, 0xd8, 0xdc, 0xcc, 0x14, 0x09, 0x26, 0x05, 0x46,
+	0x0d, 0xce, 0x20, 0x36, 0x10, 0xd7, 0x33, 0x45, 0xc8, 0x82, 0x8b, 0x27, 0x37, 0xb5, 0xb8, 0x38,
+	0x31, 0x3d, 0x35, 0xbe, 0xa4, 0xb2, 0x20, 0x55, 0x82, 0x59, 0x81, 0x51, 0x83, 0xcf, 0x48, 0x54,
+	0x0f, 0x66, 0xa1, 0x9e, 0x2f, 0x44, 0x36, 0xa4, 0xb2, 0x20, 0x35, 0x88, 0x3b, 0x17, 0xc1, 0x11,
+	0x12, 0xe3, 0x62, 0x2b, 0x01, 0xdb, 0x2d, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x11, 0x04, 0xe5, 0x29,
+	0x6d, 0x63, 0xe4, 0xe2, 0x0e, 0x4a, 0x4d, 0x4c, 0x09, 0x82, 0x78, 0x81, 0x7e, 0x0e, 0x92, 0xe7,
+	0x82, 0x71, 0xe3, 0x33, 0x53, 0x8a, 0x25, 0x58, 0x14, 0x98, 0x35, 0x38, 0x83, 0xb8, 0xa0, 0x42,
+	0x9e, 0x29, 0xc5, 0x42, 0xb2, 0x5c, 0x5c, 0x45, 0xa9, 0x89, 0x29, 0xf1, 0x10, 0xe7, 0xb0, 0x82,
+	0x9d, 0xc3, 0x09, 0x12, 0x71, 0x06, 0x09, 0x38, 0xf1, 0x46, 0x71, 0xeb, 0xe9, 0x5b, 0xc3, 0xec,
+	0x49, 0x62, 0x03, 0xb3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xa9, 0xdb, 0xca, 0x91,
+	0x01, 0x00, 0x00,
+}
@@ -54,6 +54,46 @@ func (CommunityMember_Roles) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f937943d74c1cd8b, []int{1, 0}
 }
 
+type CommunityRole_Capability int32
+
+const (
+	CommunityRole_UNKNOWN_CAPABILITY CommunityRole_Capability = 0
+	CommunityRole_PIN_MESSAGES       CommunityRole_Capability = 1
+	CommunityRole_DELETE_MESSAGES    CommunityRole_Capability = 2
+	CommunityRole_KICK_MEMBERS       CommunityRole_Capability = 3
+	CommunityRole_BAN_MEMBERS        CommunityRole_Capability = 4
+	CommunityRole_MANAGE_CHANNELS    CommunityRole_Capability = 5
+	CommunityRole_ACCEPT_REQUESTS    CommunityRole_Capability = 6
+)
+
+var CommunityRole_Capability_name = map[int32]string{
+	0: "UNKNOWN_CAPABILITY",
+	1: "PIN_MESSAGES",
+	2: "DELETE_MESSAGES",
+	3: "KICK_MEMBERS",
+	4: "BAN_MEMBERS",
+	5: "MANAGE_CHANNELS",
+	6: "ACCEPT_REQUESTS",
+}
+
+var CommunityRole_Capability_value = map[string]int32{
+	"UNKNOWN_CAPABILITY": 0,
+	"PIN_MESSAGES":       1,
+	"DELETE_MESSAGES":    2,
+	"KICK_MEMBERS":       3,
+	"BAN_MEMBERS":        4,
+	"MANAGE_CHANNELS":    5,
+	"ACCEPT_REQUESTS":    6,
+}
+
+func (x CommunityRole_Capability) String() string {
+	return proto.EnumName(CommunityRole_Capability_name, int32(x))
+}
+
+func (CommunityRole_Capability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{3, 0}
+}
+
 type CommunityPermissions_Access int32
 
 const (
@@ -82,7 +122,7 @@ func (x CommunityPermissions_Access) String() string {
 }
 
 func (CommunityPermissions_Access) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{3, 0}
+	return fileDescriptor_f937943d74c1cd8b, []int{5, 0}
 }
 
 type CommunityTokenPermission_Type int32
@@ -93,6 +133,7 @@ const (
 	CommunityTokenPermission_BECOME_MEMBER             CommunityTokenPermission_Type = 2
 	CommunityTokenPermission_CAN_VIEW_CHANNEL          CommunityTokenPermission_Type = 3
 	CommunityTokenPermission_CAN_VIEW_AND_POST_CHANNEL CommunityTokenPermission_Type = 4
+	CommunityTokenPermission_BECOME_ROLE               CommunityTokenPermission_Type = 5
 )
 
 var CommunityTokenPermission_Type_name = map[int32]string{
@@ -101,6 +142,7 @@ var CommunityTokenPermission_Type_name = map[int32]string{
 	2: "BECOME_MEMBER",
 	3: "CAN_VIEW_CHANNEL",
 	4: "CAN_VIEW_AND_POST_CHANNEL",
+	5: "BECOME_ROLE",
 }
 
 var CommunityTokenPermission_Type_value = map[string]int32{
@@ -109,6 +151,7 @@ var CommunityTokenPermission_Type_value = map[string]int32{
 	"BECOME_MEMBER":             2,
 	"CAN_VIEW_CHANNEL":          3,
 	"CAN_VIEW_AND_POST_CHANNEL": 4,
+	"BECOME_ROLE":               5,
 }
 
 func (x CommunityTokenPermission_Type) String() string {
@@ -116,7 +159,38 @@ func (x CommunityTokenPermission_Type) String() string {
 }
 
 func (CommunityTokenPermission_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{5, 0}
+	return fileDescriptor_f937943d74c1cd8b, []int{7, 0}
+}
+
+type CommunityCalendarEventRsvp_Response int32
+
+const (
+	CommunityCalendarEventRsvp_UNKNOWN_RESPONSE CommunityCalendarEventRsvp_Response = 0
+	CommunityCalendarEventRsvp_GOING            CommunityCalendarEventRsvp_Response = 1
+	CommunityCalendarEventRsvp_MAYBE            CommunityCalendarEventRsvp_Response = 2
+	CommunityCalendarEventRsvp_NOT_GOING        CommunityCalendarEventRsvp_Response = 3
+)
+
+var CommunityCalendarEventRsvp_Response_name = map[int32]string{
+	0: "UNKNOWN_RESPONSE",
+	1: "GOING",
+	2: "MAYBE",
+	3: "NOT_GOING",
+}
+
+var CommunityCalendarEventRsvp_Response_value = map[string]int32{
+	"UNKNOWN_RESPONSE": 0,
+	"GOING":            1,
+	"MAYBE":            2,
+	"NOT_GOING":        3,
+}
+
+func (x CommunityCalendarEventRsvp_Response) String() string {
+	return proto.EnumName(CommunityCalendarEventRsvp_Response_name, int32(x))
+}
+
+func (CommunityCalendarEventRsvp_Response) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{29, 0}
 }
 
 type Grant struct {
@@ -183,12 +257,18 @@ func (m *Grant) GetClock() uint64 {
 }
 
 type CommunityMember struct {
-	Roles                []CommunityMember_Roles `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=protobuf.CommunityMember_Roles" json:"roles,omitempty"`
-	RevealedAccounts     []*RevealedAccount      `protobuf:"bytes,2,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
-	LastUpdateClock      uint64                  `protobuf:"varint,3,opt,name=last_update_clock,json=lastUpdateClock,proto3" json:"last_update_clock,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Roles            []CommunityMember_Roles `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=protobuf.CommunityMember_Roles" json:"roles,omitempty"`
+	RevealedAccounts []*RevealedAccount      `protobuf:"bytes,2,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
+	LastUpdateClock  uint64                  `protobuf:"varint,3,opt,name=last_update_clock,json=lastUpdateClock,proto3" json:"last_update_clock,omitempty"`
+	// Unix time in seconds at which the member was added, zero for members added before it was recorded
+	JoinedAt uint64 `protobuf:"varint,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// IDs of the custom roles assigned to the member by the owner
+	RoleIds []string `protobuf:"bytes,5,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	// IDs of the custom roles granted to the member through token permissions
+	TokenRoleIds         []string `protobuf:"bytes,6,rep,name=token_role_ids,json=tokenRoleIds,proto3" json:"token_role_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommunityMember) Reset()         { *m = CommunityMember{} }
@@ -237,6 +317,138 @@ func (m *CommunityMember) GetLastUpdateClock() uint64 {
 	return 0
 }
 
+func (m *CommunityMember) GetJoinedAt() uint64 {
+	if m != nil {
+		return m.JoinedAt
+	}
+	return 0
+}
+
+func (m *CommunityMember) GetRoleIds() []string {
+	if m != nil {
+		return m.RoleIds
+	}
+	return nil
+}
+
+func (m *CommunityMember) GetTokenRoleIds() []string {
+	if m != nil {
+		return m.TokenRoleIds
+	}
+	return nil
+}
+
+// CommunityMemberRoles are the custom roles of a member in a single channel
+type CommunityMemberRoles struct {
+	RoleIds              []string `protobuf:"bytes,1,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	TokenRoleIds         []string `protobuf:"bytes,2,rep,name=token_role_ids,json=tokenRoleIds,proto3" json:"token_role_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMemberRoles) Reset()         { *m = CommunityMemberRoles{} }
+func (m *CommunityMemberRoles) String() string { return proto.CompactTextString(m) }
+func (*CommunityMemberRoles) ProtoMessage()    {}
+func (*CommunityMemberRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{2}
+}
+
+func (m *CommunityMemberRoles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMemberRoles.Unmarshal(m, b)
+}
+func (m *CommunityMemberRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMemberRoles.Marshal(b, m, deterministic)
+}
+func (m *CommunityMemberRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMemberRoles.Merge(m, src)
+}
+func (m *CommunityMemberRoles) XXX_Size() int {
+	return xxx_messageInfo_CommunityMemberRoles.Size(m)
+}
+func (m *CommunityMemberRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMemberRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMemberRoles proto.InternalMessageInfo
+
+func (m *CommunityMemberRoles) GetRoleIds() []string {
+	if m != nil {
+		return m.RoleIds
+	}
+	return nil
+}
+
+func (m *CommunityMemberRoles) GetTokenRoleIds() []string {
+	if m != nil {
+		return m.TokenRoleIds
+	}
+	return nil
+}
+
+type CommunityRole struct {
+	Id                   string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color                string                     `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Capabilities         []CommunityRole_Capability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=protobuf.CommunityRole_Capability" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CommunityRole) Reset()         { *m = CommunityRole{} }
+func (m *CommunityRole) String() string { return proto.CompactTextString(m) }
+func (*CommunityRole) ProtoMessage()    {}
+func (*CommunityRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{3}
+}
+
+func (m *CommunityRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityRole.Unmarshal(m, b)
+}
+func (m *CommunityRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityRole.Marshal(b, m, deterministic)
+}
+func (m *CommunityRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityRole.Merge(m, src)
+}
+func (m *CommunityRole) XXX_Size() int {
+	return xxx_messageInfo_CommunityRole.Size(m)
+}
+func (m *CommunityRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityRole proto.InternalMessageInfo
+
+func (m *CommunityRole) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityRole) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *CommunityRole) GetCapabilities() []CommunityRole_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type CommunityTokenMetadata struct {
 	ContractAddresses    map[uint64]string  `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Description          string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -254,7 +466,7 @@ func (m *CommunityTokenMetadata) Reset()         { *m = CommunityTokenMetadata{}
 func (m *CommunityTokenMetadata) String() string { return proto.CompactTextString(m) }
 func (*CommunityTokenMetadata) ProtoMessage()    {}
 func (*CommunityTokenMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{2}
+	return fileDescriptor_f937943d74c1cd8b, []int{4}
 }
 
 func (m *CommunityTokenMetadata) XXX_Unmarshal(b []byte) error {
@@ -338,7 +550,7 @@ func (m *CommunityPermissions) Reset()         { *m = CommunityPermissions{} }
 func (m *CommunityPermissions) String() string { return proto.CompactTextString(m) }
 func (*CommunityPermissions) ProtoMessage()    {}
 func (*CommunityPermissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{3}
+	return fileDescriptor_f937943d74c1cd8b, []int{5}
 }
 
 func (m *CommunityPermissions) XXX_Unmarshal(b []byte) error {
@@ -398,7 +610,7 @@ func (m *TokenCriteria) Reset()         { *m = TokenCriteria{} }
 func (m *TokenCriteria) String() string { return proto.CompactTextString(m) }
 func (*TokenCriteria) ProtoMessage()    {}
 func (*TokenCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{4}
+	return fileDescriptor_f937943d74c1cd8b, []int{6}
 }
 
 func (m *TokenCriteria) XXX_Unmarshal(b []byte) error {
@@ -476,21 +688,23 @@ func (m *TokenCriteria) GetDecimals() uint64 {
 }
 
 type CommunityTokenPermission struct {
-	Id                   string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                 CommunityTokenPermission_Type `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.CommunityTokenPermission_Type" json:"type,omitempty"`
-	TokenCriteria        []*TokenCriteria              `protobuf:"bytes,3,rep,name=token_criteria,json=tokenCriteria,proto3" json:"token_criteria,omitempty"`
-	ChatIds              []string                      `protobuf:"bytes,4,rep,name=chat_ids,json=chatIds,proto3" json:"chat_ids,omitempty"`
-	IsPrivate            bool                          `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	Id            string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          CommunityTokenPermission_Type `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.CommunityTokenPermission_Type" json:"type,omitempty"`
+	TokenCriteria []*TokenCriteria              `protobuf:"bytes,3,rep,name=token_criteria,json=tokenCriteria,proto3" json:"token_criteria,omitempty"`
+	ChatIds       []string                      `protobuf:"bytes,4,rep,name=chat_ids,json=chatIds,proto3" json:"chat_ids,omitempty"`
+	IsPrivate     bool                          `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	// ID of the custom role granted by a BECOME_ROLE permission, in the channels of chat_ids if any
+	RoleId               string   `protobuf:"bytes,6,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommunityTokenPermission) Reset()         { *m = CommunityTokenPermission{} }
 func (m *CommunityTokenPermission) String() string { return proto.CompactTextString(m) }
 func (*CommunityTokenPermission) ProtoMessage()    {}
 func (*CommunityTokenPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{5}
+	return fileDescriptor_f937943d74c1cd8b, []int{7}
 }
 
 func (m *CommunityTokenPermission) XXX_Unmarshal(b []byte) error {
@@ -546,6 +760,13 @@ func (m *CommunityTokenPermission) GetIsPrivate() bool {
 	return false
 }
 
+func (m *CommunityTokenPermission) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
 type CommunityDescription struct {
 	Clock                   uint64                               `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Members                 map[string]*CommunityMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -563,6 +784,8 @@ type CommunityDescription struct {
 	TokenPermissions        map[string]*CommunityTokenPermission `protobuf:"bytes,15,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CommunityTokensMetadata []*CommunityTokenMetadata            `protobuf:"bytes,16,rep,name=community_tokens_metadata,json=communityTokensMetadata,proto3" json:"community_tokens_metadata,omitempty"`
 	ActiveMembersCount      uint64                               `protobuf:"varint,17,opt,name=active_members_count,json=activeMembersCount,proto3" json:"active_members_count,omitempty"`
+	ModerationRules         *CommunityModerationRules            `protobuf:"bytes,18,opt,name=moderation_rules,json=moderationRules,proto3" json:"moderation_rules,omitempty"`
+	Roles                   map[string]*CommunityRole            `protobuf:"bytes,19,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral    struct{}                             `json:"-"`
 	XXX_unrecognized        []byte                               `json:"-"`
 	XXX_sizecache           int32                                `json:"-"`
@@ -572,7 +795,7 @@ func (m *CommunityDescription) Reset()         { *m = CommunityDescription{} }
 func (m *CommunityDescription) String() string { return proto.CompactTextString(m) }
 func (*CommunityDescription) ProtoMessage()    {}
 func (*CommunityDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{6}
+	return fileDescriptor_f937943d74c1cd8b, []int{8}
 }
 
 func (m *CommunityDescription) XXX_Unmarshal(b []byte) error {
@@ -705,6 +928,20 @@ func (m *CommunityDescription) GetActiveMembersCount() uint64 {
 	return 0
 }
 
+func (m *CommunityDescription) GetModerationRules() *CommunityModerationRules {
+	if m != nil {
+		return m.ModerationRules
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetRoles() map[string]*CommunityRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type CommunityAdminSettings struct {
 	PinMessageAllMembersEnabled bool     `protobuf:"varint,1,opt,name=pin_message_all_members_enabled,json=pinMessageAllMembersEnabled,proto3" json:"pin_message_all_members_enabled,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
@@ -716,7 +953,7 @@ func (m *CommunityAdminSettings) Reset()         { *m = CommunityAdminSettings{}
 func (m *CommunityAdminSettings) String() string { return proto.CompactTextString(m) }
 func (*CommunityAdminSettings) ProtoMessage()    {}
 func (*CommunityAdminSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{7}
+	return fileDescriptor_f937943d74c1cd8b, []int{9}
 }
 
 func (m *CommunityAdminSettings) XXX_Unmarshal(b []byte) error {
@@ -744,22 +981,102 @@ func (m *CommunityAdminSettings) GetPinMessageAllMembersEnabled() bool {
 	return false
 }
 
+type CommunityModerationRules struct {
+	// Words which can't be posted, matched case-insensitively on word boundaries
+	BannedWords []string `protobuf:"bytes,1,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`
+	// Regular expressions which posted text can't match
+	BannedPatterns []string `protobuf:"bytes,2,rep,name=banned_patterns,json=bannedPatterns,proto3" json:"banned_patterns,omitempty"`
+	// Maximum number of links in a message, zero for no limit
+	MaxLinks uint32 `protobuf:"varint,3,opt,name=max_links,json=maxLinks,proto3" json:"max_links,omitempty"`
+	// Maximum number of mentions in a message, zero for no limit
+	MaxMentions uint32 `protobuf:"varint,4,opt,name=max_mentions,json=maxMentions,proto3" json:"max_mentions,omitempty"`
+	// Number of seconds new members have to wait before posting
+	NewMemberDelaySeconds uint32   `protobuf:"varint,5,opt,name=new_member_delay_seconds,json=newMemberDelaySeconds,proto3" json:"new_member_delay_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *CommunityModerationRules) Reset()         { *m = CommunityModerationRules{} }
+func (m *CommunityModerationRules) String() string { return proto.CompactTextString(m) }
+func (*CommunityModerationRules) ProtoMessage()    {}
+func (*CommunityModerationRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{10}
+}
+
+func (m *CommunityModerationRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityModerationRules.Unmarshal(m, b)
+}
+func (m *CommunityModerationRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityModerationRules.Marshal(b, m, deterministic)
+}
+func (m *CommunityModerationRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityModerationRules.Merge(m, src)
+}
+func (m *CommunityModerationRules) XXX_Size() int {
+	return xxx_messageInfo_CommunityModerationRules.Size(m)
+}
+func (m *CommunityModerationRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityModerationRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityModerationRules proto.InternalMessageInfo
+
+func (m *CommunityModerationRules) GetBannedWords() []string {
+	if m != nil {
+		return m.BannedWords
+	}
+	return nil
+}
+
+func (m *CommunityModerationRules) GetBannedPatterns() []string {
+	if m != nil {
+		return m.BannedPatterns
+	}
+	return nil
+}
+
+func (m *CommunityModerationRules) GetMaxLinks() uint32 {
+	if m != nil {
+		return m.MaxLinks
+	}
+	return 0
+}
+
+func (m *CommunityModerationRules) GetMaxMentions() uint32 {
+	if m != nil {
+		return m.MaxMentions
+	}
+	return 0
+}
+
+func (m *CommunityModerationRules) GetNewMemberDelaySeconds() uint32 {
+	if m != nil {
+		return m.NewMemberDelaySeconds
+	}
+	return 0
+}
+
 type CommunityChat struct {
-	Members              map[string]*CommunityMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Permissions          *CommunityPermissions       `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Identity             *ChatIdentity               `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
-	CategoryId           string                      `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Position             int32                       `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Members     map[string]*CommunityMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Permissions *CommunityPermissions       `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Identity    *ChatIdentity               `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	CategoryId  string                      `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Position    int32                       `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	// Minimum number of seconds between two messages of a member, zero to disable
+	SlowModeSeconds uint32 `protobuf:"varint,6,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"`
+	// Custom roles of members in the channel, on top of their community wide roles
+	MemberRoles          map[string]*CommunityMemberRoles `protobuf:"bytes,7,rep,name=member_roles,json=memberRoles,proto3" json:"member_roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *CommunityChat) Reset()         { *m = CommunityChat{} }
 func (m *CommunityChat) String() string { return proto.CompactTextString(m) }
 func (*CommunityChat) ProtoMessage()    {}
 func (*CommunityChat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{8}
+	return fileDescriptor_f937943d74c1cd8b, []int{11}
 }
 
 func (m *CommunityChat) XXX_Unmarshal(b []byte) error {
@@ -815,6 +1132,20 @@ func (m *CommunityChat) GetPosition() int32 {
 	return 0
 }
 
+func (m *CommunityChat) GetSlowModeSeconds() uint32 {
+	if m != nil {
+		return m.SlowModeSeconds
+	}
+	return 0
+}
+
+func (m *CommunityChat) GetMemberRoles() map[string]*CommunityMemberRoles {
+	if m != nil {
+		return m.MemberRoles
+	}
+	return nil
+}
+
 type CommunityCategory struct {
 	CategoryId           string   `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -828,7 +1159,7 @@ func (m *CommunityCategory) Reset()         { *m = CommunityCategory{} }
 func (m *CommunityCategory) String() string { return proto.CompactTextString(m) }
 func (*CommunityCategory) ProtoMessage()    {}
 func (*CommunityCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{9}
+	return fileDescriptor_f937943d74c1cd8b, []int{12}
 }
 
 func (m *CommunityCategory) XXX_Unmarshal(b []byte) error {
@@ -884,7 +1215,7 @@ func (m *CommunityInvitation) Reset()         { *m = CommunityInvitation{} }
 func (m *CommunityInvitation) String() string { return proto.CompactTextString(m) }
 func (*CommunityInvitation) ProtoMessage()    {}
 func (*CommunityInvitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{10}
+	return fileDescriptor_f937943d74c1cd8b, []int{13}
 }
 
 func (m *CommunityInvitation) XXX_Unmarshal(b []byte) error {
@@ -947,7 +1278,7 @@ func (m *RevealedAccount) Reset()         { *m = RevealedAccount{} }
 func (m *RevealedAccount) String() string { return proto.CompactTextString(m) }
 func (*RevealedAccount) ProtoMessage()    {}
 func (*RevealedAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{11}
+	return fileDescriptor_f937943d74c1cd8b, []int{14}
 }
 
 func (m *RevealedAccount) XXX_Unmarshal(b []byte) error {
@@ -997,22 +1328,23 @@ func (m *RevealedAccount) GetIsAirdropAddress() bool {
 }
 
 type CommunityRequestToJoin struct {
-	Clock                uint64             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	EnsName              string             `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
-	ChatId               string             `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	CommunityId          []byte             `protobuf:"bytes,4,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	DisplayName          string             `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	RevealedAccounts     []*RevealedAccount `protobuf:"bytes,6,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Clock                uint64               `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	EnsName              string               `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	ChatId               string               `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	CommunityId          []byte               `protobuf:"bytes,4,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	DisplayName          string               `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	RevealedAccounts     []*RevealedAccount   `protobuf:"bytes,6,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
+	InviteLink           *CommunityInviteLink `protobuf:"bytes,7,opt,name=invite_link,json=inviteLink,proto3" json:"invite_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CommunityRequestToJoin) Reset()         { *m = CommunityRequestToJoin{} }
 func (m *CommunityRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoin) ProtoMessage()    {}
 func (*CommunityRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{12}
+	return fileDescriptor_f937943d74c1cd8b, []int{15}
 }
 
 func (m *CommunityRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -1075,6 +1407,155 @@ func (m *CommunityRequestToJoin) GetRevealedAccounts() []*RevealedAccount {
 	return nil
 }
 
+func (m *CommunityRequestToJoin) GetInviteLink() *CommunityInviteLink {
+	if m != nil {
+		return m.InviteLink
+	}
+	return nil
+}
+
+// CommunityInvite is the content of an invite link, signed by the control node
+type CommunityInvite struct {
+	CommunityId []byte `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	InviteId    []byte `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Clock       uint64 `protobuf:"varint,3,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Expiry time in seconds, zero if the invite never expires
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Maximum number of members who can use the invite, zero if unlimited
+	MaxUses uint32 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// Role given to the members joining with the invite
+	Role CommunityMember_Roles `protobuf:"varint,6,opt,name=role,proto3,enum=protobuf.CommunityMember_Roles" json:"role,omitempty"`
+	// Whether requests to join made with the invite are accepted automatically
+	AutoAccept           bool     `protobuf:"varint,7,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityInvite) Reset()         { *m = CommunityInvite{} }
+func (m *CommunityInvite) String() string { return proto.CompactTextString(m) }
+func (*CommunityInvite) ProtoMessage()    {}
+func (*CommunityInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{16}
+}
+
+func (m *CommunityInvite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityInvite.Unmarshal(m, b)
+}
+func (m *CommunityInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityInvite.Marshal(b, m, deterministic)
+}
+func (m *CommunityInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityInvite.Merge(m, src)
+}
+func (m *CommunityInvite) XXX_Size() int {
+	return xxx_messageInfo_CommunityInvite.Size(m)
+}
+func (m *CommunityInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityInvite proto.InternalMessageInfo
+
+func (m *CommunityInvite) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityInvite) GetInviteId() []byte {
+	if m != nil {
+		return m.InviteId
+	}
+	return nil
+}
+
+func (m *CommunityInvite) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityInvite) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CommunityInvite) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *CommunityInvite) GetRole() CommunityMember_Roles {
+	if m != nil {
+		return m.Role
+	}
+	return CommunityMember_ROLE_NONE
+}
+
+func (m *CommunityInvite) GetAutoAccept() bool {
+	if m != nil {
+		return m.AutoAccept
+	}
+	return false
+}
+
+// CommunityInviteLink is an invite as shared in a link
+type CommunityInviteLink struct {
+	// Encoded CommunityInvite
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Encoded signature of data by the control node
+	Signature            string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityInviteLink) Reset()         { *m = CommunityInviteLink{} }
+func (m *CommunityInviteLink) String() string { return proto.CompactTextString(m) }
+func (*CommunityInviteLink) ProtoMessage()    {}
+func (*CommunityInviteLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{17}
+}
+
+func (m *CommunityInviteLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityInviteLink.Unmarshal(m, b)
+}
+func (m *CommunityInviteLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityInviteLink.Marshal(b, m, deterministic)
+}
+func (m *CommunityInviteLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityInviteLink.Merge(m, src)
+}
+func (m *CommunityInviteLink) XXX_Size() int {
+	return xxx_messageInfo_CommunityInviteLink.Size(m)
+}
+func (m *CommunityInviteLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityInviteLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityInviteLink proto.InternalMessageInfo
+
+func (m *CommunityInviteLink) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *CommunityInviteLink) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 type CommunityEditRevealedAccounts struct {
 	Clock                uint64             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	CommunityId          []byte             `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
@@ -1088,7 +1569,7 @@ func (m *CommunityEditRevealedAccounts) Reset()         { *m = CommunityEditReve
 func (m *CommunityEditRevealedAccounts) String() string { return proto.CompactTextString(m) }
 func (*CommunityEditRevealedAccounts) ProtoMessage()    {}
 func (*CommunityEditRevealedAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{13}
+	return fileDescriptor_f937943d74c1cd8b, []int{18}
 }
 
 func (m *CommunityEditRevealedAccounts) XXX_Unmarshal(b []byte) error {
@@ -1145,7 +1626,7 @@ func (m *CommunityCancelRequestToJoin) Reset()         { *m = CommunityCancelReq
 func (m *CommunityCancelRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityCancelRequestToJoin) ProtoMessage()    {}
 func (*CommunityCancelRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{14}
+	return fileDescriptor_f937943d74c1cd8b, []int{19}
 }
 
 func (m *CommunityCancelRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -1217,7 +1698,7 @@ func (m *CommunityRequestToJoinResponse) Reset()         { *m = CommunityRequest
 func (m *CommunityRequestToJoinResponse) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoinResponse) ProtoMessage()    {}
 func (*CommunityRequestToJoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{15}
+	return fileDescriptor_f937943d74c1cd8b, []int{20}
 }
 
 func (m *CommunityRequestToJoinResponse) XXX_Unmarshal(b []byte) error {
@@ -1292,7 +1773,7 @@ func (m *CommunityRequestToLeave) Reset()         { *m = CommunityRequestToLeave
 func (m *CommunityRequestToLeave) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToLeave) ProtoMessage()    {}
 func (*CommunityRequestToLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{16}
+	return fileDescriptor_f937943d74c1cd8b, []int{21}
 }
 
 func (m *CommunityRequestToLeave) XXX_Unmarshal(b []byte) error {
@@ -1339,7 +1820,7 @@ func (m *CommunityMessageArchiveMagnetlink) Reset()         { *m = CommunityMess
 func (m *CommunityMessageArchiveMagnetlink) String() string { return proto.CompactTextString(m) }
 func (*CommunityMessageArchiveMagnetlink) ProtoMessage()    {}
 func (*CommunityMessageArchiveMagnetlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{17}
+	return fileDescriptor_f937943d74c1cd8b, []int{22}
 }
 
 func (m *CommunityMessageArchiveMagnetlink) XXX_Unmarshal(b []byte) error {
@@ -1391,7 +1872,7 @@ func (m *WakuMessage) Reset()         { *m = WakuMessage{} }
 func (m *WakuMessage) String() string { return proto.CompactTextString(m) }
 func (*WakuMessage) ProtoMessage()    {}
 func (*WakuMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{18}
+	return fileDescriptor_f937943d74c1cd8b, []int{23}
 }
 
 func (m *WakuMessage) XXX_Unmarshal(b []byte) error {
@@ -1475,7 +1956,7 @@ func (m *WakuMessageArchiveMetadata) Reset()         { *m = WakuMessageArchiveMe
 func (m *WakuMessageArchiveMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{19}
+	return fileDescriptor_f937943d74c1cd8b, []int{24}
 }
 
 func (m *WakuMessageArchiveMetadata) XXX_Unmarshal(b []byte) error {
@@ -1537,7 +2018,7 @@ func (m *WakuMessageArchive) Reset()         { *m = WakuMessageArchive{} }
 func (m *WakuMessageArchive) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchive) ProtoMessage()    {}
 func (*WakuMessageArchive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{20}
+	return fileDescriptor_f937943d74c1cd8b, []int{25}
 }
 
 func (m *WakuMessageArchive) XXX_Unmarshal(b []byte) error {
@@ -1594,7 +2075,7 @@ func (m *WakuMessageArchiveIndexMetadata) Reset()         { *m = WakuMessageArch
 func (m *WakuMessageArchiveIndexMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndexMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveIndexMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{21}
+	return fileDescriptor_f937943d74c1cd8b, []int{26}
 }
 
 func (m *WakuMessageArchiveIndexMetadata) XXX_Unmarshal(b []byte) error {
@@ -1661,7 +2142,7 @@ func (m *WakuMessageArchiveIndex) Reset()         { *m = WakuMessageArchiveIndex
 func (m *WakuMessageArchiveIndex) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndex) ProtoMessage()    {}
 func (*WakuMessageArchiveIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{22}
+	return fileDescriptor_f937943d74c1cd8b, []int{27}
 }
 
 func (m *WakuMessageArchiveIndex) XXX_Unmarshal(b []byte) error {
@@ -1689,12 +2170,196 @@ func (m *WakuMessageArchiveIndex) GetArchives() map[string]*WakuMessageArchiveIn
 	return nil
 }
 
+// CommunityCalendarEvent is an event scheduled in a community channel, published by an admin
+type CommunityCalendarEvent struct {
+	// Lamport timestamp, the latest version of the event replaces the previous ones
+	Clock       uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId []byte `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Id          string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Id of the channel the event takes place in
+	ChatId      string `protobuf:"bytes,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Title       string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Start and end times in seconds, end is zero if the event has no set duration
+	StartTime uint64 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   uint64 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Tokens members must hold to attend, empty if the event is open to all members
+	TokenCriteria        []*TokenCriteria `protobuf:"bytes,9,rep,name=token_criteria,json=tokenCriteria,proto3" json:"token_criteria,omitempty"`
+	Cancelled            bool             `protobuf:"varint,10,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CommunityCalendarEvent) Reset()         { *m = CommunityCalendarEvent{} }
+func (m *CommunityCalendarEvent) String() string { return proto.CompactTextString(m) }
+func (*CommunityCalendarEvent) ProtoMessage()    {}
+func (*CommunityCalendarEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{28}
+}
+
+func (m *CommunityCalendarEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityCalendarEvent.Unmarshal(m, b)
+}
+func (m *CommunityCalendarEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityCalendarEvent.Marshal(b, m, deterministic)
+}
+func (m *CommunityCalendarEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityCalendarEvent.Merge(m, src)
+}
+func (m *CommunityCalendarEvent) XXX_Size() int {
+	return xxx_messageInfo_CommunityCalendarEvent.Size(m)
+}
+func (m *CommunityCalendarEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityCalendarEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityCalendarEvent proto.InternalMessageInfo
+
+func (m *CommunityCalendarEvent) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityCalendarEvent) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityCalendarEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityCalendarEvent) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *CommunityCalendarEvent) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CommunityCalendarEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommunityCalendarEvent) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *CommunityCalendarEvent) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *CommunityCalendarEvent) GetTokenCriteria() []*TokenCriteria {
+	if m != nil {
+		return m.TokenCriteria
+	}
+	return nil
+}
+
+func (m *CommunityCalendarEvent) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+type CommunityCalendarEventRsvp struct {
+	// Lamport timestamp, the latest response of a member replaces the previous ones
+	Clock                uint64                              `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte                              `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	EventId              string                              `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Response             CommunityCalendarEventRsvp_Response `protobuf:"varint,4,opt,name=response,proto3,enum=protobuf.CommunityCalendarEventRsvp_Response" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *CommunityCalendarEventRsvp) Reset()         { *m = CommunityCalendarEventRsvp{} }
+func (m *CommunityCalendarEventRsvp) String() string { return proto.CompactTextString(m) }
+func (*CommunityCalendarEventRsvp) ProtoMessage()    {}
+func (*CommunityCalendarEventRsvp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{29}
+}
+
+func (m *CommunityCalendarEventRsvp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityCalendarEventRsvp.Unmarshal(m, b)
+}
+func (m *CommunityCalendarEventRsvp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityCalendarEventRsvp.Marshal(b, m, deterministic)
+}
+func (m *CommunityCalendarEventRsvp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityCalendarEventRsvp.Merge(m, src)
+}
+func (m *CommunityCalendarEventRsvp) XXX_Size() int {
+	return xxx_messageInfo_CommunityCalendarEventRsvp.Size(m)
+}
+func (m *CommunityCalendarEventRsvp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityCalendarEventRsvp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityCalendarEventRsvp proto.InternalMessageInfo
+
+func (m *CommunityCalendarEventRsvp) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityCalendarEventRsvp) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityCalendarEventRsvp) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *CommunityCalendarEventRsvp) GetResponse() CommunityCalendarEventRsvp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return CommunityCalendarEventRsvp_UNKNOWN_RESPONSE
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.CommunityMember_Roles", CommunityMember_Roles_name, CommunityMember_Roles_value)
+	proto.RegisterEnum("protobuf.CommunityRole_Capability", CommunityRole_Capability_name, CommunityRole_Capability_value)
 	proto.RegisterEnum("protobuf.CommunityPermissions_Access", CommunityPermissions_Access_name, CommunityPermissions_Access_value)
 	proto.RegisterEnum("protobuf.CommunityTokenPermission_Type", CommunityTokenPermission_Type_name, CommunityTokenPermission_Type_value)
+	proto.RegisterEnum("protobuf.CommunityCalendarEventRsvp_Response", CommunityCalendarEventRsvp_Response_name, CommunityCalendarEventRsvp_Response_value)
 	proto.RegisterType((*Grant)(nil), "protobuf.Grant")
 	proto.RegisterType((*CommunityMember)(nil), "protobuf.CommunityMember")
+	proto.RegisterType((*CommunityMemberRoles)(nil), "protobuf.CommunityMemberRoles")
+	proto.RegisterType((*CommunityRole)(nil), "protobuf.CommunityRole")
 	proto.RegisterType((*CommunityTokenMetadata)(nil), "protobuf.CommunityTokenMetadata")
 	proto.RegisterMapType((map[uint64]string)(nil), "protobuf.CommunityTokenMetadata.ContractAddressesEntry")
 	proto.RegisterType((*CommunityPermissions)(nil), "protobuf.CommunityPermissions")
@@ -1705,14 +2370,19 @@ func init() {
 	proto.RegisterMapType((map[string]*CommunityCategory)(nil), "protobuf.CommunityDescription.CategoriesEntry")
 	proto.RegisterMapType((map[string]*CommunityChat)(nil), "protobuf.CommunityDescription.ChatsEntry")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityDescription.MembersEntry")
+	proto.RegisterMapType((map[string]*CommunityRole)(nil), "protobuf.CommunityDescription.RolesEntry")
 	proto.RegisterMapType((map[string]*CommunityTokenPermission)(nil), "protobuf.CommunityDescription.TokenPermissionsEntry")
 	proto.RegisterType((*CommunityAdminSettings)(nil), "protobuf.CommunityAdminSettings")
+	proto.RegisterType((*CommunityModerationRules)(nil), "protobuf.CommunityModerationRules")
 	proto.RegisterType((*CommunityChat)(nil), "protobuf.CommunityChat")
+	proto.RegisterMapType((map[string]*CommunityMemberRoles)(nil), "protobuf.CommunityChat.MemberRolesEntry")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityChat.MembersEntry")
 	proto.RegisterType((*CommunityCategory)(nil), "protobuf.CommunityCategory")
 	proto.RegisterType((*CommunityInvitation)(nil), "protobuf.CommunityInvitation")
 	proto.RegisterType((*RevealedAccount)(nil), "protobuf.RevealedAccount")
 	proto.RegisterType((*CommunityRequestToJoin)(nil), "protobuf.CommunityRequestToJoin")
+	proto.RegisterType((*CommunityInvite)(nil), "protobuf.CommunityInvite")
+	proto.RegisterType((*CommunityInviteLink)(nil), "protobuf.CommunityInviteLink")
 	proto.RegisterType((*CommunityEditRevealedAccounts)(nil), "protobuf.CommunityEditRevealedAccounts")
 	proto.RegisterType((*CommunityCancelRequestToJoin)(nil), "protobuf.CommunityCancelRequestToJoin")
 	proto.RegisterType((*CommunityRequestToJoinResponse)(nil), "protobuf.CommunityRequestToJoinResponse")
@@ -1724,6 +2394,8 @@ func init() {
 	proto.RegisterType((*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndexMetadata")
 	proto.RegisterType((*WakuMessageArchiveIndex)(nil), "protobuf.WakuMessageArchiveIndex")
 	proto.RegisterMapType((map[string]*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndex.ArchivesEntry")
+	proto.RegisterType((*CommunityCalendarEvent)(nil), "protobuf.CommunityCalendarEvent")
+	proto.RegisterType((*CommunityCalendarEventRsvp)(nil), "protobuf.CommunityCalendarEventRsvp")
 }
 
 func init() {
@@ -1731,135 +2403,180 @@ func init() {
 }
 
 var fileDescriptor_f937943d74c1cd8b = []byte{
-	// 2068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x73, 0x23, 0x49,
-	0xd1, 0x9e, 0x96, 0x64, 0x5b, 0x4a, 0x59, 0xb6, 0x5c, 0x33, 0x63, 0xb7, 0x3d, 0x33, 0x3b, 0x9a,
-	0x7e, 0x5f, 0x02, 0xef, 0x12, 0x68, 0x76, 0xbd, 0x10, 0x4c, 0xec, 0xc2, 0xee, 0x6a, 0xe4, 0x66,
-	0x56, 0xcc, 0xb8, 0xe5, 0x2d, 0xcb, 0x3b, 0xb0, 0x01, 0x74, 0x94, 0xbb, 0xcb, 0x76, 0xc5, 0x48,
-	0xdd, 0xa2, 0xab, 0xe4, 0x40, 0x1c, 0xf6, 0x40, 0xf0, 0x0b, 0x38, 0x71, 0x24, 0x82, 0x08, 0x8e,
-	0xfc, 0x05, 0x0e, 0xdc, 0xb9, 0x73, 0x83, 0x1b, 0x47, 0x4e, 0x9c, 0x89, 0xfa, 0xe8, 0x56, 0xb7,
-	0x2c, 0xcd, 0x07, 0x0b, 0x11, 0x9c, 0xd4, 0x99, 0x95, 0x95, 0x95, 0x95, 0xf9, 0x64, 0x56, 0xa6,
-	0x60, 0x2b, 0x88, 0x47, 0xa3, 0x49, 0xc4, 0x04, 0xa3, 0xbc, 0x3d, 0x4e, 0x62, 0x11, 0xa3, 0xaa,
-	0xfa, 0x39, 0x9b, 0x9c, 0xef, 0xdd, 0x0c, 0x2e, 0x89, 0xf0, 0x59, 0x48, 0x23, 0xc1, 0xc4, 0x54,
-	0x2f, 0xef, 0xd5, 0x69, 0x34, 0x19, 0x19, 0x59, 0xe7, 0x0a, 0x56, 0x9e, 0x24, 0x24, 0x12, 0xe8,
-	0x01, 0xac, 0xa7, 0x9a, 0xa6, 0x3e, 0x0b, 0x6d, 0xab, 0x65, 0xed, 0xaf, 0xe3, 0x7a, 0xc6, 0xeb,
-	0x85, 0xe8, 0x0e, 0xd4, 0x46, 0x74, 0x74, 0x46, 0x13, 0xb9, 0x5e, 0x52, 0xeb, 0x55, 0xcd, 0xe8,
-	0x85, 0x68, 0x07, 0xd6, 0xcc, 0x61, 0x76, 0xb9, 0x65, 0xed, 0xd7, 0xf0, 0xaa, 0x24, 0x7b, 0x21,
-	0xba, 0x05, 0x2b, 0xc1, 0x30, 0x0e, 0x5e, 0xd8, 0x95, 0x96, 0xb5, 0x5f, 0xc1, 0x9a, 0x70, 0x7e,
-	0x5f, 0x82, 0xcd, 0x6e, 0xaa, 0xfb, 0x48, 0x29, 0x41, 0xdf, 0x86, 0x95, 0x24, 0x1e, 0x52, 0x6e,
-	0x5b, 0xad, 0xf2, 0xfe, 0xc6, 0xc1, 0xfd, 0x76, 0x7a, 0x8f, 0xf6, 0x9c, 0x64, 0x1b, 0x4b, 0x31,
-	0xac, 0xa5, 0xd1, 0xf7, 0x61, 0x2b, 0xa1, 0x57, 0x94, 0x0c, 0x69, 0xe8, 0x93, 0x20, 0x88, 0x27,
-	0x91, 0xe0, 0x76, 0xa9, 0x55, 0xde, 0xaf, 0x1f, 0xec, 0xce, 0x54, 0x60, 0x23, 0xd2, 0xd1, 0x12,
-	0xb8, 0x99, 0x14, 0x19, 0x1c, 0xbd, 0x03, 0x5b, 0x43, 0xc2, 0x85, 0x3f, 0x19, 0x87, 0x44, 0x50,
-	0x5f, 0x1b, 0x5d, 0x56, 0x46, 0x6f, 0xca, 0x85, 0x53, 0xc5, 0xef, 0x2a, 0xf3, 0x2f, 0x61, 0x45,
-	0xd9, 0x80, 0x1a, 0x50, 0xc3, 0xfd, 0x67, 0xae, 0xef, 0xf5, 0x3d, 0xb7, 0x79, 0x03, 0x6d, 0x00,
-	0x28, 0xb2, 0xff, 0xdc, 0x73, 0x71, 0xd3, 0x42, 0xb7, 0x61, 0x4b, 0xd1, 0x47, 0x1d, 0xaf, 0xf3,
-	0xc4, 0xf5, 0x4f, 0x4f, 0x5c, 0x7c, 0xd2, 0x2c, 0xa1, 0x5d, 0xb8, 0xad, 0xd9, 0xfd, 0x43, 0x17,
-	0x77, 0x06, 0xae, 0xdf, 0xed, 0x7b, 0x03, 0xd7, 0x1b, 0x34, 0xcb, 0x99, 0x86, 0xce, 0xe1, 0x51,
-	0xcf, 0x6b, 0x56, 0x9c, 0x5f, 0x96, 0x61, 0x3b, 0xbb, 0xfe, 0x20, 0x7e, 0x41, 0xa3, 0x23, 0x2a,
-	0x48, 0x48, 0x04, 0x41, 0xe7, 0x80, 0x82, 0x38, 0x12, 0x09, 0x09, 0x84, 0x4f, 0xc2, 0x30, 0xa1,
-	0x9c, 0x1b, 0xe7, 0xd5, 0x0f, 0xbe, 0xb3, 0xc0, 0x79, 0x85, 0xdd, 0xed, 0xae, 0xd9, 0xda, 0x49,
-	0x77, 0xba, 0x91, 0x48, 0xa6, 0x78, 0x2b, 0x98, 0xe7, 0xa3, 0x16, 0xd4, 0x43, 0xca, 0x83, 0x84,
-	0x8d, 0x05, 0x8b, 0x23, 0x15, 0xf9, 0x1a, 0xce, 0xb3, 0x64, 0x8c, 0xd9, 0x88, 0x5c, 0x50, 0x13,
-	0x7a, 0x4d, 0xa0, 0x0f, 0xa0, 0x26, 0xe4, 0x91, 0x83, 0xe9, 0x98, 0xaa, 0xe8, 0x6f, 0x1c, 0xdc,
-	0x5d, 0x66, 0x96, 0x94, 0xc1, 0x33, 0x71, 0xb4, 0x0d, 0xab, 0x7c, 0x3a, 0x3a, 0x8b, 0x87, 0xf6,
-	0x8a, 0x46, 0x93, 0xa6, 0x10, 0x82, 0x4a, 0x44, 0x46, 0xd4, 0x5e, 0x55, 0x5c, 0xf5, 0x8d, 0xf6,
-	0xa0, 0x1a, 0xd2, 0x80, 0x8d, 0xc8, 0x90, 0xdb, 0x6b, 0x2d, 0x6b, 0xbf, 0x81, 0x33, 0x7a, 0xef,
-	0x50, 0x7a, 0x6f, 0xd1, 0x45, 0x51, 0x13, 0xca, 0x2f, 0xe8, 0x54, 0xe1, 0xbc, 0x82, 0xe5, 0xa7,
-	0xbc, 0xc5, 0x15, 0x19, 0x4e, 0xa8, 0xb9, 0xa1, 0x26, 0x3e, 0x28, 0x3d, 0xb2, 0x9c, 0xbf, 0x5a,
-	0x70, 0x2b, 0xb3, 0xf7, 0x98, 0x26, 0x23, 0xc6, 0x39, 0x8b, 0x23, 0x8e, 0x76, 0xa1, 0x4a, 0x23,
-	0xee, 0xc7, 0xd1, 0x50, 0x6b, 0xaa, 0xe2, 0x35, 0x1a, 0xf1, 0x7e, 0x34, 0x9c, 0x22, 0x1b, 0xd6,
-	0xc6, 0x09, 0xbb, 0x22, 0x42, 0xeb, 0xab, 0xe2, 0x94, 0x44, 0xdf, 0x83, 0x55, 0x12, 0x04, 0x94,
-	0x73, 0xe5, 0xae, 0x8d, 0x83, 0xaf, 0x2d, 0x70, 0x4a, 0xee, 0x90, 0x76, 0x47, 0x09, 0x63, 0xb3,
-	0xc9, 0x19, 0xc0, 0xaa, 0xe6, 0x20, 0x04, 0x1b, 0xa7, 0xde, 0x53, 0xaf, 0xff, 0xdc, 0xf3, 0x3b,
-	0xdd, 0xae, 0x7b, 0x72, 0xd2, 0xbc, 0x81, 0xb6, 0xa0, 0xe1, 0xf5, 0xfd, 0x23, 0xf7, 0xe8, 0xb1,
-	0x8b, 0x4f, 0x3e, 0xed, 0x1d, 0x37, 0x2d, 0x74, 0x13, 0x36, 0x7b, 0xde, 0xe7, 0xbd, 0x41, 0x67,
-	0xd0, 0xeb, 0x7b, 0x7e, 0xdf, 0x7b, 0xf6, 0xa3, 0x66, 0x49, 0xe2, 0xac, 0xef, 0xf9, 0xd8, 0xfd,
-	0xec, 0xd4, 0x3d, 0x19, 0x34, 0xcb, 0xce, 0xaf, 0xca, 0xd0, 0x50, 0x91, 0xe8, 0x26, 0x4c, 0xd0,
-	0x84, 0x11, 0xf4, 0x93, 0x97, 0xc0, 0xab, 0x3d, 0x33, 0xb9, 0xb0, 0xe9, 0x0d, 0x50, 0xf5, 0x2e,
-	0x54, 0x84, 0x04, 0x46, 0xe9, 0x35, 0x80, 0xa1, 0x24, 0x73, 0x98, 0x28, 0x2f, 0xc4, 0x44, 0x25,
-	0x87, 0x89, 0x6d, 0x58, 0x25, 0x23, 0x99, 0xd7, 0x29, 0x7e, 0x34, 0x25, 0x6b, 0x98, 0x02, 0x99,
-	0xcf, 0x42, 0x6e, 0xaf, 0xb6, 0xca, 0xfb, 0x15, 0x5c, 0x55, 0x8c, 0x5e, 0xc8, 0xd1, 0x7d, 0xa8,
-	0xcb, 0x68, 0x8e, 0x89, 0x10, 0x34, 0x89, 0x14, 0x96, 0x6a, 0x18, 0x68, 0xc4, 0x8f, 0x35, 0xa7,
-	0x80, 0xb4, 0xaa, 0x02, 0xce, 0x7f, 0x1a, 0x69, 0x7f, 0x2b, 0x81, 0x5d, 0x74, 0xc0, 0x0c, 0x09,
-	0x68, 0x03, 0x4a, 0xa6, 0x32, 0xd7, 0x70, 0x89, 0x85, 0xe8, 0xc3, 0x82, 0x0b, 0xbf, 0xbe, 0xcc,
-	0x85, 0x33, 0x0d, 0xed, 0x9c, 0x37, 0x3f, 0x82, 0x0d, 0xed, 0x89, 0xc0, 0xc4, 0xce, 0x2e, 0xab,
-	0xd0, 0xee, 0x2c, 0x09, 0x2d, 0x6e, 0x88, 0x02, 0x3c, 0x76, 0xa1, 0x6a, 0x0a, 0x3e, 0xb7, 0x2b,
-	0xad, 0xf2, 0x7e, 0x0d, 0xaf, 0xe9, 0x8a, 0xcf, 0xd1, 0x3d, 0x00, 0xc6, 0xfd, 0x14, 0xfd, 0x2b,
-	0x0a, 0xfd, 0x35, 0xc6, 0x8f, 0x35, 0xc3, 0xf9, 0x12, 0x2a, 0x2a, 0xc7, 0xef, 0x82, 0x9d, 0xc2,
-	0x77, 0xd0, 0x7f, 0xea, 0x7a, 0xfe, 0xb1, 0x8b, 0x8f, 0x7a, 0x27, 0x27, 0xbd, 0xbe, 0xd7, 0xbc,
-	0x81, 0x9a, 0xb0, 0xfe, 0xd8, 0xed, 0xf6, 0x8f, 0xd2, 0x52, 0x68, 0x49, 0x68, 0x1b, 0x8e, 0x86,
-	0x77, 0xb3, 0x84, 0x6e, 0x41, 0xb3, 0xdb, 0xf1, 0xfc, 0xcf, 0x7b, 0xee, 0x73, 0xbf, 0xfb, 0x69,
-	0xc7, 0xf3, 0xdc, 0x67, 0xcd, 0x32, 0xba, 0x07, 0xbb, 0x19, 0xb7, 0xe3, 0x1d, 0xfa, 0xc7, 0xfd,
-	0x93, 0x41, 0xb6, 0x5c, 0x71, 0xfe, 0x59, 0xcb, 0x65, 0xf3, 0x61, 0xb1, 0x8c, 0xe9, 0xaa, 0x6f,
-	0xe5, 0x9e, 0x2a, 0xe4, 0xc2, 0x9a, 0x7e, 0xe5, 0xd2, 0x57, 0xe5, 0x1b, 0x0b, 0x1c, 0x9d, 0x53,
-	0xd3, 0xd6, 0x8f, 0x94, 0x41, 0x7e, 0xba, 0x17, 0x7d, 0x02, 0xf5, 0xf1, 0x2c, 0xa9, 0x15, 0x84,
-	0xeb, 0x07, 0x6f, 0xbd, 0x3c, 0xf5, 0x71, 0x7e, 0x0b, 0x3a, 0x80, 0x6a, 0xfa, 0x94, 0x2b, 0xa7,
-	0xd6, 0x0f, 0xb6, 0x73, 0xdb, 0x95, 0xef, 0xf5, 0x2a, 0xce, 0xe4, 0xd0, 0xc7, 0xb0, 0x22, 0xa3,
-	0xa2, 0xb1, 0x5e, 0x3f, 0x78, 0xfb, 0x15, 0xa6, 0x4b, 0x2d, 0xc6, 0x70, 0xbd, 0x4f, 0x86, 0xf9,
-	0x8c, 0x44, 0xfe, 0x90, 0x71, 0x61, 0xaf, 0xe9, 0x30, 0x9f, 0x91, 0xe8, 0x19, 0xe3, 0x02, 0x79,
-	0x00, 0x01, 0x11, 0xf4, 0x22, 0x4e, 0x18, 0x95, 0xf9, 0x30, 0x57, 0x18, 0x16, 0x1f, 0x90, 0x6d,
-	0xd0, 0xa7, 0xe4, 0x34, 0xa0, 0x47, 0x60, 0x93, 0x24, 0xb8, 0x64, 0x57, 0xd4, 0x1f, 0x91, 0x8b,
-	0x88, 0x8a, 0x21, 0x8b, 0x5e, 0x98, 0x77, 0xb8, 0xa6, 0x22, 0xb2, 0x6d, 0xd6, 0x8f, 0xb2, 0x65,
-	0xf5, 0x1c, 0xa3, 0x27, 0xb0, 0x41, 0xc2, 0x11, 0x8b, 0x7c, 0x4e, 0x85, 0x60, 0xd1, 0x05, 0xb7,
-	0x41, 0xf9, 0xa7, 0xb5, 0xc0, 0x9a, 0x8e, 0x14, 0x3c, 0x31, 0x72, 0xb8, 0x41, 0xf2, 0x24, 0xfa,
-	0x3f, 0x68, 0xb0, 0x48, 0x24, 0xb1, 0x3f, 0xa2, 0x9c, 0xcb, 0x07, 0xad, 0xae, 0x92, 0x6d, 0x5d,
-	0x31, 0x8f, 0x34, 0x4f, 0x0a, 0xc5, 0x93, 0xbc, 0xd0, 0xba, 0x16, 0x52, 0xcc, 0x54, 0xe8, 0x2e,
-	0xd4, 0x68, 0x14, 0x24, 0xd3, 0xb1, 0xa0, 0xa1, 0xdd, 0xd0, 0x29, 0x90, 0x31, 0x64, 0xc9, 0x12,
-	0xe4, 0x82, 0xdb, 0x1b, 0xca, 0xa3, 0xea, 0x1b, 0x11, 0xd8, 0xd2, 0x09, 0x99, 0x87, 0xc9, 0xa6,
-	0xf2, 0xea, 0xb7, 0x5e, 0xe1, 0xd5, 0xb9, 0x34, 0x37, 0xbe, 0x6d, 0x8a, 0x39, 0x36, 0xfa, 0x31,
-	0xec, 0xce, 0x9a, 0x3c, 0xb5, 0xca, 0xfd, 0x91, 0x69, 0x08, 0xec, 0xa6, 0x3a, 0xaa, 0xf5, 0xaa,
-	0xc6, 0x01, 0xef, 0x04, 0x05, 0x3e, 0xcf, 0xfa, 0x91, 0x77, 0xe1, 0x16, 0x09, 0x84, 0x0a, 0x9f,
-	0xc6, 0xbc, 0xaf, 0x3a, 0x2b, 0x7b, 0x4b, 0xc5, 0x0e, 0xe9, 0x35, 0x93, 0x1c, 0x5d, 0xb9, 0xb2,
-	0x77, 0x0a, 0xeb, 0xf9, 0x64, 0xc9, 0x57, 0xca, 0x9a, 0xae, 0x94, 0x0f, 0xf3, 0x95, 0xb2, 0xd0,
-	0xd0, 0xcd, 0xf5, 0x84, 0xb9, 0x22, 0xba, 0xf7, 0x19, 0xc0, 0x0c, 0xc8, 0x0b, 0x94, 0x7e, 0xb3,
-	0xa8, 0x74, 0x67, 0x81, 0x52, 0xb9, 0x3f, 0xaf, 0xf2, 0x0b, 0xd8, 0x9c, 0x83, 0xee, 0x02, 0xbd,
-	0xef, 0x15, 0xf5, 0xde, 0x59, 0xa4, 0x57, 0x2b, 0x99, 0xe6, 0x75, 0x5f, 0xc0, 0xed, 0x85, 0x01,
-	0x5c, 0x70, 0xc2, 0xa3, 0xe2, 0x09, 0xce, 0xab, 0x4b, 0x7e, 0xfe, 0x71, 0xf9, 0x69, 0xae, 0x95,
-	0x2c, 0xa4, 0x01, 0x3a, 0x84, 0xfb, 0x63, 0x16, 0xa5, 0x80, 0xf6, 0xc9, 0x70, 0x98, 0xc5, 0x90,
-	0x46, 0xe4, 0x6c, 0x48, 0x43, 0xd3, 0xde, 0xdc, 0x19, 0xb3, 0xc8, 0x40, 0xbc, 0x33, 0x1c, 0x66,
-	0xc1, 0x53, 0x22, 0xce, 0x5f, 0x4a, 0xd0, 0x28, 0x78, 0x10, 0x7d, 0x34, 0xab, 0x9d, 0xba, 0x71,
-	0xf8, 0xff, 0x25, 0xbe, 0x7e, 0xbd, 0xa2, 0x59, 0xfa, 0x6a, 0x45, 0xb3, 0xfc, 0x9a, 0x45, 0xf3,
-	0x3e, 0xd4, 0x4d, 0x59, 0x52, 0xa3, 0x90, 0xee, 0x2b, 0xd2, 0x4a, 0x25, 0x27, 0xa1, 0x3d, 0xa8,
-	0x8e, 0x63, 0xce, 0x54, 0x3b, 0x2c, 0x2b, 0xf1, 0x0a, 0xce, 0xe8, 0xff, 0x12, 0xa6, 0x9d, 0x10,
-	0xb6, 0xae, 0x81, 0x68, 0xde, 0x50, 0xeb, 0x9a, 0xa1, 0x69, 0x6b, 0x54, 0x2a, 0xb6, 0xcb, 0x99,
-	0xf1, 0xe5, 0xa2, 0xf1, 0xce, 0x6f, 0x2c, 0xb8, 0x99, 0x1d, 0xd3, 0x8b, 0xae, 0x98, 0x20, 0xea,
-	0x65, 0x7c, 0x1f, 0x6e, 0xcf, 0x0a, 0x47, 0x7e, 0x18, 0xd0, 0x63, 0xe2, 0xad, 0x60, 0xc9, 0x73,
-	0x7a, 0x21, 0x67, 0x4b, 0x33, 0x2b, 0x6a, 0x62, 0xf9, 0xa0, 0x78, 0x0f, 0x60, 0x3c, 0x39, 0x1b,
-	0xb2, 0xc0, 0x97, 0xfe, 0xaa, 0xa8, 0x3d, 0x35, 0xcd, 0x79, 0x4a, 0xa7, 0xce, 0xaf, 0x2d, 0xd8,
-	0x9c, 0x1b, 0xe2, 0x64, 0x8f, 0x6d, 0x3a, 0x53, 0x73, 0xf7, 0x94, 0x94, 0xe5, 0x97, 0xb3, 0x8b,
-	0x88, 0x88, 0x49, 0x42, 0xcd, 0xf9, 0x33, 0x86, 0xec, 0x02, 0x83, 0x4b, 0xc2, 0x74, 0x17, 0x58,
-	0xd6, 0x5d, 0xa0, 0x62, 0xc8, 0xee, 0xe5, 0x1d, 0x68, 0x32, 0xde, 0x61, 0x49, 0x98, 0xc4, 0x63,
-	0xd3, 0xc9, 0x29, 0x6b, 0xaa, 0xf8, 0x1a, 0xdf, 0xf9, 0x87, 0x95, 0x4b, 0x29, 0x4c, 0x7f, 0x36,
-	0xa1, 0x5c, 0x0c, 0xe2, 0x1f, 0xc4, 0x6c, 0x59, 0x33, 0x61, 0x06, 0x86, 0x5c, 0x50, 0xe4, 0xc0,
-	0xe0, 0xc9, 0xb8, 0x2c, 0x75, 0xcc, 0xfc, 0x68, 0x5e, 0xb9, 0x3e, 0x9a, 0x3f, 0x80, 0xf5, 0x90,
-	0xf1, 0xf1, 0x90, 0x4c, 0xb5, 0xea, 0x15, 0x33, 0xa3, 0x69, 0x9e, 0x52, 0xbf, 0x70, 0x4c, 0x5e,
-	0x7d, 0xe3, 0x31, 0xd9, 0xf9, 0xad, 0x05, 0xf7, 0xb2, 0x2b, 0xbb, 0x21, 0x13, 0x78, 0x7e, 0x90,
-	0x5e, 0x7c, 0xf3, 0xf9, 0x5b, 0x94, 0xae, 0xdf, 0x62, 0xa1, 0x89, 0xe5, 0x37, 0x37, 0xf1, 0x0f,
-	0x16, 0xdc, 0xcd, 0x25, 0x4b, 0x14, 0xd0, 0xe1, 0xff, 0x74, 0x6c, 0x9c, 0xbf, 0x5b, 0xf0, 0xd6,
-	0x62, 0x18, 0x61, 0xca, 0xc7, 0x71, 0xc4, 0xe9, 0x12, 0x93, 0xbf, 0x0b, 0xb5, 0xec, 0xa8, 0x97,
-	0x54, 0xc7, 0x5c, 0x56, 0xe2, 0xd9, 0x06, 0x59, 0x09, 0xe4, 0x4c, 0xa9, 0x5a, 0x94, 0xb2, 0x42,
-	0x78, 0x46, 0xcf, 0x92, 0xb7, 0x92, 0x4f, 0xde, 0xf9, 0xeb, 0xae, 0x5c, 0xbf, 0xee, 0x3d, 0x00,
-	0xdd, 0xbd, 0xf9, 0x93, 0x84, 0x99, 0x39, 0xbd, 0xa6, 0x39, 0xa7, 0x09, 0x73, 0x30, 0xec, 0x5c,
-	0xbf, 0xe9, 0x33, 0x4a, 0xae, 0xe8, 0xbf, 0x8d, 0x1b, 0xe7, 0x87, 0xf0, 0x20, 0x57, 0x39, 0xf5,
-	0xe3, 0x34, 0xdf, 0x28, 0x2e, 0xd1, 0x5e, 0xb4, 0xb6, 0x34, 0x6f, 0xed, 0x1f, 0x2d, 0xa8, 0x3f,
-	0x27, 0x2f, 0x26, 0x69, 0x57, 0xd7, 0x84, 0x32, 0x67, 0x17, 0xa6, 0xea, 0xc9, 0x4f, 0x59, 0x68,
-	0x04, 0x1b, 0x51, 0x2e, 0xc8, 0x68, 0xac, 0xf6, 0x57, 0xf0, 0x8c, 0x21, 0x0f, 0x15, 0xf1, 0x98,
-	0x05, 0xca, 0xbd, 0xeb, 0x58, 0x13, 0xea, 0xaf, 0x01, 0x32, 0x1d, 0xc6, 0x24, 0xc5, 0x4b, 0x4a,
-	0xea, 0x95, 0x30, 0x64, 0xd1, 0x85, 0x71, 0x6d, 0x4a, 0xca, 0x4a, 0x7e, 0x49, 0xf8, 0xa5, 0x72,
-	0xe8, 0x3a, 0x56, 0xdf, 0xc8, 0x81, 0x75, 0x71, 0xc9, 0x92, 0xf0, 0x98, 0x24, 0xd2, 0x0f, 0x66,
-	0x60, 0x2d, 0xf0, 0x9c, 0x2f, 0x61, 0x2f, 0x77, 0x81, 0xd4, 0x2d, 0x69, 0xcb, 0x66, 0xc3, 0xda,
-	0x15, 0x4d, 0x78, 0x5a, 0xc9, 0x1b, 0x38, 0x25, 0xe5, 0x79, 0xe7, 0x49, 0x3c, 0x32, 0x57, 0x52,
-	0xdf, 0x72, 0xfe, 0x14, 0xb1, 0xf9, 0x4b, 0xac, 0x24, 0x62, 0x79, 0xbe, 0x9c, 0xeb, 0x69, 0x24,
-	0x06, 0xea, 0x92, 0x72, 0x0c, 0x5c, 0xc7, 0x05, 0x9e, 0xf3, 0x3b, 0x0b, 0xd0, 0x75, 0x03, 0x5e,
-	0x72, 0xf0, 0x27, 0x50, 0xcd, 0x5a, 0x52, 0x8d, 0xe8, 0x5c, 0xcf, 0xb0, 0xfc, 0x2a, 0x38, 0xdb,
-	0x85, 0xde, 0x93, 0x1a, 0x94, 0x4c, 0x5a, 0x3d, 0x6e, 0x2f, 0xd4, 0x80, 0x33, 0x31, 0xe7, 0x4f,
-	0x16, 0xdc, 0xbf, 0xae, 0xbb, 0x17, 0x85, 0xf4, 0xe7, 0xaf, 0xe1, 0xab, 0xaf, 0x6e, 0xf2, 0x36,
-	0xac, 0xc6, 0xe7, 0xe7, 0x9c, 0x0a, 0xe3, 0x5d, 0x43, 0xc9, 0x28, 0x70, 0xf6, 0x0b, 0x6a, 0xfe,
-	0x3b, 0x55, 0xdf, 0xf3, 0x18, 0xa9, 0x64, 0x18, 0x71, 0xfe, 0x6c, 0xc1, 0xce, 0x92, 0x5b, 0xa0,
-	0xa7, 0x50, 0x35, 0xc3, 0x53, 0xda, 0x8a, 0x3d, 0x7c, 0x99, 0x8d, 0x6a, 0x53, 0xdb, 0x10, 0xa6,
-	0x2b, 0xcb, 0x14, 0xec, 0x9d, 0x43, 0xa3, 0xb0, 0xb4, 0xa0, 0xc9, 0xf9, 0xb8, 0xd8, 0xe4, 0xbc,
-	0xfd, 0xca, 0xc3, 0x32, 0xaf, 0xcc, 0x9a, 0x9e, 0xc7, 0x8d, 0x2f, 0xea, 0xed, 0x87, 0x1f, 0xa6,
-	0x3b, 0xcf, 0x56, 0xd5, 0xd7, 0xfb, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x9c, 0x85, 0xa9,
-	0xf4, 0x16, 0x00, 0x00,
+	// 2798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0xdf, 0xd1, 0xb7, 0x9e, 0x24, 0x7b, 0xdc, 0xfb, 0x61, 0xad, 0xb3, 0xce, 0x3a, 0x43, 0xa8,
+	0x38, 0xa1, 0xe2, 0x24, 0x4e, 0xa8, 0xa4, 0x12, 0x48, 0xa2, 0x95, 0x27, 0x8e, 0xb0, 0x35, 0x72,
+	0x5a, 0x72, 0x96, 0xa4, 0x20, 0x53, 0xed, 0x99, 0x5e, 0x7b, 0x58, 0xcd, 0x8c, 0x98, 0x69, 0x79,
+	0x57, 0x1c, 0x38, 0xa4, 0x38, 0x52, 0x45, 0x15, 0x27, 0x8e, 0x54, 0x71, 0xa5, 0x28, 0x8a, 0x7f,
+	0x80, 0x03, 0x37, 0x0e, 0xdc, 0x39, 0xc1, 0x91, 0xff, 0x80, 0x2b, 0xd5, 0x1f, 0x33, 0x1a, 0xc9,
+	0xe3, 0x5d, 0xef, 0x06, 0xaa, 0x38, 0x69, 0xfa, 0xf5, 0xeb, 0xd7, 0xdd, 0xaf, 0x7f, 0xef, 0x53,
+	0xb0, 0xe6, 0x84, 0xbe, 0x3f, 0x0d, 0x3c, 0xe6, 0xd1, 0x78, 0x67, 0x12, 0x85, 0x2c, 0x44, 0x35,
+	0xf1, 0x73, 0x32, 0x7d, 0xb0, 0x71, 0xdd, 0x39, 0x23, 0xcc, 0xf6, 0x5c, 0x1a, 0x30, 0x8f, 0xcd,
+	0xe4, 0xf4, 0x46, 0x83, 0x06, 0x53, 0x5f, 0xf1, 0x1a, 0xe7, 0x50, 0xde, 0x8f, 0x48, 0xc0, 0xd0,
+	0x4b, 0xd0, 0x4c, 0x24, 0xcd, 0x6c, 0xcf, 0x6d, 0x6b, 0x5b, 0xda, 0x76, 0x13, 0x37, 0x52, 0x5a,
+	0xcf, 0x45, 0x2f, 0x40, 0xdd, 0xa7, 0xfe, 0x09, 0x8d, 0xf8, 0x7c, 0x41, 0xcc, 0xd7, 0x24, 0xa1,
+	0xe7, 0xa2, 0x75, 0xa8, 0xaa, 0xcd, 0xda, 0xc5, 0x2d, 0x6d, 0xbb, 0x8e, 0x2b, 0x7c, 0xd8, 0x73,
+	0xd1, 0x0d, 0x28, 0x3b, 0xe3, 0xd0, 0x79, 0xd8, 0x2e, 0x6d, 0x69, 0xdb, 0x25, 0x2c, 0x07, 0xc6,
+	0x2f, 0x8a, 0xb0, 0xda, 0x4d, 0x64, 0xf7, 0x85, 0x10, 0xf4, 0x5d, 0x28, 0x47, 0xe1, 0x98, 0xc6,
+	0x6d, 0x6d, 0xab, 0xb8, 0xbd, 0xb2, 0x7b, 0x77, 0x27, 0xb9, 0xc7, 0xce, 0x12, 0xe7, 0x0e, 0xe6,
+	0x6c, 0x58, 0x72, 0xa3, 0x4f, 0x60, 0x2d, 0xa2, 0xe7, 0x94, 0x8c, 0xa9, 0x6b, 0x13, 0xc7, 0x09,
+	0xa7, 0x01, 0x8b, 0xdb, 0x85, 0xad, 0xe2, 0x76, 0x63, 0xf7, 0xf6, 0x5c, 0x04, 0x56, 0x2c, 0x1d,
+	0xc9, 0x81, 0xf5, 0x68, 0x91, 0x10, 0xa3, 0xd7, 0x60, 0x6d, 0x4c, 0x62, 0x66, 0x4f, 0x27, 0x2e,
+	0x61, 0xd4, 0x96, 0x87, 0x2e, 0x8a, 0x43, 0xaf, 0xf2, 0x89, 0x63, 0x41, 0xef, 0x72, 0x32, 0x57,
+	0xc5, 0x4f, 0x42, 0x2f, 0xe0, 0x3b, 0x32, 0x75, 0xb1, 0x9a, 0x24, 0x74, 0x18, 0xba, 0x0d, 0x35,
+	0x7e, 0x32, 0xdb, 0x73, 0xe3, 0x76, 0x79, 0xab, 0xb8, 0x5d, 0xc7, 0x55, 0x3e, 0xee, 0xb9, 0x31,
+	0x7a, 0x19, 0x56, 0x58, 0xf8, 0x90, 0x06, 0x76, 0xca, 0x50, 0x11, 0x0c, 0x4d, 0x41, 0xc5, 0x92,
+	0xcb, 0x38, 0x83, 0xb2, 0xb8, 0x21, 0x6a, 0x41, 0x1d, 0x0f, 0x0e, 0x4d, 0xdb, 0x1a, 0x58, 0xa6,
+	0x7e, 0x0d, 0xad, 0x00, 0x88, 0xe1, 0xe0, 0xbe, 0x65, 0x62, 0x5d, 0x43, 0x37, 0x61, 0x4d, 0x8c,
+	0xfb, 0x1d, 0xab, 0xb3, 0x6f, 0xda, 0xc7, 0x43, 0x13, 0x0f, 0xf5, 0x02, 0xba, 0x0d, 0x37, 0x25,
+	0x79, 0xb0, 0x67, 0xe2, 0xce, 0xc8, 0xb4, 0xbb, 0x03, 0x6b, 0x64, 0x5a, 0x23, 0xbd, 0x98, 0x4a,
+	0xe8, 0xec, 0xf5, 0x7b, 0x96, 0x5e, 0x32, 0xee, 0xc3, 0x8d, 0x25, 0xdd, 0xca, 0x8d, 0xb3, 0x57,
+	0xd0, 0x9e, 0x76, 0x85, 0x42, 0xce, 0x15, 0x7e, 0x5f, 0x80, 0x56, 0x2a, 0x99, 0x13, 0xd1, 0x0a,
+	0x14, 0x14, 0xac, 0xea, 0xb8, 0xe0, 0xb9, 0x08, 0x41, 0x29, 0x20, 0x3e, 0x15, 0x40, 0xaa, 0x63,
+	0xf1, 0x2d, 0xb0, 0x12, 0x8e, 0xc3, 0x48, 0x41, 0x48, 0x0e, 0xd0, 0x27, 0xd0, 0x74, 0xc8, 0x84,
+	0x9c, 0x78, 0x63, 0x81, 0xf2, 0x76, 0x49, 0xc0, 0xc3, 0xc8, 0x81, 0x07, 0xdf, 0x68, 0xa7, 0x9b,
+	0xf0, 0xce, 0xf0, 0xc2, 0x3a, 0xe3, 0x37, 0x1a, 0xc0, 0x7c, 0x12, 0xdd, 0x02, 0x74, 0x6c, 0x1d,
+	0x58, 0x83, 0xfb, 0x96, 0xdd, 0xed, 0x1c, 0x75, 0xee, 0xf5, 0x0e, 0x7b, 0xa3, 0x2f, 0xf4, 0x6b,
+	0x48, 0x87, 0xe6, 0x51, 0xcf, 0xb2, 0xfb, 0xe6, 0x70, 0xd8, 0xd9, 0x37, 0x87, 0xba, 0x86, 0xae,
+	0xc3, 0xea, 0x9e, 0x79, 0x68, 0x8e, 0xcc, 0x39, 0xb1, 0xc0, 0xd9, 0x0e, 0x7a, 0xdd, 0x03, 0xbb,
+	0x6f, 0xf6, 0xef, 0x71, 0xbd, 0x17, 0xd1, 0x2a, 0x34, 0xee, 0x75, 0xac, 0x94, 0x50, 0xe2, 0xeb,
+	0xd4, 0xd3, 0x74, 0x3f, 0xed, 0x58, 0x96, 0x79, 0x38, 0xd4, 0xcb, 0x9c, 0xd8, 0xe9, 0x76, 0xcd,
+	0xa3, 0x91, 0x8d, 0xcd, 0xcf, 0x8e, 0xcd, 0xe1, 0x68, 0xa8, 0x57, 0x8c, 0xaf, 0x8b, 0x70, 0x2b,
+	0xbd, 0xc5, 0x88, 0x2b, 0xb2, 0x4f, 0x19, 0x71, 0x09, 0x23, 0xe8, 0x01, 0x20, 0x27, 0x0c, 0x58,
+	0x44, 0x1c, 0x66, 0x13, 0xd7, 0x8d, 0x68, 0x1c, 0x2b, 0x13, 0x69, 0xec, 0xbe, 0x9b, 0xa3, 0x83,
+	0x85, 0xd5, 0x3b, 0x5d, 0xb5, 0xb4, 0x93, 0xac, 0x34, 0x03, 0x16, 0xcd, 0xf0, 0x9a, 0xb3, 0x4c,
+	0x47, 0x5b, 0xd0, 0x70, 0x69, 0xec, 0x44, 0xde, 0x84, 0x79, 0x61, 0xa0, 0x9e, 0x25, 0x4b, 0xe2,
+	0xaf, 0xe3, 0xf9, 0xe4, 0x94, 0x26, 0xaf, 0x23, 0x06, 0xe8, 0x7d, 0xa8, 0x8b, 0x97, 0x1f, 0xcd,
+	0x26, 0x54, 0x98, 0xc2, 0xca, 0xee, 0x9d, 0xcb, 0x8e, 0xc5, 0x79, 0xf0, 0x9c, 0x1d, 0xdd, 0x82,
+	0x4a, 0x3c, 0xf3, 0x4f, 0xc2, 0x71, 0xbb, 0x2c, 0x7d, 0x86, 0x1c, 0xa5, 0xd8, 0xa8, 0x64, 0xb0,
+	0xb1, 0x01, 0x35, 0x97, 0x3a, 0x9e, 0x4f, 0xc6, 0x71, 0xbb, 0xba, 0xa5, 0x6d, 0xb7, 0x70, 0x3a,
+	0xde, 0xd8, 0xe3, 0xda, 0xcb, 0xbb, 0x28, 0xd2, 0xa1, 0xf8, 0x90, 0xce, 0x04, 0xec, 0x4a, 0x98,
+	0x7f, 0xf2, 0x5b, 0x9c, 0x93, 0xf1, 0x34, 0x01, 0x9e, 0x1c, 0xbc, 0x5f, 0x78, 0x4f, 0x33, 0xfe,
+	0xa9, 0x65, 0xac, 0xe1, 0x88, 0x46, 0xbe, 0x17, 0xc7, 0x5e, 0x18, 0x08, 0x6b, 0xa0, 0x41, 0x6c,
+	0x87, 0xc1, 0x58, 0x4a, 0xaa, 0xe1, 0x2a, 0x0d, 0xe2, 0x41, 0x30, 0x9e, 0xa1, 0x36, 0x54, 0x27,
+	0x91, 0x77, 0x4e, 0x98, 0x94, 0x57, 0xc3, 0xc9, 0x10, 0x7d, 0x1f, 0x2a, 0xc4, 0x71, 0x68, 0x1c,
+	0x0b, 0x75, 0xad, 0xec, 0x7e, 0x3b, 0x47, 0x29, 0x99, 0x4d, 0x76, 0x3a, 0x82, 0x19, 0xab, 0x45,
+	0xc6, 0x08, 0x2a, 0x92, 0x82, 0x10, 0xac, 0x24, 0x38, 0xe5, 0xc0, 0x19, 0x0e, 0xf5, 0x6b, 0x68,
+	0x0d, 0x5a, 0xd6, 0x20, 0x41, 0xda, 0xa7, 0xbd, 0x23, 0x09, 0xd2, 0x9e, 0xf5, 0x79, 0x6f, 0xd4,
+	0x19, 0xf5, 0x06, 0x96, 0x3d, 0xb0, 0x0e, 0xbf, 0xd0, 0x0b, 0xdc, 0xde, 0x07, 0x56, 0x02, 0x34,
+	0xbd, 0xc8, 0xdd, 0x6e, 0x4b, 0xbc, 0x44, 0x37, 0xf2, 0x18, 0x8d, 0x3c, 0x82, 0x7e, 0xfc, 0x04,
+	0x78, 0xed, 0xcc, 0x8f, 0xbc, 0xb0, 0xe8, 0x19, 0x50, 0xf5, 0x26, 0x94, 0x18, 0x07, 0x46, 0xe1,
+	0x0a, 0xc0, 0x10, 0x9c, 0x19, 0x4c, 0x14, 0x73, 0x31, 0x51, 0xca, 0x60, 0xe2, 0x16, 0x54, 0x88,
+	0xcf, 0xbd, 0x77, 0x82, 0x1f, 0x39, 0xe2, 0xee, 0x59, 0xfa, 0xa8, 0xc4, 0xc3, 0x96, 0x70, 0x4d,
+	0x10, 0xb8, 0x03, 0xbb, 0x0b, 0x0d, 0xfe, 0x9a, 0x13, 0xc2, 0x18, 0x8d, 0x02, 0x81, 0xa5, 0x3a,
+	0x06, 0x1a, 0xc4, 0x47, 0x92, 0xb2, 0x80, 0xb4, 0x9a, 0xf4, 0xed, 0xff, 0x65, 0xa4, 0xfd, 0xb2,
+	0x08, 0xed, 0x45, 0x05, 0xcc, 0x91, 0x70, 0xc1, 0x51, 0x7e, 0xb0, 0xa0, 0xc2, 0x57, 0x2e, 0x53,
+	0xe1, 0x5c, 0xc2, 0x4e, 0x46, 0x9b, 0x1f, 0x26, 0xde, 0xda, 0x51, 0x6f, 0xd7, 0x2e, 0x8a, 0xa7,
+	0x5d, 0xbf, 0xe4, 0x69, 0x71, 0x8b, 0x2d, 0xc0, 0xe3, 0x36, 0xd4, 0x54, 0x58, 0x97, 0x7e, 0xb7,
+	0x8e, 0xab, 0x32, 0xae, 0xc7, 0x68, 0x13, 0xc0, 0x8b, 0xed, 0x04, 0xfd, 0x65, 0x81, 0xfe, 0xba,
+	0x17, 0x1f, 0x29, 0xfc, 0xaf, 0x43, 0x55, 0x45, 0x08, 0x65, 0xc6, 0x15, 0x19, 0x41, 0x8c, 0x5f,
+	0x69, 0x50, 0x12, 0xd6, 0x7f, 0x07, 0xda, 0x09, 0xb0, 0x47, 0x83, 0x03, 0xd3, 0xb2, 0x8f, 0x4c,
+	0xdc, 0xef, 0x0d, 0x87, 0xbd, 0x81, 0x25, 0xdd, 0xf0, 0x3d, 0xb3, 0x3b, 0xe8, 0x27, 0xc1, 0x4a,
+	0xe3, 0xa0, 0x57, 0x14, 0x09, 0x7c, 0xbd, 0x80, 0x6e, 0x80, 0xde, 0xed, 0x58, 0xf6, 0xe7, 0x3d,
+	0xf3, 0x7e, 0xe2, 0x63, 0xf5, 0x22, 0xda, 0x84, 0xdb, 0x29, 0xb5, 0x63, 0xed, 0xd9, 0x47, 0x83,
+	0xe1, 0x28, 0x9d, 0x2e, 0x09, 0x3f, 0x2d, 0xe5, 0xf0, 0x58, 0xa8, 0x97, 0x8d, 0xbf, 0x37, 0x32,
+	0x86, 0xbf, 0xb7, 0xe8, 0xf1, 0x64, 0x1a, 0xa0, 0x65, 0x72, 0x17, 0x64, 0x42, 0x55, 0xa6, 0x3d,
+	0x49, 0x9a, 0xf1, 0x9d, 0x9c, 0x37, 0xc9, 0x88, 0xd9, 0x91, 0x91, 0x55, 0x19, 0x49, 0xb2, 0x16,
+	0x7d, 0x0c, 0x8d, 0xc9, 0xdc, 0xfe, 0x05, 0xda, 0x1b, 0xbb, 0x2f, 0x3e, 0xd9, 0x4b, 0xe0, 0xec,
+	0x12, 0xb4, 0x0b, 0xb5, 0x24, 0xb7, 0x13, 0xfa, 0x6f, 0xec, 0xde, 0xca, 0x2c, 0x17, 0xcf, 0x24,
+	0x67, 0x71, 0xca, 0x87, 0x3e, 0x82, 0x32, 0x7f, 0x40, 0x69, 0x16, 0x8d, 0xdd, 0x57, 0x9f, 0x72,
+	0x74, 0x2e, 0x45, 0x1d, 0x5c, 0xae, 0xe3, 0x88, 0x38, 0x21, 0x81, 0x3d, 0xf6, 0x62, 0xd6, 0xae,
+	0x4a, 0x44, 0x9c, 0x90, 0xe0, 0xd0, 0x8b, 0x19, 0xb2, 0x00, 0x1c, 0xc2, 0xe8, 0x69, 0x18, 0xf1,
+	0x30, 0x5d, 0x5b, 0xf6, 0x21, 0xf9, 0x1b, 0xa4, 0x0b, 0xe4, 0x2e, 0x19, 0x09, 0xe8, 0x3d, 0x68,
+	0x93, 0xc8, 0x39, 0xf3, 0xce, 0xa9, 0xed, 0x93, 0xd3, 0x80, 0xb2, 0xb1, 0x17, 0x3c, 0x54, 0x89,
+	0x59, 0x5d, 0xbc, 0xc8, 0x2d, 0x35, 0xdf, 0x4f, 0xa7, 0x65, 0x7e, 0xb6, 0x0f, 0x2b, 0xc4, 0xf5,
+	0xbd, 0xc0, 0x8e, 0x29, 0x63, 0x5e, 0x70, 0x1a, 0xb7, 0x41, 0xe8, 0x67, 0x2b, 0xe7, 0x34, 0x1d,
+	0xce, 0x38, 0x54, 0x7c, 0xb8, 0x45, 0xb2, 0x43, 0xf4, 0x2d, 0x68, 0x79, 0x01, 0x8b, 0x42, 0xdb,
+	0xa7, 0x71, 0xcc, 0x63, 0x5f, 0x43, 0x60, 0xb9, 0x29, 0x88, 0x7d, 0x49, 0xe3, 0x4c, 0xe1, 0x34,
+	0xcb, 0xd4, 0x94, 0x4c, 0x82, 0x98, 0x30, 0xdd, 0x81, 0x3a, 0x0d, 0x9c, 0x68, 0x36, 0x61, 0xd4,
+	0x6d, 0xb7, 0xa4, 0xb5, 0xa4, 0x04, 0xee, 0xdd, 0x18, 0x39, 0x8d, 0xdb, 0x2b, 0x42, 0xa3, 0xe2,
+	0x1b, 0x11, 0x58, 0x93, 0xb6, 0x9b, 0x85, 0xc9, 0xaa, 0xd0, 0xea, 0x3b, 0x4f, 0xd1, 0xea, 0x92,
+	0x47, 0x50, 0xba, 0xd5, 0xd9, 0x12, 0x19, 0xfd, 0x08, 0x6e, 0xcf, 0xb3, 0x7e, 0x31, 0x1b, 0xdb,
+	0xbe, 0xca, 0x1d, 0xda, 0xba, 0xd8, 0x6a, 0xeb, 0x69, 0x39, 0x06, 0x5e, 0x77, 0x16, 0xe8, 0x71,
+	0x9a, 0xba, 0xbc, 0x09, 0x37, 0x88, 0xc3, 0xc4, 0xf3, 0x49, 0xcc, 0xdb, 0x22, 0xd5, 0x6e, 0xaf,
+	0x89, 0xb7, 0x43, 0x72, 0x4e, 0x19, 0x47, 0x57, 0x38, 0xee, 0x3e, 0xe8, 0x7e, 0xe8, 0xd2, 0x88,
+	0xf0, 0x5b, 0xd8, 0xd1, 0x94, 0x57, 0x03, 0x48, 0xbc, 0x5c, 0x5e, 0xba, 0xd7, 0x4f, 0x59, 0x31,
+	0xe7, 0xc4, 0xab, 0xfe, 0x22, 0x81, 0x83, 0x5d, 0x56, 0x14, 0xd7, 0xaf, 0x04, 0x76, 0x91, 0xfb,
+	0x2a, 0xb0, 0x8b, 0x75, 0x1b, 0xc7, 0xd0, 0xcc, 0x1a, 0x6f, 0xd6, 0xc9, 0xd7, 0xa5, 0x93, 0x7f,
+	0x23, 0xeb, 0xe4, 0x17, 0x2a, 0x8e, 0xe5, 0xc4, 0x7a, 0xee, 0xff, 0x37, 0x3e, 0x03, 0x98, 0x1b,
+	0x56, 0x8e, 0xd0, 0xd7, 0x17, 0x85, 0xae, 0xe7, 0x08, 0xe5, 0xeb, 0xb3, 0x22, 0xbf, 0x84, 0xd5,
+	0x25, 0x53, 0xca, 0x91, 0xfb, 0xd6, 0xa2, 0xdc, 0x17, 0xf2, 0xe4, 0x4a, 0x21, 0xb3, 0xac, 0xec,
+	0x53, 0xb8, 0x99, 0x0b, 0xa8, 0x9c, 0x1d, 0xde, 0x5b, 0xdc, 0xc1, 0x78, 0x7a, 0xb4, 0x5a, 0xd2,
+	0xcb, 0xfc, 0x0d, 0x9e, 0x4f, 0x2f, 0x7c, 0x7d, 0x36, 0xd4, 0x7e, 0x95, 0x49, 0xac, 0x17, 0x2c,
+	0x1d, 0xed, 0xc1, 0xdd, 0x89, 0x17, 0x24, 0x36, 0x6b, 0x93, 0xf1, 0x38, 0x85, 0x29, 0x0d, 0xc8,
+	0xc9, 0x98, 0xba, 0x2a, 0xd9, 0x7b, 0x61, 0xe2, 0x05, 0xca, 0x8a, 0x3b, 0xe3, 0x71, 0x8a, 0x07,
+	0xc1, 0x62, 0xfc, 0x43, 0xcb, 0x84, 0xf2, 0x25, 0x40, 0xf2, 0xa2, 0xfa, 0x84, 0x04, 0xbc, 0x4c,
+	0x7c, 0x14, 0x46, 0x69, 0x29, 0xd5, 0x90, 0xb4, 0xfb, 0x9c, 0x84, 0x5e, 0x81, 0x55, 0xc5, 0xa2,
+	0x12, 0x92, 0xa4, 0x9e, 0x5a, 0x91, 0x64, 0x95, 0x94, 0xc4, 0xa2, 0xfa, 0x26, 0x8f, 0x6d, 0xee,
+	0xe3, 0x64, 0xb0, 0x68, 0xe1, 0x9a, 0x4f, 0x1e, 0x1f, 0xf2, 0x31, 0xdf, 0x88, 0x4f, 0xfa, 0xdc,
+	0xc9, 0x73, 0x2f, 0x51, 0x12, 0xf3, 0x0d, 0x9f, 0x3c, 0xee, 0x2b, 0x12, 0x7a, 0x17, 0xda, 0x01,
+	0x7d, 0xa4, 0xae, 0x68, 0xbb, 0x74, 0x4c, 0x66, 0x76, 0x4c, 0x9d, 0x30, 0x10, 0x55, 0x2a, 0x67,
+	0xbf, 0x19, 0xd0, 0x47, 0xf2, 0x76, 0x7b, 0x7c, 0x76, 0x28, 0x27, 0x8d, 0x3f, 0x95, 0x32, 0xa5,
+	0x1c, 0x87, 0x1d, 0xfa, 0x70, 0x1e, 0x00, 0x65, 0xa2, 0xf8, 0xf2, 0x25, 0x00, 0xbd, 0x5a, 0xe4,
+	0x2b, 0x7c, 0xb3, 0xc8, 0x57, 0xbc, 0x62, 0xe4, 0xbb, 0x0b, 0x0d, 0x15, 0x5b, 0x44, 0x83, 0x43,
+	0xe6, 0x91, 0x49, 0xb8, 0x99, 0xf5, 0x5c, 0x9e, 0xf7, 0x4d, 0xc2, 0xd8, 0x13, 0xe5, 0x0f, 0xd7,
+	0x48, 0x19, 0xa7, 0x63, 0xf4, 0x1a, 0xac, 0xc5, 0xe3, 0xf0, 0x91, 0xcd, 0x3d, 0x4c, 0xaa, 0xb6,
+	0x8a, 0x50, 0xdb, 0x2a, 0x9f, 0xe0, 0x2f, 0xaf, 0x14, 0x86, 0x0e, 0xa0, 0xa9, 0xb4, 0x2c, 0x9d,
+	0x4f, 0x55, 0xe8, 0x68, 0xfb, 0xc9, 0x3a, 0xca, 0xf8, 0x9e, 0x86, 0x3f, 0xa7, 0xfc, 0xaf, 0x3c,
+	0xd0, 0x57, 0xa0, 0x2f, 0xef, 0x9b, 0x23, 0xfa, 0x9d, 0x45, 0xd1, 0x2f, 0x5e, 0x2e, 0x5a, 0x36,
+	0x64, 0xe6, 0x66, 0xe7, 0xc2, 0xda, 0x05, 0x97, 0xb2, 0xfc, 0x02, 0xda, 0x85, 0x17, 0xc8, 0xeb,
+	0x09, 0x64, 0x5f, 0xa5, 0xb8, 0xf8, 0x2a, 0xbc, 0xa2, 0xbf, 0x9e, 0x6e, 0xd3, 0x0b, 0xce, 0x3d,
+	0x26, 0x8c, 0x0f, 0xbd, 0x0d, 0x37, 0xe7, 0x61, 0x2d, 0x5b, 0xd5, 0xca, 0xae, 0xd6, 0x0d, 0xe7,
+	0x92, 0x64, 0xef, 0x34, 0x22, 0x01, 0x53, 0xad, 0x2d, 0x39, 0xb8, 0xbc, 0xaf, 0xb5, 0x09, 0x30,
+	0x99, 0x9e, 0x8c, 0x3d, 0xc7, 0xe6, 0x4a, 0x2b, 0x89, 0x35, 0x75, 0x49, 0x39, 0xa0, 0x33, 0xe3,
+	0xd7, 0x1a, 0xac, 0x2e, 0xf5, 0x9c, 0x78, 0xb1, 0xa8, 0x4a, 0x2c, 0x75, 0xf7, 0x64, 0xc8, 0x93,
+	0x83, 0xd8, 0x3b, 0x0d, 0x08, 0x9b, 0x46, 0x54, 0xed, 0x3f, 0x27, 0x70, 0xd3, 0x77, 0xce, 0x88,
+	0x27, 0xcb, 0x99, 0xa2, 0x2c, 0x67, 0x04, 0x81, 0xa7, 0xe1, 0xaf, 0x81, 0xee, 0xc5, 0x1d, 0x2f,
+	0x72, 0xa3, 0x70, 0xa2, 0x4a, 0x12, 0x71, 0x9a, 0x1a, 0xbe, 0x40, 0x37, 0xfe, 0x58, 0xc8, 0x78,
+	0x43, 0x4c, 0x7f, 0x3a, 0xa5, 0x31, 0x1b, 0x85, 0x3f, 0x08, 0xbd, 0xcb, 0x52, 0x5d, 0x55, 0xf9,
+	0x66, 0x1e, 0x85, 0x57, 0xbe, 0x16, 0x7f, 0x97, 0x4b, 0x15, 0xb3, 0xdc, 0x49, 0x2c, 0x5d, 0xec,
+	0x24, 0xbe, 0x04, 0x4d, 0xd7, 0x8b, 0x27, 0xdc, 0x05, 0x09, 0xd1, 0x65, 0xd5, 0x6c, 0x90, 0x34,
+	0x21, 0x3e, 0xb7, 0xab, 0x57, 0x79, 0xf6, 0xae, 0xde, 0x87, 0xd0, 0xf0, 0x38, 0x30, 0xa8, 0xf0,
+	0x9c, 0xa2, 0xda, 0x6b, 0xec, 0x6e, 0xe6, 0x00, 0x59, 0xc0, 0x87, 0x72, 0x77, 0x8a, 0xc1, 0x4b,
+	0xbf, 0x8d, 0x7f, 0x6b, 0x99, 0x46, 0xa5, 0xe4, 0xb9, 0x62, 0xaf, 0x54, 0x6d, 0x3b, 0xef, 0x95,
+	0x4a, 0x42, 0xb6, 0x25, 0x5a, 0xcc, 0xea, 0x7a, 0x13, 0x80, 0x3e, 0x9e, 0x78, 0x11, 0x8d, 0xe7,
+	0x4d, 0xc5, 0xba, 0xa2, 0xc8, 0xae, 0x22, 0x77, 0xf1, 0x53, 0x5e, 0x9e, 0x4b, 0x7f, 0x5d, 0xf5,
+	0xc9, 0xe3, 0x63, 0x5e, 0x64, 0xbf, 0x0d, 0x25, 0xee, 0x69, 0x84, 0x3f, 0xba, 0x42, 0xdf, 0x54,
+	0x30, 0x73, 0x63, 0x24, 0x53, 0x16, 0x72, 0xe5, 0xd2, 0x09, 0x13, 0x8a, 0xa9, 0x61, 0xe0, 0xa4,
+	0x8e, 0xa0, 0x18, 0xfb, 0xcb, 0xb6, 0x25, 0x14, 0xc2, 0x6d, 0x54, 0x64, 0x87, 0x12, 0xc1, 0xe2,
+	0xfb, 0x22, 0x7c, 0xeb, 0x19, 0xf8, 0x1a, 0xbf, 0xd5, 0x60, 0x33, 0x95, 0x64, 0xba, 0x1e, 0xc3,
+	0xcb, 0x8f, 0x94, 0x0f, 0xbe, 0x65, 0x35, 0x17, 0x2e, 0xaa, 0x39, 0x17, 0x25, 0xc5, 0x67, 0x46,
+	0x89, 0xf1, 0x07, 0x0d, 0xee, 0x64, 0xfc, 0x55, 0xe0, 0xd0, 0xf1, 0xff, 0xb5, 0x79, 0x18, 0xff,
+	0xd2, 0xe0, 0xc5, 0x7c, 0x4b, 0xc6, 0x34, 0x9e, 0x84, 0x41, 0x4c, 0x2f, 0x39, 0xf2, 0xf7, 0xa0,
+	0x9e, 0x6e, 0xf5, 0x04, 0xb7, 0x9e, 0x71, 0x8c, 0x78, 0xbe, 0x80, 0x3b, 0x63, 0x89, 0x17, 0x2a,
+	0xaf, 0x55, 0xc3, 0xe9, 0x78, 0xee, 0x3f, 0x4b, 0x59, 0xff, 0xb9, 0x7c, 0xdd, 0xf2, 0xc5, 0xeb,
+	0x6e, 0x02, 0xc8, 0xf2, 0xce, 0x9e, 0x46, 0x9e, 0x6a, 0x16, 0xd4, 0x25, 0xe5, 0x38, 0xf2, 0x0c,
+	0x0c, 0xeb, 0x17, 0x6f, 0x7a, 0x48, 0xc9, 0x39, 0x7d, 0x6e, 0xdc, 0x18, 0x3f, 0x84, 0x97, 0x32,
+	0xb6, 0x21, 0x53, 0xbb, 0xe5, 0x4a, 0xf2, 0x12, 0xe9, 0x8b, 0xa7, 0x2d, 0x2c, 0x9f, 0xf6, 0xcf,
+	0x1a, 0x34, 0xee, 0x93, 0x87, 0xd3, 0xa4, 0xec, 0xd3, 0xa1, 0x18, 0x7b, 0xa7, 0xca, 0x45, 0xf0,
+	0x4f, 0x6e, 0x2c, 0xcc, 0xf3, 0x69, 0xcc, 0x88, 0x3f, 0x11, 0xeb, 0x4b, 0x78, 0x4e, 0xe0, 0x9b,
+	0xb2, 0x70, 0xe2, 0x39, 0x42, 0xbd, 0x4d, 0x2c, 0x07, 0xa2, 0xcd, 0x48, 0x66, 0xe3, 0x90, 0x24,
+	0x78, 0x49, 0x86, 0x72, 0xc6, 0x75, 0xbd, 0xe0, 0x54, 0xa9, 0x36, 0x19, 0x72, 0x43, 0x3d, 0x23,
+	0xf1, 0x99, 0x50, 0x68, 0x13, 0x8b, 0x6f, 0x64, 0x40, 0x93, 0x9d, 0x79, 0x91, 0x7b, 0x44, 0x22,
+	0xae, 0x07, 0xd5, 0xfc, 0x5a, 0xa0, 0x19, 0x3f, 0x87, 0x8d, 0xcc, 0x05, 0x12, 0xb5, 0x24, 0x35,
+	0x5d, 0x1b, 0xaa, 0xe7, 0x34, 0x8a, 0x93, 0x60, 0xda, 0xc2, 0xc9, 0x90, 0xef, 0xf7, 0x20, 0x0a,
+	0x7d, 0x75, 0x25, 0xf1, 0x8d, 0x56, 0xa0, 0xc0, 0x42, 0xe5, 0xe6, 0x0a, 0x2c, 0xe4, 0xfb, 0x3b,
+	0x61, 0xc0, 0x68, 0xc0, 0x46, 0xe2, 0x92, 0xa5, 0xad, 0xe2, 0x76, 0x13, 0x2f, 0xd0, 0x8c, 0xdf,
+	0x69, 0x80, 0x2e, 0x1e, 0xe0, 0x09, 0x1b, 0x7f, 0x0c, 0xb5, 0xb4, 0x66, 0x95, 0x88, 0xce, 0xe4,
+	0xa3, 0x97, 0x5f, 0x05, 0xa7, 0xab, 0xd0, 0x5b, 0x5c, 0x82, 0xe0, 0x49, 0xbc, 0xc7, 0xcd, 0x5c,
+	0x09, 0x38, 0x65, 0x33, 0xfe, 0xa2, 0xc1, 0xdd, 0x8b, 0xb2, 0x7b, 0x81, 0x4b, 0x1f, 0x5f, 0x41,
+	0x57, 0xdf, 0xfc, 0xc8, 0xb7, 0xa0, 0x12, 0x3e, 0x78, 0x10, 0x53, 0xa6, 0xb4, 0xab, 0x46, 0xfc,
+	0x15, 0x62, 0xef, 0x67, 0x54, 0xc5, 0x0f, 0xf1, 0xbd, 0x8c, 0x91, 0x52, 0x8a, 0x11, 0xe3, 0x6f,
+	0x1a, 0xac, 0x5f, 0x72, 0x0b, 0x74, 0x00, 0x35, 0xd5, 0x5d, 0x49, 0xd2, 0xfc, 0x37, 0x9e, 0x74,
+	0x46, 0xb1, 0x68, 0x47, 0x0d, 0x54, 0x26, 0x9b, 0x0a, 0xd8, 0x78, 0x00, 0xad, 0x85, 0xa9, 0x9c,
+	0x64, 0xf3, 0xa3, 0xc5, 0x64, 0xf3, 0xd5, 0xa7, 0x6e, 0x96, 0x6a, 0x25, 0x93, 0x77, 0xfe, 0x35,
+	0x9b, 0xe1, 0x74, 0xc9, 0x98, 0x06, 0x2e, 0x89, 0xcc, 0x73, 0x1a, 0xb0, 0xe7, 0x0f, 0x32, 0xb2,
+	0x21, 0x5b, 0x4c, 0x1b, 0xb2, 0x19, 0xd7, 0x5e, 0x5a, 0xfe, 0xab, 0x93, 0x79, 0x6c, 0x9c, 0x38,
+	0x6c, 0x39, 0x58, 0xfe, 0x63, 0xa5, 0x72, 0xf1, 0x8f, 0x95, 0x4d, 0x80, 0x98, 0x91, 0x88, 0xd9,
+	0xdc, 0x0d, 0x08, 0x9b, 0x2c, 0xe1, 0xba, 0xa0, 0x8c, 0x3c, 0x9f, 0xca, 0x28, 0xe3, 0xca, 0x49,
+	0xd9, 0x8f, 0xae, 0xd2, 0xc0, 0x15, 0x53, 0x17, 0xdb, 0xbb, 0xf5, 0x67, 0x6a, 0xef, 0xde, 0x81,
+	0xba, 0x23, 0xa2, 0x1d, 0xaf, 0x76, 0x41, 0x36, 0xa5, 0x52, 0x82, 0xf1, 0x75, 0x01, 0x36, 0xf2,
+	0x95, 0x89, 0xe3, 0xf3, 0xc9, 0xf3, 0x2b, 0x94, 0x5f, 0x88, 0x4b, 0x99, 0x07, 0xc7, 0xaa, 0x18,
+	0xf7, 0x5c, 0xd4, 0x83, 0x5a, 0xa4, 0x02, 0x98, 0xfa, 0x33, 0xe9, 0xf5, 0xdc, 0x26, 0xc5, 0xd2,
+	0x59, 0x76, 0x92, 0xa8, 0x87, 0xd3, 0xe5, 0x86, 0x09, 0xb5, 0x4c, 0x2c, 0xd4, 0x93, 0x56, 0x33,
+	0x36, 0x87, 0x47, 0x03, 0x6b, 0x68, 0xea, 0xd7, 0x50, 0x1d, 0xca, 0xfb, 0x83, 0x9e, 0xb5, 0xaf,
+	0x6b, 0xfc, 0xb3, 0xdf, 0xf9, 0xe2, 0x9e, 0xa9, 0x17, 0x50, 0x0b, 0xea, 0xd6, 0x60, 0x64, 0xcb,
+	0x99, 0xe2, 0xbd, 0xd6, 0x97, 0x8d, 0x9d, 0x37, 0x3e, 0x48, 0xce, 0x70, 0x52, 0x11, 0x5f, 0x6f,
+	0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x58, 0xfc, 0x18, 0x2d, 0x78, 0x1f, 0x00, 0x00,
 }
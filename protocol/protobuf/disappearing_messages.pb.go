@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: disappearing_messages.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DisappearingMessages struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// The type of message (one-to-one/private-group-chat)
+	MessageType MessageType `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	// Number of seconds after which new messages in the chat are deleted,
+	// 0 disables disappearing messages
+	Timer                uint64   `protobuf:"varint,4,opt,name=timer,proto3" json:"timer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisappearingMessages) Reset()         { *m = DisappearingMessages{} }
+func (m *DisappearingMessages) String() string { return proto.CompactTextString(m) }
+func (*DisappearingMessages) ProtoMessage()    {}
+func (*DisappearingMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ea345024b46b3ac, []int{0}
+}
+
+func (m *DisappearingMessages) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisappearingMessages.Unmarshal(m, b)
+}
+func (m *DisappearingMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisappearingMessages.Marshal(b, m, deterministic)
+}
+func (m *DisappearingMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisappearingMessages.Merge(m, src)
+}
+func (m *DisappearingMessages) XXX_Size() int {
+	return xxx_messageInfo_DisappearingMessages.Size(m)
+}
+func (m *DisappearingMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisappearingMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisappearingMessages proto.InternalMessageInfo
+
+func (m *DisappearingMessages) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *DisappearingMessages) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *DisappearingMessages) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *DisappearingMessages) GetTimer() uint64 {
+	if m != nil {
+		return m.Timer
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DisappearingMessages)(nil), "protobuf.DisappearingMessages")
+}
+
+func init() {
+	proto.RegisterFile("disappearing_messages.proto", fileDescriptor_1ea345024b46b3ac)
+}
+
+var fileDescriptor_1ea345024b46b3ac = []byte{
+	// 180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xc9, 0x2c, 0x4e,
+	0x2c, 0x28, 0x48, 0x4d, 0x2c, 0xca, 0xcc, 0x4b, 0x8f, 0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f,
+	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x00, 0x53, 0x49, 0xa5, 0x69, 0x52, 0xdc,
+	0xa9, 0x79, 0xa5, 0xb9, 0x50, 0x61, 0xa5, 0xa9, 0x8c, 0x5c, 0x22, 0x2e, 0x48, 0xda, 0x7c, 0xa1,
+	0xba, 0x84, 0x44, 0xb8, 0x58, 0x93, 0x73, 0xf2, 0x93, 0xb3, 0x25, 0x18, 0x15, 0x18, 0x35, 0x58,
+	0x82, 0x20, 0x1c, 0x21, 0x71, 0x2e, 0xf6, 0xe4, 0x8c, 0xc4, 0x92, 0xf8, 0xcc, 0x14, 0x09, 0x26,
+	0x05, 0x46, 0x0d, 0xce, 0x20, 0x36, 0x10, 0xd7, 0x33, 0x45, 0xc8, 0x82, 0x8b, 0x07, 0x6a, 0x61,
+	0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0xa8, 0x1e, 0xcc, 0x56,
+	0x3d, 0xa8, 0xc1, 0x21, 0x95, 0x05, 0xa9, 0x41, 0xdc, 0xb9, 0x08, 0x0e, 0xc8, 0xa2, 0x92, 0xcc,
+	0xdc, 0xd4, 0x22, 0x09, 0x16, 0x88, 0x45, 0x60, 0x8e, 0x13, 0x6f, 0x14, 0xb7, 0x9e, 0xbe, 0x35,
+	0x4c, 0x77, 0x12, 0x1b, 0x98, 0x65, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x15, 0xf5, 0xe7,
+	0xe3, 0x00, 0x00, 0x00,
+}
@@ -230,6 +230,37 @@ func (SyncContactRequestDecision_DecisionStatus) EnumDescriptor() ([]byte, []int
 	return fileDescriptor_d61ab7221f0b5518, []int{33, 0}
 }
 
+type SyncScheduledMessage_State int32
+
+const (
+	SyncScheduledMessage_PENDING   SyncScheduledMessage_State = 0
+	SyncScheduledMessage_SENT      SyncScheduledMessage_State = 1
+	SyncScheduledMessage_CANCELLED SyncScheduledMessage_State = 2
+	SyncScheduledMessage_FAILED    SyncScheduledMessage_State = 3
+)
+
+var SyncScheduledMessage_State_name = map[int32]string{
+	0: "PENDING",
+	1: "SENT",
+	2: "CANCELLED",
+	3: "FAILED",
+}
+
+var SyncScheduledMessage_State_value = map[string]int32{
+	"PENDING":   0,
+	"SENT":      1,
+	"CANCELLED": 2,
+	"FAILED":    3,
+}
+
+func (x SyncScheduledMessage_State) String() string {
+	return proto.EnumName(SyncScheduledMessage_State_name, int32(x))
+}
+
+func (SyncScheduledMessage_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{41, 0}
+}
+
 // `FetchingBackedUpDataDetails` is used to describe how many messages a single backup data structure consists of
 type FetchingBackedUpDataDetails struct {
 	DataNumber           uint32   `protobuf:"varint,1,opt,name=data_number,json=dataNumber,proto3" json:"data_number,omitempty"`
@@ -1304,7 +1335,7 @@ func (m *SyncInstallationPublicChat) GetId() string {
 type SyncCommunity struct {
 	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Id    []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// Don't sync private_key vecause we want to have only one control node
+	// Don't sync private_key because we want to have only one control node
 	PrivateKey           []byte                         `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"` // Deprecated: Do not use.
 	Description          []byte                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Joined               bool                           `protobuf:"varint,5,opt,name=joined,proto3" json:"joined,omitempty"`
@@ -1925,6 +1956,7 @@ type SyncActivityCenterNotification struct {
 	ContactVerificationStatus SyncActivityCenterNotification_ContactVerificationStatus `protobuf:"varint,13,opt,name=contact_verification_status,json=contactVerificationStatus,proto3,enum=protobuf.SyncActivityCenterNotification_ContactVerificationStatus" json:"contact_verification_status,omitempty"`
 	Deleted                   bool                                                     `protobuf:"varint,14,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	UpdatedAt                 uint64                                                   `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ModerationViolation       string                                                   `protobuf:"bytes,16,opt,name=moderation_violation,json=moderationViolation,proto3" json:"moderation_violation,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                                                 `json:"-"`
 	XXX_unrecognized          []byte                                                   `json:"-"`
 	XXX_sizecache             int32                                                    `json:"-"`
@@ -2060,6 +2092,13 @@ func (m *SyncActivityCenterNotification) GetUpdatedAt() uint64 {
 	return 0
 }
 
+func (m *SyncActivityCenterNotification) GetModerationViolation() string {
+	if m != nil {
+		return m.ModerationViolation
+	}
+	return ""
+}
+
 type SyncBookmark struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
@@ -3405,6 +3444,242 @@ func (m *SyncAccountCustomizationColor) GetKeyUid() string {
 	return ""
 }
 
+type SyncMessageThread struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Id of the first message of the thread
+	ThreadId string `protobuf:"bytes,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Muted    bool   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+	// Replies up to this clock value have been read
+	ReadClock            uint64   `protobuf:"varint,5,opt,name=read_clock,json=readClock,proto3" json:"read_clock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncMessageThread) Reset()         { *m = SyncMessageThread{} }
+func (m *SyncMessageThread) String() string { return proto.CompactTextString(m) }
+func (*SyncMessageThread) ProtoMessage()    {}
+func (*SyncMessageThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{40}
+}
+
+func (m *SyncMessageThread) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncMessageThread.Unmarshal(m, b)
+}
+func (m *SyncMessageThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncMessageThread.Marshal(b, m, deterministic)
+}
+func (m *SyncMessageThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncMessageThread.Merge(m, src)
+}
+func (m *SyncMessageThread) XXX_Size() int {
+	return xxx_messageInfo_SyncMessageThread.Size(m)
+}
+func (m *SyncMessageThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncMessageThread.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncMessageThread proto.InternalMessageInfo
+
+func (m *SyncMessageThread) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncMessageThread) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncMessageThread) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *SyncMessageThread) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+func (m *SyncMessageThread) GetReadClock() uint64 {
+	if m != nil {
+		return m.ReadClock
+	}
+	return 0
+}
+
+type SyncScheduledMessage struct {
+	Clock      uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ChatId     string `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Text       string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	ResponseTo string `protobuf:"bytes,5,opt,name=response_to,json=responseTo,proto3" json:"response_to,omitempty"`
+	// Unix timestamp in milliseconds at which the message is sent
+	ScheduledAt uint64 `protobuf:"varint,6,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// Only the installation that scheduled or last edited the message sends it
+	InstallationId string                     `protobuf:"bytes,7,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	State          SyncScheduledMessage_State `protobuf:"varint,8,opt,name=state,proto3,enum=protobuf.SyncScheduledMessage_State" json:"state,omitempty"`
+	// Id of the chat message once sent
+	MessageId            string   `protobuf:"bytes,9,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncScheduledMessage) Reset()         { *m = SyncScheduledMessage{} }
+func (m *SyncScheduledMessage) String() string { return proto.CompactTextString(m) }
+func (*SyncScheduledMessage) ProtoMessage()    {}
+func (*SyncScheduledMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{41}
+}
+
+func (m *SyncScheduledMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncScheduledMessage.Unmarshal(m, b)
+}
+func (m *SyncScheduledMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncScheduledMessage.Marshal(b, m, deterministic)
+}
+func (m *SyncScheduledMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncScheduledMessage.Merge(m, src)
+}
+func (m *SyncScheduledMessage) XXX_Size() int {
+	return xxx_messageInfo_SyncScheduledMessage.Size(m)
+}
+func (m *SyncScheduledMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncScheduledMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncScheduledMessage proto.InternalMessageInfo
+
+func (m *SyncScheduledMessage) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncScheduledMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SyncScheduledMessage) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncScheduledMessage) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SyncScheduledMessage) GetResponseTo() string {
+	if m != nil {
+		return m.ResponseTo
+	}
+	return ""
+}
+
+func (m *SyncScheduledMessage) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *SyncScheduledMessage) GetInstallationId() string {
+	if m != nil {
+		return m.InstallationId
+	}
+	return ""
+}
+
+func (m *SyncScheduledMessage) GetState() SyncScheduledMessage_State {
+	if m != nil {
+		return m.State
+	}
+	return SyncScheduledMessage_PENDING
+}
+
+func (m *SyncScheduledMessage) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+type SyncCommunityCalendarEvent struct {
+	Event *CommunityCalendarEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Public key of the admin who published the event
+	PublishedBy string `protobuf:"bytes,2,opt,name=published_by,json=publishedBy,proto3" json:"published_by,omitempty"`
+	// Our own response to the event, if any
+	Rsvp                 *CommunityCalendarEventRsvp `protobuf:"bytes,3,opt,name=rsvp,proto3" json:"rsvp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SyncCommunityCalendarEvent) Reset()         { *m = SyncCommunityCalendarEvent{} }
+func (m *SyncCommunityCalendarEvent) String() string { return proto.CompactTextString(m) }
+func (*SyncCommunityCalendarEvent) ProtoMessage()    {}
+func (*SyncCommunityCalendarEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{42}
+}
+
+func (m *SyncCommunityCalendarEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCommunityCalendarEvent.Unmarshal(m, b)
+}
+func (m *SyncCommunityCalendarEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCommunityCalendarEvent.Marshal(b, m, deterministic)
+}
+func (m *SyncCommunityCalendarEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCommunityCalendarEvent.Merge(m, src)
+}
+func (m *SyncCommunityCalendarEvent) XXX_Size() int {
+	return xxx_messageInfo_SyncCommunityCalendarEvent.Size(m)
+}
+func (m *SyncCommunityCalendarEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCommunityCalendarEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCommunityCalendarEvent proto.InternalMessageInfo
+
+func (m *SyncCommunityCalendarEvent) GetEvent() *CommunityCalendarEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SyncCommunityCalendarEvent) GetPublishedBy() string {
+	if m != nil {
+		return m.PublishedBy
+	}
+	return ""
+}
+
+func (m *SyncCommunityCalendarEvent) GetRsvp() *CommunityCalendarEventRsvp {
+	if m != nil {
+		return m.Rsvp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.SyncActivityCenterNotification_NotificationType", SyncActivityCenterNotification_NotificationType_name, SyncActivityCenterNotification_NotificationType_value)
 	proto.RegisterEnum("protobuf.SyncActivityCenterNotification_MembershipStatus", SyncActivityCenterNotification_MembershipStatus_name, SyncActivityCenterNotification_MembershipStatus_value)
@@ -3412,6 +3687,7 @@ func init() {
 	proto.RegisterEnum("protobuf.SyncTrustedUser_TrustStatus", SyncTrustedUser_TrustStatus_name, SyncTrustedUser_TrustStatus_value)
 	proto.RegisterEnum("protobuf.SyncVerificationRequest_VerificationStatus", SyncVerificationRequest_VerificationStatus_name, SyncVerificationRequest_VerificationStatus_value)
 	proto.RegisterEnum("protobuf.SyncContactRequestDecision_DecisionStatus", SyncContactRequestDecision_DecisionStatus_name, SyncContactRequestDecision_DecisionStatus_value)
+	proto.RegisterEnum("protobuf.SyncScheduledMessage_State", SyncScheduledMessage_State_name, SyncScheduledMessage_State_value)
 	proto.RegisterType((*FetchingBackedUpDataDetails)(nil), "protobuf.FetchingBackedUpDataDetails")
 	proto.RegisterType((*Backup)(nil), "protobuf.Backup")
 	proto.RegisterType((*MultiAccount)(nil), "protobuf.MultiAccount")
@@ -3455,6 +3731,9 @@ func init() {
 	proto.RegisterType((*SyncKeycard)(nil), "protobuf.SyncKeycard")
 	proto.RegisterType((*SyncSocialLinks)(nil), "protobuf.SyncSocialLinks")
 	proto.RegisterType((*SyncAccountCustomizationColor)(nil), "protobuf.SyncAccountCustomizationColor")
+	proto.RegisterType((*SyncMessageThread)(nil), "protobuf.SyncMessageThread")
+	proto.RegisterType((*SyncScheduledMessage)(nil), "protobuf.SyncScheduledMessage")
+	proto.RegisterType((*SyncCommunityCalendarEvent)(nil), "protobuf.SyncCommunityCalendarEvent")
 }
 
 func init() {
@@ -3462,220 +3741,237 @@ func init() {
 }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 3436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x6f, 0x23, 0xc7,
-	0x95, 0x6e, 0x92, 0xe2, 0xc7, 0x23, 0x25, 0xb5, 0x4a, 0xf2, 0x88, 0xd2, 0x68, 0x3c, 0x33, 0x3d,
-	0x6b, 0x78, 0x76, 0xe1, 0x95, 0x77, 0xc7, 0xbb, 0xeb, 0xb5, 0xc7, 0x86, 0x57, 0x23, 0xc9, 0x1e,
-	0xcd, 0x87, 0x46, 0x5b, 0x92, 0xec, 0x24, 0x08, 0xd0, 0x2e, 0x75, 0xd7, 0x88, 0x6d, 0x35, 0xbb,
-	0x99, 0xae, 0xa2, 0x14, 0xfa, 0x10, 0x24, 0x01, 0x02, 0xe4, 0x16, 0x20, 0x17, 0xdf, 0x02, 0x1f,
-	0x83, 0x1c, 0x73, 0x33, 0x90, 0x73, 0xe0, 0xff, 0x90, 0x5c, 0x73, 0xc9, 0x2d, 0xb7, 0x1c, 0x72,
-	0x08, 0x5e, 0x55, 0x75, 0xb3, 0x9b, 0x64, 0xcb, 0x12, 0x72, 0xca, 0x49, 0xfd, 0x5e, 0xbd, 0x7a,
-	0xf5, 0xea, 0x7d, 0xd5, 0x7b, 0x4f, 0x84, 0xf9, 0x01, 0x0b, 0x92, 0x20, 0x3a, 0xdd, 0x1c, 0x24,
-	0xb1, 0x8c, 0x49, 0x53, 0xfd, 0x39, 0x19, 0xbe, 0x5c, 0x5f, 0xf6, 0x7a, 0x4c, 0xba, 0x81, 0xcf,
-	0x23, 0x19, 0xc8, 0x91, 0x5e, 0x5e, 0x5f, 0x16, 0xa3, 0xc8, 0x73, 0x05, 0x97, 0x32, 0x88, 0x4e,
-	0x85, 0x41, 0x3a, 0x6c, 0x30, 0x08, 0x03, 0x8f, 0xc9, 0x20, 0x8e, 0xdc, 0x3e, 0x97, 0xcc, 0x67,
-	0x92, 0xb9, 0x7d, 0x2e, 0x04, 0x3b, 0xe5, 0x86, 0x66, 0xc9, 0x8b, 0xfb, 0xfd, 0x61, 0x14, 0xc8,
-	0x80, 0x9b, 0x6d, 0x0e, 0x83, 0x9b, 0x1f, 0x71, 0xe9, 0xf5, 0x82, 0xe8, 0xf4, 0x11, 0xf3, 0xce,
-	0xb8, 0x7f, 0x3c, 0xd8, 0x61, 0x92, 0xed, 0x70, 0xc9, 0x82, 0x50, 0x90, 0xdb, 0xd0, 0x56, 0x7c,
-	0xa2, 0x61, 0xff, 0x84, 0x27, 0x5d, 0xeb, 0x8e, 0x75, 0x7f, 0x9e, 0x02, 0xa2, 0xf6, 0x15, 0x86,
-	0xdc, 0x85, 0x8e, 0x8c, 0x25, 0x0b, 0x53, 0x8a, 0x8a, 0xa2, 0x68, 0x2b, 0x9c, 0x26, 0x71, 0xfe,
-	0x56, 0x87, 0x3a, 0xf2, 0x1e, 0x0e, 0xc8, 0x0a, 0xcc, 0x79, 0x61, 0xec, 0x9d, 0x29, 0x46, 0x35,
-	0xaa, 0x01, 0xb2, 0x00, 0x95, 0xc0, 0x57, 0x3b, 0x5b, 0xb4, 0x12, 0xf8, 0xe4, 0x43, 0x68, 0x7a,
-	0x71, 0x24, 0x99, 0x27, 0x45, 0xb7, 0x7a, 0xa7, 0x7a, 0xbf, 0xfd, 0xe0, 0xde, 0x66, 0xaa, 0x91,
-	0xcd, 0xc3, 0x51, 0xe4, 0xed, 0x45, 0x42, 0xb2, 0x30, 0x54, 0x77, 0xdd, 0xd6, 0x94, 0x9f, 0x3c,
-	0xa0, 0xd9, 0x26, 0xf2, 0x2e, 0xb4, 0x73, 0x37, 0xed, 0xd6, 0x14, 0x8f, 0xd5, 0x22, 0x8f, 0x6d,
-	0x43, 0x30, 0xa2, 0x79, 0x5a, 0xf2, 0x02, 0x16, 0x53, 0x36, 0x46, 0x07, 0xdd, 0xb9, 0x3b, 0xd6,
-	0xfd, 0xf6, 0x83, 0xd7, 0xc7, 0xdb, 0x2f, 0x51, 0x18, 0x9d, 0xdc, 0x4d, 0x8e, 0x81, 0xe4, 0xf8,
-	0xa7, 0x3c, 0xeb, 0xd7, 0xe1, 0x39, 0x83, 0x01, 0x79, 0x1b, 0x1a, 0x83, 0x24, 0x7e, 0x19, 0x84,
-	0xbc, 0xdb, 0x50, 0xbc, 0xd6, 0xc6, 0xbc, 0x52, 0x1e, 0x07, 0x9a, 0x80, 0xa6, 0x94, 0xe4, 0x39,
-	0x2c, 0x98, 0xcf, 0x54, 0x8e, 0xe6, 0x75, 0xe4, 0x98, 0xd8, 0x4c, 0xde, 0x82, 0x86, 0x71, 0xc2,
-	0x6e, 0x4b, 0xf1, 0x79, 0xb5, 0xa8, 0xe2, 0x43, 0xbd, 0x48, 0x53, 0x2a, 0x54, 0x6e, 0xea, 0xb5,
-	0xa9, 0x00, 0x70, 0x2d, 0xe5, 0x4e, 0xec, 0x46, 0x09, 0xce, 0xf8, 0x08, 0x83, 0xa7, 0xdb, 0x9e,
-	0x25, 0xc1, 0x53, 0xbd, 0x48, 0x53, 0x2a, 0xd4, 0x80, 0xf9, 0x4c, 0x05, 0xe8, 0x5c, 0x4b, 0x03,
-	0xc5, 0xcd, 0x64, 0x0b, 0xec, 0x0b, 0x26, 0xbd, 0xde, 0x8b, 0x28, 0x1c, 0x6d, 0x79, 0x5e, 0x3c,
-	0x8c, 0x64, 0x77, 0x7e, 0x96, 0x20, 0x66, 0x91, 0x4e, 0x91, 0x13, 0x17, 0x56, 0x27, 0x71, 0xa9,
-	0x68, 0x0b, 0xd7, 0x11, 0xad, 0x8c, 0x8b, 0xf3, 0xeb, 0x39, 0xe8, 0x3c, 0x1f, 0x86, 0x32, 0x48,
-	0x4f, 0x24, 0x50, 0x8b, 0x58, 0x9f, 0xab, 0x18, 0x6c, 0x51, 0xf5, 0x4d, 0x36, 0xa0, 0x25, 0x83,
-	0x3e, 0x17, 0x92, 0xf5, 0x07, 0x2a, 0x12, 0xab, 0x74, 0x8c, 0xc0, 0x55, 0x9d, 0x82, 0xbc, 0x38,
-	0xea, 0x56, 0xd5, 0xb6, 0x31, 0x82, 0x7c, 0x08, 0xe0, 0xc5, 0x61, 0x9c, 0xb8, 0x3d, 0x26, 0x7a,
-	0x26, 0xd8, 0xee, 0x8c, 0x85, 0xce, 0x9f, 0xbd, 0xb9, 0x8d, 0x84, 0x8f, 0x99, 0xe8, 0xd1, 0x96,
-	0x97, 0x7e, 0x92, 0x35, 0x8c, 0x77, 0x64, 0x10, 0xf8, 0x2a, 0xd8, 0xaa, 0xb4, 0xa1, 0xe0, 0x3d,
-	0x9f, 0xbc, 0x01, 0x8b, 0x67, 0x7c, 0xe4, 0xb1, 0xc4, 0x77, 0x4d, 0x8a, 0x54, 0xa1, 0xd3, 0x52,
-	0x96, 0x40, 0xf4, 0x81, 0xc6, 0x92, 0x55, 0xe5, 0x09, 0xee, 0x30, 0xf0, 0x55, 0x3c, 0xb4, 0x68,
-	0xfd, 0x8c, 0x8f, 0x8e, 0x03, 0x9f, 0xbc, 0x0f, 0xf5, 0xa0, 0xcf, 0x4e, 0x39, 0xfa, 0x3a, 0x4a,
-	0xf6, 0x2f, 0x25, 0x92, 0xed, 0x99, 0x1c, 0xbb, 0x87, 0xc4, 0xd4, 0xec, 0x21, 0x6f, 0xc1, 0xb2,
-	0x37, 0x14, 0x32, 0xee, 0x07, 0x5f, 0xe8, 0xcc, 0xaa, 0x04, 0x53, 0xee, 0xde, 0xa2, 0xa4, 0xb0,
-	0xa4, 0xae, 0x46, 0xde, 0x83, 0xb5, 0x19, 0x1b, 0x5c, 0x9d, 0xf5, 0x40, 0x65, 0xbd, 0xd5, 0xe9,
-	0x6d, 0xdb, 0xb8, 0xbc, 0x7e, 0x17, 0x5a, 0x99, 0x7e, 0x30, 0x55, 0x06, 0x91, 0xcf, 0x7f, 0xd8,
-	0xb5, 0xee, 0x54, 0xef, 0x57, 0xa9, 0x06, 0xd6, 0xff, 0x60, 0xc1, 0x7c, 0x41, 0xd2, 0xfc, 0xc5,
-	0xad, 0xc2, 0xc5, 0x53, 0x33, 0x57, 0x72, 0x66, 0xee, 0x42, 0x63, 0xc0, 0x46, 0x61, 0xcc, 0x7c,
-	0x65, 0xc6, 0x0e, 0x4d, 0x41, 0x3c, 0xee, 0x22, 0xf0, 0x25, 0xda, 0x0f, 0x0d, 0xa0, 0x01, 0x72,
-	0x03, 0xea, 0x3d, 0x1e, 0x9c, 0xf6, 0xa4, 0xb1, 0x8b, 0x81, 0xc8, 0x3a, 0x34, 0x31, 0x11, 0x88,
-	0xe0, 0x0b, 0xae, 0xec, 0x51, 0xa5, 0x19, 0x4c, 0xee, 0xc1, 0x7c, 0xa2, 0xbe, 0x5c, 0xc9, 0x92,
-	0x53, 0x2e, 0x95, 0x3d, 0xaa, 0xb4, 0xa3, 0x91, 0x47, 0x0a, 0x37, 0x7e, 0x08, 0x9a, 0xb9, 0x87,
-	0xc0, 0xf9, 0xb2, 0x02, 0xcb, 0xcf, 0x62, 0x8f, 0x85, 0xc6, 0xaa, 0x07, 0x46, 0xb8, 0xff, 0x86,
-	0xda, 0x19, 0x1f, 0x09, 0xa5, 0x8a, 0xf6, 0x83, 0xbb, 0x63, 0x0b, 0xce, 0x20, 0xde, 0x7c, 0xca,
-	0x47, 0x54, 0x91, 0x93, 0xf7, 0xa0, 0xd3, 0x47, 0x13, 0x33, 0x13, 0x99, 0x15, 0x15, 0x4f, 0x37,
-	0x66, 0x3b, 0x00, 0x2d, 0xd0, 0xe2, 0x0d, 0x07, 0x4c, 0x88, 0x8b, 0x38, 0xf1, 0x8d, 0xc7, 0x67,
-	0x30, 0x6a, 0x11, 0x9f, 0xe5, 0xa7, 0x7c, 0xa4, 0xb4, 0xd5, 0xa2, 0x29, 0x48, 0xee, 0x67, 0xee,
-	0x6a, 0x84, 0xd2, 0xaf, 0x47, 0x8b, 0x4e, 0xa2, 0xd7, 0xff, 0x1d, 0xaa, 0xb8, 0x61, 0x56, 0x2c,
-	0x12, 0xa8, 0xe1, 0x03, 0xab, 0xc4, 0xed, 0x50, 0xf5, 0xed, 0x7c, 0x6d, 0xc1, 0xab, 0x85, 0xcb,
-	0x72, 0x9e, 0x3c, 0xe6, 0x61, 0x18, 0x63, 0x84, 0x98, 0xc8, 0x70, 0xcf, 0x79, 0x22, 0x82, 0x38,
-	0x52, 0xcc, 0xe6, 0xe8, 0x82, 0x41, 0x7f, 0xa2, 0xb1, 0xe8, 0x28, 0x03, 0xce, 0x55, 0x90, 0x69,
-	0xce, 0x75, 0x04, 0xf7, 0x7c, 0xf5, 0xc6, 0xf3, 0xf3, 0xc0, 0xe3, 0xae, 0x12, 0x45, 0xdf, 0x16,
-	0x34, 0x6a, 0x1f, 0x05, 0x1a, 0x13, 0xc8, 0xd1, 0x80, 0x9b, 0x3b, 0x1b, 0x82, 0xa3, 0xd1, 0x40,
-	0x65, 0x0f, 0x11, 0x9c, 0x46, 0x4c, 0x0e, 0x13, 0xae, 0x2e, 0xdc, 0xa1, 0x63, 0x84, 0xf3, 0x95,
-	0x05, 0x36, 0x8a, 0x9d, 0x7f, 0xb5, 0x4b, 0x2a, 0x81, 0x37, 0x60, 0x31, 0xc8, 0x51, 0xb9, 0x59,
-	0x59, 0xb0, 0x90, 0x47, 0x17, 0x64, 0x56, 0x22, 0x55, 0xa7, 0x44, 0x4a, 0x15, 0x5b, 0x2b, 0x7a,
-	0x7f, 0xaa, 0xa2, 0x39, 0x55, 0xa6, 0xa4, 0xa0, 0xf3, 0x67, 0x0b, 0x56, 0x4b, 0x0a, 0x8b, 0x2b,
-	0xd6, 0x2c, 0xf7, 0x60, 0xde, 0xbc, 0x8e, 0xae, 0x4a, 0x1d, 0x46, 0xa4, 0x8e, 0x41, 0xea, 0x58,
-	0x5d, 0x83, 0x26, 0x8f, 0x84, 0x9b, 0x13, 0xac, 0xc1, 0x23, 0xa1, 0x74, 0x7c, 0x17, 0x3a, 0x21,
-	0x13, 0xd2, 0x1d, 0x0e, 0x7c, 0x26, 0xb9, 0xce, 0x83, 0x35, 0xda, 0x46, 0xdc, 0xb1, 0x46, 0xe1,
-	0x9d, 0xc5, 0x48, 0x48, 0xde, 0x77, 0x25, 0x3b, 0xc5, 0x12, 0xa2, 0x8a, 0x77, 0xd6, 0xa8, 0x23,
-	0x76, 0x2a, 0xc8, 0xeb, 0xb0, 0x10, 0xa2, 0x8f, 0xb8, 0x51, 0xe0, 0x9d, 0xa9, 0x43, 0x74, 0x2a,
-	0x9c, 0x57, 0xd8, 0x7d, 0x83, 0x74, 0x7e, 0x52, 0x87, 0xb5, 0xd2, 0x2a, 0x8a, 0xfc, 0x07, 0xac,
-	0xe4, 0x05, 0x71, 0xd5, 0xde, 0x70, 0x64, 0x6e, 0x4f, 0x72, 0x02, 0x3d, 0xd3, 0x2b, 0xff, 0xc4,
-	0xaa, 0x40, 0xdb, 0x32, 0xdf, 0xe7, 0xbe, 0x4a, 0xe8, 0x4d, 0xaa, 0x01, 0xf4, 0x93, 0x13, 0x34,
-	0x32, 0xf7, 0x55, 0xc6, 0x6e, 0xd2, 0x14, 0x44, 0xfa, 0xfe, 0x10, 0x65, 0x6a, 0x6b, 0x7a, 0x05,
-	0x20, 0x7d, 0xc2, 0xfb, 0xf1, 0x39, 0xf7, 0x55, 0x35, 0xd1, 0xa4, 0x29, 0x48, 0xee, 0x40, 0xa7,
-	0xc7, 0x84, 0xab, 0xd8, 0xba, 0x43, 0xa1, 0x6a, 0x83, 0x26, 0x85, 0x1e, 0x13, 0x5b, 0x88, 0x3a,
-	0x56, 0x0f, 0xcc, 0x39, 0x4f, 0x82, 0x97, 0x69, 0xe5, 0x2e, 0x24, 0x93, 0x43, 0xfd, 0xf4, 0x57,
-	0x29, 0xc9, 0x2f, 0x1d, 0xaa, 0x15, 0x55, 0x70, 0x27, 0x43, 0x21, 0x53, 0xca, 0x45, 0x45, 0xd9,
-	0x56, 0x38, 0x43, 0xf2, 0x01, 0xdc, 0x34, 0x55, 0xa8, 0x9b, 0xf0, 0x1f, 0x0c, 0xb9, 0x90, 0xda,
-	0x8a, 0x6a, 0x0b, 0xef, 0xda, 0x6a, 0x47, 0xd7, 0x90, 0x50, 0x4d, 0xa1, 0x8c, 0x89, 0xfb, 0x79,
-	0xf9, 0x76, 0x1d, 0x06, 0x4b, 0xa5, 0xdb, 0xd5, 0x2b, 0x46, 0x3e, 0x84, 0x8d, 0xc9, 0xed, 0xa8,
-	0x0e, 0xc9, 0xcd, 0xf1, 0x44, 0xed, 0x5f, 0x2b, 0xee, 0xa7, 0x8a, 0x42, 0x9f, 0x5f, 0xce, 0x40,
-	0x0b, 0xb0, 0x5c, 0xce, 0x40, 0x4b, 0x70, 0x17, 0x3a, 0x7e, 0x20, 0x06, 0x21, 0x1b, 0x69, 0xff,
-	0x5a, 0x51, 0xa6, 0x6f, 0x1b, 0x1c, 0xfa, 0x98, 0x73, 0x31, 0x1d, 0xef, 0x69, 0x79, 0x34, 0x3b,
-	0xde, 0xa7, 0x9c, 0xba, 0x32, 0xc3, 0xa9, 0x27, 0x3d, 0xb7, 0x3a, 0xe5, 0xb9, 0xce, 0x23, 0x58,
-	0x9f, 0x3c, 0xf8, 0x60, 0x78, 0x12, 0x06, 0xde, 0x76, 0x8f, 0x5d, 0x31, 0xd7, 0x38, 0x5f, 0x57,
-	0x61, 0xbe, 0xd0, 0xc2, 0x7c, 0xeb, 0xbe, 0x8e, 0x09, 0xcc, 0xf6, 0x20, 0x09, 0xce, 0x99, 0xe4,
-	0xee, 0x19, 0x1f, 0xe9, 0x0a, 0xe0, 0x51, 0xa5, 0x6b, 0x51, 0x30, 0x68, 0x7c, 0x91, 0xee, 0x60,
-	0x66, 0x15, 0x5e, 0x12, 0x0c, 0x50, 0x36, 0x15, 0x9b, 0x1d, 0x9a, 0x47, 0x61, 0x51, 0xf0, 0x79,
-	0x1c, 0x44, 0x26, 0x32, 0x9b, 0xd4, 0x40, 0xf8, 0x64, 0x6a, 0x7f, 0xe5, 0xbe, 0x2a, 0x0a, 0x9a,
-	0x34, 0x83, 0xc7, 0x81, 0xd3, 0xc8, 0x07, 0xce, 0x0b, 0xb0, 0x8d, 0x85, 0x85, 0x2b, 0x63, 0x17,
-	0xf9, 0x98, 0x2a, 0xed, 0xf5, 0xb2, 0x66, 0xcd, 0x90, 0x1f, 0xc5, 0x4f, 0xe2, 0x20, 0xa2, 0x0b,
-	0x49, 0x01, 0x26, 0x0f, 0xa1, 0x99, 0xb6, 0x08, 0xa6, 0x25, 0xb9, 0x5d, 0xc2, 0xc8, 0xf4, 0x26,
-	0x82, 0x66, 0x1b, 0xf0, 0x15, 0xe3, 0x91, 0x97, 0x8c, 0x06, 0x32, 0x0b, 0xfc, 0x31, 0x42, 0xbd,
-	0x71, 0x03, 0xee, 0x49, 0x36, 0x0e, 0xff, 0x31, 0x02, 0x1f, 0x2e, 0x43, 0x8a, 0x41, 0xac, 0x8a,
-	0x95, 0x8e, 0xd2, 0xdc, 0xc2, 0x18, 0xfd, 0x94, 0x8f, 0x04, 0x96, 0x38, 0x37, 0x2f, 0xb9, 0x91,
-	0xb1, 0x99, 0x95, 0xd9, 0xec, 0x16, 0xc0, 0x40, 0xf9, 0x87, 0x32, 0x99, 0xf6, 0x81, 0x96, 0xc6,
-	0xa0, 0xb5, 0x32, 0xc3, 0x57, 0xf3, 0x86, 0xbf, 0x24, 0xb9, 0xae, 0xea, 0xda, 0x25, 0x2d, 0xb5,
-	0x5b, 0xb4, 0x8e, 0xe0, 0x9e, 0x8f, 0xbe, 0x9b, 0xb6, 0x99, 0x23, 0x5c, 0xad, 0x6b, 0xc3, 0x67,
-	0xb8, 0x3d, 0x65, 0x44, 0x1d, 0xc2, 0x0d, 0x7d, 0x98, 0x02, 0xc8, 0x47, 0xb0, 0x94, 0xf0, 0x73,
-	0xce, 0x42, 0xee, 0xbb, 0xa6, 0x7a, 0x4a, 0x6b, 0xed, 0x5c, 0x4f, 0x4a, 0x0d, 0x49, 0xd6, 0x08,
-	0x25, 0x45, 0x84, 0x70, 0x7e, 0x59, 0x01, 0x7b, 0x32, 0x34, 0xc8, 0x07, 0xb9, 0x51, 0xc0, 0x54,
-	0xf5, 0x57, 0xf2, 0x88, 0xe5, 0x06, 0x01, 0x1f, 0x43, 0xc7, 0x68, 0x0f, 0x6f, 0x29, 0xba, 0x95,
-	0xc9, 0x16, 0xa0, 0x3c, 0x16, 0x69, 0x7b, 0x90, 0x7d, 0x0b, 0xf2, 0x10, 0x1a, 0x69, 0x15, 0x59,
-	0x55, 0x7e, 0x75, 0x89, 0x18, 0xe9, 0x15, 0xd3, 0x1d, 0xff, 0xc0, 0x38, 0xc2, 0x79, 0x07, 0x16,
-	0xd5, 0x2a, 0x0a, 0x64, 0xde, 0x94, 0xab, 0xe5, 0x88, 0xf7, 0x61, 0x25, 0xdd, 0xf8, 0x5c, 0xcf,
-	0x80, 0x04, 0xe5, 0xec, 0xaa, 0xbb, 0xff, 0x0f, 0x6e, 0xe8, 0xae, 0x55, 0x06, 0xe7, 0x81, 0x1c,
-	0x6d, 0xf3, 0x48, 0xf2, 0xe4, 0x92, 0xfd, 0x36, 0x54, 0x03, 0x5f, 0xab, 0xb7, 0x43, 0xf1, 0xd3,
-	0xd9, 0xd1, 0x79, 0xae, 0xc8, 0x61, 0xcb, 0xf3, 0xb8, 0x0a, 0xa6, 0xab, 0x72, 0xd9, 0xd5, 0xc1,
-	0x52, 0xe4, 0xb2, 0x13, 0x88, 0x7e, 0x20, 0xc4, 0x35, 0xd8, 0xb8, 0x70, 0x6f, 0x9a, 0xcd, 0x7e,
-	0x2c, 0x0b, 0x6f, 0x2b, 0xc7, 0x58, 0x4b, 0xab, 0x1e, 0x26, 0x0d, 0xcf, 0x96, 0xc1, 0x6c, 0x49,
-	0x8c, 0x2a, 0x7c, 0xcc, 0x05, 0xe7, 0x91, 0x52, 0x55, 0x93, 0x36, 0x7a, 0x4c, 0x1c, 0x72, 0x1e,
-	0x39, 0xbf, 0xb0, 0xe0, 0xf6, 0xe5, 0x27, 0x08, 0x12, 0xc2, 0x2d, 0x66, 0x96, 0x5d, 0x4f, 0xad,
-	0xbb, 0x51, 0x9e, 0xc0, 0xf8, 0xf7, 0xfd, 0xc9, 0xc1, 0x41, 0x19, 0x47, 0x7a, 0x93, 0x95, 0x9f,
-	0xe6, 0xfc, 0xae, 0x05, 0xaf, 0x5d, 0xbe, 0x7f, 0x2a, 0xd5, 0x4c, 0xcd, 0x00, 0x6a, 0xf9, 0x19,
-	0xc0, 0x4b, 0x58, 0xca, 0x8b, 0x3b, 0xae, 0xbb, 0x17, 0x1e, 0xbc, 0x7b, 0x55, 0x91, 0x37, 0xf3,
-	0x00, 0x96, 0xe9, 0xd4, 0x8e, 0x26, 0x30, 0xf9, 0x04, 0x55, 0x2b, 0x24, 0x28, 0x02, 0xb5, 0x84,
-	0xb3, 0xf4, 0xd1, 0x51, 0xdf, 0x28, 0xb2, 0x9f, 0x7a, 0x83, 0x79, 0x73, 0xc6, 0x08, 0x7c, 0x90,
-	0x98, 0xf1, 0x38, 0xf3, 0xee, 0x64, 0x30, 0xd6, 0x6c, 0x66, 0x36, 0xaa, 0x5a, 0xd0, 0x0e, 0x4d,
-	0x41, 0x7c, 0xde, 0xd8, 0x50, 0xf6, 0xb2, 0x2e, 0xdf, 0x40, 0xba, 0xaf, 0x1d, 0x84, 0xa3, 0x74,
-	0xa6, 0xaa, 0x9e, 0x88, 0x0e, 0xf6, 0xb5, 0x83, 0x70, 0x64, 0x62, 0x6c, 0x2a, 0x8b, 0xb6, 0x75,
-	0xe9, 0x91, 0xcf, 0xa2, 0x2f, 0x61, 0xa9, 0xcf, 0xfb, 0x27, 0x3c, 0x11, 0xbd, 0x60, 0x90, 0x56,
-	0x71, 0x9d, 0x6b, 0x2a, 0xf2, 0x79, 0xc6, 0x41, 0xd7, 0x7c, 0xd4, 0xee, 0x4f, 0x60, 0xc8, 0x4f,
-	0xad, 0x71, 0x1d, 0x37, 0xab, 0xc4, 0x9c, 0x57, 0x47, 0x3e, 0xba, 0xf2, 0x91, 0x69, 0x8b, 0x30,
-	0x55, 0x92, 0x66, 0xa5, 0xd8, 0xf4, 0x12, 0xaa, 0xd9, 0xe7, 0x21, 0x47, 0x0b, 0x2c, 0xe8, 0x90,
-	0x31, 0xe0, 0x44, 0xb0, 0x2d, 0x4e, 0x04, 0x9b, 0xf3, 0x17, 0x0b, 0xec, 0x49, 0x6f, 0x21, 0x00,
-	0xf5, 0xfd, 0x18, 0xbf, 0xec, 0x57, 0xc8, 0x22, 0xb4, 0xf7, 0xf9, 0xc5, 0x8b, 0x88, 0x1f, 0xc5,
-	0x2f, 0x22, 0x6e, 0x5b, 0x64, 0x15, 0x96, 0xf7, 0xf9, 0xc5, 0x81, 0xae, 0x64, 0x3e, 0x4e, 0xe2,
-	0xe1, 0x00, 0x93, 0x9f, 0x5d, 0x21, 0x6d, 0x68, 0x3c, 0xe7, 0x11, 0x32, 0xb1, 0xab, 0xa4, 0x05,
-	0x73, 0x14, 0x0d, 0x66, 0xd7, 0x08, 0x81, 0x85, 0xed, 0x42, 0x0d, 0x69, 0xcf, 0x21, 0x93, 0x2c,
-	0x13, 0xef, 0x45, 0xe7, 0x81, 0x54, 0x87, 0xdb, 0x75, 0xb2, 0x02, 0xf6, 0xe4, 0x93, 0x6d, 0x37,
-	0xc8, 0x6b, 0xb0, 0x9e, 0x61, 0xc7, 0x26, 0x49, 0xd7, 0x9b, 0x64, 0x19, 0x16, 0xb3, 0xf5, 0xa7,
-	0x01, 0xb6, 0x10, 0x76, 0x4b, 0x9f, 0x31, 0xa5, 0x30, 0x1b, 0x9c, 0x9f, 0x59, 0x60, 0x4f, 0x1a,
-	0x96, 0x74, 0x61, 0x65, 0x12, 0xb7, 0xe7, 0x87, 0xa8, 0x81, 0x9b, 0xb0, 0x3a, 0xb9, 0x72, 0xc0,
-	0x23, 0x3f, 0x88, 0x4e, 0x6d, 0x8b, 0x6c, 0x40, 0x77, 0x72, 0x31, 0xcd, 0xbe, 0x76, 0x65, 0xd6,
-	0xea, 0x0e, 0xf7, 0x42, 0x2c, 0xe3, 0xec, 0xaa, 0xf3, 0x63, 0x0b, 0xd6, 0x4a, 0xad, 0x8d, 0xea,
-	0x3c, 0x8e, 0xce, 0xa2, 0xf8, 0x22, 0xb2, 0x5f, 0x41, 0x60, 0x7c, 0x66, 0x07, 0x9a, 0xb9, 0x33,
-	0x3a, 0xd0, 0x1c, 0xf3, 0x24, 0xf3, 0xd0, 0xda, 0x66, 0x91, 0xc7, 0xc3, 0x90, 0xfb, 0x76, 0x0d,
-	0xf7, 0x1d, 0x61, 0xc7, 0xc2, 0x7d, 0x7b, 0x8e, 0x2c, 0xc1, 0xfc, 0x71, 0xa4, 0xc0, 0x4f, 0xe3,
-	0x44, 0xf6, 0x46, 0x76, 0xdd, 0xf9, 0xca, 0x82, 0x0e, 0xfa, 0xe3, 0xa3, 0x38, 0x3e, 0xeb, 0xb3,
-	0xe4, 0xac, 0x3c, 0xd5, 0x0f, 0x93, 0xd0, 0x3c, 0x5c, 0xf8, 0x99, 0xf5, 0xfd, 0xd5, 0x5c, 0xdf,
-	0x7f, 0x13, 0x5a, 0xaa, 0x66, 0x77, 0x91, 0x56, 0x27, 0x95, 0xa6, 0x42, 0x1c, 0x27, 0x61, 0xbe,
-	0x79, 0x9b, 0x2b, 0x36, 0x6f, 0xb7, 0x00, 0x8c, 0xb3, 0xa2, 0x87, 0xd6, 0xb5, 0x87, 0x1a, 0xcc,
-	0x96, 0x74, 0x7e, 0x04, 0xaf, 0xa2, 0x84, 0xbb, 0x91, 0x38, 0x16, 0x3c, 0xc1, 0x83, 0xf4, 0xc4,
-	0xb5, 0x44, 0xd4, 0x75, 0x68, 0x0e, 0x0d, 0x9d, 0x91, 0x37, 0x83, 0xd5, 0x00, 0xb4, 0xc7, 0x02,
-	0x35, 0xef, 0xd0, 0x85, 0x5c, 0x43, 0xc1, 0x7b, 0x85, 0xde, 0xb2, 0x56, 0x10, 0xcf, 0x79, 0xa2,
-	0xcb, 0xa5, 0xed, 0x90, 0xb3, 0xe4, 0x71, 0x20, 0x64, 0x9c, 0x8c, 0xf2, 0xc9, 0xd3, 0x2a, 0x24,
-	0xcf, 0x5b, 0x00, 0x1e, 0x12, 0xea, 0xbb, 0x98, 0xe4, 0x6e, 0x30, 0x5b, 0xd2, 0xf9, 0xc6, 0x02,
-	0x82, 0xcc, 0xcc, 0x7f, 0x0c, 0x0e, 0x02, 0x4f, 0x0e, 0x13, 0x3e, 0x73, 0x3a, 0x95, 0x1b, 0x21,
-	0x56, 0x4a, 0x46, 0x88, 0x55, 0x35, 0x5c, 0x99, 0x1a, 0x21, 0xd6, 0x14, 0x3a, 0x1d, 0x21, 0xde,
-	0x84, 0x96, 0xea, 0xa6, 0xd4, 0x0c, 0x51, 0x8f, 0x63, 0xd4, 0x0c, 0xf1, 0x70, 0xe6, 0x0c, 0xb1,
-	0xae, 0x08, 0x4a, 0x66, 0x88, 0x8d, 0xfc, 0x0c, 0xb1, 0x07, 0xcb, 0xd3, 0x37, 0x11, 0xe5, 0x63,
-	0xd2, 0xff, 0x85, 0xe6, 0xc0, 0x10, 0x99, 0xf2, 0x70, 0xa3, 0x98, 0x12, 0x8b, 0x9c, 0x68, 0x46,
-	0xed, 0x7c, 0x53, 0x81, 0x76, 0x6e, 0xb6, 0x5f, 0x62, 0xf7, 0x2e, 0x34, 0x98, 0xef, 0x27, 0x5c,
-	0x88, 0x54, 0x5f, 0x06, 0xcc, 0x8b, 0x54, 0x2d, 0x88, 0x54, 0xac, 0xf9, 0x75, 0x07, 0x96, 0xab,
-	0xf9, 0x09, 0xd4, 0x06, 0x4c, 0xf6, 0x4c, 0xfd, 0xae, 0xbe, 0x33, 0x4b, 0xd5, 0x73, 0x96, 0xca,
-	0x8f, 0xd5, 0x1b, 0x66, 0x4e, 0x69, 0xc6, 0xea, 0x2b, 0x30, 0xc7, 0xfb, 0xf1, 0xe7, 0x81, 0x7a,
-	0xfb, 0x5a, 0x54, 0x03, 0x68, 0xaa, 0x0b, 0x16, 0x86, 0x5c, 0x9a, 0x71, 0x88, 0x81, 0x90, 0x39,
-	0xba, 0x91, 0xe9, 0x89, 0xd4, 0xb7, 0x32, 0x6b, 0xe0, 0xfb, 0x3c, 0x32, 0xbd, 0x90, 0x81, 0x2e,
-	0x99, 0x85, 0xac, 0x43, 0x73, 0x10, 0x8b, 0x40, 0x75, 0x95, 0xf3, 0x7a, 0x66, 0x9c, 0xc2, 0xce,
-	0x9f, 0x8c, 0x2a, 0xcd, 0xff, 0x6b, 0x4a, 0x54, 0x99, 0x53, 0x58, 0x65, 0xe6, 0xa8, 0xbb, 0x5a,
-	0x9c, 0xa2, 0xe6, 0xa6, 0x95, 0xea, 0x5b, 0x0d, 0x06, 0x78, 0x12, 0x9c, 0x73, 0xdf, 0x7d, 0x99,
-	0xc4, 0x7d, 0xa3, 0xc1, 0xb6, 0xc1, 0x7d, 0x94, 0xc4, 0x7d, 0xf2, 0x10, 0xd6, 0x75, 0x0b, 0x2f,
-	0xb8, 0xef, 0xaa, 0x05, 0x33, 0x89, 0x54, 0xb3, 0x78, 0x9d, 0x04, 0x56, 0x55, 0x43, 0x2f, 0xb8,
-	0xbf, 0x93, 0xad, 0xef, 0xe1, 0xb2, 0x1e, 0x4b, 0x45, 0x5e, 0xca, 0x5e, 0x2b, 0x1d, 0x34, 0x4a,
-	0x71, 0xff, 0x4f, 0x55, 0x91, 0xe4, 0x5b, 0xa4, 0x92, 0xff, 0x13, 0x65, 0x64, 0xb8, 0xc5, 0xcc,
-	0x8e, 0xb1, 0xa5, 0xad, 0xce, 0xfc, 0x1f, 0x17, 0xae, 0xd2, 0x8c, 0x2c, 0x6f, 0x03, 0x28, 0xe6,
-	0x8c, 0xcf, 0x74, 0xce, 0x4a, 0x7b, 0xae, 0x03, 0xa3, 0x7f, 0x51, 0xa2, 0xf0, 0xbc, 0xb8, 0x95,
-	0x2b, 0x89, 0xeb, 0xfc, 0xd5, 0xd2, 0x69, 0xe9, 0x90, 0x9d, 0x73, 0x7f, 0xcb, 0x78, 0x7a, 0x2e,
-	0x06, 0xac, 0x62, 0x0c, 0xcc, 0xfa, 0x27, 0xc5, 0x06, 0xb4, 0x5e, 0xb2, 0xf3, 0x78, 0x98, 0x04,
-	0x52, 0x9b, 0xb4, 0x49, 0xc7, 0x88, 0x4b, 0xf2, 0xf5, 0x5d, 0xe8, 0xe8, 0xfa, 0xc1, 0xcd, 0xa7,
-	0x85, 0xb6, 0xc6, 0xe9, 0xc9, 0xd0, 0xbf, 0xc1, 0x92, 0x4e, 0xb4, 0xa2, 0x17, 0x27, 0x52, 0x35,
-	0xc8, 0xc2, 0xc4, 0xc0, 0xa2, 0x5a, 0x38, 0x44, 0x3c, 0x36, 0xca, 0x02, 0xdf, 0x16, 0x1e, 0x09,
-	0x53, 0x04, 0xe2, 0x27, 0xfa, 0x5f, 0x20, 0x5c, 0xc9, 0x45, 0x1a, 0x0a, 0xf5, 0x40, 0x1c, 0x71,
-	0x21, 0x9f, 0xd4, 0x9a, 0x35, 0x7b, 0xce, 0xf9, 0xd2, 0xd2, 0xda, 0x9d, 0x9a, 0x31, 0x94, 0x68,
-	0x77, 0xb2, 0x56, 0xac, 0x4c, 0xd7, 0x8a, 0xbb, 0x70, 0xbb, 0xa7, 0x53, 0xbb, 0xcb, 0x12, 0xaf,
-	0x17, 0x9c, 0x73, 0x57, 0x0c, 0x07, 0x03, 0x94, 0x9d, 0x47, 0xec, 0x24, 0x34, 0x33, 0xa6, 0x26,
-	0xdd, 0x30, 0x64, 0x5b, 0x9a, 0xea, 0x50, 0x13, 0xed, 0x6a, 0x1a, 0xe7, 0xb7, 0x96, 0x6e, 0x23,
-	0xcd, 0x93, 0x8b, 0xef, 0xd5, 0x15, 0xc7, 0xda, 0x1f, 0x40, 0xdd, 0x94, 0x8b, 0xba, 0xd4, 0x9f,
-	0x98, 0xcb, 0xe4, 0x18, 0x6e, 0x1e, 0x8d, 0x27, 0x90, 0xd4, 0x6c, 0x72, 0xde, 0x83, 0x76, 0x0e,
-	0xad, 0x4a, 0x87, 0xfd, 0xa7, 0xfb, 0x2f, 0x3e, 0xdd, 0xd7, 0xa5, 0xc3, 0x11, 0x3d, 0x3e, 0x3c,
-	0xda, 0xdd, 0xb1, 0x2d, 0x55, 0x02, 0xec, 0x2b, 0xf0, 0xd3, 0x17, 0xf4, 0xe8, 0xf1, 0x77, 0xed,
-	0x8a, 0xf3, 0x55, 0x55, 0xcf, 0xe8, 0xf2, 0x25, 0x88, 0xa9, 0xac, 0x4a, 0x84, 0x27, 0x50, 0x53,
-	0x71, 0x67, 0x9c, 0x09, 0xbf, 0xf1, 0x42, 0x32, 0x36, 0x89, 0xa1, 0x22, 0x63, 0x74, 0x2e, 0xaf,
-	0x87, 0x69, 0x2d, 0x3a, 0x4d, 0x73, 0xc3, 0x18, 0x81, 0x26, 0x31, 0x13, 0x25, 0xfd, 0x50, 0x9a,
-	0xd1, 0x73, 0x86, 0xdb, 0x52, 0xff, 0x18, 0x4a, 0xb8, 0x18, 0xc4, 0x91, 0x48, 0xb3, 0x6d, 0x06,
-	0x63, 0xe2, 0xc6, 0x6e, 0x20, 0xd0, 0x9b, 0xb5, 0xff, 0xb5, 0x0c, 0x66, 0x4b, 0x12, 0x3e, 0x7b,
-	0xd6, 0xdb, 0x54, 0x9a, 0xfd, 0xaf, 0xa2, 0x66, 0x67, 0xdc, 0x7a, 0x73, 0x46, 0xe9, 0x3d, 0x6b,
-	0x42, 0xac, 0x6d, 0xd8, 0xca, 0x9a, 0xf9, 0xef, 0x00, 0x29, 0x29, 0xe3, 0xf2, 0xb6, 0x38, 0xd8,
-	0xdd, 0xdf, 0xd9, 0xdb, 0xff, 0xd8, 0x94, 0x71, 0xdb, 0xdb, 0xbb, 0x07, 0x68, 0x19, 0x5d, 0xc6,
-	0xed, 0x6e, 0x3f, 0xdb, 0xdb, 0xdf, 0xdd, 0xb1, 0xab, 0x08, 0x6d, 0x6f, 0xed, 0x6f, 0xef, 0x3e,
-	0xdb, 0xdd, 0xb1, 0x6b, 0xce, 0x1f, 0x2d, 0xdd, 0xe5, 0x17, 0xcb, 0xe8, 0x1d, 0xee, 0x05, 0xa2,
-	0xfc, 0x7f, 0x3c, 0x1b, 0xd0, 0x32, 0xfa, 0xdc, 0x4b, 0x3d, 0x6d, 0x8c, 0x20, 0xdf, 0x87, 0x45,
-	0xdf, 0xec, 0x77, 0x0b, 0x9e, 0xf7, 0xf6, 0xe4, 0xbc, 0x64, 0xd6, 0x91, 0x9b, 0xe9, 0x87, 0x51,
-	0xcf, 0x82, 0x5f, 0x80, 0x9d, 0x37, 0x61, 0xa1, 0x48, 0x51, 0xb8, 0xec, 0x2b, 0x85, 0xcb, 0x5a,
-	0xce, 0xef, 0x2b, 0xb0, 0x38, 0xf1, 0x5b, 0x8a, 0xf2, 0x3a, 0x62, 0x72, 0xe8, 0x5c, 0x99, 0x1a,
-	0x3a, 0x93, 0x37, 0x81, 0xe4, 0x49, 0xdc, 0xfc, 0xe4, 0xce, 0xce, 0x11, 0xea, 0x5c, 0x95, 0x2f,
-	0x4c, 0x6a, 0xd7, 0x29, 0x4c, 0xc8, 0xfb, 0xd0, 0x11, 0xb1, 0x17, 0xb0, 0xd0, 0x0d, 0x83, 0xe8,
-	0x2c, 0xfd, 0x01, 0xcb, 0xda, 0xc4, 0x8f, 0x33, 0x14, 0xc5, 0x33, 0x24, 0xa0, 0x6d, 0x31, 0x06,
-	0xc8, 0xff, 0xc3, 0x0a, 0x8f, 0x84, 0x9b, 0x16, 0xa7, 0xae, 0x9f, 0xfd, 0x64, 0xa5, 0x3a, 0x3d,
-	0x4f, 0x9d, 0xaa, 0x7e, 0x29, 0xe1, 0x93, 0x28, 0xe1, 0x08, 0x00, 0xca, 0x2e, 0xd2, 0x1e, 0x39,
-	0x57, 0x41, 0x5a, 0xc5, 0x0a, 0xf2, 0x29, 0xb4, 0x4d, 0x73, 0x8d, 0x4d, 0x9e, 0x52, 0xe1, 0xc2,
-	0x83, 0x7f, 0x1d, 0x9f, 0xb8, 0x35, 0xfe, 0x89, 0xd3, 0x73, 0xf3, 0x0b, 0x27, 0xc3, 0x74, 0x53,
-	0x4d, 0x13, 0xf2, 0xbb, 0x9d, 0xdf, 0x58, 0xb0, 0x80, 0x22, 0xe6, 0x4e, 0xfe, 0x1f, 0x68, 0x27,
-	0x19, 0x94, 0x0e, 0x5c, 0x56, 0x72, 0x43, 0xca, 0x6c, 0x91, 0xe6, 0x09, 0xc9, 0x03, 0x58, 0x11,
-	0xc3, 0x93, 0xf4, 0xd5, 0x7c, 0x22, 0xe2, 0xe8, 0xd1, 0x48, 0xf2, 0xb4, 0xa0, 0x9b, 0xb9, 0x46,
-	0xde, 0x84, 0xa5, 0x74, 0xb2, 0x3c, 0xde, 0xa0, 0xff, 0xe9, 0x3e, 0xbd, 0xe0, 0xfc, 0xca, 0xca,
-	0x0a, 0x20, 0x7c, 0xc3, 0x55, 0x63, 0x93, 0xb9, 0x18, 0x7e, 0xce, 0x7c, 0x29, 0x6f, 0x40, 0xdd,
-	0xfc, 0x9f, 0x4a, 0xbf, 0x02, 0x06, 0xca, 0x3b, 0x69, 0xad, 0xe0, 0xa4, 0x1b, 0xd0, 0x32, 0x2f,
-	0x2f, 0x47, 0xb7, 0xa8, 0x62, 0x61, 0x99, 0x21, 0x0a, 0x15, 0x9a, 0xae, 0x74, 0xc6, 0x15, 0xda,
-	0x67, 0xfa, 0x05, 0xc9, 0x79, 0x0d, 0x79, 0x67, 0xc2, 0xcd, 0xa6, 0xd4, 0x39, 0x26, 0x2e, 0x7a,
-	0x58, 0x96, 0x17, 0x2a, 0xf9, 0xc2, 0xfd, 0xe7, 0x16, 0xdc, 0xca, 0xd5, 0x14, 0xdb, 0xd3, 0xbf,
-	0xad, 0xf8, 0x96, 0xf9, 0x5c, 0xc9, 0x6f, 0x35, 0x2a, 0xa5, 0xbf, 0xd5, 0x28, 0x2b, 0xc0, 0x1f,
-	0xcd, 0x7f, 0xaf, 0xbd, 0xf9, 0xd6, 0xc3, 0xf4, 0x1e, 0x27, 0x75, 0xf5, 0xf5, 0xf6, 0xdf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x29, 0x1b, 0xc3, 0xa6, 0x27, 0x00, 0x00,
+	// 3704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x6f, 0x24, 0x49,
+	0x56, 0x93, 0x55, 0xe5, 0xfa, 0x78, 0x55, 0xb6, 0xd3, 0x61, 0xcf, 0xb8, 0xda, 0xdd, 0xbd, 0xd3,
+	0x9d, 0xb3, 0xa3, 0x6d, 0xd0, 0xe0, 0x61, 0x7b, 0x60, 0x77, 0xe7, 0x4b, 0x83, 0xdb, 0xf6, 0xcc,
+	0x54, 0x7f, 0xb8, 0x4d, 0xd8, 0x9e, 0x01, 0x84, 0x94, 0x1b, 0xce, 0x8c, 0x76, 0xe5, 0x3a, 0x2b,
+	0xb3, 0xc8, 0x88, 0xb2, 0xa7, 0xf6, 0x80, 0x00, 0x09, 0x89, 0x1b, 0x12, 0x42, 0xda, 0x1b, 0x9a,
+	0x23, 0xe2, 0x06, 0xb7, 0xfd, 0x03, 0x68, 0xfe, 0x03, 0x5c, 0xb9, 0x20, 0x24, 0xc4, 0x8d, 0x03,
+	0x07, 0x14, 0x2f, 0x22, 0xb2, 0x32, 0xab, 0x2a, 0x3d, 0x6e, 0x71, 0xe2, 0x54, 0xf9, 0x5e, 0xbc,
+	0x78, 0xf9, 0xe2, 0xc5, 0xfb, 0xce, 0x82, 0xd5, 0x31, 0x8b, 0xb2, 0x28, 0xb9, 0xd8, 0x1d, 0x67,
+	0xa9, 0x4c, 0x49, 0x1b, 0x7f, 0xce, 0x27, 0xaf, 0x76, 0x36, 0x83, 0x21, 0x93, 0x7e, 0x14, 0xf2,
+	0x44, 0x46, 0x72, 0xaa, 0x97, 0x77, 0x36, 0xc5, 0x34, 0x09, 0x7c, 0xc1, 0xa5, 0x8c, 0x92, 0x0b,
+	0x61, 0x90, 0x1e, 0x1b, 0x8f, 0xe3, 0x28, 0x60, 0x32, 0x4a, 0x13, 0x7f, 0xc4, 0x25, 0x0b, 0x99,
+	0x64, 0xfe, 0x88, 0x0b, 0xc1, 0x2e, 0xb8, 0xa1, 0xd9, 0x08, 0xd2, 0xd1, 0x68, 0x92, 0x44, 0x32,
+	0xe2, 0x66, 0x9b, 0xc7, 0xe0, 0xee, 0xe7, 0x5c, 0x06, 0xc3, 0x28, 0xb9, 0x78, 0xc2, 0x82, 0x4b,
+	0x1e, 0x9e, 0x8d, 0x0f, 0x98, 0x64, 0x07, 0x5c, 0xb2, 0x28, 0x16, 0xe4, 0x6d, 0xe8, 0x22, 0x9f,
+	0x64, 0x32, 0x3a, 0xe7, 0x59, 0xdf, 0x79, 0xe0, 0x3c, 0x5a, 0xa5, 0xa0, 0x50, 0x47, 0x88, 0x21,
+	0x0f, 0xa1, 0x27, 0x53, 0xc9, 0x62, 0x4b, 0x51, 0x43, 0x8a, 0x2e, 0xe2, 0x34, 0x89, 0xf7, 0x3f,
+	0x4d, 0x68, 0x2a, 0xde, 0x93, 0x31, 0xd9, 0x82, 0x95, 0x20, 0x4e, 0x83, 0x4b, 0x64, 0xd4, 0xa0,
+	0x1a, 0x20, 0x6b, 0x50, 0x8b, 0x42, 0xdc, 0xd9, 0xa1, 0xb5, 0x28, 0x24, 0x9f, 0x41, 0x3b, 0x48,
+	0x13, 0xc9, 0x02, 0x29, 0xfa, 0xf5, 0x07, 0xf5, 0x47, 0xdd, 0xc7, 0xef, 0xec, 0x5a, 0x8d, 0xec,
+	0x9e, 0x4c, 0x93, 0x60, 0x90, 0x08, 0xc9, 0xe2, 0x18, 0xcf, 0xba, 0xaf, 0x29, 0xbf, 0x7a, 0x4c,
+	0xf3, 0x4d, 0xe4, 0x43, 0xe8, 0x16, 0x4e, 0xda, 0x6f, 0x20, 0x8f, 0xed, 0x32, 0x8f, 0x7d, 0x43,
+	0x30, 0xa5, 0x45, 0x5a, 0xf2, 0x12, 0xd6, 0x2d, 0x1b, 0xa3, 0x83, 0xfe, 0xca, 0x03, 0xe7, 0x51,
+	0xf7, 0xf1, 0xbb, 0xb3, 0xed, 0x37, 0x28, 0x8c, 0xce, 0xef, 0x26, 0x67, 0x40, 0x0a, 0xfc, 0x2d,
+	0xcf, 0xe6, 0xeb, 0xf0, 0x5c, 0xc2, 0x80, 0x7c, 0x00, 0xad, 0x71, 0x96, 0xbe, 0x8a, 0x62, 0xde,
+	0x6f, 0x21, 0xaf, 0x3b, 0x33, 0x5e, 0x96, 0xc7, 0xb1, 0x26, 0xa0, 0x96, 0x92, 0xbc, 0x80, 0x35,
+	0xf3, 0x68, 0xe5, 0x68, 0xbf, 0x8e, 0x1c, 0x73, 0x9b, 0xc9, 0xfb, 0xd0, 0x32, 0x46, 0xd8, 0xef,
+	0x20, 0x9f, 0x37, 0xcb, 0x2a, 0x3e, 0xd1, 0x8b, 0xd4, 0x52, 0x29, 0xe5, 0x5a, 0xab, 0xb5, 0x02,
+	0xc0, 0x6b, 0x29, 0x77, 0x6e, 0xb7, 0x92, 0xe0, 0x92, 0x4f, 0x95, 0xf3, 0xf4, 0xbb, 0xcb, 0x24,
+	0x78, 0xa6, 0x17, 0xa9, 0xa5, 0x52, 0x1a, 0x30, 0x8f, 0x56, 0x80, 0xde, 0x6b, 0x69, 0xa0, 0xbc,
+	0x99, 0xec, 0x81, 0x7b, 0xcd, 0x64, 0x30, 0x7c, 0x99, 0xc4, 0xd3, 0xbd, 0x20, 0x48, 0x27, 0x89,
+	0xec, 0xaf, 0x2e, 0x13, 0xc4, 0x2c, 0xd2, 0x05, 0x72, 0xe2, 0xc3, 0xf6, 0x3c, 0xce, 0x8a, 0xb6,
+	0xf6, 0x3a, 0xa2, 0x55, 0x71, 0xf1, 0xfe, 0x7e, 0x05, 0x7a, 0x2f, 0x26, 0xb1, 0x8c, 0xec, 0x1b,
+	0x09, 0x34, 0x12, 0x36, 0xe2, 0xe8, 0x83, 0x1d, 0x8a, 0xcf, 0xe4, 0x1e, 0x74, 0x64, 0x34, 0xe2,
+	0x42, 0xb2, 0xd1, 0x18, 0x3d, 0xb1, 0x4e, 0x67, 0x08, 0xb5, 0xaa, 0x43, 0x50, 0x90, 0x26, 0xfd,
+	0x3a, 0x6e, 0x9b, 0x21, 0xc8, 0x67, 0x00, 0x41, 0x1a, 0xa7, 0x99, 0x3f, 0x64, 0x62, 0x68, 0x9c,
+	0xed, 0xc1, 0x4c, 0xe8, 0xe2, 0xbb, 0x77, 0xf7, 0x15, 0xe1, 0x97, 0x4c, 0x0c, 0x69, 0x27, 0xb0,
+	0x8f, 0xe4, 0x8e, 0xf2, 0x77, 0xc5, 0x20, 0x0a, 0xd1, 0xd9, 0xea, 0xb4, 0x85, 0xf0, 0x20, 0x24,
+	0x3f, 0x82, 0xf5, 0x4b, 0x3e, 0x0d, 0x58, 0x16, 0xfa, 0x26, 0x44, 0xa2, 0xeb, 0x74, 0xf0, 0x26,
+	0x14, 0xfa, 0x58, 0x63, 0xc9, 0x36, 0x5a, 0x82, 0x3f, 0x89, 0x42, 0xf4, 0x87, 0x0e, 0x6d, 0x5e,
+	0xf2, 0xe9, 0x59, 0x14, 0x92, 0x4f, 0xa0, 0x19, 0x8d, 0xd8, 0x05, 0x57, 0xb6, 0xae, 0x24, 0xfb,
+	0x61, 0x85, 0x64, 0x03, 0x13, 0x63, 0x07, 0x8a, 0x98, 0x9a, 0x3d, 0xe4, 0x7d, 0xd8, 0x0c, 0x26,
+	0x42, 0xa6, 0xa3, 0xe8, 0x97, 0x3a, 0xb2, 0xa2, 0x60, 0x68, 0xee, 0x1d, 0x4a, 0x4a, 0x4b, 0x78,
+	0x34, 0xf2, 0x11, 0xdc, 0x59, 0xb2, 0xc1, 0xd7, 0x51, 0x0f, 0x30, 0xea, 0x6d, 0x2f, 0x6e, 0xdb,
+	0x57, 0xcb, 0x3b, 0x0f, 0xa1, 0x93, 0xeb, 0x47, 0x85, 0xca, 0x28, 0x09, 0xf9, 0x37, 0x7d, 0xe7,
+	0x41, 0xfd, 0x51, 0x9d, 0x6a, 0x60, 0xe7, 0x5f, 0x1c, 0x58, 0x2d, 0x49, 0x5a, 0x3c, 0xb8, 0x53,
+	0x3a, 0xb8, 0xbd, 0xe6, 0x5a, 0xe1, 0x9a, 0xfb, 0xd0, 0x1a, 0xb3, 0x69, 0x9c, 0xb2, 0x10, 0xaf,
+	0xb1, 0x47, 0x2d, 0xa8, 0x5e, 0x77, 0x1d, 0x85, 0x52, 0xdd, 0x9f, 0xba, 0x00, 0x0d, 0x90, 0xb7,
+	0xa0, 0x39, 0xe4, 0xd1, 0xc5, 0x50, 0x9a, 0x7b, 0x31, 0x10, 0xd9, 0x81, 0xb6, 0x0a, 0x04, 0x22,
+	0xfa, 0x25, 0xc7, 0xfb, 0xa8, 0xd3, 0x1c, 0x26, 0xef, 0xc0, 0x6a, 0x86, 0x4f, 0xbe, 0x64, 0xd9,
+	0x05, 0x97, 0x78, 0x1f, 0x75, 0xda, 0xd3, 0xc8, 0x53, 0xc4, 0xcd, 0x12, 0x41, 0xbb, 0x90, 0x08,
+	0xbc, 0x5f, 0xd5, 0x60, 0xf3, 0x79, 0x1a, 0xb0, 0xd8, 0xdc, 0xea, 0xb1, 0x11, 0xee, 0x77, 0xa1,
+	0x71, 0xc9, 0xa7, 0x02, 0x55, 0xd1, 0x7d, 0xfc, 0x70, 0x76, 0x83, 0x4b, 0x88, 0x77, 0x9f, 0xf1,
+	0x29, 0x45, 0x72, 0xf2, 0x11, 0xf4, 0x46, 0xea, 0x8a, 0x99, 0xf1, 0xcc, 0x1a, 0xfa, 0xd3, 0x5b,
+	0xcb, 0x0d, 0x80, 0x96, 0x68, 0xd5, 0x09, 0xc7, 0x4c, 0x88, 0xeb, 0x34, 0x0b, 0x8d, 0xc5, 0xe7,
+	0xb0, 0xd2, 0xa2, 0x4a, 0xcb, 0xcf, 0xf8, 0x14, 0xb5, 0xd5, 0xa1, 0x16, 0x24, 0x8f, 0x72, 0x73,
+	0x35, 0x42, 0xe9, 0xec, 0xd1, 0xa1, 0xf3, 0xe8, 0x9d, 0xdf, 0x82, 0xba, 0xda, 0xb0, 0xcc, 0x17,
+	0x09, 0x34, 0x54, 0x82, 0x45, 0x71, 0x7b, 0x14, 0x9f, 0xbd, 0x5f, 0x3b, 0xf0, 0x66, 0xe9, 0xb0,
+	0x9c, 0x67, 0x5f, 0xf2, 0x38, 0x4e, 0x95, 0x87, 0x18, 0xcf, 0xf0, 0xaf, 0x78, 0x26, 0xa2, 0x34,
+	0x41, 0x66, 0x2b, 0x74, 0xcd, 0xa0, 0xbf, 0xd2, 0x58, 0x65, 0x28, 0x63, 0xce, 0xd1, 0xc9, 0x34,
+	0xe7, 0xa6, 0x02, 0x07, 0x21, 0xe6, 0x78, 0x7e, 0x15, 0x05, 0xdc, 0x47, 0x51, 0xf4, 0x69, 0x41,
+	0xa3, 0x8e, 0x94, 0x40, 0x33, 0x02, 0x39, 0x1d, 0x73, 0x73, 0x66, 0x43, 0x70, 0x3a, 0x1d, 0x63,
+	0xf4, 0x10, 0xd1, 0x45, 0xc2, 0xe4, 0x24, 0xe3, 0x78, 0xe0, 0x1e, 0x9d, 0x21, 0xbc, 0x6f, 0x1d,
+	0x70, 0x95, 0xd8, 0xc5, 0xac, 0x5d, 0x51, 0x09, 0xfc, 0x08, 0xd6, 0xa3, 0x02, 0x95, 0x9f, 0x97,
+	0x05, 0x6b, 0x45, 0x74, 0x49, 0x66, 0x14, 0xa9, 0xbe, 0x20, 0x92, 0x55, 0x6c, 0xa3, 0x6c, 0xfd,
+	0x56, 0x45, 0x2b, 0x58, 0xa6, 0x58, 0xd0, 0xfb, 0x77, 0x07, 0xb6, 0x2b, 0x0a, 0x8b, 0x5b, 0xd6,
+	0x2c, 0xef, 0xc0, 0xaa, 0xc9, 0x8e, 0x3e, 0x86, 0x0e, 0x23, 0x52, 0xcf, 0x20, 0xb5, 0xaf, 0xde,
+	0x81, 0x36, 0x4f, 0x84, 0x5f, 0x10, 0xac, 0xc5, 0x13, 0x81, 0x3a, 0x7e, 0x08, 0xbd, 0x98, 0x09,
+	0xe9, 0x4f, 0xc6, 0x21, 0x93, 0x5c, 0xc7, 0xc1, 0x06, 0xed, 0x2a, 0xdc, 0x99, 0x46, 0xa9, 0x33,
+	0x8b, 0xa9, 0x90, 0x7c, 0xe4, 0x4b, 0x76, 0xa1, 0x4a, 0x88, 0xba, 0x3a, 0xb3, 0x46, 0x9d, 0xb2,
+	0x0b, 0x41, 0xde, 0x85, 0xb5, 0x58, 0xd9, 0x88, 0x9f, 0x44, 0xc1, 0x25, 0xbe, 0x44, 0x87, 0xc2,
+	0x55, 0xc4, 0x1e, 0x19, 0xa4, 0xf7, 0xe7, 0x4d, 0xb8, 0x53, 0x59, 0x45, 0x91, 0xdf, 0x86, 0xad,
+	0xa2, 0x20, 0x3e, 0xee, 0x8d, 0xa7, 0xe6, 0xf4, 0xa4, 0x20, 0xd0, 0x73, 0xbd, 0xf2, 0xff, 0x58,
+	0x15, 0xea, 0x6e, 0x59, 0x18, 0xf2, 0x10, 0x03, 0x7a, 0x9b, 0x6a, 0x40, 0xd9, 0xc9, 0xb9, 0xba,
+	0x64, 0x1e, 0x62, 0xc4, 0x6e, 0x53, 0x0b, 0x2a, 0xfa, 0xd1, 0x44, 0xc9, 0xd4, 0xd5, 0xf4, 0x08,
+	0x28, 0xfa, 0x8c, 0x8f, 0xd2, 0x2b, 0x1e, 0x62, 0x35, 0xd1, 0xa6, 0x16, 0x24, 0x0f, 0xa0, 0x37,
+	0x64, 0xc2, 0x47, 0xb6, 0xfe, 0x44, 0x60, 0x6d, 0xd0, 0xa6, 0x30, 0x64, 0x62, 0x4f, 0xa1, 0xce,
+	0x30, 0xc1, 0x5c, 0xf1, 0x2c, 0x7a, 0x65, 0x2b, 0x77, 0x21, 0x99, 0x9c, 0xe8, 0xd4, 0x5f, 0xa7,
+	0xa4, 0xb8, 0x74, 0x82, 0x2b, 0x58, 0x70, 0x67, 0x13, 0x21, 0x2d, 0xe5, 0x3a, 0x52, 0x76, 0x11,
+	0x67, 0x48, 0x3e, 0x85, 0xbb, 0xa6, 0x0a, 0xf5, 0x33, 0xfe, 0x27, 0x13, 0x2e, 0xa4, 0xbe, 0x45,
+	0xdc, 0xc2, 0xfb, 0x2e, 0xee, 0xe8, 0x1b, 0x12, 0xaa, 0x29, 0xf0, 0x32, 0xd5, 0x7e, 0x5e, 0xbd,
+	0x5d, 0xbb, 0xc1, 0x46, 0xe5, 0x76, 0xcc, 0x62, 0xe4, 0x33, 0xb8, 0x37, 0xbf, 0x5d, 0xa9, 0x43,
+	0x72, 0xf3, 0x7a, 0x82, 0xfb, 0xef, 0x94, 0xf7, 0x53, 0xa4, 0xd0, 0xef, 0xaf, 0x66, 0xa0, 0x05,
+	0xd8, 0xac, 0x66, 0xa0, 0x25, 0x78, 0x08, 0xbd, 0x30, 0x12, 0xe3, 0x98, 0x4d, 0xb5, 0x7d, 0x6d,
+	0xe1, 0xd5, 0x77, 0x0d, 0x4e, 0xd9, 0x98, 0x77, 0xbd, 0xe8, 0xef, 0xb6, 0x3c, 0x5a, 0xee, 0xef,
+	0x0b, 0x46, 0x5d, 0x5b, 0x62, 0xd4, 0xf3, 0x96, 0x5b, 0x5f, 0xb0, 0x5c, 0xef, 0x09, 0xec, 0xcc,
+	0xbf, 0xf8, 0x78, 0x72, 0x1e, 0x47, 0xc1, 0xfe, 0x90, 0xdd, 0x32, 0xd6, 0x78, 0xbf, 0xae, 0xc3,
+	0x6a, 0xa9, 0x85, 0xf9, 0xde, 0x7d, 0x3d, 0xe3, 0x98, 0xdd, 0x71, 0x16, 0x5d, 0x31, 0xc9, 0xfd,
+	0x4b, 0x3e, 0xd5, 0x15, 0xc0, 0x93, 0x5a, 0xdf, 0xa1, 0x60, 0xd0, 0x2a, 0x23, 0x3d, 0x50, 0x91,
+	0x55, 0x04, 0x59, 0x34, 0x56, 0xb2, 0xa1, 0x6f, 0xf6, 0x68, 0x11, 0xa5, 0x8a, 0x82, 0x5f, 0xa4,
+	0x51, 0x62, 0x3c, 0xb3, 0x4d, 0x0d, 0xa4, 0x52, 0xa6, 0xb6, 0x57, 0x1e, 0x62, 0x51, 0xd0, 0xa6,
+	0x39, 0x3c, 0x73, 0x9c, 0x56, 0xd1, 0x71, 0x5e, 0x82, 0x6b, 0x6e, 0x58, 0xf8, 0x32, 0xf5, 0x15,
+	0x1f, 0x53, 0xa5, 0xbd, 0x5b, 0xd5, 0xac, 0x19, 0xf2, 0xd3, 0xf4, 0x69, 0x1a, 0x25, 0x74, 0x2d,
+	0x2b, 0xc1, 0xe4, 0x63, 0x68, 0xdb, 0x16, 0xc1, 0xb4, 0x24, 0x6f, 0x57, 0x30, 0x32, 0xbd, 0x89,
+	0xa0, 0xf9, 0x06, 0x95, 0xc5, 0x78, 0x12, 0x64, 0xd3, 0xb1, 0xcc, 0x1d, 0x7f, 0x86, 0xc0, 0x1c,
+	0x37, 0xe6, 0x81, 0x64, 0x33, 0xf7, 0x9f, 0x21, 0x54, 0xe2, 0x32, 0xa4, 0xca, 0x89, 0xb1, 0x58,
+	0xe9, 0xa1, 0xe6, 0xd6, 0x66, 0xe8, 0x67, 0x7c, 0x2a, 0x54, 0x89, 0x73, 0xf7, 0x86, 0x13, 0x99,
+	0x3b, 0x73, 0xf2, 0x3b, 0xbb, 0x0f, 0x30, 0x46, 0xfb, 0xc0, 0x2b, 0xd3, 0x36, 0xd0, 0xd1, 0x18,
+	0x75, 0x5b, 0xf9, 0xc5, 0xd7, 0x8b, 0x17, 0x7f, 0x43, 0x70, 0xdd, 0xd6, 0xb5, 0x8b, 0x2d, 0xb5,
+	0x3b, 0xb4, 0xa9, 0xc0, 0x41, 0xa8, 0x6c, 0xd7, 0xb6, 0x99, 0x53, 0xb5, 0xda, 0xd4, 0x17, 0x9f,
+	0xe3, 0x06, 0x78, 0x89, 0xda, 0x85, 0x5b, 0xfa, 0x65, 0x08, 0x90, 0xcf, 0x61, 0x23, 0xe3, 0x57,
+	0x9c, 0xc5, 0x3c, 0xf4, 0x4d, 0xf5, 0x64, 0x6b, 0xed, 0x42, 0x4f, 0x4a, 0x0d, 0x49, 0xde, 0x08,
+	0x65, 0x65, 0x84, 0xf0, 0xfe, 0xa6, 0x06, 0xee, 0xbc, 0x6b, 0x90, 0x4f, 0x0b, 0xa3, 0x80, 0x85,
+	0xea, 0xaf, 0x22, 0x89, 0x15, 0x06, 0x01, 0x5f, 0x40, 0xcf, 0x68, 0x4f, 0x9d, 0x52, 0xf4, 0x6b,
+	0xf3, 0x2d, 0x40, 0xb5, 0x2f, 0xd2, 0xee, 0x38, 0x7f, 0x16, 0xe4, 0x63, 0x68, 0xd9, 0x2a, 0xb2,
+	0x8e, 0x76, 0x75, 0x83, 0x18, 0xf6, 0x88, 0x76, 0xc7, 0xff, 0x61, 0x1c, 0xe1, 0xfd, 0x14, 0xd6,
+	0x71, 0x55, 0x09, 0x64, 0x72, 0xca, 0xed, 0x62, 0xc4, 0x27, 0xb0, 0x65, 0x37, 0xbe, 0xd0, 0x33,
+	0x20, 0x41, 0x39, 0xbb, 0xed, 0xee, 0xdf, 0x83, 0xb7, 0x74, 0xd7, 0x2a, 0xa3, 0xab, 0x48, 0x4e,
+	0xf7, 0x79, 0x22, 0x79, 0x76, 0xc3, 0x7e, 0x17, 0xea, 0x51, 0xa8, 0xd5, 0xdb, 0xa3, 0xea, 0xd1,
+	0x3b, 0xd0, 0x71, 0xae, 0xcc, 0x61, 0x2f, 0x08, 0x38, 0x3a, 0xd3, 0x6d, 0xb9, 0x1c, 0x6a, 0x67,
+	0x29, 0x73, 0x39, 0x88, 0xc4, 0x28, 0x12, 0xe2, 0x35, 0xd8, 0xf8, 0xf0, 0xce, 0x22, 0x9b, 0xa3,
+	0x54, 0x96, 0x72, 0x2b, 0x57, 0xbe, 0x66, 0xab, 0x1e, 0x26, 0x0d, 0xcf, 0x8e, 0xc1, 0xec, 0x49,
+	0xe5, 0x55, 0x2a, 0x99, 0x0b, 0xce, 0x13, 0x54, 0x55, 0x9b, 0xb6, 0x86, 0x4c, 0x9c, 0x70, 0x9e,
+	0x78, 0x7f, 0xed, 0xc0, 0xdb, 0x37, 0xbf, 0x41, 0x90, 0x18, 0xee, 0x33, 0xb3, 0xec, 0x07, 0xb8,
+	0xee, 0x27, 0x45, 0x02, 0x63, 0xdf, 0x8f, 0xe6, 0x07, 0x07, 0x55, 0x1c, 0xe9, 0x5d, 0x56, 0xfd,
+	0x36, 0xef, 0x3f, 0x3b, 0xf0, 0x83, 0x9b, 0xf7, 0x2f, 0x84, 0x9a, 0x85, 0x19, 0x40, 0xa3, 0x38,
+	0x03, 0x78, 0x05, 0x1b, 0x45, 0x71, 0x67, 0x75, 0xf7, 0xda, 0xe3, 0x0f, 0x6f, 0x2b, 0xf2, 0x6e,
+	0x11, 0x50, 0x65, 0x3a, 0x75, 0x93, 0x39, 0x4c, 0x31, 0x40, 0x35, 0x4a, 0x01, 0x8a, 0x40, 0x23,
+	0xe3, 0xcc, 0x26, 0x1d, 0x7c, 0x56, 0x22, 0x87, 0xd6, 0x1a, 0x4c, 0xce, 0x99, 0x21, 0x54, 0x42,
+	0x62, 0xc6, 0xe2, 0x4c, 0xde, 0xc9, 0x61, 0x55, 0xb3, 0x99, 0xd9, 0x28, 0xb6, 0xa0, 0x3d, 0x6a,
+	0x41, 0x95, 0xde, 0xd8, 0x44, 0x0e, 0xf3, 0x2e, 0xdf, 0x40, 0xba, 0xaf, 0x1d, 0xc7, 0x53, 0x3b,
+	0x53, 0xc5, 0x14, 0xd1, 0x53, 0x7d, 0xed, 0x38, 0x9e, 0x1a, 0x1f, 0x5b, 0x88, 0xa2, 0x5d, 0x5d,
+	0x7a, 0x14, 0xa3, 0xe8, 0x2b, 0xd8, 0x18, 0xf1, 0xd1, 0x39, 0xcf, 0xc4, 0x30, 0x1a, 0xdb, 0x2a,
+	0xae, 0xf7, 0x9a, 0x8a, 0x7c, 0x91, 0x73, 0xd0, 0x35, 0x1f, 0x75, 0x47, 0x73, 0x18, 0xf2, 0x17,
+	0xce, 0xac, 0x8e, 0x5b, 0x56, 0x62, 0xae, 0xe2, 0x2b, 0x9f, 0xdc, 0xfa, 0x95, 0xb6, 0x45, 0x58,
+	0x28, 0x49, 0xf3, 0x52, 0x6c, 0x71, 0x49, 0xa9, 0x39, 0xe4, 0x31, 0x57, 0x37, 0xb0, 0xa6, 0x5d,
+	0xc6, 0x80, 0x73, 0xce, 0xb6, 0x3e, 0xef, 0x6c, 0x3f, 0x86, 0xad, 0x51, 0x1a, 0xf2, 0x4c, 0x8b,
+	0x7c, 0x15, 0xa5, 0x3a, 0xb6, 0x62, 0xf1, 0xda, 0xa1, 0x9b, 0xb3, 0xb5, 0xaf, 0xec, 0x92, 0xf7,
+	0x5f, 0x0e, 0xb8, 0xf3, 0x06, 0x46, 0x00, 0x9a, 0x47, 0xa9, 0x7a, 0x72, 0xdf, 0x20, 0xeb, 0xd0,
+	0x3d, 0xe2, 0xd7, 0x2f, 0x13, 0x7e, 0x9a, 0xbe, 0x4c, 0xb8, 0xeb, 0x90, 0x6d, 0xd8, 0x3c, 0xe2,
+	0xd7, 0xc7, 0xba, 0xf8, 0xf9, 0x22, 0x4b, 0x27, 0x63, 0x15, 0x2f, 0xdd, 0x1a, 0xe9, 0x42, 0xeb,
+	0x05, 0x4f, 0x14, 0x13, 0xb7, 0x4e, 0x3a, 0xb0, 0x42, 0xd5, 0x1d, 0xbb, 0x0d, 0x42, 0x60, 0x6d,
+	0xbf, 0x54, 0x76, 0xba, 0x2b, 0x8a, 0x49, 0x1e, 0xbc, 0x07, 0xc9, 0x55, 0x24, 0xf1, 0xe5, 0x6e,
+	0x93, 0x6c, 0x81, 0x3b, 0x9f, 0xe5, 0xdd, 0x16, 0xf9, 0x01, 0xec, 0xe4, 0xd8, 0xd9, 0x2d, 0xda,
+	0xf5, 0x36, 0xd9, 0x84, 0xf5, 0x7c, 0xfd, 0x59, 0xa4, 0xba, 0x0e, 0xb7, 0xa3, 0xdf, 0xb1, 0xa0,
+	0x63, 0x17, 0xbc, 0xbf, 0x74, 0xc0, 0x9d, 0xb7, 0x05, 0xd2, 0x87, 0xad, 0x79, 0xdc, 0x20, 0x8c,
+	0x95, 0x06, 0xee, 0xc2, 0xf6, 0xfc, 0xca, 0x31, 0x4f, 0xc2, 0x28, 0xb9, 0x70, 0x1d, 0x72, 0x0f,
+	0xfa, 0xf3, 0x8b, 0x36, 0x60, 0xbb, 0xb5, 0x65, 0xab, 0x07, 0x3c, 0x88, 0x55, 0xe5, 0xe7, 0xd6,
+	0xbd, 0x3f, 0x73, 0xe0, 0x4e, 0xa5, 0x81, 0x28, 0x75, 0x9e, 0x25, 0x97, 0x49, 0x7a, 0x9d, 0xb8,
+	0x6f, 0x28, 0x60, 0xf6, 0xce, 0x1e, 0xb4, 0x0b, 0xef, 0xe8, 0x41, 0x7b, 0xc6, 0x93, 0xac, 0x42,
+	0x67, 0x9f, 0x25, 0x01, 0x8f, 0x63, 0x1e, 0xba, 0x0d, 0xb5, 0xef, 0x54, 0x35, 0x39, 0x3c, 0x74,
+	0x57, 0xc8, 0x06, 0xac, 0x9e, 0x25, 0x08, 0x7e, 0x9d, 0x66, 0x72, 0x38, 0x75, 0x9b, 0xde, 0xb7,
+	0x0e, 0xf4, 0x94, 0x09, 0x3f, 0x49, 0xd3, 0xcb, 0x11, 0xcb, 0x2e, 0xab, 0xb3, 0xc3, 0x24, 0x8b,
+	0x4d, 0xae, 0x53, 0x8f, 0xf9, 0xa8, 0xa0, 0x5e, 0x18, 0x15, 0xdc, 0x85, 0x0e, 0x96, 0xf9, 0xbe,
+	0xa2, 0xd5, 0x71, 0xa8, 0x8d, 0x88, 0xb3, 0x2c, 0x2e, 0xf6, 0x7b, 0x2b, 0xe5, 0x7e, 0xef, 0x3e,
+	0x80, 0xb1, 0x6f, 0x65, 0xd4, 0x4d, 0x6d, 0xd4, 0x06, 0xb3, 0x27, 0xbd, 0x3f, 0x85, 0x37, 0x95,
+	0x84, 0x87, 0x89, 0x38, 0x13, 0x3c, 0x53, 0x2f, 0xd2, 0x43, 0xda, 0x0a, 0x51, 0x77, 0xa0, 0x3d,
+	0x31, 0x74, 0x46, 0xde, 0x1c, 0xc6, 0x99, 0xe9, 0x90, 0x45, 0x38, 0x22, 0xd1, 0xb5, 0x5f, 0x0b,
+	0xe1, 0x41, 0xa9, 0x1d, 0x6d, 0x94, 0xc4, 0xf3, 0x9e, 0xea, 0x0a, 0x6b, 0x3f, 0xe6, 0x2c, 0xfb,
+	0x32, 0x12, 0x32, 0xcd, 0xa6, 0xc5, 0x78, 0xeb, 0x94, 0xe2, 0xed, 0x7d, 0x80, 0x40, 0x11, 0xea,
+	0xb3, 0x98, 0x7c, 0x60, 0x30, 0x7b, 0xd2, 0xfb, 0xce, 0x01, 0xa2, 0x98, 0x99, 0x8f, 0x0c, 0xc7,
+	0x51, 0x20, 0x27, 0x19, 0x5f, 0x3a, 0xd0, 0x2a, 0x4c, 0x1d, 0x6b, 0x15, 0x53, 0xc7, 0x3a, 0xce,
+	0x63, 0x16, 0xa6, 0x8e, 0x0d, 0x44, 0xdb, 0xa9, 0xe3, 0x5d, 0xe8, 0x60, 0x03, 0x86, 0x63, 0x47,
+	0x3d, 0xc1, 0xc1, 0xb1, 0xe3, 0xc9, 0xd2, 0xb1, 0x63, 0x13, 0x09, 0x2a, 0xc6, 0x8e, 0xad, 0xe2,
+	0xd8, 0x71, 0x08, 0x9b, 0x8b, 0x27, 0x11, 0xd5, 0x93, 0xd5, 0x9f, 0x41, 0x7b, 0x6c, 0x88, 0x4c,
+	0x45, 0x79, 0xaf, 0x1c, 0x45, 0xcb, 0x9c, 0x68, 0x4e, 0xed, 0x7d, 0x57, 0x83, 0x6e, 0xe1, 0x73,
+	0x40, 0xc5, 0xbd, 0xf7, 0xa1, 0xc5, 0xc2, 0x30, 0xe3, 0x42, 0x58, 0x7d, 0x19, 0xb0, 0x28, 0x52,
+	0xbd, 0x24, 0x52, 0xb9, 0x4d, 0xd0, 0x4d, 0x5b, 0xa1, 0x4d, 0x20, 0xd0, 0x18, 0x33, 0x39, 0x34,
+	0x25, 0x3f, 0x3e, 0xe7, 0x37, 0xd5, 0x2c, 0xdc, 0x54, 0x71, 0x12, 0xdf, 0x32, 0xa3, 0x4d, 0x33,
+	0x89, 0xdf, 0x82, 0x15, 0x3e, 0x4a, 0x7f, 0x11, 0x61, 0xba, 0xec, 0x50, 0x0d, 0xa8, 0xab, 0xba,
+	0x66, 0x71, 0xcc, 0xa5, 0x99, 0xa0, 0x18, 0x48, 0x31, 0x57, 0x66, 0x64, 0xda, 0x28, 0x7c, 0xc6,
+	0x6b, 0x8d, 0xc2, 0x90, 0x27, 0xa6, 0x7d, 0x32, 0xd0, 0x0d, 0xe3, 0x93, 0x1d, 0x68, 0x8f, 0x53,
+	0x11, 0x61, 0xe0, 0x5f, 0xd5, 0x63, 0x66, 0x0b, 0x7b, 0xff, 0x66, 0x54, 0x69, 0x3e, 0xf1, 0x54,
+	0xa8, 0xb2, 0xa0, 0xb0, 0xda, 0xd2, 0xe9, 0x78, 0xbd, 0x3c, 0x78, 0x2d, 0x0c, 0x38, 0xf1, 0x19,
+	0x67, 0x09, 0x3c, 0x8b, 0xae, 0x78, 0xe8, 0xbf, 0xca, 0xd2, 0x91, 0xd1, 0x60, 0xd7, 0xe0, 0x3e,
+	0xcf, 0xd2, 0x11, 0xf9, 0x18, 0x76, 0x74, 0xd7, 0x2f, 0x78, 0xe8, 0xe3, 0x82, 0x19, 0x5e, 0xe2,
+	0xf8, 0x5e, 0x07, 0x81, 0x6d, 0x9c, 0x01, 0x08, 0x1e, 0x1e, 0xe4, 0xeb, 0x03, 0xb5, 0xac, 0x27,
+	0x59, 0x49, 0x60, 0xd9, 0x6b, 0xa5, 0x83, 0x46, 0x21, 0xf7, 0x1f, 0x63, 0x11, 0x53, 0xec, 0xaa,
+	0x2a, 0x3e, 0x2d, 0xe5, 0x64, 0x6a, 0x8b, 0x19, 0x37, 0xab, 0x2e, 0xb8, 0xbe, 0xf4, 0xb3, 0x98,
+	0x5a, 0xa5, 0x39, 0x59, 0xf1, 0x0e, 0xa0, 0x1c, 0x33, 0x7e, 0xae, 0x63, 0x96, 0x6d, 0xd3, 0x8e,
+	0x8d, 0xfe, 0x45, 0x85, 0xc2, 0x8b, 0xe2, 0xd6, 0x6e, 0x25, 0xae, 0xf7, 0xdf, 0x8e, 0x0e, 0x4b,
+	0x27, 0xec, 0x8a, 0x87, 0x7b, 0xc6, 0xd2, 0x0b, 0x3e, 0xe0, 0x94, 0x7d, 0x60, 0xd9, 0x77, 0x8d,
+	0x7b, 0xd0, 0x79, 0xc5, 0xae, 0xd2, 0x49, 0x16, 0x49, 0x7d, 0xa5, 0x6d, 0x3a, 0x43, 0xdc, 0x10,
+	0xaf, 0x1f, 0x42, 0x4f, 0x97, 0x1c, 0x7e, 0x31, 0x2c, 0x74, 0x35, 0x4e, 0x0f, 0x93, 0x7e, 0x13,
+	0x36, 0x74, 0xa0, 0x15, 0xc3, 0x34, 0x93, 0xd8, 0x53, 0x0b, 0xe3, 0x03, 0xeb, 0xb8, 0x70, 0xa2,
+	0xf0, 0xaa, 0xb7, 0x16, 0x2a, 0xb7, 0xf0, 0x44, 0x98, 0xba, 0x51, 0x3d, 0x2a, 0xfb, 0x8b, 0x84,
+	0x2f, 0xb9, 0xb0, 0xae, 0xd0, 0x8c, 0xc4, 0x29, 0x17, 0xf2, 0x69, 0xa3, 0xdd, 0x70, 0x57, 0xbc,
+	0x5f, 0x39, 0x5a, 0xbb, 0x0b, 0x63, 0x89, 0x0a, 0xed, 0xce, 0x97, 0x97, 0xb5, 0xc5, 0xf2, 0xf2,
+	0x10, 0xde, 0x1e, 0xea, 0xd0, 0xee, 0xb3, 0x2c, 0x18, 0x46, 0x57, 0xdc, 0x17, 0x93, 0xf1, 0x58,
+	0xc9, 0xce, 0x13, 0x76, 0x1e, 0x9b, 0xb1, 0x54, 0x9b, 0xde, 0x33, 0x64, 0x7b, 0x9a, 0xea, 0x44,
+	0x13, 0x1d, 0x6a, 0x1a, 0xef, 0x9f, 0x1c, 0xdd, 0x79, 0x9a, 0x94, 0xab, 0xf2, 0xd5, 0x2d, 0x27,
+	0xe1, 0x9f, 0x42, 0xd3, 0x54, 0x98, 0xba, 0x3b, 0x98, 0x1b, 0xe5, 0x14, 0x18, 0xee, 0x9e, 0xce,
+	0x86, 0x96, 0xd4, 0x6c, 0xf2, 0x3e, 0x82, 0x6e, 0x01, 0x8d, 0xa5, 0xc3, 0xd1, 0xb3, 0xa3, 0x97,
+	0x5f, 0x1f, 0xe9, 0xd2, 0xe1, 0x94, 0x9e, 0x9d, 0x9c, 0x1e, 0x1e, 0xb8, 0x0e, 0x96, 0x00, 0x47,
+	0x08, 0x7e, 0xfd, 0x92, 0x9e, 0x7e, 0xf9, 0x87, 0x6e, 0xcd, 0xfb, 0xb6, 0xae, 0xc7, 0x7a, 0xc5,
+	0x12, 0xc4, 0x54, 0x56, 0x15, 0xc2, 0x13, 0x68, 0xa0, 0xdf, 0x19, 0x63, 0x52, 0xcf, 0xea, 0x40,
+	0x32, 0x35, 0x81, 0xa1, 0x26, 0x53, 0x65, 0x5c, 0xc1, 0x50, 0x85, 0xb5, 0xe4, 0xc2, 0xc6, 0x86,
+	0x19, 0x42, 0x5d, 0x89, 0x19, 0x42, 0xe9, 0x44, 0x69, 0xa6, 0xd5, 0x39, 0x6e, 0x0f, 0xbf, 0x25,
+	0x65, 0x5c, 0x8c, 0xd3, 0x44, 0xd8, 0x68, 0x9b, 0xc3, 0x2a, 0x70, 0xab, 0x06, 0x22, 0xd2, 0x9b,
+	0xb5, 0xfd, 0x75, 0x0c, 0x66, 0x4f, 0x12, 0xbe, 0x7c, 0x3c, 0xdc, 0x46, 0xcd, 0xfe, 0x4e, 0x59,
+	0xb3, 0x4b, 0x4e, 0xbd, 0xbb, 0xa4, 0x5a, 0x5f, 0x36, 0x54, 0xd6, 0x77, 0xd8, 0xc9, 0xfb, 0xff,
+	0x3f, 0x00, 0x52, 0x51, 0xc6, 0x15, 0xef, 0xe2, 0xf8, 0xf0, 0xe8, 0x60, 0x70, 0xf4, 0x85, 0x29,
+	0xe3, 0xf6, 0xf7, 0x0f, 0x8f, 0xd5, 0xcd, 0xe8, 0x32, 0xee, 0x70, 0xff, 0xf9, 0xe0, 0xe8, 0xf0,
+	0xc0, 0xad, 0x2b, 0x68, 0x7f, 0xef, 0x68, 0xff, 0xf0, 0xf9, 0xe1, 0x81, 0xdb, 0xf0, 0xfe, 0xd5,
+	0xd1, 0x83, 0x81, 0x72, 0x19, 0x7d, 0xc0, 0x83, 0x48, 0x54, 0x7f, 0x16, 0xba, 0x07, 0x1d, 0xa3,
+	0xcf, 0x81, 0xb5, 0xb4, 0x19, 0x82, 0xfc, 0x31, 0xac, 0x87, 0x66, 0xbf, 0x5f, 0xb2, 0xbc, 0x0f,
+	0xe6, 0x47, 0x2c, 0xcb, 0x5e, 0xb9, 0x6b, 0x1f, 0x8c, 0x7a, 0xd6, 0xc2, 0x12, 0xec, 0xbd, 0x07,
+	0x6b, 0x65, 0x8a, 0xd2, 0x61, 0xdf, 0x28, 0x1d, 0xd6, 0xf1, 0xfe, 0xb9, 0x06, 0xeb, 0x73, 0x7f,
+	0xbf, 0xa8, 0xae, 0x23, 0xe6, 0xe7, 0xd4, 0xb5, 0x85, 0x39, 0x35, 0x79, 0x0f, 0x48, 0x91, 0xc4,
+	0x2f, 0x0e, 0xfb, 0xdc, 0x02, 0xa1, 0x8e, 0x55, 0xc5, 0xc2, 0xa4, 0xf1, 0x3a, 0x85, 0x09, 0xf9,
+	0x04, 0x7a, 0x22, 0x0d, 0x22, 0x16, 0xfb, 0x71, 0x94, 0x5c, 0xda, 0xff, 0xbc, 0xdc, 0x99, 0xfb,
+	0x3f, 0x07, 0x52, 0x3c, 0x57, 0x04, 0xb4, 0x2b, 0x66, 0x00, 0xf9, 0x7d, 0xd8, 0xe2, 0x89, 0xf0,
+	0x6d, 0x71, 0xea, 0x87, 0xf9, 0xbf, 0x5c, 0xea, 0x8b, 0x23, 0xd8, 0x85, 0xea, 0x97, 0x12, 0x3e,
+	0x8f, 0x12, 0x9e, 0x00, 0xa0, 0xec, 0xda, 0xb6, 0xd5, 0x85, 0x0a, 0xd2, 0x29, 0x57, 0x90, 0xcf,
+	0xa0, 0x6b, 0xfa, 0x71, 0xd5, 0xe4, 0xa1, 0x0a, 0xd7, 0x1e, 0xff, 0xc6, 0xec, 0x8d, 0x7b, 0xb3,
+	0x7f, 0x45, 0xbd, 0x30, 0x7f, 0x8a, 0x32, 0x4c, 0x77, 0x71, 0x00, 0x51, 0xdc, 0xed, 0xfd, 0x83,
+	0x03, 0x6b, 0x4a, 0xc4, 0xc2, 0x9b, 0x7f, 0x02, 0xdd, 0x2c, 0x87, 0xec, 0x8c, 0x66, 0xab, 0x30,
+	0xd7, 0xcc, 0x17, 0x69, 0x91, 0x90, 0x3c, 0x86, 0x2d, 0x31, 0x39, 0xb7, 0x59, 0xf3, 0xa9, 0x48,
+	0x93, 0x27, 0x53, 0xc9, 0x6d, 0x41, 0xb7, 0x74, 0x8d, 0xbc, 0x07, 0x1b, 0x76, 0x18, 0x3d, 0xdb,
+	0xa0, 0xbf, 0xd3, 0x2f, 0x2e, 0x78, 0x7f, 0xe7, 0xe4, 0x05, 0x90, 0xca, 0xe1, 0xd8, 0xd8, 0xe4,
+	0x26, 0xa6, 0x1e, 0x97, 0x66, 0xca, 0xb7, 0xa0, 0x69, 0x3e, 0x6d, 0xe9, 0x2c, 0x60, 0xa0, 0xa2,
+	0x91, 0x36, 0x4a, 0x46, 0x7a, 0x0f, 0x3a, 0x26, 0xf3, 0x72, 0x65, 0x16, 0x75, 0x55, 0x58, 0xe6,
+	0x88, 0x52, 0x85, 0xa6, 0x2b, 0x9d, 0x59, 0x85, 0xf6, 0x73, 0x9d, 0x41, 0x0a, 0x56, 0x43, 0x7e,
+	0x3a, 0x67, 0x66, 0x0b, 0xea, 0x9c, 0x11, 0x97, 0x2d, 0x2c, 0x8f, 0x0b, 0xb5, 0x62, 0xe1, 0xfe,
+	0x57, 0x0e, 0xdc, 0x2f, 0xd4, 0x14, 0xfb, 0x8b, 0x7f, 0xc7, 0xf8, 0x9e, 0x91, 0x5e, 0xc5, 0xdf,
+	0x3b, 0x6a, 0x95, 0x7f, 0xef, 0xa8, 0x2a, 0xc0, 0xbd, 0xbf, 0x75, 0x60, 0x43, 0x89, 0x62, 0x0c,
+	0xe0, 0x74, 0x98, 0x55, 0x4f, 0x4b, 0x0b, 0x2d, 0x57, 0xad, 0xd4, 0x72, 0xdd, 0x85, 0x8e, 0xc4,
+	0x8d, 0x7e, 0xce, 0xbf, 0xad, 0x11, 0x83, 0xc2, 0x27, 0x94, 0x46, 0xf1, 0x13, 0x0a, 0xe6, 0x0f,
+	0x16, 0x9a, 0xc0, 0xb0, 0x62, 0xf3, 0x07, 0x0b, 0x31, 0x22, 0x78, 0xff, 0x51, 0xd3, 0x73, 0xe0,
+	0x93, 0x60, 0xc8, 0xc3, 0x49, 0xcc, 0x43, 0x6b, 0xd7, 0xb7, 0xcb, 0xe5, 0x05, 0x49, 0xeb, 0xf3,
+	0xc3, 0x38, 0xc9, 0xbf, 0x91, 0x79, 0xa9, 0xcc, 0xbf, 0x91, 0xaa, 0x94, 0xb5, 0x69, 0xcd, 0x97,
+	0xa9, 0xa9, 0x94, 0xc1, 0xa2, 0x4e, 0x53, 0x15, 0xef, 0x84, 0x95, 0x63, 0xd6, 0x1f, 0x77, 0x73,
+	0xdc, 0x9e, 0x5c, 0xf6, 0x07, 0x80, 0xd6, 0xd2, 0x3f, 0x00, 0x7c, 0x64, 0xbf, 0x45, 0xe8, 0x54,
+	0x38, 0x37, 0xd2, 0x9f, 0x3f, 0xee, 0x2e, 0x0e, 0x78, 0xed, 0x17, 0x8b, 0xfb, 0x00, 0xc6, 0xeb,
+	0xfd, 0x3c, 0xeb, 0x75, 0x0c, 0x66, 0x10, 0x7a, 0x1f, 0xc2, 0x8a, 0x9e, 0x07, 0x17, 0x52, 0xdc,
+	0x1b, 0xa4, 0x0d, 0x8d, 0x93, 0xc3, 0xa3, 0x53, 0xd7, 0xc1, 0xb9, 0x04, 0x26, 0xb4, 0xe7, 0x98,
+	0xed, 0x00, 0x9a, 0x9f, 0xef, 0x0d, 0xd4, 0x73, 0xdd, 0xfb, 0xc7, 0x3c, 0xbb, 0x99, 0x82, 0x6c,
+	0x9f, 0xc5, 0x3c, 0x09, 0x59, 0x76, 0x78, 0xc5, 0x13, 0x49, 0x7e, 0x02, 0x2b, 0x5c, 0x3d, 0xa0,
+	0xd2, 0x4b, 0x7f, 0x92, 0x5a, 0xbe, 0x81, 0x6a, 0x72, 0xa5, 0x38, 0xec, 0xe5, 0xc4, 0x90, 0x87,
+	0xfe, 0xb9, 0xfd, 0x0c, 0xd4, 0xcd, 0x71, 0x4f, 0xa6, 0xe4, 0x67, 0xd0, 0xc8, 0xc4, 0xd5, 0xd8,
+	0x7c, 0x9d, 0xf8, 0xe1, 0xf7, 0x72, 0x16, 0x57, 0x63, 0x8a, 0x3b, 0x9e, 0xac, 0xfe, 0x51, 0x77,
+	0xf7, 0xfd, 0x8f, 0x2d, 0xfd, 0x79, 0x13, 0x9f, 0x3e, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x53, 0x06, 0x38, 0xc2, 0x93, 0x2a, 0x00, 0x00,
 }
@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: polls.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PollOption struct {
+	// Unique id of the option within the poll
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollOption) Reset()         { *m = PollOption{} }
+func (m *PollOption) String() string { return proto.CompactTextString(m) }
+func (*PollOption) ProtoMessage()    {}
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31256f45f39bb945, []int{0}
+}
+
+func (m *PollOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollOption.Unmarshal(m, b)
+}
+func (m *PollOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollOption.Marshal(b, m, deterministic)
+}
+func (m *PollOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollOption.Merge(m, src)
+}
+func (m *PollOption) XXX_Size() int {
+	return xxx_messageInfo_PollOption.Size(m)
+}
+func (m *PollOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollOption proto.InternalMessageInfo
+
+func (m *PollOption) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PollOption) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type PollMessage struct {
+	Question string        `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Options  []*PollOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Whether voters can pick more than one option
+	MultipleChoice bool `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	// Unix timestamp in milliseconds after which votes are not accepted anymore,
+	// 0 means the poll never closes
+	ClosesAt             uint64   `protobuf:"varint,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollMessage) Reset()         { *m = PollMessage{} }
+func (m *PollMessage) String() string { return proto.CompactTextString(m) }
+func (*PollMessage) ProtoMessage()    {}
+func (*PollMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31256f45f39bb945, []int{1}
+}
+
+func (m *PollMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollMessage.Unmarshal(m, b)
+}
+func (m *PollMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollMessage.Marshal(b, m, deterministic)
+}
+func (m *PollMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollMessage.Merge(m, src)
+}
+func (m *PollMessage) XXX_Size() int {
+	return xxx_messageInfo_PollMessage.Size(m)
+}
+func (m *PollMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollMessage proto.InternalMessageInfo
+
+func (m *PollMessage) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *PollMessage) GetOptions() []*PollOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PollMessage) GetMultipleChoice() bool {
+	if m != nil {
+		return m.MultipleChoice
+	}
+	return false
+}
+
+func (m *PollMessage) GetClosesAt() uint64 {
+	if m != nil {
+		return m.ClosesAt
+	}
+	return 0
+}
+
+type PollVote struct {
+	// Lamport timestamp of the vote, the latest vote of a voter replaces the previous ones
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Id of the message containing the poll
+	MessageId   string      `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MessageType MessageType `protobuf:"varint,4,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	// Ids of the chosen options, empty retracts the vote
+	OptionIds []string `protobuf:"bytes,5,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	// Grant for community chat messages
+	Grant                []byte   `protobuf:"bytes,6,opt,name=grant,proto3" json:"grant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollVote) Reset()         { *m = PollVote{} }
+func (m *PollVote) String() string { return proto.CompactTextString(m) }
+func (*PollVote) ProtoMessage()    {}
+func (*PollVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31256f45f39bb945, []int{2}
+}
+
+func (m *PollVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollVote.Unmarshal(m, b)
+}
+func (m *PollVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollVote.Marshal(b, m, deterministic)
+}
+func (m *PollVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollVote.Merge(m, src)
+}
+func (m *PollVote) XXX_Size() int {
+	return xxx_messageInfo_PollVote.Size(m)
+}
+func (m *PollVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollVote proto.InternalMessageInfo
+
+func (m *PollVote) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *PollVote) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *PollVote) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *PollVote) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *PollVote) GetOptionIds() []string {
+	if m != nil {
+		return m.OptionIds
+	}
+	return nil
+}
+
+func (m *PollVote) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PollOption)(nil), "protobuf.PollOption")
+	proto.RegisterType((*PollMessage)(nil), "protobuf.PollMessage")
+	proto.RegisterType((*PollVote)(nil), "protobuf.PollVote")
+}
+
+func init() {
+	proto.RegisterFile("polls.proto", fileDescriptor_31256f45f39bb945)
+}
+
+var fileDescriptor_31256f45f39bb945 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
+	0x10, 0xc6, 0xe5, 0x24, 0x6d, 0xd3, 0x4b, 0xff, 0xfd, 0x4b, 0x56, 0x11, 0x56, 0x11, 0x52, 0xd4,
+	0x85, 0x4c, 0x01, 0x95, 0x05, 0x89, 0x09, 0x98, 0x3a, 0x20, 0x90, 0x85, 0x18, 0x58, 0xa2, 0x34,
+	0x36, 0xad, 0x85, 0x53, 0x87, 0xda, 0x91, 0xe8, 0xd3, 0xf0, 0x38, 0xbc, 0x16, 0xb2, 0x9d, 0xd0,
+	0x29, 0xf7, 0xfd, 0x2e, 0xf7, 0xdd, 0x7d, 0x86, 0xa4, 0x51, 0x52, 0xea, 0xbc, 0xd9, 0x2b, 0xa3,
+	0x70, 0xec, 0x3e, 0xeb, 0xf6, 0x7d, 0x9e, 0xf0, 0x5d, 0x5b, 0x77, 0x78, 0x71, 0x05, 0xf0, 0xac,
+	0xa4, 0x7c, 0x6a, 0x8c, 0x50, 0x3b, 0x3c, 0x85, 0x40, 0x30, 0x82, 0x52, 0x94, 0x8d, 0x69, 0x20,
+	0x18, 0xc6, 0x10, 0x19, 0xfe, 0x65, 0x48, 0xe0, 0x88, 0xab, 0x17, 0xdf, 0x08, 0x12, 0x3b, 0xf2,
+	0xc8, 0xb5, 0x2e, 0x37, 0x1c, 0xcf, 0x21, 0xfe, 0x6c, 0xb9, 0xb6, 0xf3, 0xdd, 0xe4, 0x9f, 0xc6,
+	0x39, 0x8c, 0x94, 0x73, 0xd6, 0x24, 0x48, 0xc3, 0x2c, 0x59, 0xce, 0xf2, 0xfe, 0x8c, 0xfc, 0xb8,
+	0x96, 0xf6, 0x3f, 0xe1, 0x0b, 0xf8, 0x5f, 0xb7, 0xd2, 0x88, 0x46, 0xf2, 0xa2, 0xda, 0x2a, 0x51,
+	0x71, 0x12, 0xa6, 0x28, 0x8b, 0xe9, 0xb4, 0xc7, 0x0f, 0x8e, 0xe2, 0x33, 0x18, 0x57, 0x52, 0x69,
+	0xae, 0x8b, 0xd2, 0x90, 0x28, 0x45, 0x59, 0x44, 0x63, 0x0f, 0xee, 0xcc, 0xe2, 0x07, 0x41, 0x6c,
+	0xdd, 0x5f, 0x95, 0xe1, 0x78, 0x06, 0x83, 0x4a, 0xaa, 0xea, 0xc3, 0xdd, 0x16, 0x51, 0x2f, 0xf0,
+	0x29, 0x8c, 0xaa, 0x6d, 0x69, 0x0a, 0xc1, 0xba, 0x6c, 0x43, 0x2b, 0x57, 0x0c, 0x9f, 0x03, 0xd4,
+	0x3e, 0x98, 0xed, 0x85, 0xae, 0x37, 0xee, 0xc8, 0x8a, 0xe1, 0x1b, 0x98, 0xf4, 0x6d, 0x73, 0x68,
+	0xb8, 0x5b, 0x3d, 0x5d, 0x9e, 0x1c, 0x53, 0x75, 0xaf, 0xf2, 0x72, 0x68, 0x38, 0x4d, 0xea, 0xa3,
+	0xb0, 0xc6, 0x3e, 0x65, 0x21, 0x98, 0x26, 0x83, 0x34, 0xb4, 0xc6, 0x9e, 0xac, 0x98, 0xb6, 0x67,
+	0x6e, 0xf6, 0xe5, 0xce, 0x90, 0x61, 0x8a, 0xb2, 0x09, 0xf5, 0xe2, 0xfe, 0xdf, 0x5b, 0x92, 0x5f,
+	0xde, 0xf6, 0xe6, 0xeb, 0xa1, 0xab, 0xae, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x0e, 0x2f,
+	0x34, 0xd9, 0x01, 0x00, 0x00,
+}
@@ -41,6 +41,8 @@ const (
 	SyncSetting_MNEMONIC_REMOVED            SyncSetting_Type = 15
 	SyncSetting_ENS_USERNAMES               SyncSetting_Type = 16
 	SyncSetting_INCLUDE_WATCHONLY_ACCOUNT   SyncSetting_Type = 17
+	SyncSetting_SEND_TYPING_INDICATORS      SyncSetting_Type = 18
+	SyncSetting_SEND_READ_RECEIPTS          SyncSetting_Type = 19
 )
 
 var SyncSetting_Type_name = map[int32]string{
@@ -62,6 +64,8 @@ var SyncSetting_Type_name = map[int32]string{
 	15: "MNEMONIC_REMOVED",
 	16: "ENS_USERNAMES",
 	17: "INCLUDE_WATCHONLY_ACCOUNT",
+	18: "SEND_TYPING_INDICATORS",
+	19: "SEND_READ_RECEIPTS",
 }
 
 var SyncSetting_Type_value = map[string]int32{
@@ -83,6 +87,8 @@ var SyncSetting_Type_value = map[string]int32{
 	"MNEMONIC_REMOVED":            15,
 	"ENS_USERNAMES":               16,
 	"INCLUDE_WATCHONLY_ACCOUNT":   17,
+	"SEND_TYPING_INDICATORS":      18,
+	"SEND_READ_RECEIPTS":          19,
 }
 
 func (x SyncSetting_Type) String() string {
@@ -230,38 +236,40 @@ func init() {
 }
 
 var fileDescriptor_e2f7a0bce2873c78 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x5f, 0x6f, 0xd3, 0x3c,
-	0x14, 0xc6, 0x9b, 0xb5, 0x5d, 0xbb, 0xd3, 0x6e, 0xf3, 0xbc, 0xe9, 0x7d, 0xc3, 0x00, 0x2d, 0x8c,
-	0x9b, 0x5c, 0x05, 0x09, 0x10, 0x37, 0x5c, 0xb9, 0xce, 0xe9, 0x6a, 0x2d, 0xb1, 0x23, 0xdb, 0x69,
-	0x55, 0x6e, 0x2c, 0x56, 0x95, 0x69, 0xa2, 0x6a, 0xa6, 0x35, 0x43, 0xea, 0x97, 0xe0, 0x4b, 0xf0,
-	0x45, 0x51, 0x12, 0xca, 0xdf, 0x2b, 0xfb, 0x3c, 0xe7, 0x77, 0x1e, 0x1f, 0x1f, 0x1b, 0x4e, 0x37,
-	0xdb, 0xf5, 0xc2, 0x6d, 0x96, 0x65, 0x79, 0xb7, 0xbe, 0xdd, 0x44, 0xf7, 0x0f, 0x45, 0x59, 0xd0,
-	0x7e, 0xbd, 0xdc, 0x3c, 0x7e, 0xba, 0xfc, 0xda, 0x85, 0x81, 0xd9, 0xae, 0x17, 0xa6, 0x01, 0x68,
-	0x04, 0x9d, 0x72, 0x7b, 0xbf, 0xf4, 0xbd, 0xc0, 0x0b, 0x8f, 0x5e, 0x9f, 0x47, 0x3b, 0x30, 0xfa,
-	0x0d, 0x8a, 0xec, 0xf6, 0x7e, 0xa9, 0x6b, 0x8e, 0x9e, 0x41, 0x77, 0xb1, 0x2a, 0x16, 0x9f, 0xfd,
-	0xbd, 0xc0, 0x0b, 0x3b, 0xba, 0x09, 0xe8, 0x4b, 0x18, 0x7e, 0xf9, 0xb8, 0x7a, 0x5c, 0xba, 0x4d,
-	0xf9, 0x70, 0xb7, 0xbe, 0xf5, 0xdb, 0x81, 0x17, 0x1e, 0x4c, 0x5a, 0x7a, 0x50, 0xab, 0xa6, 0x16,
-	0xe9, 0x0b, 0x68, 0x42, 0x77, 0xb3, 0x2d, 0x97, 0x1b, 0xbf, 0x13, 0x78, 0xe1, 0x70, 0xd2, 0xd2,
-	0x50, 0x8b, 0xa3, 0x4a, 0xa3, 0x17, 0x00, 0x3f, 0x90, 0xa2, 0x58, 0xf9, 0xdd, 0xc0, 0x0b, 0xfb,
-	0x93, 0x96, 0x3e, 0x68, 0x88, 0xa2, 0x58, 0xfd, 0xf2, 0xb8, 0x5b, 0x97, 0xef, 0xde, 0xfa, 0xfb,
-	0x81, 0x17, 0xb6, 0x7f, 0x7a, 0x88, 0x4a, 0xbb, 0xfc, 0xd6, 0x86, 0x4e, 0xd5, 0x30, 0x1d, 0x40,
-	0x2f, 0x97, 0xd7, 0x52, 0xcd, 0x24, 0x69, 0xd1, 0x21, 0xf4, 0x79, 0xae, 0x35, 0x4a, 0x3e, 0x27,
-	0x1e, 0x3d, 0x86, 0xc1, 0x95, 0x18, 0x3b, 0x8d, 0x1c, 0xa5, 0x35, 0x64, 0x8f, 0x52, 0x38, 0xaa,
-	0x84, 0x31, 0x9b, 0xaa, 0x5c, 0x0b, 0x8b, 0x86, 0xb4, 0xe9, 0x05, 0x3c, 0x4d, 0xd1, 0x18, 0x76,
-	0x85, 0xc6, 0x8d, 0xb5, 0x4a, 0x1d, 0x57, 0xd2, 0x32, 0x6e, 0x8d, 0x53, 0x32, 0x99, 0x93, 0x4e,
-	0x55, 0x94, 0x69, 0x1c, 0xa3, 0xd6, 0x18, 0x3b, 0xc9, 0x52, 0x24, 0x5d, 0x7a, 0x0a, 0xc7, 0x99,
-	0xc6, 0xa9, 0xc0, 0x99, 0xcb, 0xb4, 0x98, 0x32, 0x3e, 0x27, 0xfb, 0xf4, 0x19, 0xf8, 0x99, 0x56,
-	0x63, 0x91, 0xa0, 0xcb, 0x04, 0xb7, 0xb9, 0x46, 0xe3, 0xcc, 0x44, 0xcd, 0x9c, 0x55, 0xa4, 0x57,
-	0x9d, 0xf3, 0x4f, 0x76, 0x2a, 0x8c, 0x18, 0x89, 0x44, 0xd8, 0x39, 0xe9, 0xd3, 0xff, 0xe1, 0xd4,
-	0xa0, 0x8c, 0x9d, 0xb1, 0xcc, 0xe6, 0xc6, 0xe5, 0x59, 0xcc, 0xaa, 0x0e, 0x0f, 0x2a, 0x5f, 0x63,
-	0x05, 0xbf, 0x46, 0x6d, 0x5c, 0xc6, 0xf8, 0xb5, 0x71, 0x42, 0x1a, 0xcb, 0x92, 0x04, 0x63, 0x02,
-	0xf4, 0x1c, 0xfe, 0xfb, 0x2b, 0x9b, 0xa1, 0x8c, 0x85, 0xbc, 0x22, 0x83, 0x3f, 0x2a, 0x9b, 0x29,
-	0xb8, 0x5d, 0x4c, 0x86, 0x94, 0xc0, 0x30, 0x16, 0x26, 0x4b, 0xd8, 0xbc, 0xb9, 0xd6, 0x21, 0xed,
-	0x41, 0x7b, 0x24, 0x14, 0x39, 0xa2, 0x67, 0x40, 0x52, 0x89, 0xa9, 0x92, 0x82, 0x3b, 0x8d, 0xa9,
-	0x9a, 0x62, 0x4c, 0x8e, 0xe9, 0x09, 0x1c, 0xa2, 0x34, 0x2e, 0x37, 0xa8, 0xab, 0x02, 0x43, 0x08,
-	0x7d, 0x0e, 0x4f, 0x84, 0xe4, 0x49, 0x1e, 0xa3, 0x9b, 0x31, 0xcb, 0x27, 0xd5, 0xcc, 0x1c, 0xe3,
-	0x5c, 0xe5, 0xd2, 0x92, 0x93, 0x51, 0x0f, 0xba, 0xcd, 0xab, 0x1e, 0x7e, 0x18, 0x44, 0xaf, 0xde,
-	0xef, 0xbe, 0xdd, 0xcd, 0x7e, 0xbd, 0x7b, 0xf3, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x93, 0x18, 0x16,
-	0x3f, 0xc7, 0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcf, 0x6e, 0xda, 0x40,
+	0x10, 0xc6, 0x71, 0x80, 0x40, 0x06, 0x92, 0x6c, 0x96, 0x28, 0xa5, 0x69, 0xab, 0xd0, 0xf4, 0xc2,
+	0x89, 0x4a, 0x6d, 0xd5, 0x4b, 0x4f, 0xcb, 0x7a, 0x80, 0x55, 0xcc, 0xae, 0xb5, 0xb3, 0x06, 0xd1,
+	0xcb, 0xaa, 0x41, 0x34, 0x8a, 0x8a, 0x70, 0x14, 0x9c, 0x4a, 0xbc, 0x5e, 0x5f, 0xa2, 0xaf, 0x53,
+	0xd9, 0x6e, 0xfa, 0xf7, 0x64, 0xcf, 0x37, 0xbf, 0x99, 0xfd, 0x66, 0x34, 0xd0, 0xd9, 0xee, 0x36,
+	0x4b, 0xbf, 0x5d, 0x65, 0xd9, 0xed, 0xe6, 0x66, 0x3b, 0xb8, 0xbb, 0x4f, 0xb3, 0x94, 0x37, 0x8b,
+	0xcf, 0xf5, 0xc3, 0xe7, 0xcb, 0x6f, 0x75, 0x68, 0xd1, 0x6e, 0xb3, 0xa4, 0x12, 0xe0, 0x03, 0xa8,
+	0x65, 0xbb, 0xbb, 0x55, 0x37, 0xe8, 0x05, 0xfd, 0xa3, 0x37, 0xe7, 0x83, 0x47, 0x70, 0xf0, 0x07,
+	0x34, 0x70, 0xbb, 0xbb, 0x95, 0x2d, 0x38, 0x7e, 0x0a, 0xf5, 0xe5, 0x3a, 0x5d, 0x7e, 0xe9, 0xee,
+	0xf5, 0x82, 0x7e, 0xcd, 0x96, 0x01, 0x7f, 0x05, 0xed, 0xaf, 0x9f, 0xd6, 0x0f, 0x2b, 0xbf, 0xcd,
+	0xee, 0x6f, 0x37, 0x37, 0xdd, 0x6a, 0x2f, 0xe8, 0x1f, 0x4c, 0x2a, 0xb6, 0x55, 0xa8, 0x54, 0x88,
+	0xfc, 0x25, 0x94, 0xa1, 0xbf, 0xde, 0x65, 0xab, 0x6d, 0xb7, 0xd6, 0x0b, 0xfa, 0xed, 0x49, 0xc5,
+	0x42, 0x21, 0x0e, 0x73, 0x8d, 0x5f, 0x00, 0xfc, 0x44, 0xd2, 0x74, 0xdd, 0xad, 0xf7, 0x82, 0x7e,
+	0x73, 0x52, 0xb1, 0x07, 0x25, 0x91, 0xa6, 0xeb, 0xdf, 0x3d, 0x6e, 0x37, 0xd9, 0xfb, 0x77, 0xdd,
+	0xfd, 0x5e, 0xd0, 0xaf, 0xfe, 0xea, 0xa1, 0x72, 0xed, 0xf2, 0x7b, 0x15, 0x6a, 0xb9, 0x61, 0xde,
+	0x82, 0x46, 0xa2, 0xaf, 0xb4, 0x99, 0x6b, 0x56, 0xe1, 0x6d, 0x68, 0xca, 0xc4, 0x5a, 0xd4, 0x72,
+	0xc1, 0x02, 0x7e, 0x0c, 0xad, 0xb1, 0x1a, 0x79, 0x8b, 0x12, 0xb5, 0x23, 0xb6, 0xc7, 0x39, 0x1c,
+	0xe5, 0xc2, 0x48, 0xcc, 0x4c, 0x62, 0x95, 0x43, 0x62, 0x55, 0x7e, 0x01, 0xcf, 0xa6, 0x48, 0x24,
+	0xc6, 0x48, 0x7e, 0x64, 0xcd, 0xd4, 0x4b, 0xa3, 0x9d, 0x90, 0x8e, 0xbc, 0xd1, 0xd1, 0x82, 0xd5,
+	0xf2, 0xa2, 0xd8, 0xe2, 0x08, 0xad, 0xc5, 0xd0, 0x6b, 0x31, 0x45, 0x56, 0xe7, 0x1d, 0x38, 0x8e,
+	0x2d, 0xce, 0x14, 0xce, 0x7d, 0x6c, 0xd5, 0x4c, 0xc8, 0x05, 0xdb, 0xe7, 0xcf, 0xa1, 0x1b, 0x5b,
+	0x33, 0x52, 0x11, 0xfa, 0x58, 0x49, 0x97, 0x58, 0x24, 0x4f, 0x13, 0x33, 0xf7, 0xce, 0xb0, 0x46,
+	0xfe, 0xce, 0x7f, 0xd9, 0x99, 0x22, 0x35, 0x54, 0x91, 0x72, 0x0b, 0xd6, 0xe4, 0x4f, 0xa0, 0x43,
+	0xa8, 0x43, 0x4f, 0x4e, 0xb8, 0x84, 0x7c, 0x12, 0x87, 0x22, 0x77, 0x78, 0x90, 0xf7, 0x25, 0xa7,
+	0xe4, 0x15, 0x5a, 0xf2, 0xb1, 0x90, 0x57, 0xe4, 0x95, 0x26, 0x27, 0xa2, 0x08, 0x43, 0x06, 0xfc,
+	0x1c, 0xce, 0xfe, 0xc9, 0xc6, 0xa8, 0x43, 0xa5, 0xc7, 0xac, 0xf5, 0x57, 0x65, 0xb9, 0x05, 0xff,
+	0x18, 0xb3, 0x36, 0x67, 0xd0, 0x0e, 0x15, 0xc5, 0x91, 0x58, 0x94, 0x63, 0x1d, 0xf2, 0x06, 0x54,
+	0x87, 0xca, 0xb0, 0x23, 0x7e, 0x0a, 0x6c, 0xaa, 0x71, 0x6a, 0xb4, 0x92, 0xde, 0xe2, 0xd4, 0xcc,
+	0x30, 0x64, 0xc7, 0xfc, 0x04, 0x0e, 0x51, 0x93, 0x4f, 0x08, 0x6d, 0x5e, 0x40, 0x8c, 0xf1, 0x17,
+	0xf0, 0x54, 0x69, 0x19, 0x25, 0x21, 0xfa, 0xb9, 0x70, 0x72, 0x92, 0xef, 0xcc, 0x0b, 0x29, 0x4d,
+	0xa2, 0x1d, 0x3b, 0x29, 0xcc, 0xe5, 0x33, 0xb9, 0x45, 0xac, 0xf4, 0xd8, 0x2b, 0x1d, 0x2a, 0x29,
+	0x9c, 0xb1, 0xc4, 0x38, 0x3f, 0x03, 0x5e, 0xe4, 0x2c, 0x8a, 0xb0, 0x70, 0xa7, 0x62, 0x47, 0xac,
+	0x33, 0x6c, 0x40, 0xbd, 0xbc, 0x84, 0xc3, 0x8f, 0xad, 0xc1, 0xeb, 0x0f, 0x8f, 0xa7, 0x7a, 0xbd,
+	0x5f, 0xfc, 0xbd, 0xfd, 0x11, 0x00, 0x00, 0xff, 0xff, 0x45, 0x50, 0xe4, 0xcd, 0xfb, 0x02, 0x00,
+	0x00,
 }
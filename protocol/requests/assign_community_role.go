@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrAssignCommunityRoleInvalidCommunityID = errors.New("assign-community-role: invalid community id")
+var ErrAssignCommunityRoleInvalidUser = errors.New("assign-community-role: invalid user id")
+var ErrAssignCommunityRoleInvalidRoleID = errors.New("assign-community-role: invalid role id")
+
+type AssignCommunityRole struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	User        types.HexBytes `json:"user"`
+	RoleID      string         `json:"roleId"`
+	// ChatID scopes the role to a single channel, the role applies to the whole community if empty
+	ChatID string `json:"chatId"`
+}
+
+func (a *AssignCommunityRole) Validate() error {
+	if len(a.CommunityID) == 0 {
+		return ErrAssignCommunityRoleInvalidCommunityID
+	}
+
+	if len(a.User) == 0 {
+		return ErrAssignCommunityRoleInvalidUser
+	}
+
+	if a.RoleID == "" {
+		return ErrAssignCommunityRoleInvalidRoleID
+	}
+
+	return nil
+}
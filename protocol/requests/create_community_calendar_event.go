@@ -0,0 +1,60 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityCalendarEventInvalidCommunityID = errors.New("create-community-calendar-event: invalid community id")
+var ErrCreateCommunityCalendarEventInvalidChatID = errors.New("create-community-calendar-event: invalid chat id")
+var ErrCreateCommunityCalendarEventInvalidTitle = errors.New("create-community-calendar-event: invalid title")
+var ErrCreateCommunityCalendarEventInvalidTime = errors.New("create-community-calendar-event: invalid time")
+
+type CreateCommunityCalendarEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// ChatID is the channel the event takes place in
+	ChatID      string `json:"chatId"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// StartTime and EndTime are in seconds, EndTime is zero if the event has no set duration
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime"`
+	// TokenCriteria members must meet to attend, empty if the event is open to all members
+	TokenCriteria []*protobuf.TokenCriteria `json:"tokenCriteria"`
+}
+
+func (c *CreateCommunityCalendarEvent) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityCalendarEventInvalidCommunityID
+	}
+
+	if c.ChatID == "" {
+		return ErrCreateCommunityCalendarEventInvalidChatID
+	}
+
+	if c.Title == "" {
+		return ErrCreateCommunityCalendarEventInvalidTitle
+	}
+
+	if c.StartTime == 0 || (c.EndTime != 0 && c.EndTime < c.StartTime) {
+		return ErrCreateCommunityCalendarEventInvalidTime
+	}
+
+	return nil
+}
+
+func (c *CreateCommunityCalendarEvent) ToCommunityCalendarEvent(id string, clock uint64) *protobuf.CommunityCalendarEvent {
+	return &protobuf.CommunityCalendarEvent{
+		Clock:         clock,
+		CommunityId:   c.CommunityID,
+		Id:            id,
+		ChatId:        c.ChatID,
+		Title:         c.Title,
+		Description:   c.Description,
+		StartTime:     c.StartTime,
+		EndTime:       c.EndTime,
+		TokenCriteria: c.TokenCriteria,
+	}
+}
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityInviteLinkInvalidCommunityID = errors.New("create-community-invite-link: invalid community id")
+var ErrCreateCommunityInviteLinkInvalidExpiry = errors.New("create-community-invite-link: expiry in the past")
+var ErrCreateCommunityInviteLinkInvalidRole = errors.New("create-community-invite-link: invalid role")
+
+type CreateCommunityInviteLink struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// ExpiresAt is the expiry time in seconds, zero if the link never expires
+	ExpiresAt uint64 `json:"expiresAt"`
+	// MaxUses is the maximum number of members who can use the link, zero if unlimited
+	MaxUses uint32 `json:"maxUses"`
+	// Role is given to the members joining with the link
+	Role protobuf.CommunityMember_Roles `json:"role"`
+	// AutoAccept accepts the requests to join made with the link without review
+	AutoAccept bool `json:"autoAccept"`
+}
+
+func (c *CreateCommunityInviteLink) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityInviteLinkInvalidCommunityID
+	}
+
+	if c.ExpiresAt != 0 && c.ExpiresAt <= uint64(time.Now().Unix()) {
+		return ErrCreateCommunityInviteLinkInvalidExpiry
+	}
+
+	if c.Role == protobuf.CommunityMember_ROLE_OWNER {
+		return ErrCreateCommunityInviteLinkInvalidRole
+	}
+
+	if _, ok := protobuf.CommunityMember_Roles_name[int32(c.Role)]; !ok {
+		return ErrCreateCommunityInviteLinkInvalidRole
+	}
+
+	return nil
+}
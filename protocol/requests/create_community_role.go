@@ -0,0 +1,46 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityRoleInvalidCommunityID = errors.New("create-community-role: invalid community id")
+var ErrCreateCommunityRoleInvalidName = errors.New("create-community-role: invalid name")
+var ErrCreateCommunityRoleInvalidCapability = errors.New("create-community-role: invalid capability")
+
+type CreateCommunityRole struct {
+	CommunityID  types.HexBytes                      `json:"communityId"`
+	Name         string                              `json:"name"`
+	Color        string                              `json:"color"`
+	Capabilities []protobuf.CommunityRole_Capability `json:"capabilities"`
+}
+
+func (c *CreateCommunityRole) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityRoleInvalidCommunityID
+	}
+
+	if c.Name == "" {
+		return ErrCreateCommunityRoleInvalidName
+	}
+
+	for _, capability := range c.Capabilities {
+		if _, ok := protobuf.CommunityRole_Capability_name[int32(capability)]; !ok || capability == protobuf.CommunityRole_UNKNOWN_CAPABILITY {
+			return ErrCreateCommunityRoleInvalidCapability
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateCommunityRole) ToCommunityRole(id string) *protobuf.CommunityRole {
+	return &protobuf.CommunityRole{
+		Id:           id,
+		Name:         c.Name,
+		Color:        c.Color,
+		Capabilities: c.Capabilities,
+	}
+}
@@ -15,6 +15,7 @@ var (
 	ErrCreateCommunityTokenPermissionTooManyTokenCriteria  = errors.New("too many token criteria")
 	ErrCreateCommunityTokenPermissionInvalidPermissionType = errors.New("invalid community token permission type")
 	ErrCreateCommunityTokenPermissionInvalidTokenCriteria  = errors.New("invalid community permission token criteria data")
+	ErrCreateCommunityTokenPermissionInvalidRoleID         = errors.New("community token permission needs a role id to grant the role")
 )
 
 type CreateCommunityTokenPermission struct {
@@ -23,6 +24,7 @@ type CreateCommunityTokenPermission struct {
 	TokenCriteria []*protobuf.TokenCriteria              `json:"tokenCriteria"`
 	IsPrivate     bool                                   `json:"isPrivate"`
 	ChatIds       []string                               `json:"chat_ids"`
+	RoleID        string                                 `json:"roleId"`
 }
 
 func (p *CreateCommunityTokenPermission) Validate() error {
@@ -38,6 +40,10 @@ func (p *CreateCommunityTokenPermission) Validate() error {
 		return ErrCreateCommunityTokenPermissionInvalidPermissionType
 	}
 
+	if (p.Type == protobuf.CommunityTokenPermission_BECOME_ROLE) != (p.RoleID != "") {
+		return ErrCreateCommunityTokenPermissionInvalidRoleID
+	}
+
 	for _, c := range p.TokenCriteria {
 		if c.EnsPattern == "" && len(c.ContractAddresses) == 0 {
 			return ErrCreateCommunityTokenPermissionInvalidTokenCriteria
@@ -58,5 +64,6 @@ func (p *CreateCommunityTokenPermission) ToCommunityTokenPermission() protobuf.C
 		TokenCriteria: p.TokenCriteria,
 		IsPrivate:     p.IsPrivate,
 		ChatIds:       p.ChatIds,
+		RoleId:        p.RoleID,
 	}
 }
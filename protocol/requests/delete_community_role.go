@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrDeleteCommunityRoleInvalidCommunityID = errors.New("delete-community-role: invalid community id")
+var ErrDeleteCommunityRoleInvalidRoleID = errors.New("delete-community-role: invalid role id")
+
+type DeleteCommunityRole struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	RoleID      string         `json:"roleId"`
+}
+
+func (d *DeleteCommunityRole) Validate() error {
+	if len(d.CommunityID) == 0 {
+		return ErrDeleteCommunityRoleInvalidCommunityID
+	}
+
+	if d.RoleID == "" {
+		return ErrDeleteCommunityRoleInvalidRoleID
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrEditCommunityCalendarEventInvalidEventID = errors.New("edit-community-calendar-event: invalid event id")
+
+type EditCommunityCalendarEvent struct {
+	EventID string `json:"eventId"`
+	CreateCommunityCalendarEvent
+}
+
+func (e *EditCommunityCalendarEvent) Validate() error {
+	if e.EventID == "" {
+		return ErrEditCommunityCalendarEventInvalidEventID
+	}
+
+	return e.CreateCommunityCalendarEvent.Validate()
+}
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrEditCommunityRoleInvalidRoleID = errors.New("edit-community-role: invalid role id")
+
+type EditCommunityRole struct {
+	RoleID string `json:"roleId"`
+	CreateCommunityRole
+}
+
+func (e *EditCommunityRole) Validate() error {
+	if e.RoleID == "" {
+		return ErrEditCommunityRoleInvalidRoleID
+	}
+
+	return e.CreateCommunityRole.Validate()
+}
+
+func (e *EditCommunityRole) ToCommunityRole() *protobuf.CommunityRole {
+	return e.CreateCommunityRole.ToCommunityRole(e.RoleID)
+}
@@ -30,5 +30,6 @@ func (u *EditCommunityTokenPermission) ToCommunityTokenPermission() protobuf.Com
 		TokenCriteria: u.TokenCriteria,
 		ChatIds:       u.ChatIds,
 		IsPrivate:     u.IsPrivate,
+		RoleId:        u.RoleID,
 	}
 }
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrEditScheduledMessageInvalidID = errors.New("edit-scheduled-message: invalid id")
+var ErrEditScheduledMessageInvalidText = errors.New("edit-scheduled-message: invalid text")
+var ErrEditScheduledMessageInvalidScheduledAt = errors.New("edit-scheduled-message: invalid scheduled at")
+
+type EditScheduledMessage struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	// ScheduledAt is the unix timestamp in milliseconds at which the message is sent
+	ScheduledAt uint64 `json:"scheduledAt"`
+}
+
+func (e *EditScheduledMessage) Validate() error {
+	if len(e.ID) == 0 {
+		return ErrEditScheduledMessageInvalidID
+	}
+
+	if len(e.Text) == 0 {
+		return ErrEditScheduledMessageInvalidText
+	}
+
+	if e.ScheduledAt == 0 {
+		return ErrEditScheduledMessageInvalidScheduledAt
+	}
+
+	return nil
+}
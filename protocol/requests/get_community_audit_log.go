@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	DefaultCommunityAuditLogLimit = 50
+	maxCommunityAuditLogLimit     = 500
+)
+
+var ErrGetCommunityAuditLogInvalidCommunityID = errors.New("get-community-audit-log: invalid community id")
+var ErrGetCommunityAuditLogInvalidCursor = errors.New("get-community-audit-log: invalid cursor")
+var ErrGetCommunityAuditLogInvalidLimit = errors.New("get-community-audit-log: invalid limit")
+
+type GetCommunityAuditLog struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Cursor is the cursor returned with the previous page, empty for the newest entries
+	Cursor string `json:"cursor"`
+	// Limit is the maximum number of entries returned, DefaultCommunityAuditLogLimit if zero
+	Limit int `json:"limit"`
+}
+
+func (g *GetCommunityAuditLog) Validate() error {
+	if len(g.CommunityID) == 0 {
+		return ErrGetCommunityAuditLogInvalidCommunityID
+	}
+
+	if g.Cursor != "" {
+		if _, err := strconv.ParseUint(g.Cursor, 10, 64); err != nil {
+			return ErrGetCommunityAuditLogInvalidCursor
+		}
+	}
+
+	if g.Limit < 0 || g.Limit > maxCommunityAuditLogLimit {
+		return ErrGetCommunityAuditLogInvalidLimit
+	}
+
+	return nil
+}
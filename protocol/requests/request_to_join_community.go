@@ -16,6 +16,9 @@ type RequestToJoinCommunity struct {
 	Password          string         `json:"password"`
 	AddressesToReveal []string       `json:"addressesToReveal"`
 	AirdropAddress    string         `json:"airdropAddress"`
+	// InviteLink is an invite link to the community signed by its owner, letting the request through
+	// even if the community is invitation only
+	InviteLink string `json:"inviteLink"`
 }
 
 func (j *RequestToJoinCommunity) Validate() error {
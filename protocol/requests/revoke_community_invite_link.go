@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrRevokeCommunityInviteLinkInvalidCommunityID = errors.New("revoke-community-invite-link: invalid community id")
+var ErrRevokeCommunityInviteLinkInvalidInviteID = errors.New("revoke-community-invite-link: invalid invite id")
+
+type RevokeCommunityInviteLink struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	InviteID    types.HexBytes `json:"inviteId"`
+}
+
+func (r *RevokeCommunityInviteLink) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrRevokeCommunityInviteLinkInvalidCommunityID
+	}
+
+	if len(r.InviteID) == 0 {
+		return ErrRevokeCommunityInviteLinkInvalidInviteID
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrRsvpCommunityCalendarEventInvalidEventID = errors.New("rsvp-community-calendar-event: invalid event id")
+var ErrRsvpCommunityCalendarEventInvalidResponse = errors.New("rsvp-community-calendar-event: invalid response")
+
+type RsvpCommunityCalendarEvent struct {
+	EventID  string                                       `json:"eventId"`
+	Response protobuf.CommunityCalendarEventRsvp_Response `json:"response"`
+}
+
+func (r *RsvpCommunityCalendarEvent) Validate() error {
+	if r.EventID == "" {
+		return ErrRsvpCommunityCalendarEventInvalidEventID
+	}
+
+	if _, ok := protobuf.CommunityCalendarEventRsvp_Response_name[int32(r.Response)]; !ok || r.Response == protobuf.CommunityCalendarEventRsvp_UNKNOWN_RESPONSE {
+		return ErrRsvpCommunityCalendarEventInvalidResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrScheduleMessageInvalidChatID = errors.New("schedule-message: invalid chat id")
+var ErrScheduleMessageInvalidText = errors.New("schedule-message: invalid text")
+var ErrScheduleMessageInvalidScheduledAt = errors.New("schedule-message: invalid scheduled at")
+
+type ScheduleMessage struct {
+	ChatID     string `json:"chatId"`
+	Text       string `json:"text"`
+	ResponseTo string `json:"responseTo"`
+	// ScheduledAt is the unix timestamp in milliseconds at which the message is sent
+	ScheduledAt uint64 `json:"scheduledAt"`
+}
+
+func (s *ScheduleMessage) Validate() error {
+	if len(s.ChatID) == 0 {
+		return ErrScheduleMessageInvalidChatID
+	}
+
+	if len(s.Text) == 0 {
+		return ErrScheduleMessageInvalidText
+	}
+
+	if s.ScheduledAt == 0 {
+		return ErrScheduleMessageInvalidScheduledAt
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSendPollVoteInvalidMessageID = errors.New("send-poll-vote: invalid message id")
+
+type SendPollVote struct {
+	MessageID string `json:"messageId"`
+	// OptionIDs are the ids of the chosen options, empty retracts the vote
+	OptionIDs []string `json:"optionIds"`
+}
+
+func (s *SendPollVote) Validate() error {
+	if len(s.MessageID) == 0 {
+		return ErrSendPollVoteInvalidMessageID
+	}
+
+	return nil
+}
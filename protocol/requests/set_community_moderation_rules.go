@@ -0,0 +1,78 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	maxModerationRulesEntries     = 100
+	maxModerationRuleLength       = 256
+	maxNewMemberDelaySeconds      = 30 * 24 * 60 * 60
+	maxModerationRulesMessageSize = 1000
+)
+
+var ErrSetCommunityModerationRulesInvalidCommunityID = errors.New("set-community-moderation-rules: invalid community id")
+var ErrInvalidModerationRulesTooManyEntries = errors.New("invalid moderation rules: too many banned words or patterns")
+var ErrInvalidModerationRulesEmptyEntry = errors.New("invalid moderation rules: empty banned word or pattern")
+var ErrInvalidModerationRulesEntryTooLong = errors.New("invalid moderation rules: banned word or pattern too long")
+var ErrInvalidModerationRulesPattern = errors.New("invalid moderation rules: invalid banned pattern")
+var ErrInvalidModerationRulesLimit = errors.New("invalid moderation rules: limit too high")
+
+type SetCommunityModerationRules struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Rules replace the moderation rules of the community, nil to remove them
+	Rules *protobuf.CommunityModerationRules `json:"rules"`
+}
+
+func (s *SetCommunityModerationRules) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityModerationRulesInvalidCommunityID
+	}
+
+	return ValidateModerationRules(s.Rules)
+}
+
+// ValidateModerationRules checks that the banned patterns compile and that the rules are bounded,
+// as they are checked against every message posted in the community
+func ValidateModerationRules(rules *protobuf.CommunityModerationRules) error {
+	if rules == nil {
+		return nil
+	}
+
+	if len(rules.BannedWords)+len(rules.BannedPatterns) > maxModerationRulesEntries {
+		return ErrInvalidModerationRulesTooManyEntries
+	}
+
+	for _, w := range rules.BannedWords {
+		if len(strings.TrimSpace(w)) == 0 {
+			return ErrInvalidModerationRulesEmptyEntry
+		}
+		if len(w) > maxModerationRuleLength {
+			return ErrInvalidModerationRulesEntryTooLong
+		}
+	}
+
+	for _, p := range rules.BannedPatterns {
+		if len(p) == 0 {
+			return ErrInvalidModerationRulesEmptyEntry
+		}
+		if len(p) > maxModerationRuleLength {
+			return ErrInvalidModerationRulesEntryTooLong
+		}
+		if _, err := regexp.Compile(p); err != nil {
+			return ErrInvalidModerationRulesPattern
+		}
+	}
+
+	if rules.MaxLinks > maxModerationRulesMessageSize || rules.MaxMentions > maxModerationRulesMessageSize ||
+		rules.NewMemberDelaySeconds > maxNewMemberDelaySeconds {
+		return ErrInvalidModerationRulesLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"errors"
+)
+
+// MaxDisappearingMessagesTimer is the longest supported timer, 4 weeks
+const MaxDisappearingMessagesTimer = 4 * 7 * 24 * 60 * 60
+
+var ErrSetDisappearingMessagesInvalidChatID = errors.New("set-disappearing-messages: invalid chat id")
+var ErrSetDisappearingMessagesInvalidTimer = errors.New("set-disappearing-messages: invalid timer")
+
+type SetDisappearingMessages struct {
+	ChatID string `json:"chatId"`
+	// Timer is the number of seconds after which new messages are deleted,
+	// 0 disables disappearing messages
+	Timer uint64 `json:"timer"`
+}
+
+func (s *SetDisappearingMessages) Validate() error {
+	if len(s.ChatID) == 0 {
+		return ErrSetDisappearingMessagesInvalidChatID
+	}
+
+	if s.Timer > MaxDisappearingMessagesTimer {
+		return ErrSetDisappearingMessagesInvalidTimer
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrUnassignCommunityRoleInvalidCommunityID = errors.New("unassign-community-role: invalid community id")
+var ErrUnassignCommunityRoleInvalidUser = errors.New("unassign-community-role: invalid user id")
+var ErrUnassignCommunityRoleInvalidRoleID = errors.New("unassign-community-role: invalid role id")
+
+type UnassignCommunityRole struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	User        types.HexBytes `json:"user"`
+	RoleID      string         `json:"roleId"`
+	// ChatID is the channel the role was assigned in, empty if it was assigned in the whole community
+	ChatID string `json:"chatId"`
+}
+
+func (u *UnassignCommunityRole) Validate() error {
+	if len(u.CommunityID) == 0 {
+		return ErrUnassignCommunityRoleInvalidCommunityID
+	}
+
+	if len(u.User) == 0 {
+		return ErrUnassignCommunityRoleInvalidUser
+	}
+
+	if u.RoleID == "" {
+		return ErrUnassignCommunityRoleInvalidRoleID
+	}
+
+	return nil
+}
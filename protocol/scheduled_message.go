@@ -0,0 +1,59 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ScheduledMessage is a text message waiting in the outbox to be sent at a given time.
+// Scheduled messages are synced to paired devices, but only the installation
+// that scheduled or last edited a message sends it.
+type ScheduledMessage struct {
+	ID          string `json:"id"`
+	ChatID      string `json:"chatId"`
+	Text        string `json:"text"`
+	ResponseTo  string `json:"responseTo,omitempty"`
+	ScheduledAt uint64 `json:"scheduledAt"`
+	// InstallationID is the installation responsible for sending the message
+	InstallationID string                              `json:"installationId"`
+	State          protobuf.SyncScheduledMessage_State `json:"state"`
+	// MessageID is the id of the chat message once sent
+	MessageID string `json:"messageId,omitempty"`
+	Clock     uint64 `json:"clock"`
+
+	// sendCount and lastSent track the failed attempts of this installation
+	// to send the message, they are not synced
+	sendCount uint64
+	lastSent  uint64
+}
+
+func (s *ScheduledMessage) Pending() bool {
+	return s.State == protobuf.SyncScheduledMessage_PENDING
+}
+
+func (s *ScheduledMessage) toSyncProtobuf() *protobuf.SyncScheduledMessage {
+	return &protobuf.SyncScheduledMessage{
+		Clock:          s.Clock,
+		Id:             s.ID,
+		ChatId:         s.ChatID,
+		Text:           s.Text,
+		ResponseTo:     s.ResponseTo,
+		ScheduledAt:    s.ScheduledAt,
+		InstallationId: s.InstallationID,
+		State:          s.State,
+		MessageId:      s.MessageID,
+	}
+}
+
+func scheduledMessageFromSyncProtobuf(message *protobuf.SyncScheduledMessage) *ScheduledMessage {
+	return &ScheduledMessage{
+		ID:             message.Id,
+		ChatID:         message.ChatId,
+		Text:           message.Text,
+		ResponseTo:     message.ResponseTo,
+		ScheduledAt:    message.ScheduledAt,
+		InstallationID: message.InstallationId,
+		State:          message.State,
+		MessageID:      message.MessageId,
+		Clock:          message.Clock,
+	}
+}
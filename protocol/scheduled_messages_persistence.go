@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"database/sql"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const selectScheduledMessagesQuery = `SELECT id, local_chat_id, text, response_to, scheduled_at, installation_id, state, message_id, clock, send_count, last_sent FROM scheduled_messages`
+
+// SaveScheduledMessage stores a scheduled message, unless a more recent version has been stored already.
+// It returns whether the message has been stored.
+func (db sqlitePersistence) SaveScheduledMessage(message *ScheduledMessage) (bool, error) {
+	result, err := db.db.Exec(`
+		INSERT INTO scheduled_messages(id, local_chat_id, text, response_to, scheduled_at, installation_id, state, message_id, clock)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM scheduled_messages WHERE id = ? AND clock >= ?)`,
+		message.ID, message.ChatID, message.Text, message.ResponseTo, message.ScheduledAt, message.InstallationID, message.State, message.MessageID, message.Clock,
+		message.ID, message.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateScheduledMessageSendCount stores the failed attempts to send a scheduled message,
+// saving a new version of the message resets them
+func (db sqlitePersistence) UpdateScheduledMessageSendCount(message *ScheduledMessage) error {
+	_, err := db.db.Exec(`UPDATE scheduled_messages SET send_count = ?, last_sent = ? WHERE id = ?`, message.sendCount, message.lastSent, message.ID)
+	return err
+}
+
+func (db sqlitePersistence) ScheduledMessage(id string) (*ScheduledMessage, error) {
+	rows, err := db.db.Query(selectScheduledMessagesQuery+` WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages, err := db.scanScheduledMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, common.ErrRecordNotFound
+	}
+
+	return messages[0], nil
+}
+
+// PendingScheduledMessages returns the messages waiting to be sent in a chat,
+// or in all chats if chatID is empty, earliest first
+func (db sqlitePersistence) PendingScheduledMessages(chatID string) ([]*ScheduledMessage, error) {
+	rows, err := db.db.Query(selectScheduledMessagesQuery+` WHERE state = ? AND (? = '' OR local_chat_id = ?) ORDER BY scheduled_at ASC`,
+		protobuf.SyncScheduledMessage_PENDING, chatID, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.scanScheduledMessages(rows)
+}
+
+// DueScheduledMessages returns the pending messages the installation is responsible for
+// and that should have been sent at the given time
+func (db sqlitePersistence) DueScheduledMessages(installationID string, now uint64) ([]*ScheduledMessage, error) {
+	rows, err := db.db.Query(selectScheduledMessagesQuery+` WHERE state = ? AND installation_id = ? AND scheduled_at <= ? ORDER BY scheduled_at ASC`,
+		protobuf.SyncScheduledMessage_PENDING, installationID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.scanScheduledMessages(rows)
+}
+
+func (db sqlitePersistence) scanScheduledMessages(rows *sql.Rows) ([]*ScheduledMessage, error) {
+	var messages []*ScheduledMessage
+	for rows.Next() {
+		message := &ScheduledMessage{}
+		err := rows.Scan(
+			&message.ID,
+			&message.ChatID,
+			&message.Text,
+			&message.ResponseTo,
+			&message.ScheduledAt,
+			&message.InstallationID,
+			&message.State,
+			&message.MessageID,
+			&message.Clock,
+			&message.sendCount,
+			&message.lastSent,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
@@ -165,5 +165,9 @@ func Migrate(database *sql.DB) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to apply status-go/protocol migrations")
 	}
+	err = EnsureMessageSearchIndex(database)
+	if err != nil {
+		return errors.Wrap(err, "failed to set up message search index")
+	}
 	return nil
 }
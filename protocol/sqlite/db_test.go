@@ -128,3 +128,39 @@ func TestCommunitiesMigrationNotDirty(t *testing.T) {
 	require.Equal(t, "communities_communities", name)
 
 }
+
+func TestEnsureMessageSearchIndex(t *testing.T) {
+	db, err := openAndMigrate(InMemoryPath, "some-key", ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+
+	if !MessageSearchIndexAvailable(db) {
+		t.Skip("full-text search index not available, build with the fts5 tag")
+	}
+
+	// Simulate messages inserted by a build without FTS5 support
+	for name := range messageSearchIndexTriggers {
+		_, err = db.Exec(`DROP TRIGGER ` + name)
+		require.NoError(t, err)
+	}
+	insertMessage := func(id, text string, hide bool) {
+		_, err := db.Exec(`INSERT INTO user_messages (id, text, hide, whisper_timestamp, source, content_type, timestamp, chat_id, local_chat_id, clock_value)
+			VALUES (?, ?, ?, 0, '', 0, 0, '', '', 0)`, id, text, hide)
+		require.NoError(t, err)
+	}
+	insertMessage("1", "hello world", false)
+	insertMessage("2", "hidden world", true)
+
+	require.NoError(t, EnsureMessageSearchIndex(db))
+
+	var id string
+	err = db.QueryRow(`SELECT m.id FROM user_messages_fts f JOIN user_messages m ON m.rowid = f.rowid WHERE user_messages_fts MATCH 'world'`).Scan(&id)
+	require.NoError(t, err)
+	require.Equal(t, "1", id)
+
+	// Triggers are back in place
+	insertMessage("3", "new world", false)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM user_messages_fts WHERE user_messages_fts MATCH 'world'`).Scan(&count))
+	require.Equal(t, 2, count)
+}
@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// messageSearchIndexTriggers keeps user_messages_fts in sync with user_messages.
+// Rows in the index share their rowid with user_messages.
+// The BEFORE INSERT trigger is needed because INSERT OR REPLACE does not fire
+// delete triggers unless recursive triggers are enabled, so the stale entry of
+// a replaced row has to be dropped before the new one gets a new rowid.
+var messageSearchIndexTriggers = map[string]string{
+	"user_messages_fts_before_insert": `
+CREATE TRIGGER user_messages_fts_before_insert BEFORE INSERT ON user_messages
+BEGIN
+	DELETE FROM user_messages_fts WHERE rowid = (SELECT rowid FROM user_messages WHERE id = new.id);
+END`,
+	"user_messages_fts_after_insert": `
+CREATE TRIGGER user_messages_fts_after_insert AFTER INSERT ON user_messages
+WHEN new.text != '' AND NOT(COALESCE(new.hide, 0)) AND NOT(COALESCE(new.deleted, 0)) AND NOT(COALESCE(new.deleted_for_me, 0))
+BEGIN
+	INSERT INTO user_messages_fts(rowid, text) VALUES (new.rowid, new.text);
+END`,
+	"user_messages_fts_after_update": `
+CREATE TRIGGER user_messages_fts_after_update AFTER UPDATE OF text, hide, deleted, deleted_for_me ON user_messages
+BEGIN
+	DELETE FROM user_messages_fts WHERE rowid = old.rowid;
+	INSERT INTO user_messages_fts(rowid, text)
+	SELECT new.rowid, new.text
+	WHERE new.text != '' AND NOT(COALESCE(new.hide, 0)) AND NOT(COALESCE(new.deleted, 0)) AND NOT(COALESCE(new.deleted_for_me, 0));
+END`,
+	"user_messages_fts_after_delete": `
+CREATE TRIGGER user_messages_fts_after_delete AFTER DELETE ON user_messages
+BEGIN
+	DELETE FROM user_messages_fts WHERE rowid = old.rowid;
+END`,
+}
+
+// MessageSearchIndexAvailable returns whether the sqlite build supports FTS5.
+// FTS5 is only compiled in when building with the `fts5` tag.
+func MessageSearchIndexAvailable(db *sql.DB) bool {
+	var enabled bool
+	err := db.QueryRow(`SELECT sqlite_compileoption_used('ENABLE_FTS5')`).Scan(&enabled)
+	return err == nil && enabled
+}
+
+// EnsureMessageSearchIndex creates the full-text search index over user_messages
+// and backfills it with the existing messages.
+// This can't be a regular migration, as FTS5 is not available in every build.
+// When FTS5 is missing, the sync triggers are dropped so that inserts keep working,
+// and the index is rebuilt the next time the database is opened by a build that
+// supports it.
+func EnsureMessageSearchIndex(db *sql.DB) (err error) {
+	available := MessageSearchIndexAvailable(db)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	if !available {
+		for name := range messageSearchIndexTriggers {
+			if _, err = tx.Exec(`DROP TRIGGER IF EXISTS ` + name); err != nil {
+				return errors.Wrap(err, "failed to drop message search index trigger")
+			}
+		}
+		return nil
+	}
+
+	var triggersCount int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger' AND name LIKE 'user_messages_fts_%'`).Scan(&triggersCount)
+	if err != nil {
+		return err
+	}
+
+	if triggersCount == len(messageSearchIndexTriggers) {
+		return nil
+	}
+
+	_, err = tx.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS user_messages_fts USING fts5(text, tokenize = 'unicode61 remove_diacritics 2')`)
+	if err != nil {
+		return errors.Wrap(err, "failed to create message search index")
+	}
+
+	// The index might be stale if it was created by a previous run, rebuild it from scratch
+	if _, err = tx.Exec(`DELETE FROM user_messages_fts`); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO user_messages_fts(rowid, text)
+		SELECT rowid, text FROM user_messages
+		WHERE text != '' AND NOT(COALESCE(hide, 0)) AND NOT(COALESCE(deleted, 0)) AND NOT(COALESCE(deleted_for_me, 0))`)
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill message search index")
+	}
+
+	for name, trigger := range messageSearchIndexTriggers {
+		if _, err = tx.Exec(`DROP TRIGGER IF EXISTS ` + name); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(trigger); err != nil {
+			return errors.Wrap(err, "failed to create message search index trigger")
+		}
+	}
+
+	return nil
+}
@@ -208,6 +208,26 @@ func (m *StatusMessage) HandleApplication() error {
 	case protobuf.ApplicationMetadataMessage_PIN_MESSAGE:
 		return m.unmarshalProtobufData(new(protobuf.PinMessage))
 
+	case protobuf.ApplicationMetadataMessage_DISAPPEARING_MESSAGES:
+		return m.unmarshalProtobufData(new(protobuf.DisappearingMessages))
+
+	case protobuf.ApplicationMetadataMessage_POLL_VOTE:
+		return m.unmarshalProtobufData(new(protobuf.PollVote))
+	case protobuf.ApplicationMetadataMessage_SYNC_MESSAGE_THREAD:
+		return m.unmarshalProtobufData(new(protobuf.SyncMessageThread))
+	case protobuf.ApplicationMetadataMessage_TYPING_INDICATOR:
+		return m.unmarshalProtobufData(new(protobuf.TypingIndicator))
+	case protobuf.ApplicationMetadataMessage_READ_RECEIPT:
+		return m.unmarshalProtobufData(new(protobuf.ReadReceipt))
+	case protobuf.ApplicationMetadataMessage_SYNC_SCHEDULED_MESSAGE:
+		return m.unmarshalProtobufData(new(protobuf.SyncScheduledMessage))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT:
+		return m.unmarshalProtobufData(new(protobuf.CommunityCalendarEvent))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP:
+		return m.unmarshalProtobufData(new(protobuf.CommunityCalendarEventRsvp))
+	case protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_CALENDAR_EVENT:
+		return m.unmarshalProtobufData(new(protobuf.SyncCommunityCalendarEvent))
+
 	case protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION:
 		return m.unmarshalProtobufData(new(protobuf.SyncInstallation))
 
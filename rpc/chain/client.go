@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,7 +17,6 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/services/rpcstats"
 )
@@ -25,19 +25,26 @@ type FeeHistory struct {
 	BaseFeePerGas []string `json:"baseFeePerGas"`
 }
 
+// ClientWithFallback spreads the calls for a chain over several RPC providers.
+// Calls go to the provider with the best latency and error rate, and move on to the
+// next one when it fails. Providers are skipped while rate limited or while their
+// circuit is open, and slow reads are hedged by sending them to the next provider too.
 type ClientWithFallback struct {
-	ChainID  uint64
-	main     *ethclient.Client
-	fallback *ethclient.Client
-
-	mainRPC     *rpc.Client
-	fallbackRPC *rpc.Client
+	ChainID   uint64
+	providers []*provider
 
 	WalletNotifier func(chainId uint64, message string)
+	// ProviderNotifier is called when the provider serving the calls changes,
+	// or when a provider becomes available or unavailable
+	ProviderNotifier func(chainID uint64, status ProvidersStatus)
 
 	IsConnected     bool
 	IsConnectedLock sync.RWMutex
 	LastCheckedAt   int64
+
+	providersLock      sync.Mutex
+	lastServedBy       string
+	lastAvailabilities []bool
 }
 
 // Don't mark connection as failed if we get one of these errors
@@ -67,52 +74,54 @@ type CommandResult struct {
 	vmError error
 }
 
+type providerResult struct {
+	provider *provider
+	result   CommandResult
+	err      error
+}
+
 func NewSimpleClient(main *rpc.Client, chainID uint64) *ClientWithFallback {
-	hystrix.ConfigureCommand(fmt.Sprintf("ethClient_%d", chainID), hystrix.CommandConfig{
-		Timeout:               10000,
-		MaxConcurrentRequests: 100,
-		SleepWindow:           300000,
-		ErrorPercentThreshold: 25,
-	})
+	return newClient([]RPCProvider{{Name: "main", Client: main}}, chainID, 10000)
+}
 
-	return &ClientWithFallback{
-		ChainID:       chainID,
-		main:          ethclient.NewClient(main),
-		fallback:      nil,
-		mainRPC:       main,
-		fallbackRPC:   nil,
-		IsConnected:   true,
-		LastCheckedAt: time.Now().Unix(),
+func NewClient(main, fallback *rpc.Client, chainID uint64) *ClientWithFallback {
+	providers := []RPCProvider{{Name: "main", Client: main}}
+	if fallback != nil {
+		providers = append(providers, RPCProvider{Name: "fallback", Client: fallback})
 	}
+	return newClient(providers, chainID, 20000)
 }
 
-func NewClient(main, fallback *rpc.Client, chainID uint64) *ClientWithFallback {
-	hystrix.ConfigureCommand(fmt.Sprintf("ethClient_%d", chainID), hystrix.CommandConfig{
-		Timeout:               20000,
-		MaxConcurrentRequests: 100,
-		SleepWindow:           300000,
-		ErrorPercentThreshold: 25,
-	})
+// NewClientWithProviders creates a client balancing the calls over the given providers,
+// the first ones are preferred until the providers health is known
+func NewClientWithProviders(providers []RPCProvider, chainID uint64) *ClientWithFallback {
+	return newClient(providers, chainID, 20000)
+}
 
-	var fallbackEthClient *ethclient.Client
-	if fallback != nil {
-		fallbackEthClient = ethclient.NewClient(fallback)
+func newClient(rpcProviders []RPCProvider, chainID uint64, timeout int) *ClientWithFallback {
+	providers := make([]*provider, 0, len(rpcProviders))
+	for i, rpcProvider := range rpcProviders {
+		command := fmt.Sprintf("ethClient_%d_%d", chainID, i)
+		hystrix.ConfigureCommand(command, hystrix.CommandConfig{
+			Timeout:               timeout,
+			MaxConcurrentRequests: 100,
+			SleepWindow:           300000,
+			ErrorPercentThreshold: 25,
+		})
+		providers = append(providers, newProvider(rpcProvider.Name, command, rpcProvider.Client))
 	}
+
 	return &ClientWithFallback{
 		ChainID:       chainID,
-		main:          ethclient.NewClient(main),
-		fallback:      fallbackEthClient,
-		mainRPC:       main,
-		fallbackRPC:   fallback,
+		providers:     providers,
 		IsConnected:   true,
 		LastCheckedAt: time.Now().Unix(),
 	}
 }
 
 func (c *ClientWithFallback) Close() {
-	c.main.Close()
-	if c.fallback != nil {
-		c.fallback.Close()
+	for _, p := range c.providers {
+		p.eth.Close()
 	}
 }
 
@@ -150,55 +159,44 @@ func (c *ClientWithFallback) SetIsConnected(value bool) {
 	}
 }
 
-func (c *ClientWithFallback) makeCallNoReturn(main func() error, fallback func() error) error {
-	resultChan := make(chan CommandResult, 1)
-	c.LastCheckedAt = time.Now().Unix()
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		err := main()
-		if err != nil {
-			if isVMError(err) {
-				resultChan <- CommandResult{vmError: err}
-				return nil
-			}
-			return err
-		}
-		c.SetIsConnected(true)
-		resultChan <- CommandResult{}
-		return nil
-	}, func(err error) error {
-		if c.fallback == nil {
-			c.SetIsConnected(false)
-			return err
-		}
+// ProvidersStatus returns the health of the providers, best first
+func (c *ClientWithFallback) ProvidersStatus() ProvidersStatus {
+	c.providersLock.Lock()
+	servedBy := c.lastServedBy
+	c.providersLock.Unlock()
 
-		err = fallback()
-		if err != nil {
-			if isVMError(err) {
-				resultChan <- CommandResult{vmError: err}
-				return nil
-			}
-			c.SetIsConnected(false)
-			return err
-		}
-		resultChan <- CommandResult{}
-		return nil
-	})
+	now := time.Now()
+	status := ProvidersStatus{ServedBy: servedBy}
+	for _, p := range c.sortedProviders(now) {
+		status.Providers = append(status.Providers, p.status(now))
+	}
+	return status
+}
 
-	select {
-	case result := <-resultChan:
-		if result.vmError != nil {
-			return result.vmError
+// sortedProviders returns the available providers by score, followed by the
+// unavailable ones, which are only tried when no other provider is left
+func (c *ClientWithFallback) sortedProviders(now time.Time) []*provider {
+	var available, unavailable []*provider
+	for _, p := range c.providers {
+		if p.available(now) {
+			available = append(available, p)
+		} else {
+			unavailable = append(unavailable, p)
 		}
-		return nil
-	case err := <-errChan:
-		return err
 	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].health.score() < available[j].health.score()
+	})
+
+	return append(available, unavailable...)
 }
 
-func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fallback func() (any, error), toggleIsConnected bool) (any, error) {
+func (c *ClientWithFallback) callProvider(p *provider, call func(p *provider) (any, any, error)) (CommandResult, error) {
 	resultChan := make(chan CommandResult, 1)
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		res, err := main()
+	start := time.Now()
+	errChan := hystrix.Go(p.command, func() error {
+		a, b, err := call(p)
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -206,100 +204,155 @@ func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fall
 			}
 			return err
 		}
-		if toggleIsConnected {
-			c.SetIsConnected(true)
-		}
-		resultChan <- CommandResult{res1: res}
-		return nil
-	}, func(err error) error {
-		if c.fallback == nil {
-			if toggleIsConnected {
-				c.SetIsConnected(false)
-			}
-			return err
-		}
-
-		res, err := fallback()
-		if err != nil {
-			if isVMError(err) {
-				resultChan <- CommandResult{vmError: err}
-				return nil
-			}
-			if toggleIsConnected {
-				c.SetIsConnected(false)
-			}
-			return err
-		}
-		if toggleIsConnected {
-			c.SetIsConnected(true)
-		}
-		resultChan <- CommandResult{res1: res}
+		resultChan <- CommandResult{res1: a, res2: b}
 		return nil
-	})
+	}, nil)
 
 	select {
 	case result := <-resultChan:
-		if result.vmError != nil {
-			return nil, result.vmError
-		}
-		return result.res1, nil
+		p.health.recordSuccess(time.Since(start))
+		return result, nil
 	case err := <-errChan:
-		return nil, err
+		if !isContextError(err) {
+			p.health.recordFailure(err, time.Now())
+		}
+		return CommandResult{}, err
 	}
 }
 
-func (c *ClientWithFallback) makeCallDoubleReturn(main func() (any, any, error), fallback func() (any, any, error)) (any, any, error) {
-	resultChan := make(chan CommandResult, 1)
+// makeCall tries the providers one after the other until one of them succeeds.
+// When hedge is set, the next provider is also tried if the current one is slow,
+// and the first response wins.
+func (c *ClientWithFallback) makeCall(call func(p *provider) (any, any, error), hedge bool, toggleIsConnected bool) (CommandResult, error) {
 	c.LastCheckedAt = time.Now().Unix()
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		a, b, err := main()
-		if err != nil {
-			if isVMError(err) {
-				resultChan <- CommandResult{vmError: err}
-				return nil
-			}
-			return err
-		}
-		c.SetIsConnected(true)
-		resultChan <- CommandResult{res1: a, res2: b}
-		return nil
-	}, func(err error) error {
-		if c.fallback == nil {
-			c.SetIsConnected(false)
-			return err
+	providers := c.sortedProviders(time.Now())
+	results := make(chan providerResult, len(providers))
+
+	next := 0
+	inFlight := 0
+	launch := func() {
+		p := providers[next]
+		next++
+		inFlight++
+		go func() {
+			result, err := c.callProvider(p, call)
+			results <- providerResult{provider: p, result: result, err: err}
+		}()
+	}
+
+	launch()
+	var lastErr error
+	for inFlight > 0 {
+		var hedgeTimer <-chan time.Time
+		if hedge && next < len(providers) {
+			hedgeTimer = time.After(providers[next-1].health.hedgeDelay())
 		}
 
-		a, b, err := fallback()
-		if err != nil {
-			if isVMError(err) {
-				resultChan <- CommandResult{vmError: err}
-				return nil
+		select {
+		case r := <-results:
+			inFlight--
+			if r.err == nil {
+				if toggleIsConnected {
+					c.SetIsConnected(true)
+				}
+				c.notifyProviders(r.provider)
+				return r.result, nil
 			}
-			c.SetIsConnected(false)
-			return err
+			lastErr = r.err
+			if next < len(providers) {
+				launch()
+			}
+		case <-hedgeTimer:
+			launch()
 		}
-		c.SetIsConnected(true)
-		resultChan <- CommandResult{res1: a, res2: b}
-		return nil
-	})
+	}
 
-	select {
-	case result := <-resultChan:
-		if result.vmError != nil {
-			return nil, nil, result.vmError
-		}
-		return result.res1, result.res2, nil
-	case err := <-errChan:
+	if toggleIsConnected {
+		c.SetIsConnected(false)
+	}
+	c.notifyProviders(nil)
+	return CommandResult{}, lastErr
+}
+
+func (c *ClientWithFallback) notifyProviders(servedBy *provider) {
+	now := time.Now()
+	availabilities := make([]bool, len(c.providers))
+	for i, p := range c.providers {
+		availabilities[i] = p.available(now)
+	}
+
+	c.providersLock.Lock()
+	changed := len(c.lastAvailabilities) != len(availabilities)
+	for i := 0; !changed && i < len(availabilities); i++ {
+		changed = c.lastAvailabilities[i] != availabilities[i]
+	}
+	c.lastAvailabilities = availabilities
+	if servedBy != nil && servedBy.name != c.lastServedBy {
+		c.lastServedBy = servedBy.name
+		changed = true
+	}
+	c.providersLock.Unlock()
+
+	if changed && c.ProviderNotifier != nil {
+		c.ProviderNotifier(c.ChainID, c.ProvidersStatus())
+	}
+}
+
+func (c *ClientWithFallback) makeCallNoReturn(call func(p *provider) error) error {
+	result, err := c.makeCall(func(p *provider) (any, any, error) {
+		return nil, nil, call(p)
+	}, false, true)
+	if err != nil {
+		return err
+	}
+	return result.vmError
+}
+
+func (c *ClientWithFallback) makeCallSingleReturn(call func(p *provider) (any, error), toggleIsConnected bool) (any, error) {
+	result, err := c.makeCall(func(p *provider) (any, any, error) {
+		res, err := call(p)
+		return res, nil, err
+	}, true, toggleIsConnected)
+	if err != nil {
+		return nil, err
+	}
+	if result.vmError != nil {
+		return nil, result.vmError
+	}
+	return result.res1, nil
+}
+
+func (c *ClientWithFallback) makeCallDoubleReturn(call func(p *provider) (any, any, error)) (any, any, error) {
+	result, err := c.makeCall(call, true, true)
+	if err != nil {
 		return nil, nil, err
 	}
+	if result.vmError != nil {
+		return nil, nil, result.vmError
+	}
+	return result.res1, result.res2, nil
+}
+
+// makeSubscription subscribes with a single provider, subscriptions are never hedged
+func (c *ClientWithFallback) makeSubscription(call func(p *provider) (any, error)) (any, error) {
+	result, err := c.makeCall(func(p *provider) (any, any, error) {
+		res, err := call(p)
+		return res, nil, err
+	}, false, true)
+	if err != nil {
+		return nil, err
+	}
+	if result.vmError != nil {
+		return nil, result.vmError
+	}
+	return result.res1, nil
 }
 
 func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
 	rpcstats.CountCall("eth_BlockByHash")
 
 	block, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockByHash(ctx, hash) },
-		func() (any, error) { return c.fallback.BlockByHash(ctx, hash) },
+		func(p *provider) (any, error) { return p.eth.BlockByHash(ctx, hash) },
 		true,
 	)
 
@@ -313,8 +366,7 @@ func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash)
 func (c *ClientWithFallback) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
 	rpcstats.CountCall("eth_BlockByNumber")
 	block, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockByNumber(ctx, number) },
-		func() (any, error) { return c.fallback.BlockByNumber(ctx, number) },
+		func(p *provider) (any, error) { return p.eth.BlockByNumber(ctx, number) },
 		true,
 	)
 
@@ -329,8 +381,7 @@ func (c *ClientWithFallback) BlockNumber(ctx context.Context) (uint64, error) {
 	rpcstats.CountCall("eth_BlockNumber")
 
 	number, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockNumber(ctx) },
-		func() (any, error) { return c.fallback.BlockNumber(ctx) },
+		func(p *provider) (any, error) { return p.eth.BlockNumber(ctx) },
 		true,
 	)
 
@@ -345,8 +396,7 @@ func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
 	rpcstats.CountCall("eth_PeerCount")
 
 	peerCount, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PeerCount(ctx) },
-		func() (any, error) { return c.fallback.PeerCount(ctx) },
+		func(p *provider) (any, error) { return p.eth.PeerCount(ctx) },
 		true,
 	)
 
@@ -360,8 +410,7 @@ func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
 func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
 	rpcstats.CountCall("eth_HeaderByHash")
 	header, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.HeaderByHash(ctx, hash) },
-		func() (any, error) { return c.fallback.HeaderByHash(ctx, hash) },
+		func(p *provider) (any, error) { return p.eth.HeaderByHash(ctx, hash) },
 		false,
 	)
 
@@ -375,8 +424,7 @@ func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash)
 func (c *ClientWithFallback) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	rpcstats.CountCall("eth_HeaderByNumber")
 	header, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.HeaderByNumber(ctx, number) },
-		func() (any, error) { return c.fallback.HeaderByNumber(ctx, number) },
+		func(p *provider) (any, error) { return p.eth.HeaderByNumber(ctx, number) },
 		false,
 	)
 
@@ -391,8 +439,7 @@ func (c *ClientWithFallback) TransactionByHash(ctx context.Context, hash common.
 	rpcstats.CountCall("eth_TransactionByHash")
 
 	tx, isPending, err := c.makeCallDoubleReturn(
-		func() (any, any, error) { return c.main.TransactionByHash(ctx, hash) },
-		func() (any, any, error) { return c.fallback.TransactionByHash(ctx, hash) },
+		func(p *provider) (any, any, error) { return p.eth.TransactionByHash(ctx, hash) },
 	)
 
 	if err != nil {
@@ -406,8 +453,7 @@ func (c *ClientWithFallback) TransactionSender(ctx context.Context, tx *types.Tr
 	rpcstats.CountCall("eth_TransactionSender")
 
 	address, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionSender(ctx, tx, block, index) },
-		func() (any, error) { return c.fallback.TransactionSender(ctx, tx, block, index) },
+		func(p *provider) (any, error) { return p.eth.TransactionSender(ctx, tx, block, index) },
 		true,
 	)
 
@@ -418,8 +464,7 @@ func (c *ClientWithFallback) TransactionCount(ctx context.Context, blockHash com
 	rpcstats.CountCall("eth_TransactionCount")
 
 	count, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionCount(ctx, blockHash) },
-		func() (any, error) { return c.fallback.TransactionCount(ctx, blockHash) },
+		func(p *provider) (any, error) { return p.eth.TransactionCount(ctx, blockHash) },
 		true,
 	)
 
@@ -434,8 +479,7 @@ func (c *ClientWithFallback) TransactionInBlock(ctx context.Context, blockHash c
 	rpcstats.CountCall("eth_TransactionInBlock")
 
 	transactions, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionInBlock(ctx, blockHash, index) },
-		func() (any, error) { return c.fallback.TransactionInBlock(ctx, blockHash, index) },
+		func(p *provider) (any, error) { return p.eth.TransactionInBlock(ctx, blockHash, index) },
 		true,
 	)
 
@@ -450,8 +494,7 @@ func (c *ClientWithFallback) TransactionReceipt(ctx context.Context, txHash comm
 	rpcstats.CountCall("eth_TransactionReceipt")
 
 	receipt, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionReceipt(ctx, txHash) },
-		func() (any, error) { return c.fallback.TransactionReceipt(ctx, txHash) },
+		func(p *provider) (any, error) { return p.eth.TransactionReceipt(ctx, txHash) },
 		true,
 	)
 
@@ -466,8 +509,7 @@ func (c *ClientWithFallback) SyncProgress(ctx context.Context) (*ethereum.SyncPr
 	rpcstats.CountCall("eth_SyncProgress")
 
 	progress, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SyncProgress(ctx) },
-		func() (any, error) { return c.fallback.SyncProgress(ctx) },
+		func(p *provider) (any, error) { return p.eth.SyncProgress(ctx) },
 		true,
 	)
 
@@ -481,10 +523,8 @@ func (c *ClientWithFallback) SyncProgress(ctx context.Context) (*ethereum.SyncPr
 func (c *ClientWithFallback) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
 	rpcstats.CountCall("eth_SubscribeNewHead")
 
-	sub, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SubscribeNewHead(ctx, ch) },
-		func() (any, error) { return c.fallback.SubscribeNewHead(ctx, ch) },
-		true,
+	sub, err := c.makeSubscription(
+		func(p *provider) (any, error) { return p.eth.SubscribeNewHead(ctx, ch) },
 	)
 
 	if err != nil {
@@ -498,8 +538,7 @@ func (c *ClientWithFallback) NetworkID(ctx context.Context) (*big.Int, error) {
 	rpcstats.CountCall("eth_NetworkID")
 
 	networkID, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.NetworkID(ctx) },
-		func() (any, error) { return c.fallback.NetworkID(ctx) },
+		func(p *provider) (any, error) { return p.eth.NetworkID(ctx) },
 		true,
 	)
 
@@ -514,8 +553,7 @@ func (c *ClientWithFallback) BalanceAt(ctx context.Context, account common.Addre
 	rpcstats.CountCall("eth_BalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BalanceAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.BalanceAt(ctx, account, blockNumber) },
+		func(p *provider) (any, error) { return p.eth.BalanceAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -530,8 +568,7 @@ func (c *ClientWithFallback) StorageAt(ctx context.Context, account common.Addre
 	rpcstats.CountCall("eth_StorageAt")
 
 	storage, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.StorageAt(ctx, account, key, blockNumber) },
-		func() (any, error) { return c.fallback.StorageAt(ctx, account, key, blockNumber) },
+		func(p *provider) (any, error) { return p.eth.StorageAt(ctx, account, key, blockNumber) },
 		true,
 	)
 
@@ -546,8 +583,7 @@ func (c *ClientWithFallback) CodeAt(ctx context.Context, account common.Address,
 	rpcstats.CountCall("eth_CodeAt")
 
 	code, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CodeAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.CodeAt(ctx, account, blockNumber) },
+		func(p *provider) (any, error) { return p.eth.CodeAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -562,8 +598,7 @@ func (c *ClientWithFallback) NonceAt(ctx context.Context, account common.Address
 	rpcstats.CountCall("eth_NonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.NonceAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.NonceAt(ctx, account, blockNumber) },
+		func(p *provider) (any, error) { return p.eth.NonceAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -578,8 +613,7 @@ func (c *ClientWithFallback) FilterLogs(ctx context.Context, q ethereum.FilterQu
 	rpcstats.CountCall("eth_FilterLogs")
 
 	logs, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.FilterLogs(ctx, q) },
-		func() (any, error) { return c.fallback.FilterLogs(ctx, q) },
+		func(p *provider) (any, error) { return p.eth.FilterLogs(ctx, q) },
 		true,
 	)
 
@@ -593,10 +627,8 @@ func (c *ClientWithFallback) FilterLogs(ctx context.Context, q ethereum.FilterQu
 func (c *ClientWithFallback) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
 	rpcstats.CountCall("eth_SubscribeFilterLogs")
 
-	sub, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SubscribeFilterLogs(ctx, q, ch) },
-		func() (any, error) { return c.fallback.SubscribeFilterLogs(ctx, q, ch) },
-		true,
+	sub, err := c.makeSubscription(
+		func(p *provider) (any, error) { return p.eth.SubscribeFilterLogs(ctx, q, ch) },
 	)
 
 	if err != nil {
@@ -610,8 +642,7 @@ func (c *ClientWithFallback) PendingBalanceAt(ctx context.Context, account commo
 	rpcstats.CountCall("eth_PendingBalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingBalanceAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingBalanceAt(ctx, account) },
+		func(p *provider) (any, error) { return p.eth.PendingBalanceAt(ctx, account) },
 		true,
 	)
 
@@ -626,8 +657,7 @@ func (c *ClientWithFallback) PendingStorageAt(ctx context.Context, account commo
 	rpcstats.CountCall("eth_PendingStorageAt")
 
 	storage, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingStorageAt(ctx, account, key) },
-		func() (any, error) { return c.fallback.PendingStorageAt(ctx, account, key) },
+		func(p *provider) (any, error) { return p.eth.PendingStorageAt(ctx, account, key) },
 		true,
 	)
 
@@ -642,8 +672,7 @@ func (c *ClientWithFallback) PendingCodeAt(ctx context.Context, account common.A
 	rpcstats.CountCall("eth_PendingCodeAt")
 
 	code, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingCodeAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingCodeAt(ctx, account) },
+		func(p *provider) (any, error) { return p.eth.PendingCodeAt(ctx, account) },
 		true,
 	)
 
@@ -658,8 +687,7 @@ func (c *ClientWithFallback) PendingNonceAt(ctx context.Context, account common.
 	rpcstats.CountCall("eth_PendingNonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingNonceAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingNonceAt(ctx, account) },
+		func(p *provider) (any, error) { return p.eth.PendingNonceAt(ctx, account) },
 		true,
 	)
 
@@ -674,8 +702,7 @@ func (c *ClientWithFallback) PendingTransactionCount(ctx context.Context) (uint,
 	rpcstats.CountCall("eth_PendingTransactionCount")
 
 	count, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingTransactionCount(ctx) },
-		func() (any, error) { return c.fallback.PendingTransactionCount(ctx) },
+		func(p *provider) (any, error) { return p.eth.PendingTransactionCount(ctx) },
 		true,
 	)
 
@@ -690,8 +717,7 @@ func (c *ClientWithFallback) CallContract(ctx context.Context, msg ethereum.Call
 	rpcstats.CountCall("eth_CallContract")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CallContract(ctx, msg, blockNumber) },
-		func() (any, error) { return c.fallback.CallContract(ctx, msg, blockNumber) },
+		func(p *provider) (any, error) { return p.eth.CallContract(ctx, msg, blockNumber) },
 		true,
 	)
 
@@ -706,8 +732,7 @@ func (c *ClientWithFallback) CallContractAtHash(ctx context.Context, msg ethereu
 	rpcstats.CountCall("eth_CallContractAtHash")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CallContractAtHash(ctx, msg, blockHash) },
-		func() (any, error) { return c.fallback.CallContractAtHash(ctx, msg, blockHash) },
+		func(p *provider) (any, error) { return p.eth.CallContractAtHash(ctx, msg, blockHash) },
 		true,
 	)
 
@@ -722,8 +747,7 @@ func (c *ClientWithFallback) PendingCallContract(ctx context.Context, msg ethere
 	rpcstats.CountCall("eth_PendingCallContract")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingCallContract(ctx, msg) },
-		func() (any, error) { return c.fallback.PendingCallContract(ctx, msg) },
+		func(p *provider) (any, error) { return p.eth.PendingCallContract(ctx, msg) },
 		true,
 	)
 
@@ -738,8 +762,7 @@ func (c *ClientWithFallback) SuggestGasPrice(ctx context.Context) (*big.Int, err
 	rpcstats.CountCall("eth_SuggestGasPrice")
 
 	gasPrice, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SuggestGasPrice(ctx) },
-		func() (any, error) { return c.fallback.SuggestGasPrice(ctx) },
+		func(p *provider) (any, error) { return p.eth.SuggestGasPrice(ctx) },
 		true,
 	)
 
@@ -754,8 +777,7 @@ func (c *ClientWithFallback) SuggestGasTipCap(ctx context.Context) (*big.Int, er
 	rpcstats.CountCall("eth_SuggestGasTipCap")
 
 	tip, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SuggestGasTipCap(ctx) },
-		func() (any, error) { return c.fallback.SuggestGasTipCap(ctx) },
+		func(p *provider) (any, error) { return p.eth.SuggestGasTipCap(ctx) },
 		true,
 	)
 
@@ -770,8 +792,7 @@ func (c *ClientWithFallback) FeeHistory(ctx context.Context, blockCount uint64,
 	rpcstats.CountCall("eth_FeeHistory")
 
 	feeHistory, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
-		func() (any, error) { return c.fallback.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
+		func(p *provider) (any, error) { return p.eth.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
 		true,
 	)
 
@@ -786,8 +807,7 @@ func (c *ClientWithFallback) EstimateGas(ctx context.Context, msg ethereum.CallM
 	rpcstats.CountCall("eth_EstimateGas")
 
 	estimate, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.EstimateGas(ctx, msg) },
-		func() (any, error) { return c.fallback.EstimateGas(ctx, msg) },
+		func(p *provider) (any, error) { return p.eth.EstimateGas(ctx, msg) },
 		true,
 	)
 
@@ -802,8 +822,7 @@ func (c *ClientWithFallback) SendTransaction(ctx context.Context, tx *types.Tran
 	rpcstats.CountCall("eth_SendTransaction")
 
 	return c.makeCallNoReturn(
-		func() error { return c.main.SendTransaction(ctx, tx) },
-		func() error { return c.fallback.SendTransaction(ctx, tx) },
+		func(p *provider) error { return p.eth.SendTransaction(ctx, tx) },
 	)
 }
 
@@ -811,8 +830,7 @@ func (c *ClientWithFallback) CallContext(ctx context.Context, result interface{}
 	rpcstats.CountCall("eth_CallContext")
 
 	return c.makeCallNoReturn(
-		func() error { return c.mainRPC.CallContext(ctx, result, method, args...) },
-		func() error { return c.fallbackRPC.CallContext(ctx, result, method, args...) },
+		func(p *provider) error { return p.rpc.CallContext(ctx, result, method, args...) },
 	)
 }
 
@@ -823,7 +841,7 @@ func (c *ClientWithFallback) ToBigInt() *big.Int {
 func (c *ClientWithFallback) GetBaseFeeFromBlock(blockNumber *big.Int) (string, error) {
 	rpcstats.CountCall("eth_GetBaseFeeFromBlock")
 	var feeHistory FeeHistory
-	err := c.mainRPC.Call(&feeHistory, "eth_feeHistory", "0x1", (*hexutil.Big)(blockNumber), nil)
+	err := c.providers[0].rpc.Call(&feeHistory, "eth_feeHistory", "0x1", (*hexutil.Big)(blockNumber), nil)
 	if err != nil {
 		if err.Error() == "the method eth_feeHistory does not exist/is not available" {
 			return "", nil
@@ -846,11 +864,8 @@ func (c *ClientWithFallback) FullTransactionByBlockNumberAndIndex(ctx context.Co
 	rpcstats.CountCall("eth_FullTransactionByBlockNumberAndIndex")
 
 	tx, err := c.makeCallSingleReturn(
-		func() (any, error) {
-			return callFullTransactionByBlockNumberAndIndex(ctx, c.mainRPC, blockNumber, index)
-		},
-		func() (any, error) {
-			return callFullTransactionByBlockNumberAndIndex(ctx, c.fallbackRPC, blockNumber, index)
+		func(p *provider) (any, error) {
+			return callFullTransactionByBlockNumberAndIndex(ctx, p.rpc, blockNumber, index)
 		},
 		true,
 	)
@@ -0,0 +1,171 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type testProvider struct {
+	server *httptest.Server
+	delay  time.Duration
+	status int
+	calls  int
+	mu     sync.Mutex
+}
+
+func newTestProvider(t *testing.T, blockNumber string) *testProvider {
+	p := &testProvider{status: http.StatusOK}
+	p.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.calls++
+		status := p.status
+		delay := p.delay
+		p.mu.Unlock()
+
+		time.Sleep(delay)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprintf(w, `{"id": 1, "jsonrpc": "2.0", "result": "%s"}`, blockNumber)
+	}))
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *testProvider) set(status int, delay time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status = status
+	p.delay = delay
+}
+
+func (p *testProvider) callCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func newTestClient(t *testing.T, chainID uint64, providers ...*testProvider) *ClientWithFallback {
+	var rpcProviders []RPCProvider
+	for i, p := range providers {
+		client, err := rpc.Dial(p.server.URL)
+		require.NoError(t, err)
+		rpcProviders = append(rpcProviders, RPCProvider{Name: fmt.Sprintf("provider%d", i), Client: client})
+	}
+	return NewClientWithProviders(rpcProviders, chainID)
+}
+
+func TestProviderFailover(t *testing.T) {
+	first := newTestProvider(t, "0x1")
+	second := newTestProvider(t, "0x2")
+	c := newTestClient(t, 1001, first, second)
+
+	var notified []ProvidersStatus
+	c.ProviderNotifier = func(chainID uint64, status ProvidersStatus) {
+		require.Equal(t, uint64(1001), chainID)
+		notified = append(notified, status)
+	}
+
+	number, err := c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Len(t, notified, 1)
+	require.Equal(t, "provider0", notified[0].ServedBy)
+
+	first.set(http.StatusInternalServerError, 0)
+
+	number, err = c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Len(t, notified, 2)
+	require.Equal(t, "provider1", notified[1].ServedBy)
+
+	// The failing provider scores worse and is not tried first anymore
+	calls := first.callCount()
+	number, err = c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, calls, first.callCount())
+
+	status := c.ProvidersStatus()
+	require.Equal(t, "provider1", status.ServedBy)
+	require.Equal(t, "provider1", status.Providers[0].Name)
+	require.Equal(t, uint64(1), status.Providers[1].Failures)
+	require.True(t, c.IsConnected)
+}
+
+func TestProviderRateLimited(t *testing.T) {
+	first := newTestProvider(t, "0x1")
+	second := newTestProvider(t, "0x2")
+	c := newTestClient(t, 1002, first, second)
+
+	first.set(http.StatusTooManyRequests, 0)
+
+	number, err := c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+
+	status := c.ProvidersStatus()
+	require.Equal(t, "provider0", status.Providers[1].Name)
+	require.True(t, status.Providers[1].RateLimited)
+	require.False(t, status.Providers[1].Available)
+
+	// Rate limited providers are only tried when no other provider is left
+	second.set(http.StatusInternalServerError, 0)
+	_, err = c.BlockNumber(context.Background())
+	require.Error(t, err)
+	require.False(t, c.IsConnected)
+	require.Equal(t, 2, first.callCount())
+}
+
+func TestProviderHedging(t *testing.T) {
+	slow := newTestProvider(t, "0x1")
+	fast := newTestProvider(t, "0x2")
+	c := newTestClient(t, 1003, slow, fast)
+
+	slow.set(http.StatusOK, 3*time.Second)
+
+	start := time.Now()
+	number, err := c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.Equal(t, 1, slow.callCount())
+	require.Equal(t, 1, fast.callCount())
+}
+
+func TestProviderHealth(t *testing.T) {
+	health := providerHealth{}
+	require.Equal(t, float64(defaultHedgeDelay), health.score())
+	require.Equal(t, defaultHedgeDelay, health.hedgeDelay())
+
+	health.recordSuccess(100 * time.Millisecond)
+	require.Equal(t, float64(100*time.Millisecond), health.score())
+	require.Equal(t, minHedgeDelay, health.hedgeDelay())
+
+	now := time.Now()
+	health.recordFailure(rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, now)
+	require.True(t, health.rateLimited(now))
+	require.False(t, health.rateLimited(now.Add(minRateLimitBackoff)))
+	// A single failure makes the provider score worse than an unknown one
+	require.Greater(t, health.score(), float64(defaultHedgeDelay))
+
+	// Backoff doubles while the provider keeps rate limiting
+	health.recordFailure(fmt.Errorf("429 Too Many Requests"), now)
+	require.True(t, health.rateLimited(now.Add(minRateLimitBackoff)))
+	require.False(t, health.rateLimited(now.Add(2*minRateLimitBackoff)))
+
+	health.recordSuccess(100 * time.Millisecond)
+	health.recordFailure(rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, now)
+	require.False(t, health.rateLimited(now.Add(minRateLimitBackoff)))
+}
@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/afex/hystrix-go/hystrix"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// Weight of the newest sample in the latency and error rate moving averages
+	latencyDecay   = 0.2
+	errorRateDecay = 0.1
+	// A provider failing every call scores as if it was this much slower
+	errorRatePenalty = 20 * time.Second
+
+	minRateLimitBackoff = time.Second
+	maxRateLimitBackoff = time.Minute
+
+	// Reads are sent to the next provider when the current one is slower than
+	// twice its average latency, within these bounds
+	minHedgeDelay     = 200 * time.Millisecond
+	defaultHedgeDelay = time.Second
+	maxHedgeDelay     = 2 * time.Second
+)
+
+// RPCProvider is an RPC endpoint serving a chain
+type RPCProvider struct {
+	// Name identifies the provider in health reports, it must not contain secrets
+	Name   string
+	Client *rpc.Client
+}
+
+// ProviderStatus is the health of an RPC provider
+type ProviderStatus struct {
+	Name        string  `json:"name"`
+	Available   bool    `json:"available"`
+	CircuitOpen bool    `json:"circuitOpen"`
+	RateLimited bool    `json:"rateLimited"`
+	Latency     int64   `json:"latency"`
+	ErrorRate   float64 `json:"errorRate"`
+	Calls       uint64  `json:"calls"`
+	Failures    uint64  `json:"failures"`
+}
+
+// ProvidersStatus is the health of the RPC providers of a chain, along with
+// the provider which served the latest call
+type ProvidersStatus struct {
+	ServedBy  string           `json:"servedBy"`
+	Providers []ProviderStatus `json:"providers"`
+}
+
+type provider struct {
+	name    string
+	command string
+	eth     *ethclient.Client
+	rpc     *rpc.Client
+	health  providerHealth
+}
+
+type providerHealth struct {
+	sync.Mutex
+	latency          time.Duration
+	errorRate        float64
+	calls            uint64
+	failures         uint64
+	rateLimitBackoff time.Duration
+	rateLimitedUntil time.Time
+}
+
+func isRateLimitError(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "too many requests") || strings.Contains(message, "rate limit")
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (h *providerHealth) recordSuccess(latency time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.calls++
+	if h.latency == 0 {
+		h.latency = latency
+	} else {
+		h.latency = time.Duration(latencyDecay*float64(latency) + (1-latencyDecay)*float64(h.latency))
+	}
+	h.errorRate = (1 - errorRateDecay) * h.errorRate
+	h.rateLimitBackoff = 0
+}
+
+func (h *providerHealth) recordFailure(err error, now time.Time) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.calls++
+	h.failures++
+	h.errorRate = errorRateDecay + (1-errorRateDecay)*h.errorRate
+
+	if isRateLimitError(err) {
+		h.rateLimitBackoff *= 2
+		if h.rateLimitBackoff < minRateLimitBackoff {
+			h.rateLimitBackoff = minRateLimitBackoff
+		}
+		if h.rateLimitBackoff > maxRateLimitBackoff {
+			h.rateLimitBackoff = maxRateLimitBackoff
+		}
+		h.rateLimitedUntil = now.Add(h.rateLimitBackoff)
+	}
+}
+
+func (h *providerHealth) rateLimited(now time.Time) bool {
+	h.Lock()
+	defer h.Unlock()
+	return now.Before(h.rateLimitedUntil)
+}
+
+// score is lower for faster and more reliable providers. Providers which have
+// not served any call yet are assumed to be as fast as the hedging delay.
+func (h *providerHealth) score() float64 {
+	h.Lock()
+	defer h.Unlock()
+	latency := h.latency
+	if latency == 0 {
+		latency = defaultHedgeDelay
+	}
+	return float64(latency) + h.errorRate*float64(errorRatePenalty)
+}
+
+func (h *providerHealth) hedgeDelay() time.Duration {
+	h.Lock()
+	defer h.Unlock()
+	if h.latency == 0 {
+		return defaultHedgeDelay
+	}
+
+	delay := 2 * h.latency
+	if delay < minHedgeDelay {
+		return minHedgeDelay
+	}
+	if delay > maxHedgeDelay {
+		return maxHedgeDelay
+	}
+	return delay
+}
+
+func newProvider(name string, command string, client *rpc.Client) *provider {
+	return &provider{
+		name:    name,
+		command: command,
+		eth:     ethclient.NewClient(client),
+		rpc:     client,
+	}
+}
+
+func (p *provider) circuitOpen() bool {
+	circuit, _, err := hystrix.GetCircuit(p.command)
+	if err != nil {
+		return false
+	}
+	return circuit.IsOpen()
+}
+
+func (p *provider) available(now time.Time) bool {
+	return !p.health.rateLimited(now) && !p.circuitOpen()
+}
+
+func (p *provider) status(now time.Time) ProviderStatus {
+	rateLimited := p.health.rateLimited(now)
+	circuitOpen := p.circuitOpen()
+
+	p.health.Lock()
+	defer p.health.Unlock()
+
+	return ProviderStatus{
+		Name:        p.name,
+		Available:   !rateLimited && !circuitOpen,
+		CircuitOpen: circuitOpen,
+		RateLimited: rateLimited,
+		Latency:     p.health.latency.Milliseconds(),
+		ErrorRate:   p.health.errorRate,
+		Calls:       p.health.calls,
+		Failures:    p.health.failures,
+	}
+}
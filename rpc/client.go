@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"sync"
 	"time"
@@ -53,7 +54,8 @@ type Client struct {
 	handlers   map[string]Handler // locally registered handlers
 	log        log.Logger
 
-	walletNotifier func(chainID uint64, message string)
+	walletNotifier   func(chainID uint64, message string)
+	providerNotifier func(chainID uint64, status chain.ProvidersStatus)
 }
 
 // Is initialized in a build-tag-dependent module
@@ -106,11 +108,28 @@ func (c *Client) SetWalletNotifier(notifier func(chainID uint64, message string)
 	c.walletNotifier = notifier
 }
 
+// SetProviderNotifier sets the function called with the health of the RPC providers of a chain
+func (c *Client) SetProviderNotifier(notifier func(chainID uint64, status chain.ProvidersStatus)) {
+	c.providerNotifier = notifier
+}
+
+// providerName identifies an RPC provider by its host, as the rest of the URL may contain API keys
+func providerName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || len(u.Host) == 0 {
+		return "unknown"
+	}
+	return u.Host
+}
+
 func (c *Client) getClientUsingCache(chainID uint64) (*chain.ClientWithFallback, error) {
 	if rpcClient, ok := c.rpcClients[chainID]; ok {
 		if rpcClient.WalletNotifier == nil {
 			rpcClient.WalletNotifier = c.walletNotifier
 		}
+		if rpcClient.ProviderNotifier == nil {
+			rpcClient.ProviderNotifier = c.providerNotifier
+		}
 		return rpcClient, nil
 	}
 
@@ -122,21 +141,24 @@ func (c *Client) getClientUsingCache(chainID uint64) (*chain.ClientWithFallback,
 		return nil, fmt.Errorf("could not find network: %d", chainID)
 	}
 
-	rpcClient, err := gethrpc.Dial(network.RPCURL)
-	if err != nil {
-		return nil, fmt.Errorf("dial upstream server: %s", err)
+	urls := []string{network.RPCURL}
+	if len(network.FallbackURL) > 0 {
+		urls = append(urls, network.FallbackURL)
 	}
+	urls = append(urls, network.AdditionalRPCURLs...)
 
-	var rpcFallbackClient *gethrpc.Client
-	if len(network.FallbackURL) > 0 {
-		rpcFallbackClient, err = gethrpc.Dial(network.FallbackURL)
+	var providers []chain.RPCProvider
+	for _, rpcURL := range urls {
+		rpcClient, err := gethrpc.Dial(rpcURL)
 		if err != nil {
 			return nil, fmt.Errorf("dial upstream server: %s", err)
 		}
+		providers = append(providers, chain.RPCProvider{Name: providerName(rpcURL), Client: rpcClient})
 	}
 
-	client := chain.NewClient(rpcClient, rpcFallbackClient, chainID)
+	client := chain.NewClientWithProviders(providers, chainID)
 	client.WalletNotifier = c.walletNotifier
+	client.ProviderNotifier = c.providerNotifier
 	c.rpcClients[chainID] = client
 	return client, nil
 }
@@ -3,6 +3,7 @@ package network
 import (
 	"bytes"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/status-im/status-go/params"
@@ -13,7 +14,7 @@ type CombinedNetwork struct {
 	Test *params.Network
 }
 
-const baseQuery = "SELECT chain_id, chain_name, rpc_url, fallback_url, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name, related_chain_id FROM networks"
+const baseQuery = "SELECT chain_id, chain_name, rpc_url, fallback_url, additional_rpc_urls, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name, related_chain_id FROM networks"
 
 func newNetworksQuery() *networksQuery {
 	buf := bytes.NewBuffer(nil)
@@ -60,8 +61,9 @@ func (nq *networksQuery) exec(db *sql.DB) ([]*params.Network, error) {
 	defer rows.Close()
 	for rows.Next() {
 		network := params.Network{}
+		var additionalRPCURLs string
 		err := rows.Scan(
-			&network.ChainID, &network.ChainName, &network.RPCURL, &network.FallbackURL, &network.BlockExplorerURL, &network.IconURL,
+			&network.ChainID, &network.ChainName, &network.RPCURL, &network.FallbackURL, &additionalRPCURLs, &network.BlockExplorerURL, &network.IconURL,
 			&network.NativeCurrencyName, &network.NativeCurrencySymbol,
 			&network.NativeCurrencyDecimals, &network.IsTest, &network.Layer, &network.Enabled, &network.ChainColor, &network.ShortName,
 			&network.RelatedChainID,
@@ -69,12 +71,38 @@ func (nq *networksQuery) exec(db *sql.DB) ([]*params.Network, error) {
 		if err != nil {
 			return nil, err
 		}
+		if len(additionalRPCURLs) > 0 {
+			err = json.Unmarshal([]byte(additionalRPCURLs), &network.AdditionalRPCURLs)
+			if err != nil {
+				return nil, err
+			}
+		}
 		res = append(res, &network)
 	}
 
 	return res, err
 }
 
+func encodeURLs(urls []string) (string, error) {
+	if len(urls) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(urls)
+	return string(encoded), err
+}
+
+func equalURLs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 type Manager struct {
 	db       *sql.DB
 	networks []params.Network
@@ -136,6 +164,14 @@ func (nm *Manager) Init(networks []params.Network) error {
 					}
 				}
 
+				if !equalURLs(currentNetworks[j].AdditionalRPCURLs, networks[i].AdditionalRPCURLs) {
+					// Update additional_rpc_urls if they are different
+					err := nm.UpdateAdditionalRPCURLs(currentNetworks[j].ChainID, networks[i].AdditionalRPCURLs)
+					if err != nil {
+						errors += fmt.Sprintf("error updating network additional_rpc_urls for ChainID: %d, %s", currentNetworks[j].ChainID, err.Error())
+					}
+				}
+
 				if currentNetworks[j].RelatedChainID != networks[i].RelatedChainID {
 					// Update fallback_url if it's different
 					err := nm.UpdateRelatedChainID(currentNetworks[j].ChainID, networks[i].RelatedChainID)
@@ -164,9 +200,14 @@ func (nm *Manager) Init(networks []params.Network) error {
 }
 
 func (nm *Manager) Upsert(network *params.Network) error {
-	_, err := nm.db.Exec(
-		"INSERT OR REPLACE INTO networks (chain_id, chain_name, rpc_url, fallback_url, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name, related_chain_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		network.ChainID, network.ChainName, network.RPCURL, network.FallbackURL, network.BlockExplorerURL, network.IconURL,
+	additionalRPCURLs, err := encodeURLs(network.AdditionalRPCURLs)
+	if err != nil {
+		return err
+	}
+
+	_, err = nm.db.Exec(
+		"INSERT OR REPLACE INTO networks (chain_id, chain_name, rpc_url, fallback_url, additional_rpc_urls, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name, related_chain_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		network.ChainID, network.ChainName, network.RPCURL, network.FallbackURL, additionalRPCURLs, network.BlockExplorerURL, network.IconURL,
 		network.NativeCurrencyName, network.NativeCurrencySymbol, network.NativeCurrencyDecimals,
 		network.IsTest, network.Layer, network.Enabled, network.ChainColor, network.ShortName,
 		network.RelatedChainID,
@@ -189,6 +230,16 @@ func (nm *Manager) UpdateFallbackURL(chainID uint64, fallbackURL string) error {
 	return err
 }
 
+func (nm *Manager) UpdateAdditionalRPCURLs(chainID uint64, urls []string) error {
+	additionalRPCURLs, err := encodeURLs(urls)
+	if err != nil {
+		return err
+	}
+
+	_, err = nm.db.Exec(`UPDATE networks SET additional_rpc_urls = ? WHERE chain_id = ?`, additionalRPCURLs, chainID)
+	return err
+}
+
 func (nm *Manager) UpdateRelatedChainID(chainID uint64, relatedChainID uint64) error {
 	_, err := nm.db.Exec(`UPDATE networks SET related_chain_id = ? WHERE chain_id = ?`, relatedChainID, chainID)
 	return err
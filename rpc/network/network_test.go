@@ -147,3 +147,26 @@ func TestUpsert(t *testing.T) {
 	require.NotNil(t, network)
 	require.Equal(t, newName, network.ChainName)
 }
+
+func TestAdditionalRPCURLs(t *testing.T) {
+	db, stop := setupTestNetworkDB(t)
+	defer stop()
+
+	nm := &Manager{db: db}
+	err := nm.Init(initNetworks)
+	require.NoError(t, err)
+
+	network := nm.Find(1)
+	require.NotNil(t, network)
+	require.Empty(t, network.AdditionalRPCURLs)
+
+	networks := make([]params.Network, len(initNetworks))
+	copy(networks, initNetworks)
+	networks[0].AdditionalRPCURLs = []string{"https://eth.llamarpc.com", "https://rpc.ankr.com/eth"}
+	err = nm.Init(networks)
+	require.NoError(t, err)
+
+	network = nm.Find(1)
+	require.NotNil(t, network)
+	require.Equal(t, networks[0].AdditionalRPCURLs, network.AdditionalRPCURLs)
+}
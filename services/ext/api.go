@@ -519,6 +519,78 @@ func (api *PublicAPI) AddRoleToMember(request *requests.AddRoleToMember) (*proto
 	return api.service.messenger.AddRoleToMember(request)
 }
 
+func (api *PublicAPI) CreateCommunityRole(request *requests.CreateCommunityRole) (*protobuf.CommunityRole, error) {
+	return api.service.messenger.CreateCommunityRole(request)
+}
+
+func (api *PublicAPI) EditCommunityRole(request *requests.EditCommunityRole) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditCommunityRole(request)
+}
+
+func (api *PublicAPI) DeleteCommunityRole(request *requests.DeleteCommunityRole) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteCommunityRole(request)
+}
+
+func (api *PublicAPI) AssignCommunityRole(request *requests.AssignCommunityRole) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AssignCommunityRole(request)
+}
+
+func (api *PublicAPI) UnassignCommunityRole(request *requests.UnassignCommunityRole) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.UnassignCommunityRole(request)
+}
+
+func (api *PublicAPI) CreateCommunityCalendarEvent(ctx context.Context, request *requests.CreateCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateCommunityCalendarEvent(ctx, request)
+}
+
+func (api *PublicAPI) EditCommunityCalendarEvent(ctx context.Context, request *requests.EditCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditCommunityCalendarEvent(ctx, request)
+}
+
+func (api *PublicAPI) CancelCommunityCalendarEvent(ctx context.Context, eventID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CancelCommunityCalendarEvent(ctx, eventID)
+}
+
+func (api *PublicAPI) RsvpCommunityCalendarEvent(ctx context.Context, request *requests.RsvpCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RsvpCommunityCalendarEvent(ctx, request)
+}
+
+func (api *PublicAPI) CommunityCalendarEvents(communityID types.HexBytes) ([]*communities.CalendarEvent, error) {
+	return api.service.messenger.CommunityCalendarEvents(communityID)
+}
+
+func (api *PublicAPI) ExportCommunityCalendarEvents(communityID types.HexBytes) (string, error) {
+	return api.service.messenger.ExportCommunityCalendarEvents(communityID)
+}
+
+func (api *PublicAPI) ExportCommunityCalendarEvent(eventID string) (string, error) {
+	return api.service.messenger.ExportCommunityCalendarEvent(eventID)
+}
+
+func (api *PublicAPI) SetCommunityModerationRules(request *requests.SetCommunityModerationRules) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityModerationRules(request)
+}
+
+func (api *PublicAPI) GetCommunityAuditLog(request *requests.GetCommunityAuditLog) (*communities.AuditLogPage, error) {
+	return api.service.messenger.GetCommunityAuditLog(request)
+}
+
+func (api *PublicAPI) VerifyCommunityAuditLog(communityID types.HexBytes) error {
+	return api.service.messenger.VerifyCommunityAuditLog(communityID)
+}
+
+func (api *PublicAPI) CreateCommunityInviteLink(request *requests.CreateCommunityInviteLink) (*communities.InviteLink, error) {
+	return api.service.messenger.CreateCommunityInviteLink(request)
+}
+
+func (api *PublicAPI) GetCommunityInviteLinks(communityID types.HexBytes) ([]*communities.InviteLink, error) {
+	return api.service.messenger.GetCommunityInviteLinks(communityID)
+}
+
+func (api *PublicAPI) RevokeCommunityInviteLink(request *requests.RevokeCommunityInviteLink) error {
+	return api.service.messenger.RevokeCommunityInviteLink(request)
+}
+
 func (api *PublicAPI) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.RemoveRoleFromMember(request)
 }
@@ -680,6 +752,10 @@ func (api *PublicAPI) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(community
 	}, nil
 }
 
+func (api *PublicAPI) SearchMessages(request *protocol.MessageSearchRequest) (*protocol.MessageSearchResponse, error) {
+	return api.service.messenger.SearchMessages(request)
+}
+
 func (api *PublicAPI) ChatPinnedMessages(chatID, cursor string, limit int) (*ApplicationPinnedMessagesResponse, error) {
 	pinnedMessages, cursor, err := api.service.messenger.PinnedMessageByChatID(chatID, cursor, limit)
 	if err != nil {
@@ -840,6 +916,73 @@ func (api *PublicAPI) SendPinMessage(ctx context.Context, message *common.PinMes
 	return api.service.messenger.SendPinMessage(ctx, message)
 }
 
+// SetDisappearingMessages sets the timer, in seconds, after which new messages
+// in a one-to-one or private group chat are deleted. A timer of 0 turns it off.
+func (api *PublicAPI) SetDisappearingMessages(ctx context.Context, request *requests.SetDisappearingMessages) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetDisappearingMessages(ctx, request)
+}
+
+// SendPollVote votes for the given options of a poll message
+func (api *PublicAPI) SendPollVote(ctx context.Context, request *requests.SendPollVote) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendPollVote(ctx, request)
+}
+
+// PollResults returns the tally of the votes of a poll message
+func (api *PublicAPI) PollResults(messageID string) (*protocol.PollResults, error) {
+	return api.service.messenger.PollResults(messageID)
+}
+
+// ThreadReplies returns the replies of the thread started by the message threadID, latest first
+func (api *PublicAPI) ThreadReplies(threadID, cursor string, limit int) (*ApplicationMessagesResponse, error) {
+	messages, cursor, err := api.service.messenger.ThreadReplies(threadID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationMessagesResponse{
+		Messages: messages,
+		Cursor:   cursor,
+	}, nil
+}
+
+// MarkThreadRead marks all the replies of a thread as seen
+func (api *PublicAPI) MarkThreadRead(ctx context.Context, threadID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.MarkThreadRead(ctx, threadID)
+}
+
+// MuteThread stops notifications for the replies of a thread, except for mentions
+func (api *PublicAPI) MuteThread(ctx context.Context, threadID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.MuteThread(ctx, threadID)
+}
+
+func (api *PublicAPI) UnmuteThread(ctx context.Context, threadID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.UnmuteThread(ctx, threadID)
+}
+
+// ScheduleMessage adds a text message to the outbox, to be sent to the chat at the scheduled time
+func (api *PublicAPI) ScheduleMessage(ctx context.Context, request *requests.ScheduleMessage) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ScheduleMessage(ctx, request)
+}
+
+// ScheduledMessages returns the messages waiting to be sent in a chat, or in all chats if chatID is empty
+func (api *PublicAPI) ScheduledMessages(chatID string) ([]*protocol.ScheduledMessage, error) {
+	return api.service.messenger.ScheduledMessages(chatID)
+}
+
+func (api *PublicAPI) EditScheduledMessage(ctx context.Context, request *requests.EditScheduledMessage) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditScheduledMessage(ctx, request)
+}
+
+func (api *PublicAPI) CancelScheduledMessage(ctx context.Context, id string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CancelScheduledMessage(ctx, id)
+}
+
+// SendTypingIndicator notifies the members of a one-to-one or private group chat
+// that we started or stopped typing, if enabled in the settings
+func (api *PublicAPI) SendTypingIndicator(ctx context.Context, chatID string, typing bool) error {
+	return api.service.messenger.SendTypingIndicator(ctx, chatID, typing)
+}
+
 func (api *PublicAPI) RequestTransaction(ctx context.Context, chatID, value, contract, address string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.RequestTransaction(ctx, chatID, value, contract, address)
 }
@@ -48,6 +48,7 @@ import (
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	mailserversDB "github.com/status-im/status-go/services/mailservers"
 	"github.com/status-im/status-go/services/wallet"
+	"github.com/status-im/status-go/services/wallet/addressbook"
 	w_common "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 )
@@ -583,3 +584,27 @@ func (s *Service) FetchNFTMetadata(chainID uint64, id thirdparty.NFTUniqueID, to
 
 	return nil, nil
 }
+
+// WalletContacts returns the added contacts with the wallet address of their chat key, to name them in the wallet
+func (s *Service) WalletContacts() []*addressbook.Contact {
+	if s.messenger == nil {
+		return nil
+	}
+
+	var contacts []*addressbook.Contact
+	for _, contact := range s.messenger.AddedContacts() {
+		if contact.Blocked {
+			continue
+		}
+		publicKey, err := common.HexToPubkey(contact.ID)
+		if err != nil {
+			continue
+		}
+		contacts = append(contacts, &addressbook.Contact{
+			ID:          contact.ID,
+			Address:     commongethtypes.Address(crypto.PubkeyToAddress(*publicKey)),
+			DisplayName: contact.PrimaryName(),
+		})
+	}
+	return contacts
+}
@@ -5,7 +5,11 @@ const (
 	CategoryMessage                PushCategory = "newMessage"
 	CategoryGroupInvite            PushCategory = "groupInvite"
 	CategoryCommunityRequestToJoin              = "communityRequestToJoin"
+	CategoryWalletAlert            PushCategory = "walletAlert"
+	CategoryCommunityEventReminder PushCategory = "communityEventReminder"
 
-	TypeTransaction NotificationType = "transaction"
-	TypeMessage     NotificationType = "message"
+	TypeTransaction    NotificationType = "transaction"
+	TypeMessage        NotificationType = "message"
+	TypeWalletAlert    NotificationType = "walletAlert"
+	TypeCommunityEvent NotificationType = "communityEvent"
 )
@@ -56,6 +56,8 @@ type Entry struct {
 	tokenIn         *Token       // Used for activityType ReceiveAT, BuyAT, SwapAT, BridgeAT
 	sender          *eth.Address
 	recipient       *eth.Address
+	senderName      string // Display name of the sender, see addressbook.Resolver
+	recipientName   string
 	chainIDOut      *common.ChainID
 	chainIDIn       *common.ChainID
 	transferType    *TransferType
@@ -75,6 +77,8 @@ type jsonSerializationTemplate struct {
 	TokenIn         *Token                          `json:"tokenIn,omitempty"`
 	Sender          *eth.Address                    `json:"sender,omitempty"`
 	Recipient       *eth.Address                    `json:"recipient,omitempty"`
+	SenderName      string                          `json:"senderName,omitempty"`
+	RecipientName   string                          `json:"recipientName,omitempty"`
 	ChainIDOut      *common.ChainID                 `json:"chainIdOut,omitempty"`
 	ChainIDIn       *common.ChainID                 `json:"chainIdIn,omitempty"`
 	TransferType    *TransferType                   `json:"transferType,omitempty"`
@@ -95,6 +99,8 @@ func (e *Entry) MarshalJSON() ([]byte, error) {
 		TokenIn:         e.tokenIn,
 		Sender:          e.sender,
 		Recipient:       e.recipient,
+		SenderName:      e.senderName,
+		RecipientName:   e.recipientName,
 		ChainIDOut:      e.chainIDOut,
 		ChainIDIn:       e.chainIDIn,
 		TransferType:    e.transferType,
@@ -121,6 +127,8 @@ func (e *Entry) UnmarshalJSON(data []byte) error {
 	e.tokenIn = aux.TokenIn
 	e.sender = aux.Sender
 	e.recipient = aux.Recipient
+	e.senderName = aux.SenderName
+	e.recipientName = aux.RecipientName
 	e.chainIDOut = aux.ChainIDOut
 	e.chainIDIn = aux.ChainIDIn
 	e.transferType = aux.TransferType
@@ -269,6 +277,7 @@ const (
 	//    filter_networks will account for that
 	// 2. Filtering by token identity (chain and address for transfers table) where the symbol is ignored and all the
 	//      token identities must be provided
+	//    ERC-1155 identities also match the token ID when one is provided, all the collection items otherwise
 	queryFormatString = `
     WITH filter_conditions AS (
         SELECT
@@ -315,6 +324,9 @@ const (
         assets_erc20(chain_id, token_address) AS (
             VALUES %s
         ),
+        assets_erc1155(chain_id, token_address, token_id) AS (
+            VALUES %s
+        ),
         filter_networks(network_id) AS (
             VALUES %s
         ),
@@ -336,6 +348,7 @@ const (
             FROM
               pending_transactions
             WHERE pending_transactions.multi_transaction_id != 0
+                AND pending_transactions.replaced_by IS NULL
             GROUP BY pending_transactions.multi_transaction_id
         )
     SELECT
@@ -378,7 +391,8 @@ const (
 		NULL AS out_network_id,
 		NULL AS in_network_id,
 		transfers.type AS type,
-		transfers.contract_address AS contract_address
+		transfers.contract_address AS contract_address,
+		transfers.token_id AS token_id
     FROM transfers, filter_conditions
     LEFT JOIN
         filter_addresses from_join ON HEX(transfers.tx_from_address) = from_join.address
@@ -409,7 +423,11 @@ const (
             OR (HEX(transfers.tx_to_address) IN filter_to_addresses)
         )
         AND (includeAllTokenTypeAssets OR (transfers.type = "eth" AND ("ETH" IN assets_token_codes))
-						OR (transfers.type = "erc20" AND ((transfers.network_id, HEX(transfers.token_address)) IN assets_erc20)))
+						OR (transfers.type = "erc20" AND ((transfers.network_id, HEX(transfers.token_address)) IN assets_erc20))
+						OR (transfers.type = "erc1155" AND EXISTS (SELECT 1 FROM assets_erc1155
+							WHERE assets_erc1155.chain_id = transfers.network_id
+								AND assets_erc1155.token_address = HEX(transfers.token_address)
+								AND (assets_erc1155.token_id IS NULL OR assets_erc1155.token_id = HEX(transfers.token_id)))))
         AND (includeAllNetworks OR (transfers.network_id IN filter_networks))
         AND (filterAllActivityStatus OR ((filterStatusCompleted OR filterStatusFinalized) AND transfers.status = 1)
             OR (filterStatusFailed AND transfers.status = 0)
@@ -453,13 +471,15 @@ const (
 		NULL AS out_network_id,
 		NULL AS in_network_id,
 		pending_transactions.type AS type,
-		NULL as contract_address
+		NULL as contract_address,
+		NULL AS token_id
     FROM pending_transactions, filter_conditions
     LEFT JOIN
         filter_addresses from_join ON HEX(pending_transactions.from_address) = from_join.address
     LEFT JOIN
         filter_addresses to_join ON HEX(pending_transactions.to_address) = to_join.address
     WHERE pending_transactions.multi_transaction_id = 0
+        AND pending_transactions.replaced_by IS NULL
         AND (filterAllActivityStatus OR filterStatusPending)
         AND ((startFilterDisabled OR timestamp >= startTimestamp)
             AND (endFilterDisabled OR timestamp <= endTimestamp)
@@ -507,7 +527,8 @@ const (
 		multi_transactions.from_network_id AS out_network_id,
 		multi_transactions.to_network_id AS in_network_id,
 		NULL AS type,
-		NULL as contract_address
+		NULL as contract_address,
+		NULL AS token_id
     FROM multi_transactions, filter_conditions
     LEFT JOIN tr_status ON multi_transactions.ROWID = tr_status.multi_transaction_id
     LEFT JOIN pending_status ON multi_transactions.ROWID = pending_status.multi_transaction_id
@@ -533,8 +554,9 @@ const (
     ORDER BY timestamp DESC
     LIMIT ? OFFSET ?`
 
-	noEntriesInTmpTableSQLValues           = "(NULL)"
-	noEntriesInTwoColumnsTmpTableSQLValues = "(NULL, NULL)"
+	noEntriesInTmpTableSQLValues             = "(NULL)"
+	noEntriesInTwoColumnsTmpTableSQLValues   = "(NULL, NULL)"
+	noEntriesInThreeColumnsTmpTableSQLValues = "(NULL, NULL, NULL)"
 )
 
 type FilterDependencies struct {
@@ -555,6 +577,8 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 	assetsTokenCodes := noEntriesInTmpTableSQLValues
 	// Used for identity bearing tables transfers
 	assetsERC20 := noEntriesInTwoColumnsTmpTableSQLValues
+	// Used for transfers of ERC-1155 collections, a NULL token ID matches all the items
+	assetsERC1155 := noEntriesInThreeColumnsTmpTableSQLValues
 	if !includeAllTokenTypeAssets && !filter.FilterOutAssets {
 		symbolsSet := make(map[string]struct{})
 		var symbols []string
@@ -578,6 +602,19 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 				return ""
 			})
 		}
+
+		if sliceChecksCondition(filter.Assets, func(item *Token) bool { return item.TokenType == Erc1155 }) {
+			assetsERC1155 = joinItems(filter.Assets, func(item Token) string {
+				if item.TokenType == Erc1155 {
+					tokenID := "NULL"
+					if item.TokenID != nil {
+						tokenID = fmt.Sprintf("'%s'", strings.ToUpper(hex.EncodeToString((*hexutil.Big)(item.TokenID).ToInt().Bytes())))
+					}
+					return fmt.Sprintf("%d, '%s', %s", item.ChainID, strings.ToUpper(item.Address.Hex()[2:]), tokenID)
+				}
+				return ""
+			})
+		}
 	}
 
 	// construct chain IDs
@@ -619,7 +656,7 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 		return strconv.Itoa(int(t))
 	})
 
-	queryString := fmt.Sprintf(queryFormatString, involvedAddresses, toAddresses, assetsTokenCodes, assetsERC20, networks,
+	queryString := fmt.Sprintf(queryFormatString, involvedAddresses, toAddresses, assetsTokenCodes, assetsERC20, assetsERC1155, networks,
 		joinedMTTypes)
 
 	rows, err := deps.db.QueryContext(ctx, queryString,
@@ -646,6 +683,7 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 		var toAddress, fromAddress eth.Address
 		var toAddressDB, ownerAddressDB, contractAddressDB sql.RawBytes
 		var tokenAddress, contractAddress *eth.Address
+		var tokenIDDB sql.RawBytes
 		var aggregatedStatus int
 		var dbTrAmount sql.NullString
 		var dbMtFromAmount, dbMtToAmount, contractType sql.NullString
@@ -653,7 +691,7 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 		var transferType *TransferType
 		err := rows.Scan(&transferHash, &pendingHash, &chainID, &multiTxID, &timestamp, &dbMtType, &dbTrType, &fromAddress,
 			&toAddressDB, &ownerAddressDB, &dbTrAmount, &dbMtFromAmount, &dbMtToAmount, &aggregatedStatus, &aggregatedCount,
-			&tokenAddress, &tokenCode, &fromTokenCode, &toTokenCode, &outChainIDDB, &inChainIDDB, &contractType, &contractAddressDB)
+			&tokenAddress, &tokenCode, &fromTokenCode, &toTokenCode, &outChainIDDB, &inChainIDDB, &contractType, &contractAddressDB, &tokenIDDB)
 		if err != nil {
 			return nil, err
 		}
@@ -700,7 +738,10 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 
 			// Extract tokens and chains
 			var involvedToken *Token
-			if tokenAddress != nil && *tokenAddress != ZeroAddress {
+			if transferType != nil && *transferType == TransferTypeErc1155 && tokenAddress != nil {
+				tokenID := new(big.Int).SetBytes(tokenIDDB)
+				involvedToken = &Token{TokenType: Erc1155, ChainID: common.ChainID(chainID.Int64), Address: *tokenAddress, TokenID: (*hexutil.Big)(tokenID)}
+			} else if tokenAddress != nil && *tokenAddress != ZeroAddress {
 				involvedToken = &Token{TokenType: Erc20, ChainID: common.ChainID(chainID.Int64), Address: *tokenAddress}
 			} else {
 				involvedToken = &Token{TokenType: Native, ChainID: common.ChainID(chainID.Int64)}
@@ -858,6 +899,8 @@ func contractTypeFromDBType(dbType string) (transferType *TransferType) {
 		*transferType = TransferTypeErc20
 	case common.Erc721Transfer:
 		*transferType = TransferTypeErc721
+	case common.Erc1155Transfer:
+		*transferType = TransferTypeErc1155
 	default:
 		return nil
 	}
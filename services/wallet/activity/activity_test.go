@@ -874,6 +874,52 @@ func TestGetActivityEntriesFilterByTokenType(t *testing.T) {
 	require.Nil(t, entries[0].tokenIn)
 }
 
+func TestGetActivityEntriesFilterByErc1155(t *testing.T) {
+	deps, close := setupTestActivityDB(t)
+	defer close()
+
+	collection := eth.Address{0x11}
+	trs, fromTrs, toTrs := transfer.GenerateTestTransfers(t, deps.db, 0, 3)
+	for i := range trs {
+		trs[i].ChainID = common.ChainID(transfer.EthGoerli.ChainID)
+		transfer.InsertTestTransferWithOptions(t, deps.db, trs[i].To, &trs[i], &transfer.TestTransferOptions{
+			TokenAddress: collection,
+			TokenID:      big.NewInt(int64(i % 2)),
+		})
+	}
+	mockTestAccountsWithAddresses(t, deps.db, append(fromTrs, toTrs...))
+
+	var filter Filter
+	filter.Assets = []Token{{TokenType: Erc1155, ChainID: trs[0].ChainID, Address: collection}}
+	entries, err := getActivityEntries(context.Background(), deps, []eth.Address{}, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+
+	filter.Assets[0].TokenID = (*hexutil.Big)(big.NewInt(1))
+	entries, err = getActivityEntries(context.Background(), deps, []eth.Address{}, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, TransferTypeErc1155, *entries[0].transferType)
+	token := entries[0].tokenIn
+	if token == nil {
+		token = entries[0].tokenOut
+	}
+	require.Equal(t, Erc1155, token.TokenType)
+	require.Equal(t, collection, token.Address)
+	require.Equal(t, int64(1), (*hexutil.Big)(token.TokenID).ToInt().Int64())
+
+	// Token ID 0 is stored as an empty blob
+	filter.Assets[0].TokenID = (*hexutil.Big)(big.NewInt(0))
+	entries, err = getActivityEntries(context.Background(), deps, []eth.Address{}, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+
+	filter.Assets[0].Address = eth.Address{0x12}
+	entries, err = getActivityEntries(context.Background(), deps, []eth.Address{}, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+}
+
 func TestGetActivityEntriesFilterByToAddresses(t *testing.T) {
 	deps, close := setupTestActivityDB(t)
 	defer close()
@@ -0,0 +1,463 @@
+package activity
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/token"
+)
+
+type ExportFormat = string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+)
+
+// exportPageSize is the number of activity entries loaded from the database at once
+const exportPageSize = 100
+
+var ErrUnknownExportFormat = errors.New("unknown export format")
+
+// HistoricalPrices provides the daily exchange rates used to value the exported entries.
+// It is implemented by history.Exchange
+type HistoricalPrices interface {
+	FetchAndCacheMissingRates(token string, currency string) error
+	GetExchangeRateForDay(token string, currency string, date time.Time) (float32, error)
+}
+
+// ExportEntry is a row of the activity export. Amounts are formatted using the token decimals
+// and fiat values use the exchange rate of the day of the entry
+type ExportEntry struct {
+	Timestamp       int64           `json:"timestamp"`
+	Date            string          `json:"date"`
+	Type            string          `json:"type"`
+	Status          string          `json:"status"`
+	ChainIDOut      *common.ChainID `json:"chainIdOut,omitempty"`
+	ChainIDIn       *common.ChainID `json:"chainIdIn,omitempty"`
+	TransactionHash *eth.Hash       `json:"transactionHash,omitempty"`
+	Sender          *eth.Address    `json:"sender,omitempty"`
+	Recipient       *eth.Address    `json:"recipient,omitempty"`
+	Counterparty    *eth.Address    `json:"counterparty,omitempty"`
+	TokenOut        string          `json:"tokenOut,omitempty"`
+	AmountOut       string          `json:"amountOut,omitempty"`
+	FiatValueOut    *float64        `json:"fiatValueOut,omitempty"`
+	TokenIn         string          `json:"tokenIn,omitempty"`
+	AmountIn        string          `json:"amountIn,omitempty"`
+	FiatValueIn     *float64        `json:"fiatValueIn,omitempty"`
+	FeeToken        string          `json:"feeToken,omitempty"`
+	Fee             string          `json:"fee,omitempty"`
+	FeeFiatValue    *float64        `json:"feeFiatValue,omitempty"`
+	Currency        string          `json:"currency"`
+}
+
+var exportCSVHeader = []string{
+	"timestamp", "date", "type", "status", "chain_id_out", "chain_id_in", "transaction_hash",
+	"sender", "recipient", "counterparty", "token_out", "amount_out", "fiat_value_out",
+	"token_in", "amount_in", "fiat_value_in", "fee_token", "fee", "fee_fiat_value", "currency",
+}
+
+func (e *ExportEntry) csvRecord() []string {
+	chainID := func(c *common.ChainID) string {
+		if c == nil {
+			return ""
+		}
+		return strconv.FormatUint(uint64(*c), 10)
+	}
+	address := func(a *eth.Address) string {
+		if a == nil {
+			return ""
+		}
+		return a.Hex()
+	}
+	fiat := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', 2, 64)
+	}
+	hash := ""
+	if e.TransactionHash != nil {
+		hash = e.TransactionHash.Hex()
+	}
+
+	return []string{
+		strconv.FormatInt(e.Timestamp, 10), e.Date, e.Type, e.Status, chainID(e.ChainIDOut), chainID(e.ChainIDIn), hash,
+		address(e.Sender), address(e.Recipient), address(e.Counterparty), e.TokenOut, e.AmountOut, fiat(e.FiatValueOut),
+		e.TokenIn, e.AmountIn, fiat(e.FiatValueIn), e.FeeToken, e.Fee, fiat(e.FeeFiatValue), e.Currency,
+	}
+}
+
+func (t Type) String() string {
+	switch t {
+	case SendAT:
+		return "send"
+	case ReceiveAT:
+		return "receive"
+	case BuyAT:
+		return "buy"
+	case SwapAT:
+		return "swap"
+	case BridgeAT:
+		return "bridge"
+	case ContractDeploymentAT:
+		return "contract_deployment"
+	}
+	return "unknown"
+}
+
+func (s Status) String() string {
+	switch s {
+	case FailedAS:
+		return "failed"
+	case PendingAS:
+		return "pending"
+	case CompleteAS:
+		return "complete"
+	case FinalizedAS:
+		return "finalized"
+	}
+	return "unknown"
+}
+
+type exportWriter interface {
+	Write(entry *ExportEntry) error
+	Close() error
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func newCSVExportWriter(w io.Writer) (*csvExportWriter, error) {
+	res := &csvExportWriter{w: csv.NewWriter(w)}
+	return res, res.w.Write(exportCSVHeader)
+}
+
+func (c *csvExportWriter) Write(entry *ExportEntry) error {
+	return c.w.Write(entry.csvRecord())
+}
+
+func (c *csvExportWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonExportWriter streams entries as a JSON array
+type jsonExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func newJSONExportWriter(w io.Writer) (*jsonExportWriter, error) {
+	_, err := io.WriteString(w, "[")
+	return &jsonExportWriter{w: w}, err
+}
+
+func (j *jsonExportWriter) Write(entry *ExportEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if j.count > 0 {
+		if _, err = io.WriteString(j.w, ",\n"); err != nil {
+			return err
+		}
+	}
+	j.count++
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonExportWriter) Close() error {
+	_, err := io.WriteString(j.w, "]\n")
+	return err
+}
+
+func newExportWriter(format ExportFormat, w io.Writer) (exportWriter, error) {
+	switch format {
+	case ExportFormatCSV:
+		return newCSVExportWriter(w)
+	case ExportFormatJSON:
+		return newJSONExportWriter(w)
+	}
+	return nil, ErrUnknownExportFormat
+}
+
+type exportDependencies struct {
+	FilterDependencies
+	tokenInfo func(token Token) *token.Token
+	prices    HistoricalPrices
+}
+
+// exporter converts activity entries to export entries, caching the fetched exchange rates
+type exporter struct {
+	deps     exportDependencies
+	currency string
+	// symbols for which rates were already fetched, with the fetch result
+	fetched map[string]bool
+}
+
+func newExporter(deps exportDependencies, currency string) *exporter {
+	return &exporter{
+		deps:     deps,
+		currency: strings.ToUpper(currency),
+		fetched:  make(map[string]bool),
+	}
+}
+
+// exportActivity writes all the activity entries matching the filter to w.
+// progress is called with the number of entries exported so far after each page
+func exportActivity(ctx context.Context, deps exportDependencies, addresses []eth.Address, chainIDs []common.ChainID, filter Filter,
+	format ExportFormat, currency string, w io.Writer, progress func(count int)) (count int, err error) {
+	writer, err := newExportWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	// Pending entries have no fee nor final status, they are not exported unless requested
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []Status{FailedAS, CompleteAS, FinalizedAS}
+	}
+
+	e := newExporter(deps, currency)
+	for offset := 0; ; offset += exportPageSize {
+		entries, err := getActivityEntries(ctx, deps.FilterDependencies, addresses, chainIDs, filter, offset, exportPageSize)
+		if err != nil {
+			return count, err
+		}
+
+		for i := range entries {
+			row, err := e.exportEntry(ctx, &entries[i])
+			if err != nil {
+				return count, err
+			}
+			if err = writer.Write(row); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if progress != nil {
+			progress(count)
+		}
+		if len(entries) < exportPageSize {
+			break
+		}
+	}
+
+	return count, writer.Close()
+}
+
+func (e *exporter) exportEntry(ctx context.Context, entry *Entry) (*ExportEntry, error) {
+	date := time.Unix(entry.timestamp, 0).UTC()
+	row := &ExportEntry{
+		Timestamp:  entry.timestamp,
+		Date:       date.Format(time.RFC3339),
+		Type:       entry.activityType.String(),
+		Status:     entry.activityStatus.String(),
+		ChainIDOut: entry.chainIDOut,
+		ChainIDIn:  entry.chainIDIn,
+		Sender:     entry.sender,
+		Recipient:  entry.recipient,
+		Currency:   e.currency,
+	}
+
+	switch entry.activityType {
+	case ReceiveAT, BuyAT:
+		row.Counterparty = entry.sender
+	default:
+		row.Counterparty = entry.recipient
+	}
+
+	if entry.amountOut != nil && entry.tokenOut != nil {
+		row.TokenOut, row.AmountOut, row.FiatValueOut = e.value(*entry.tokenOut, entry.amountOut.ToInt(), date)
+	}
+	if entry.amountIn != nil && entry.tokenIn != nil {
+		row.TokenIn, row.AmountIn, row.FiatValueIn = e.value(*entry.tokenIn, entry.amountIn.ToInt(), date)
+	}
+
+	fee, err := getEntryFee(ctx, e.deps.db, entry)
+	if err != nil {
+		return nil, err
+	}
+	if fee.hash != nil {
+		row.TransactionHash = fee.hash
+	} else if entry.transaction != nil {
+		row.TransactionHash = &entry.transaction.Hash
+	}
+	if fee.amount != nil {
+		row.FeeToken, row.Fee, row.FeeFiatValue = e.value(Token{TokenType: Native, ChainID: fee.chainID}, fee.amount, date)
+	}
+
+	return row, nil
+}
+
+// value returns the symbol of the token, the formatted amount and its fiat value at date if available.
+// Amounts of unknown tokens, like collectibles, are left in their smallest unit
+func (e *exporter) value(t Token, amount *big.Int, date time.Time) (symbol string, formatted string, fiat *float64) {
+	info := e.deps.tokenInfo(t)
+	if info == nil {
+		return "", amount.String(), nil
+	}
+
+	value := new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(info.Decimals)), nil))
+	formatted = value.FloatString(int(info.Decimals))
+	if info.Decimals > 0 {
+		formatted = strings.TrimSuffix(strings.TrimRight(formatted, "0"), ".")
+	}
+
+	rate, ok := e.rate(info.Symbol, date)
+	if !ok {
+		return info.Symbol, formatted, nil
+	}
+	v, _ := value.Float64()
+	fiat = new(float64)
+	*fiat = v * float64(rate)
+	return info.Symbol, formatted, fiat
+}
+
+func (e *exporter) rate(symbol string, date time.Time) (float32, bool) {
+	if e.deps.prices == nil || e.currency == "" || symbol == "" {
+		return 0, false
+	}
+
+	fetched, found := e.fetched[symbol]
+	if !found {
+		err := e.deps.prices.FetchAndCacheMissingRates(symbol, e.currency)
+		if err != nil {
+			log.Warn("failed to fetch exchange rates for export", "symbol", symbol, "currency", e.currency, "err", err)
+		}
+		fetched = err == nil
+		e.fetched[symbol] = fetched
+	}
+	if !fetched {
+		return 0, false
+	}
+
+	rate, err := e.deps.prices.GetExchangeRateForDay(symbol, e.currency, date)
+	if err != nil {
+		return 0, false
+	}
+	return rate, true
+}
+
+type entryFee struct {
+	hash    *eth.Hash
+	chainID common.ChainID
+	// nil if the owner did not pay any fee
+	amount *big.Int
+}
+
+// getEntryFee returns the hash of the transaction behind an entry and the fee paid by the owner of the entry.
+// Fees of multi-transactions are the sum of the fees of all the transactions sent by its owner
+func getEntryFee(ctx context.Context, db *sql.DB, entry *Entry) (res entryFee, err error) {
+	var rows *sql.Rows
+	switch entry.payloadType {
+	case SimpleTransactionPT:
+		rows, err = db.QueryContext(ctx, `
+			SELECT tx_hash, network_id, tx_from_address = address, gas_used, tx_type,
+				gas_price_clamped64, gas_tip_cap_clamped64, gas_fee_cap_clamped64, base_gas_fee
+			FROM transfers
+			WHERE network_id = ? AND hash = ? AND address = ?`,
+			entry.transaction.ChainID, entry.transaction.Hash, entry.transaction.Address)
+	case MultiTransactionPT:
+		rows, err = db.QueryContext(ctx, `
+			SELECT DISTINCT transfers.tx_hash, transfers.network_id, 1, transfers.gas_used, transfers.tx_type,
+				transfers.gas_price_clamped64, transfers.gas_tip_cap_clamped64, transfers.gas_fee_cap_clamped64, transfers.base_gas_fee
+			FROM transfers
+			JOIN multi_transactions ON multi_transactions.ROWID = transfers.multi_transaction_id
+			WHERE transfers.multi_transaction_id = ? AND transfers.tx_from_address = multi_transactions.from_address
+			ORDER BY transfers.timestamp`,
+			entry.id)
+	default:
+		// Pending transactions did not pay any fee yet
+		return res, nil
+	}
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var txHash []byte
+		var chainID int64
+		var paid sql.NullBool
+		var gasUsed, txType, gasPrice, tipCap, feeCap sql.NullInt64
+		var baseFee sql.NullString
+		err = rows.Scan(&txHash, &chainID, &paid, &gasUsed, &txType, &gasPrice, &tipCap, &feeCap, &baseFee)
+		if err != nil {
+			return res, err
+		}
+
+		if res.hash == nil && len(txHash) > 0 {
+			res.hash = new(eth.Hash)
+			*res.hash = eth.BytesToHash(txHash)
+			res.chainID = common.ChainID(chainID)
+		}
+
+		if !paid.Bool || !gasUsed.Valid {
+			continue
+		}
+
+		fee := transactionFee(uint64(gasUsed.Int64), uint8(txType.Int64), gasPrice.Int64, tipCap.Int64, feeCap.Int64, baseFee.String)
+		if res.amount == nil {
+			res.amount = new(big.Int)
+		}
+		res.amount.Add(res.amount, fee)
+	}
+	return res, rows.Err()
+}
+
+// transactionFee computes the fee paid for a transaction from the gas it used and its effective gas price
+func transactionFee(gasUsed uint64, txType uint8, gasPrice, tipCap, feeCap int64, baseFee string) *big.Int {
+	price := big.NewInt(gasPrice)
+	if txType == types.DynamicFeeTxType {
+		base, ok := new(big.Int).SetString(strings.TrimPrefix(baseFee, "0x"), 16)
+		if ok {
+			// min(feeCap, baseFee + tipCap)
+			price = base.Add(base, big.NewInt(tipCap))
+			if maxPrice := big.NewInt(feeCap); price.Cmp(maxPrice) > 0 {
+				price = maxPrice
+			}
+		}
+	}
+	return new(big.Int).Mul(price, new(big.Int).SetUint64(gasUsed))
+}
+
+// exportActivityToFile writes the export to path, the partial file is removed on failure
+func exportActivityToFile(ctx context.Context, deps exportDependencies, addresses []eth.Address, chainIDs []common.ChainID, filter Filter,
+	format ExportFormat, currency string, path string, progress func(count int)) (count int, err error) {
+	if format != ExportFormatCSV && format != ExportFormatJSON {
+		return 0, ErrUnknownExportFormat
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create export file: %w", err)
+	}
+
+	count, err = exportActivity(ctx, deps, addresses, chainIDs, filter, format, currency, file, progress)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+	}
+	return count, err
+}
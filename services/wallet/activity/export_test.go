@@ -0,0 +1,153 @@
+package activity
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/testutils"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testPrices struct {
+	rates   map[string]float32
+	fetches int
+}
+
+func (p *testPrices) FetchAndCacheMissingRates(token string, currency string) error {
+	p.fetches++
+	if _, ok := p.rates[token]; !ok {
+		return errors.New("missing token")
+	}
+	return nil
+}
+
+func (p *testPrices) GetExchangeRateForDay(token string, currency string, date time.Time) (float32, error) {
+	return p.rates[token], nil
+}
+
+func setupTestExportDeps(tb testing.TB, prices HistoricalPrices) (deps exportDependencies, close func()) {
+	filterDeps, close := setupTestActivityDB(tb)
+	deps = exportDependencies{
+		FilterDependencies: filterDeps,
+		tokenInfo: func(t Token) *token.Token {
+			for i, tt := range transfer.TestTokens {
+				if tt.ChainID != uint64(t.ChainID) {
+					continue
+				}
+				native := testutils.SliceContains(transfer.NativeTokenIndices, i)
+				if (t.TokenType == Native && native) || (t.TokenType == Erc20 && tt.Address == t.Address) {
+					info := *tt
+					info.Decimals = 6
+					if native {
+						info.Decimals = 18
+					}
+					return &info
+				}
+			}
+			return nil
+		},
+		prices: prices,
+	}
+	return deps, close
+}
+
+func TestExportActivity(t *testing.T) {
+	prices := &testPrices{rates: map[string]float32{"USDC": 1, "ETH": 2000}}
+	deps, close := setupTestExportDeps(t, prices)
+	defer close()
+
+	td, fromAddresses, toAddresses := fillTestData(t, deps.db)
+
+	// The first transfer of the multi-transaction is sent by its owner
+	txHash := eth.Hash{1, 2, 3}
+	_, err := deps.db.Exec(`UPDATE transfers SET tx_hash = ?, gas_used = 21000, tx_type = ?, gas_price_clamped64 = 100,
+		gas_tip_cap_clamped64 = 1, gas_fee_cap_clamped64 = 100, base_gas_fee = '0xa' WHERE hash = ?`,
+		txHash, types.DynamicFeeTxType, td.multiTx1Tr1.Hash)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	var progress []int
+	count, err := exportActivity(context.Background(), deps, append(toAddresses, fromAddresses...), []common.ChainID{}, Filter{},
+		ExportFormatJSON, "usd", &out, func(count int) { progress = append(progress, count) })
+	require.NoError(t, err)
+	// Pending entries are not exported by default
+	require.Equal(t, 2, count)
+	require.Equal(t, []int{2}, progress)
+
+	var entries []ExportEntry
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+	require.Len(t, entries, 2)
+
+	mt := entries[0]
+	require.Equal(t, td.multiTx1.Timestamp, mt.Timestamp)
+	require.Equal(t, "send", mt.Type)
+	require.Equal(t, "complete", mt.Status)
+	require.Equal(t, txHash, *mt.TransactionHash)
+	require.Equal(t, td.multiTx1.ToAddress, *mt.Counterparty)
+	require.Equal(t, "USDC", mt.TokenOut)
+	require.Equal(t, "0.000003", mt.AmountOut)
+	require.InDelta(t, 0.000003, *mt.FiatValueOut, 1e-9)
+	// min(fee cap, base fee + tip) * gas used
+	require.Equal(t, "ETH", mt.FeeToken)
+	require.Equal(t, "0.000000000000231", mt.Fee)
+	require.InDelta(t, 231e-15*2000, *mt.FeeFiatValue, 1e-15)
+	require.Equal(t, "USD", mt.Currency)
+
+	tr := entries[1]
+	require.Equal(t, td.tr1.Timestamp, tr.Timestamp)
+	require.Equal(t, "1970-01-01T00:00:01Z", tr.Date)
+	require.Equal(t, td.tr1.To, *tr.Counterparty)
+	require.Equal(t, td.tr1.Hash, *tr.TransactionHash)
+	require.Equal(t, "ETH", tr.TokenOut)
+	require.Equal(t, "0.000000000000000001", tr.AmountOut)
+	// The owner of the entry is not the sender of the transaction
+	require.Empty(t, tr.Fee)
+	require.Nil(t, tr.FeeFiatValue)
+
+	// Rates are fetched once per token, DAI rates are missing
+	require.Equal(t, 3, prices.fetches)
+	require.Equal(t, "DAI", mt.TokenIn)
+	require.Nil(t, mt.FiatValueIn)
+
+	out.Reset()
+	count, err = exportActivity(context.Background(), deps, append(toAddresses, fromAddresses...), []common.ChainID{},
+		Filter{Statuses: []Status{PendingAS}}, ExportFormatCSV, "usd", &out, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, exportCSVHeader, records[0])
+	require.Equal(t, "pending", records[1][3])
+	require.Equal(t, "pending", records[2][3])
+}
+
+func TestExportActivityUnknownFormat(t *testing.T) {
+	deps, close := setupTestExportDeps(t, nil)
+	defer close()
+
+	_, err := exportActivityToFile(context.Background(), deps, nil, nil, Filter{}, "xls", "usd", t.TempDir()+"/export.xls", nil)
+	require.ErrorIs(t, err, ErrUnknownExportFormat)
+}
+
+func TestTransactionFee(t *testing.T) {
+	// Legacy transactions pay their gas price
+	require.Equal(t, big.NewInt(2100000), transactionFee(21000, types.LegacyTxType, 100, 0, 0, ""))
+	// Dynamic fee transactions pay the base fee and their tip, up to their fee cap
+	require.Equal(t, big.NewInt(21000*15), transactionFee(21000, types.DynamicFeeTxType, 100, 5, 100, "0xa"))
+	require.Equal(t, big.NewInt(21000*12), transactionFee(21000, types.DynamicFeeTxType, 12, 5, 12, "0xa"))
+}
@@ -0,0 +1,70 @@
+package activity
+
+import (
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/addressbook"
+	"github.com/status-im/status-go/services/wallet/common"
+)
+
+// AddressIdentities provides the display names of the senders and recipients.
+// It is implemented by addressbook.Resolver
+type AddressIdentities interface {
+	ResolveCachedAddresses(chainID uint64, addresses []eth.Address) ([]*addressbook.Identity, error)
+}
+
+// setAddressNames fills the sender and recipient names of entries. Names are a convenience for display,
+// failing to resolve them doesn't fail the filtering. ENS names which aren't cached yet are left empty
+func setAddressNames(identities AddressIdentities, entries []Entry) {
+	if identities == nil {
+		return
+	}
+
+	type nameRef struct {
+		address *eth.Address
+		name    *string
+	}
+	byChain := make(map[common.ChainID][]nameRef)
+	add := func(address *eth.Address, name *string, chainID *common.ChainID, fallback *common.ChainID) {
+		if address == nil {
+			return
+		}
+		if chainID == nil {
+			chainID = fallback
+		}
+		if chainID == nil {
+			return
+		}
+		byChain[*chainID] = append(byChain[*chainID], nameRef{address, name})
+	}
+	for i := range entries {
+		entry := &entries[i]
+		add(entry.sender, &entry.senderName, entry.chainIDOut, entry.chainIDIn)
+		add(entry.recipient, &entry.recipientName, entry.chainIDIn, entry.chainIDOut)
+	}
+
+	for chainID, refs := range byChain {
+		seen := make(map[eth.Address]bool)
+		var addresses []eth.Address
+		for _, ref := range refs {
+			if !seen[*ref.address] {
+				seen[*ref.address] = true
+				addresses = append(addresses, *ref.address)
+			}
+		}
+
+		names := make(map[eth.Address]string)
+		resolved, err := identities.ResolveCachedAddresses(uint64(chainID), addresses)
+		if err != nil {
+			log.Warn("failed to resolve activity address names", "chainID", chainID, "err", err)
+		}
+		for _, identity := range resolved {
+			names[identity.Address] = identity.DisplayName
+		}
+
+		for _, ref := range refs {
+			*ref.name = names[*ref.address]
+		}
+	}
+}
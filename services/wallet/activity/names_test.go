@@ -0,0 +1,55 @@
+package activity
+
+import (
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/addressbook"
+	"github.com/status-im/status-go/services/wallet/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testIdentities struct {
+	names map[uint64]map[eth.Address]string
+	calls []uint64
+}
+
+func (i *testIdentities) ResolveCachedAddresses(chainID uint64, addresses []eth.Address) ([]*addressbook.Identity, error) {
+	i.calls = append(i.calls, chainID)
+	identities := make([]*addressbook.Identity, len(addresses))
+	for j, address := range addresses {
+		identities[j] = &addressbook.Identity{Address: address, DisplayName: i.names[chainID][address]}
+	}
+	return identities, nil
+}
+
+func TestSetAddressNames(t *testing.T) {
+	alice, bob := eth.Address{1}, eth.Address{2}
+	mainnet, optimism := common.ChainID(1), common.ChainID(10)
+	identities := &testIdentities{names: map[uint64]map[eth.Address]string{
+		1:  {alice: "alice.eth", bob: "Bob"},
+		10: {bob: "Bob on Optimism"},
+	}}
+
+	entries := []Entry{
+		{sender: &alice, recipient: &bob, chainIDOut: &mainnet},
+		// Bridged, the recipient is named on the destination chain
+		{sender: &alice, recipient: &bob, chainIDOut: &mainnet, chainIDIn: &optimism},
+		{recipient: &alice, chainIDIn: &mainnet},
+		// Multi-transactions without a chain are not named
+		{sender: &alice},
+	}
+	setAddressNames(identities, entries)
+
+	require.Equal(t, "alice.eth", entries[0].senderName)
+	require.Equal(t, "Bob", entries[0].recipientName)
+	require.Equal(t, "alice.eth", entries[1].senderName)
+	require.Equal(t, "Bob on Optimism", entries[1].recipientName)
+	require.Equal(t, "alice.eth", entries[2].recipientName)
+	require.Empty(t, entries[3].senderName)
+	require.ElementsMatch(t, []uint64{1, 10}, identities.calls)
+
+	setAddressNames(nil, entries)
+}
@@ -20,6 +20,10 @@ const (
 	EventActivityFilteringDone          walletevent.EventType = "wallet-activity-filtering-done"
 	EventActivityGetRecipientsDone      walletevent.EventType = "wallet-activity-get-recipients-result"
 	EventActivityGetOldestTimestampDone walletevent.EventType = "wallet-activity-get-oldest-timestamp-result"
+	// ExportProgress json is sent as a message in the EventActivityExportProgress event
+	EventActivityExportProgress walletevent.EventType = "wallet-activity-export-progress"
+	// ExportResponse json is sent as a message in the EventActivityExportDone event
+	EventActivityExportDone walletevent.EventType = "wallet-activity-export-done"
 )
 
 var (
@@ -35,22 +39,33 @@ var (
 		ID:     3,
 		Policy: ReplacementPolicyCancelOld,
 	}
+	exportTask = TaskType{
+		ID:     4,
+		Policy: ReplacementPolicyIgnoreNew,
+	}
 )
 
 type Service struct {
 	db           *sql.DB
 	tokenManager *token.Manager
 	eventFeed    *event.Feed
+	prices       HistoricalPrices
+	identities   AddressIdentities
 
 	scheduler *Scheduler
+	// exports are long running, they have their own scheduler not to delay filtering
+	exportScheduler *Scheduler
 }
 
-func NewService(db *sql.DB, tokenManager *token.Manager, eventFeed *event.Feed) *Service {
+func NewService(db *sql.DB, tokenManager *token.Manager, eventFeed *event.Feed, prices HistoricalPrices, identities AddressIdentities) *Service {
 	return &Service{
-		db:           db,
-		tokenManager: tokenManager,
-		eventFeed:    eventFeed,
-		scheduler:    NewScheduler(),
+		db:              db,
+		tokenManager:    tokenManager,
+		eventFeed:       eventFeed,
+		prices:          prices,
+		identities:      identities,
+		scheduler:       NewScheduler(),
+		exportScheduler: NewScheduler(),
 	}
 }
 
@@ -77,6 +92,9 @@ type FilterResponse struct {
 func (s *Service) FilterActivityAsync(ctx context.Context, addresses []common.Address, chainIDs []w_common.ChainID, filter Filter, offset int, limit int) {
 	s.scheduler.Enqueue(filterTask, func(ctx context.Context) (interface{}, error) {
 		activities, err := getActivityEntries(ctx, s.getDeps(), addresses, chainIDs, filter, offset, limit)
+		if err == nil {
+			setAddressNames(s.identities, activities)
+		}
 		return activities, err
 	}, func(result interface{}, taskType TaskType, err error) {
 		res := FilterResponse{
@@ -155,8 +173,51 @@ func (s *Service) GetOldestTimestampAsync(ctx context.Context, addresses []commo
 	})
 }
 
+type ExportProgress struct {
+	Path  string `json:"path"`
+	Count int    `json:"count"`
+}
+
+type ExportResponse struct {
+	Path      string    `json:"path"`
+	Format    string    `json:"format"`
+	Count     int       `json:"count"`
+	ErrorCode ErrorCode `json:"errorCode"`
+}
+
+// ExportActivityAsync writes all the activity entries matching the filter to the file at path,
+// with the fees, counterparty and fiat values in currency at the time of each entry.
+// The progress is reported through EventActivityExportProgress events and the result in an EventActivityExportDone event.
+// It returns true if an export is already running; meaning that this call is ignored
+func (s *Service) ExportActivityAsync(ctx context.Context, addresses []common.Address, chainIDs []w_common.ChainID, filter Filter,
+	format ExportFormat, currency string, path string) bool {
+	return s.exportScheduler.Enqueue(exportTask, func(ctx context.Context) (interface{}, error) {
+		return exportActivityToFile(ctx, s.getExportDeps(), addresses, chainIDs, filter, format, currency, path, func(count int) {
+			s.sendResponseEvent(EventActivityExportProgress, ExportProgress{Path: path, Count: count}, nil)
+		})
+	}, func(result interface{}, taskType TaskType, err error) {
+		res := ExportResponse{
+			Path:      path,
+			Format:    format,
+			ErrorCode: ErrorCodeFailed,
+		}
+		if count, ok := result.(int); ok {
+			res.Count = count
+		}
+
+		if errors.Is(err, context.Canceled) || errors.Is(err, ErrTaskOverwritten) {
+			res.ErrorCode = ErrorCodeTaskCanceled
+		} else if err == nil {
+			res.ErrorCode = ErrorCodeSuccess
+		}
+
+		s.sendResponseEvent(EventActivityExportDone, res, err)
+	})
+}
+
 func (s *Service) Stop() {
 	s.scheduler.Stop()
+	s.exportScheduler.Stop()
 }
 
 func (s *Service) getDeps() FilterDependencies {
@@ -192,6 +253,19 @@ func (s *Service) getDeps() FilterDependencies {
 	}
 }
 
+func (s *Service) getExportDeps() exportDependencies {
+	return exportDependencies{
+		FilterDependencies: s.getDeps(),
+		tokenInfo: func(t Token) *token.Token {
+			if t.TokenType != Native && t.TokenType != Erc20 {
+				return nil
+			}
+			return s.tokenManager.LookupTokenIdentity(uint64(t.ChainID), t.Address, t.TokenType == Native)
+		},
+		prices: s.prices,
+	}
+}
+
 func (s *Service) sendResponseEvent(eventType walletevent.EventType, payloadObj interface{}, resErr error) {
 	payload, err := json.Marshal(payloadObj)
 	if err != nil {
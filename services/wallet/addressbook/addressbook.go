@@ -0,0 +1,444 @@
+package addressbook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/wealdtech/go-ens/v3"
+
+	"github.com/status-im/status-go/contracts"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+// EventAddressIdentitiesResolved is sent once the ENS names missing from ResolveCachedAddresses are looked up,
+// the message is the JSON encoded identities of the addresses which have one
+const EventAddressIdentitiesResolved walletevent.EventType = "wallet-address-identities-resolved"
+
+const (
+	// ensBatchSize is the number of addresses whose reverse ENS names are looked up at once
+	ensBatchSize = 200
+
+	// ensTTL is how long a reverse ENS name is cached, ensMissTTL how long the absence of one is
+	ensTTL     = 24 * time.Hour
+	ensMissTTL = time.Hour
+	// ensRetryDelay is how long the background lookups wait before looking up again a name that failed
+	ensRetryDelay = 5 * time.Minute
+
+	// maxENSLookups is the number of reverse ENS lookups run in parallel
+	maxENSLookups    = 10
+	ensLookupTimeout = 10 * time.Second
+
+	mainnetChainID = 1
+	goerliChainID  = 5
+)
+
+// Source is where the display name of an address comes from
+type Source string
+
+// The sources by priority, a saved address name prevails over all the others
+const (
+	SourceSavedAddress  Source = "saved-address"
+	SourceContact       Source = "contact"
+	SourceKnownContract Source = "known-contract"
+	SourceENS           Source = "ens"
+)
+
+// Identity is what is known of an address to display it
+type Identity struct {
+	Address     common.Address `json:"address"`
+	DisplayName string         `json:"displayName,omitempty"`
+	Source      Source         `json:"source,omitempty"`
+	// ENSName is the verified reverse ENS name of the address, also when another source gives the display name
+	ENSName string `json:"ensName,omitempty"`
+	// ContactID is the public key of the contact the address belongs to
+	ContactID string `json:"contactId,omitempty"`
+}
+
+// Contact is a contact of the user with the wallet address of its chat key
+type Contact struct {
+	ID          string
+	Address     common.Address
+	DisplayName string
+}
+
+// ContactsProvider gives the contacts the user added. It is implemented by the messenger, set once it is started
+type ContactsProvider interface {
+	WalletContacts() []*Contact
+}
+
+type ensKey struct {
+	chainID uint64
+	address common.Address
+}
+
+type ensEntry struct {
+	name      string
+	expiresAt time.Time
+}
+
+// Resolver gives the display names of addresses from the saved addresses, the contacts, the known contracts and the
+// reverse ENS records. Only the ENS names are cached, the other sources are read on every call
+type Resolver struct {
+	db             *sql.DB
+	feed           *event.Feed
+	isTestNetwork  func(chainID uint64) bool
+	reverseResolve func(ctx context.Context, chainID uint64, address common.Address) (string, error)
+	now            func() time.Time
+
+	mutex    sync.Mutex
+	contacts ContactsProvider
+	ensCache map[ensKey]ensEntry
+	// ensPending are the names being looked up in the background, ensRetryAt when failed ones can be looked up again
+	ensPending map[ensKey]bool
+	ensRetryAt map[ensKey]time.Time
+}
+
+func NewResolver(db *sql.DB, rpcClient *rpc.Client, feed *event.Feed) *Resolver {
+	contractMaker := &contracts.ContractMaker{RPCClient: rpcClient}
+	return &Resolver{
+		db:   db,
+		feed: feed,
+		isTestNetwork: func(chainID uint64) bool {
+			network := rpcClient.NetworkManager.Find(chainID)
+			return network != nil && network.IsTest
+		},
+		reverseResolve: func(ctx context.Context, chainID uint64, address common.Address) (string, error) {
+			return reverseResolve(ctx, contractMaker, chainID, address)
+		},
+		now:        time.Now,
+		ensCache:   make(map[ensKey]ensEntry),
+		ensPending: make(map[ensKey]bool),
+		ensRetryAt: make(map[ensKey]time.Time),
+	}
+}
+
+func (r *Resolver) SetContactsProvider(provider ContactsProvider) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.contacts = provider
+}
+
+// ResolveAddresses returns the identities of addresses on chainID, in the same order.
+// The reverse ENS names which aren't cached are looked up before returning
+func (r *Resolver) ResolveAddresses(ctx context.Context, chainID uint64, addresses []common.Address) ([]*Identity, error) {
+	return r.resolveAddresses(ctx, chainID, addresses, true)
+}
+
+// ResolveCachedAddresses returns the identities of addresses on chainID like ResolveAddresses, with the cached reverse
+// ENS names only. The missing names are looked up in the background and sent with EventAddressIdentitiesResolved
+func (r *Resolver) ResolveCachedAddresses(chainID uint64, addresses []common.Address) ([]*Identity, error) {
+	return r.resolveAddresses(context.Background(), chainID, addresses, false)
+}
+
+func (r *Resolver) resolveAddresses(ctx context.Context, chainID uint64, addresses []common.Address, lookup bool) ([]*Identity, error) {
+	testNetwork := r.isTestNetwork(chainID)
+	saved, err := r.savedAddressNames(testNetwork)
+	if err != nil {
+		return nil, err
+	}
+	contacts := r.contactsByAddress()
+
+	identities := make([]*Identity, len(addresses))
+	var ensAddresses []common.Address
+	for i, address := range addresses {
+		identity := &Identity{Address: address}
+		identities[i] = identity
+		if address == (common.Address{}) {
+			continue
+		}
+
+		if name, ok := saved[address]; ok {
+			identity.DisplayName, identity.Source = name, SourceSavedAddress
+		} else if contact, ok := contacts[address]; ok {
+			identity.DisplayName, identity.Source, identity.ContactID = contact.DisplayName, SourceContact, contact.ID
+		} else if label, ok := knownContractLabel(chainID, address); ok {
+			identity.DisplayName, identity.Source = label, SourceKnownContract
+			// Contracts don't have reverse records worth looking up
+			continue
+		}
+		ensAddresses = append(ensAddresses, address)
+	}
+
+	ensChainID := uint64(mainnetChainID)
+	if testNetwork {
+		ensChainID = goerliChainID
+	}
+	names, misses := r.cachedENSNames(ensChainID, ensAddresses)
+	if lookup {
+		for start := 0; start < len(misses); start += ensBatchSize {
+			end := start + ensBatchSize
+			if end > len(misses) {
+				end = len(misses)
+			}
+			found, _ := r.lookupENSNames(ctx, ensChainID, misses[start:end])
+			for address, name := range found {
+				names[address] = name
+			}
+		}
+	} else if len(misses) > 0 {
+		r.lookupENSNamesInBackground(chainID, ensChainID, misses)
+	}
+
+	for _, identity := range identities {
+		name := names[identity.Address]
+		if name == "" {
+			continue
+		}
+		identity.ENSName = name
+		if identity.Source == "" {
+			identity.DisplayName, identity.Source = name, SourceENS
+		}
+	}
+	return identities, nil
+}
+
+// Invalidate drops the cached ENS names of addresses, they are looked up again on the next call
+func (r *Resolver) Invalidate(addresses []common.Address) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for key := range r.ensCache {
+		for _, address := range addresses {
+			if key.address == address {
+				delete(r.ensCache, key)
+			}
+		}
+	}
+	for key := range r.ensRetryAt {
+		for _, address := range addresses {
+			if key.address == address {
+				delete(r.ensRetryAt, key)
+			}
+		}
+	}
+}
+
+func (r *Resolver) savedAddressNames(testNetwork bool) (map[common.Address]string, error) {
+	rows, err := r.db.Query(`SELECT address, name FROM saved_addresses WHERE removed != 1 AND is_test = ? AND name != ''
+		ORDER BY created_at`, testNetwork)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make(map[common.Address]string)
+	for rows.Next() {
+		var address common.Address
+		var name string
+		if err := rows.Scan(&address, &name); err != nil {
+			return nil, err
+		}
+		if _, ok := names[address]; !ok {
+			names[address] = name
+		}
+	}
+	return names, rows.Err()
+}
+
+func (r *Resolver) contactsByAddress() map[common.Address]*Contact {
+	r.mutex.Lock()
+	provider := r.contacts
+	r.mutex.Unlock()
+
+	contacts := make(map[common.Address]*Contact)
+	if provider == nil {
+		return contacts
+	}
+	for _, contact := range provider.WalletContacts() {
+		contacts[contact.Address] = contact
+	}
+	return contacts
+}
+
+// cachedENSNames returns the cached reverse ENS names of addresses, along with the addresses which aren't cached
+func (r *Resolver) cachedENSNames(chainID uint64, addresses []common.Address) (map[common.Address]string, []common.Address) {
+	names := make(map[common.Address]string)
+	var misses []common.Address
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	now := r.now()
+	for _, address := range addresses {
+		if _, seen := names[address]; seen {
+			continue
+		}
+		entry, ok := r.ensCache[ensKey{chainID, address}]
+		if ok && now.Before(entry.expiresAt) {
+			names[address] = entry.name
+			continue
+		}
+		names[address] = ""
+		misses = append(misses, address)
+	}
+	return names, misses
+}
+
+// lookupENSNames looks up the reverse ENS names of addresses in parallel and caches them, it returns the names found
+// along with the addresses whose lookup failed. Failed lookups are not cached
+func (r *Resolver) lookupENSNames(ctx context.Context, chainID uint64, addresses []common.Address) (map[common.Address]string, []common.Address) {
+	names := make(map[common.Address]string)
+	var failed []common.Address
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	limit := make(chan struct{}, maxENSLookups)
+	for _, address := range addresses {
+		address := address
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			lookupCtx, cancel := context.WithTimeout(ctx, ensLookupTimeout)
+			defer cancel()
+			name, err := r.reverseResolve(lookupCtx, chainID, address)
+			if err != nil {
+				log.Debug("reverse ENS lookup failed", "chainID", chainID, "address", address, "err", err)
+				mutex.Lock()
+				failed = append(failed, address)
+				mutex.Unlock()
+				return
+			}
+
+			ttl := ensTTL
+			if name == "" {
+				ttl = ensMissTTL
+			}
+			mutex.Lock()
+			names[address] = name
+			mutex.Unlock()
+			r.mutex.Lock()
+			r.ensCache[ensKey{chainID, address}] = ensEntry{name: name, expiresAt: r.now().Add(ttl)}
+			r.mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+	return names, failed
+}
+
+// lookupENSNamesInBackground looks up the reverse ENS names of addresses which aren't already being looked up, nor
+// failed recently. The identities of the addresses which have a name are sent with EventAddressIdentitiesResolved
+func (r *Resolver) lookupENSNamesInBackground(chainID uint64, ensChainID uint64, addresses []common.Address) {
+	var lookups []common.Address
+	r.mutex.Lock()
+	now := r.now()
+	for _, address := range addresses {
+		key := ensKey{ensChainID, address}
+		if r.ensPending[key] || now.Before(r.ensRetryAt[key]) {
+			continue
+		}
+		r.ensPending[key] = true
+		lookups = append(lookups, address)
+	}
+	r.mutex.Unlock()
+
+	if len(lookups) == 0 {
+		return
+	}
+
+	go func() {
+		var named []common.Address
+		for start := 0; start < len(lookups); start += ensBatchSize {
+			end := start + ensBatchSize
+			if end > len(lookups) {
+				end = len(lookups)
+			}
+			found, failed := r.lookupENSNames(context.Background(), ensChainID, lookups[start:end])
+			for address, name := range found {
+				if name != "" {
+					named = append(named, address)
+				}
+			}
+
+			r.mutex.Lock()
+			retryAt := r.now().Add(ensRetryDelay)
+			for _, address := range failed {
+				r.ensRetryAt[ensKey{ensChainID, address}] = retryAt
+			}
+			for _, address := range lookups[start:end] {
+				delete(r.ensPending, ensKey{ensChainID, address})
+			}
+			r.mutex.Unlock()
+		}
+
+		if len(named) == 0 || r.feed == nil {
+			return
+		}
+
+		identities, err := r.ResolveCachedAddresses(chainID, named)
+		if err != nil {
+			log.Warn("failed to resolve address identities", "chainID", chainID, "err", err)
+			return
+		}
+		message, err := json.Marshal(identities)
+		if err != nil {
+			log.Error("failed to marshal address identities", "err", err)
+			return
+		}
+		r.feed.Send(walletevent.Event{
+			Type:     EventAddressIdentitiesResolved,
+			ChainID:  chainID,
+			Accounts: named,
+			Message:  string(message),
+		})
+	}()
+}
+
+// reverseResolve returns the name of the reverse ENS record of address, empty when there is none or when the name doesn't
+// resolve back to address
+func reverseResolve(ctx context.Context, contractMaker *contracts.ContractMaker, chainID uint64, address common.Address) (string, error) {
+	registry, err := contractMaker.NewRegistry(chainID)
+	if err != nil {
+		return "", err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	resolveNode := func(name string) (*common.Address, [32]byte, error) {
+		node, err := ens.NameHash(name)
+		if err != nil {
+			return nil, node, err
+		}
+		resolverAddress, err := registry.Resolver(opts, node)
+		if err != nil || resolverAddress == (common.Address{}) {
+			return nil, node, err
+		}
+		return &resolverAddress, node, nil
+	}
+
+	resolverAddress, node, err := resolveNode(strings.ToLower(address.Hex()[2:]) + ".addr.reverse")
+	if err != nil || resolverAddress == nil {
+		return "", err
+	}
+	reverseResolver, err := contractMaker.NewPublicResolver(chainID, resolverAddress)
+	if err != nil {
+		return "", err
+	}
+	name, err := reverseResolver.Name(opts, node)
+	if err != nil || name == "" {
+		return "", err
+	}
+
+	// Anyone can set any name as reverse record, it must resolve back to the address
+	resolverAddress, node, err = resolveNode(name)
+	if err != nil || resolverAddress == nil {
+		return "", err
+	}
+	resolver, err := contractMaker.NewPublicResolver(chainID, resolverAddress)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := resolver.Addr(opts, node)
+	if err != nil || resolved != address {
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,242 @@
+package addressbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+var uniswapRouter = common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+
+type testContacts []*Contact
+
+func (c testContacts) WalletContacts() []*Contact {
+	return c
+}
+
+// testENS answers reverse lookups from names, counting the lookups per chain and address
+type testENS struct {
+	mutex   sync.Mutex
+	names   map[common.Address]string
+	fail    bool
+	lookups map[ensKey]int
+}
+
+func (e *testENS) reverseResolve(ctx context.Context, chainID uint64, address common.Address) (string, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.lookups[ensKey{chainID, address}]++
+	if e.fail {
+		return "", errors.New("rpc error")
+	}
+	return e.names[address], nil
+}
+
+func setupTestResolver(t *testing.T) (*Resolver, *testENS, *time.Time, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-addressbook-tests")
+	require.NoError(t, err)
+
+	ens := &testENS{names: make(map[common.Address]string), lookups: make(map[ensKey]int)}
+	now := time.Unix(1690000000, 0)
+	r := &Resolver{
+		db:   db,
+		feed: new(event.Feed),
+		isTestNetwork: func(chainID uint64) bool {
+			return chainID == goerliChainID
+		},
+		reverseResolve: ens.reverseResolve,
+		now: func() time.Time {
+			return now
+		},
+		ensCache:   make(map[ensKey]ensEntry),
+		ensPending: make(map[ensKey]bool),
+		ensRetryAt: make(map[ensKey]time.Time),
+	}
+	return r, ens, &now, func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestResolveAddressesSources(t *testing.T) {
+	r, ens, _, stop := setupTestResolver(t)
+	defer stop()
+
+	saved, contact, named, unknown := common.Address{1}, common.Address{2}, common.Address{3}, common.Address{4}
+	_, err := r.db.Exec(`INSERT INTO saved_addresses (address, name, removed, ens_name, is_test) VALUES (?, 'Saved', 0, '', 0),
+		(?, 'Test saved', 0, '', 1), (?, 'Removed', 1, '', 0)`, saved, unknown, named)
+	require.NoError(t, err)
+	r.SetContactsProvider(testContacts{{ID: "0x04aa", Address: contact, DisplayName: "Alice"}, {ID: "0x04bb", Address: saved, DisplayName: "Bob"}})
+	ens.names[saved] = "saved.eth"
+	ens.names[named] = "named.eth"
+
+	identities, err := r.ResolveAddresses(context.Background(), 1, []common.Address{saved, contact, named, unknown, uniswapRouter, {}})
+	require.NoError(t, err)
+	require.Equal(t, []*Identity{
+		{Address: saved, DisplayName: "Saved", Source: SourceSavedAddress, ENSName: "saved.eth"},
+		{Address: contact, DisplayName: "Alice", Source: SourceContact, ContactID: "0x04aa"},
+		{Address: named, DisplayName: "named.eth", Source: SourceENS, ENSName: "named.eth"},
+		{Address: unknown},
+		{Address: uniswapRouter, DisplayName: "Uniswap V2: Router", Source: SourceKnownContract},
+		{Address: common.Address{}},
+	}, identities)
+
+	// Known contracts and the zero address are not looked up
+	require.Len(t, ens.lookups, 4)
+	require.Equal(t, 1, ens.lookups[ensKey{mainnetChainID, saved}])
+
+	// Saved addresses of test networks name addresses of test networks, ENS names come from Goerli
+	identities, err = r.ResolveAddresses(context.Background(), goerliChainID, []common.Address{unknown})
+	require.NoError(t, err)
+	require.Equal(t, "Test saved", identities[0].DisplayName)
+	require.Equal(t, 1, ens.lookups[ensKey{goerliChainID, unknown}])
+
+	// Addresses are looked up in batches
+	many := make([]common.Address, ensBatchSize+1)
+	for i := range many {
+		many[i] = common.BigToAddress(big.NewInt(int64(i + 1000)))
+	}
+	identities, err = r.ResolveAddresses(context.Background(), 1, many)
+	require.NoError(t, err)
+	require.Len(t, identities, len(many))
+	require.Equal(t, 1, ens.lookups[ensKey{mainnetChainID, many[ensBatchSize]}])
+}
+
+func TestResolveAddressesENSCache(t *testing.T) {
+	r, ens, now, stop := setupTestResolver(t)
+	defer stop()
+
+	named, unnamed := common.Address{1}, common.Address{2}
+	ens.names[named] = "named.eth"
+	resolve := func() []*Identity {
+		identities, err := r.ResolveAddresses(context.Background(), 10, []common.Address{named, unnamed, named})
+		require.NoError(t, err)
+		return identities
+	}
+
+	ens.fail = true
+	identities := resolve()
+	require.Empty(t, identities[0].DisplayName)
+	// Failures are not cached
+	ens.fail = false
+	identities = resolve()
+	require.Equal(t, "named.eth", identities[0].DisplayName)
+	require.Equal(t, "named.eth", identities[2].DisplayName)
+	require.Equal(t, 2, ens.lookups[ensKey{mainnetChainID, named}])
+
+	resolve()
+	require.Equal(t, 2, ens.lookups[ensKey{mainnetChainID, named}])
+	require.Equal(t, 2, ens.lookups[ensKey{mainnetChainID, unnamed}])
+
+	// Missing names expire before found ones
+	*now = now.Add(ensMissTTL)
+	resolve()
+	require.Equal(t, 2, ens.lookups[ensKey{mainnetChainID, named}])
+	require.Equal(t, 3, ens.lookups[ensKey{mainnetChainID, unnamed}])
+
+	*now = now.Add(ensTTL)
+	ens.names[named] = "renamed.eth"
+	identities = resolve()
+	require.Equal(t, "renamed.eth", identities[0].DisplayName)
+
+	ens.names[named] = "other.eth"
+	r.Invalidate([]common.Address{named})
+	identities = resolve()
+	require.Equal(t, "other.eth", identities[0].DisplayName)
+	require.Equal(t, 4, ens.lookups[ensKey{mainnetChainID, named}])
+}
+
+func TestResolveCachedAddresses(t *testing.T) {
+	r, ens, now, stop := setupTestResolver(t)
+	defer stop()
+
+	named, unnamed := common.Address{1}, common.Address{2}
+	ens.names[named] = "named.eth"
+	ch := make(chan walletevent.Event, 1)
+	sub := r.feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	// The names which aren't cached are looked up in the background
+	identities, err := r.ResolveCachedAddresses(10, []common.Address{named, unnamed})
+	require.NoError(t, err)
+	require.Equal(t, []*Identity{{Address: named}, {Address: unnamed}}, identities)
+
+	select {
+	case ev := <-ch:
+		require.Equal(t, EventAddressIdentitiesResolved, ev.Type)
+		require.Equal(t, uint64(10), ev.ChainID)
+		require.Equal(t, []common.Address{named}, ev.Accounts)
+		var resolved []*Identity
+		require.NoError(t, json.Unmarshal([]byte(ev.Message), &resolved))
+		require.Equal(t, []*Identity{{Address: named, DisplayName: "named.eth", Source: SourceENS, ENSName: "named.eth"}}, resolved)
+	case <-time.After(5 * time.Second):
+		t.Fatal("identities were not sent")
+	}
+
+	identities, err = r.ResolveCachedAddresses(10, []common.Address{named, unnamed})
+	require.NoError(t, err)
+	require.Equal(t, "named.eth", identities[0].DisplayName)
+	require.Empty(t, identities[1].DisplayName)
+
+	// Failed lookups are retried after a delay, not on every call
+	other := common.Address{3}
+	ens.mutex.Lock()
+	ens.fail = true
+	ens.mutex.Unlock()
+	lookups := func() int {
+		ens.mutex.Lock()
+		defer ens.mutex.Unlock()
+		return ens.lookups[ensKey{mainnetChainID, other}]
+	}
+	waitForLookup := func() {
+		require.Eventually(t, func() bool {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+			return !r.ensPending[ensKey{mainnetChainID, other}]
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	_, err = r.ResolveCachedAddresses(10, []common.Address{other})
+	require.NoError(t, err)
+	waitForLookup()
+	require.Equal(t, 1, lookups())
+
+	_, err = r.ResolveCachedAddresses(10, []common.Address{other})
+	require.NoError(t, err)
+	waitForLookup()
+	require.Equal(t, 1, lookups())
+
+	r.mutex.Lock()
+	*now = now.Add(ensRetryDelay)
+	r.mutex.Unlock()
+	_, err = r.ResolveCachedAddresses(10, []common.Address{other})
+	require.NoError(t, err)
+	waitForLookup()
+	require.Equal(t, 2, lookups())
+}
+
+func TestKnownContractLabel(t *testing.T) {
+	label, ok := knownContractLabel(10, common.HexToAddress("0x4200000000000000000000000000000000000010"))
+	require.True(t, ok)
+	require.Equal(t, "Optimism: Gateway", label)
+	_, ok = knownContractLabel(1, common.HexToAddress("0x4200000000000000000000000000000000000010"))
+	require.False(t, ok)
+
+	// USDC and USDT share the Hop bridge on mainnet
+	label, ok = knownContractLabel(1, common.HexToAddress("0x3666f603Cc164936C1b87e207F36BEBa4AC5f18a"))
+	require.True(t, ok)
+	require.Equal(t, "Hop Protocol: USDC Bridge", label)
+	label, ok = knownContractLabel(10, common.HexToAddress("0x86cA30bEF97fB651b8d866D45503684b90cb3312"))
+	require.True(t, ok)
+	require.Equal(t, "Hop Protocol: ETH AMM Wrapper", label)
+}
@@ -0,0 +1,81 @@
+package addressbook
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/contracts/hop"
+	"github.com/status-im/status-go/services/wallet/bridge"
+)
+
+// anyChain labels contracts deployed at the same address on every chain
+const anyChain = 0
+
+var knownContracts = map[uint64]map[common.Address]string{
+	anyChain: {
+		common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"): "Uniswap V2: Router",
+		common.HexToAddress("0xE592427A0AEce92De3Edee1F18E0157C05861564"): "Uniswap V3: Router",
+		common.HexToAddress("0x68b3465833fb72A70ecDF485E0e4C7bD8665Fc45"): "Uniswap V3: Router 2",
+		common.HexToAddress("0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD"): "Uniswap: Universal Router",
+		common.HexToAddress("0xEf1c6E67703c7BD7107eed8303Fbe6EC2554BF6B"): "Uniswap: Universal Router",
+		common.HexToAddress("0x1111111254EEB25477B68fb85Ed929f73A960582"): "1inch: Aggregation Router",
+		common.HexToAddress("0xDef1C0ded9bec7F1a1670819833240f027b25EfF"): "0x: Exchange Proxy",
+	},
+	1: {
+		common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"): "Optimism: Gateway",
+		common.HexToAddress("0x72Ce9c846789fdB6fC1f34aC4AD25Dd9ef7031ef"): "Arbitrum: Gateway Router",
+		common.HexToAddress("0x4Dbd4fc535Ac27206064B68FfCf827b0A60BAB3f"): "Arbitrum: Delayed Inbox",
+	},
+	10: {
+		common.HexToAddress("0x4200000000000000000000000000000000000010"): "Optimism: Gateway",
+	},
+	42161: {
+		common.HexToAddress("0x5288c571Fd7aD117beA99bF60FE0846C4E84F933"): "Arbitrum: Gateway Router",
+	},
+}
+
+var (
+	hopContractsOnce sync.Once
+	hopContracts     map[uint64]map[common.Address]string
+)
+
+// hopContractLabels labels the Hop bridges and AMM wrappers used to send tokens across chains
+func hopContractLabels() map[uint64]map[common.Address]string {
+	hopContractsOnce.Do(func() {
+		hopContracts = make(map[uint64]map[common.Address]string)
+		symbols := make([]string, 0, len(bridge.HopBonderFeeBps))
+		for symbol := range bridge.HopBonderFeeBps {
+			symbols = append(symbols, symbol)
+		}
+		// Some tokens share a bridge, the label is the one of the first symbol
+		sort.Strings(symbols)
+		for _, symbol := range symbols {
+			for chainID := range bridge.HopBonderFeeBps[symbol] {
+				if hopContracts[chainID] == nil {
+					hopContracts[chainID] = make(map[common.Address]string)
+				}
+				labels := hopContracts[chainID]
+				if address, err := hop.L1BridgeContractAddress(chainID, symbol); err == nil && labels[address] == "" {
+					labels[address] = "Hop Protocol: " + symbol + " Bridge"
+				}
+				if address, err := hop.L2AmmWrapperContractAddress(chainID, symbol); err == nil && labels[address] == "" {
+					labels[address] = "Hop Protocol: " + symbol + " AMM Wrapper"
+				}
+			}
+		}
+	})
+	return hopContracts
+}
+
+func knownContractLabel(chainID uint64, address common.Address) (string, bool) {
+	if label, ok := knownContracts[chainID][address]; ok {
+		return label, true
+	}
+	if label, ok := hopContractLabels()[chainID][address]; ok {
+		return label, true
+	}
+	label, ok := knownContracts[anyChain][address]
+	return label, ok
+}
@@ -0,0 +1,180 @@
+package alerts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RuleType is the condition checked by an alert rule
+type RuleType string
+
+const (
+	// RulePriceAbove fires when the price of Symbol rises to Threshold or above
+	RulePriceAbove RuleType = "price-above"
+	// RulePriceBelow fires when the price of Symbol drops to Threshold or below
+	RulePriceBelow RuleType = "price-below"
+	// RulePriceChange fires when the price of Symbol moved by Threshold percent since the reference price,
+	// a negative Threshold being a drop. The reference price is then reset to the current one
+	RulePriceChange RuleType = "price-change"
+	// RuleIncomingTransfer fires when Account receives more than Threshold of Symbol
+	RuleIncomingTransfer RuleType = "incoming-transfer"
+	// RuleOutgoingTransfer fires when Account sends more than Threshold of Symbol
+	RuleOutgoingTransfer RuleType = "outgoing-transfer"
+)
+
+var (
+	ErrUnknownRuleType   = errors.New("unknown alert rule type")
+	ErrMissingSymbol     = errors.New("alert rule symbol is required")
+	ErrMissingCurrency   = errors.New("price alert rule currency is required")
+	ErrInvalidThreshold  = errors.New("invalid alert rule threshold")
+	ErrRuleNotFound      = errors.New("alert rule not found")
+	ErrTooManyAlertRules = errors.New("too many alert rules")
+)
+
+// Rule is a condition on prices or transfers which the user is notified about
+type Rule struct {
+	ID       string   `json:"id"`
+	Type     RuleType `json:"type"`
+	Symbol   string   `json:"symbol"`
+	Currency string   `json:"currency,omitempty"`
+	// Threshold is a price for the price-above and price-below rules, a percentage for the price-change rules
+	// and an amount of Symbol, in its main unit, for the transfer rules
+	Threshold float64 `json:"threshold"`
+	// Account restricts the transfer rules to an account, they apply to all of them if nil
+	Account *common.Address `json:"account,omitempty"`
+	// ChainID restricts the transfer rules to a chain, they apply to all of them if zero
+	ChainID uint64 `json:"chainId,omitempty"`
+	Enabled bool   `json:"enabled"`
+	// ReferencePrice is the price the moves of the price-change rules are measured from
+	ReferencePrice float64 `json:"referencePrice,omitempty"`
+	// Triggered is set while the condition of a price-above or price-below rule holds, so that it fires once per crossing
+	Triggered bool `json:"triggered"`
+	// Checkpoint is set when a transfer rule is enabled, the transfers up to it are not checked
+	Checkpoint  int64 `json:"checkpoint,omitempty"`
+	LastFiredAt int64 `json:"lastFiredAt,omitempty"`
+	CreatedAt   int64 `json:"createdAt"`
+}
+
+func (r *Rule) isPriceRule() bool {
+	return r.Type == RulePriceAbove || r.Type == RulePriceBelow || r.Type == RulePriceChange
+}
+
+func (r *Rule) isTransferRule() bool {
+	return r.Type == RuleIncomingTransfer || r.Type == RuleOutgoingTransfer
+}
+
+// normalize validates the rule and formats its symbol and currency
+func (r *Rule) normalize() error {
+	if !r.isPriceRule() && !r.isTransferRule() {
+		return ErrUnknownRuleType
+	}
+	r.Symbol = strings.ToUpper(strings.TrimSpace(r.Symbol))
+	if r.Symbol == "" {
+		return ErrMissingSymbol
+	}
+	if math.IsNaN(r.Threshold) || math.IsInf(r.Threshold, 0) {
+		return ErrInvalidThreshold
+	}
+
+	if r.isTransferRule() {
+		r.Currency = ""
+		if r.Threshold < 0 {
+			return ErrInvalidThreshold
+		}
+		return nil
+	}
+
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+	if r.Currency == "" {
+		return ErrMissingCurrency
+	}
+	// A price can't drop by 100% or more
+	if (r.Type == RulePriceChange && (r.Threshold == 0 || r.Threshold <= -100)) || (r.Type != RulePriceChange && r.Threshold <= 0) {
+		return ErrInvalidThreshold
+	}
+	return nil
+}
+
+// checkPrice updates the state of a price rule with the current price and returns whether it fires, with the
+// price change in percent for the price-change rules
+func (r *Rule) checkPrice(price float64) (fire bool, change float64) {
+	switch r.Type {
+	case RulePriceAbove, RulePriceBelow:
+		holds := price >= r.Threshold
+		if r.Type == RulePriceBelow {
+			holds = price <= r.Threshold
+		}
+		fire = holds && !r.Triggered
+		r.Triggered = holds
+	case RulePriceChange:
+		if r.ReferencePrice <= 0 {
+			r.ReferencePrice = price
+			return false, 0
+		}
+		change = (price - r.ReferencePrice) / r.ReferencePrice * 100
+		if (r.Threshold > 0 && change >= r.Threshold) || (r.Threshold < 0 && change <= r.Threshold) {
+			fire = true
+			r.ReferencePrice = price
+		}
+	}
+	return fire, change
+}
+
+// Alert is the body of the notification of a fired rule
+type Alert struct {
+	RuleID   string   `json:"ruleId"`
+	Type     RuleType `json:"type"`
+	Symbol   string   `json:"symbol"`
+	Currency string   `json:"currency,omitempty"`
+	// Price is the price the price rules fired at
+	Price float64 `json:"price,omitempty"`
+	// Change is the price move in percent which made a price-change rule fire
+	Change     float64         `json:"change,omitempty"`
+	Account    *common.Address `json:"account,omitempty"`
+	ChainID    uint64          `json:"chainId,omitempty"`
+	TransferID *common.Hash    `json:"transferId,omitempty"`
+	From       *common.Address `json:"from,omitempty"`
+	To         *common.Address `json:"to,omitempty"`
+	// Amount is the amount of the transfer in the smallest unit of Symbol
+	Amount   *hexutil.Big `json:"amount,omitempty"`
+	Decimals uint         `json:"decimals,omitempty"`
+	At       int64        `json:"at"`
+}
+
+func (a Alert) MarshalJSON() ([]byte, error) {
+	type Alias Alert
+	item := struct{ *Alias }{Alias: (*Alias)(&a)}
+	return json.Marshal(item)
+}
+
+// title and message of the notification
+func (a *Alert) text(amount string) (string, string) {
+	switch a.Type {
+	case RulePriceAbove:
+		return fmt.Sprintf("%s price alert", a.Symbol), fmt.Sprintf("%s rose to %s %s", a.Symbol, formatFloat(a.Price), a.Currency)
+	case RulePriceBelow:
+		return fmt.Sprintf("%s price alert", a.Symbol), fmt.Sprintf("%s dropped to %s %s", a.Symbol, formatFloat(a.Price), a.Currency)
+	case RulePriceChange:
+		move := "rose"
+		if a.Change < 0 {
+			move = "dropped"
+		}
+		return fmt.Sprintf("%s price alert", a.Symbol),
+			fmt.Sprintf("%s %s %s%% to %s %s", a.Symbol, move, strconv.FormatFloat(math.Abs(a.Change), 'f', 1, 64), formatFloat(a.Price), a.Currency)
+	case RuleIncomingTransfer:
+		return "Incoming transfer", fmt.Sprintf("Received %s %s", amount, a.Symbol)
+	default:
+		return "Outgoing transfer", fmt.Sprintf("Sent %s %s", amount, a.Symbol)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
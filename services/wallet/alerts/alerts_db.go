@@ -0,0 +1,166 @@
+package alerts
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+type DB struct {
+	db *sql.DB
+}
+
+func NewDB(sqlDb *sql.DB) *DB {
+	return &DB{
+		db: sqlDb,
+	}
+}
+
+const selectRuleColumns = `id, type, symbol, currency, threshold, account, chain_id, enabled, reference_price, triggered, checkpoint,
+	last_fired_at, created_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRule(scanner scanner) (*Rule, error) {
+	r := &Rule{}
+	var account []byte
+	err := scanner.Scan(&r.ID, &r.Type, &r.Symbol, &r.Currency, &r.Threshold, &account, &r.ChainID, &r.Enabled, &r.ReferencePrice,
+		&r.Triggered, &r.Checkpoint, &r.LastFiredAt, &r.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(account) > 0 {
+		address := common.BytesToAddress(account)
+		r.Account = &address
+	}
+	return r, nil
+}
+
+// GetRules returns all the rules, oldest first
+func (adb *DB) GetRules() ([]*Rule, error) {
+	rows, err := adb.db.Query(`SELECT ` + selectRuleColumns + ` FROM wallet_alert_rules ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*Rule
+	for rows.Next() {
+		r, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+func (adb *DB) GetRule(id string) (*Rule, error) {
+	r, err := scanRule(adb.db.QueryRow(`SELECT `+selectRuleColumns+` FROM wallet_alert_rules WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrRuleNotFound
+	}
+	return r, err
+}
+
+func (adb *DB) CountRules() (count int, err error) {
+	err = adb.db.QueryRow(`SELECT COUNT(*) FROM wallet_alert_rules`).Scan(&count)
+	return count, err
+}
+
+// SaveRule inserts the rule or replaces the one with the same id
+func (adb *DB) SaveRule(r *Rule) error {
+	var account []byte
+	if r.Account != nil {
+		account = r.Account.Bytes()
+	}
+	_, err := adb.db.Exec(`INSERT OR REPLACE INTO wallet_alert_rules (`+selectRuleColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		r.ID, r.Type, r.Symbol, r.Currency, r.Threshold, account, r.ChainID, r.Enabled, r.ReferencePrice, r.Triggered, r.Checkpoint,
+		r.LastFiredAt, r.CreatedAt)
+	return err
+}
+
+func (adb *DB) DeleteRule(id string) error {
+	res, err := adb.db.Exec(`DELETE FROM wallet_alert_rules WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+// getCheckpoint returns the timestamp of the last transfer of account checked by the rule, zero if none was
+func (adb *DB) getCheckpoint(ruleID string, account common.Address) (timestamp int64, err error) {
+	err = adb.db.QueryRow(`SELECT timestamp FROM wallet_alert_checkpoints WHERE rule_id = ? AND account = ?`, ruleID, account).Scan(&timestamp)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return timestamp, err
+}
+
+func (adb *DB) setCheckpoint(ruleID string, account common.Address, timestamp int64) error {
+	_, err := adb.db.Exec(`INSERT OR REPLACE INTO wallet_alert_checkpoints (rule_id, account, timestamp) VALUES (?, ?, ?)`, ruleID, account, timestamp)
+	return err
+}
+
+// tokenTransfer is a fungible token transfer of an account
+type tokenTransfer struct {
+	id           common.Hash
+	chainID      uint64
+	account      common.Address
+	timestamp    int64
+	tokenAddress common.Address
+	native       bool
+	from         common.Address
+	to           common.Address
+	amount       *big.Int
+}
+
+// getTransfersAfter returns the successful ETH and ERC-20 transfers of account after timestamp, oldest first
+func (adb *DB) getTransfersAfter(account common.Address, timestamp int64) ([]*tokenTransfer, error) {
+	rows, err := adb.db.Query(`
+		SELECT hash, network_id, timestamp, type, token_address, tx_from_address, tx_to_address, amount_padded128hex
+		FROM transfers
+		WHERE address = ? AND loaded = 1 AND timestamp > ? AND status = 1 AND type IN (?, ?)
+			AND tx_from_address IS NOT NULL AND tx_to_address IS NOT NULL AND amount_padded128hex IS NOT NULL
+		ORDER BY timestamp, log_index, hash`,
+		account, timestamp, w_common.EthTransfer, w_common.Erc20Transfer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*tokenTransfer
+	for rows.Next() {
+		t := &tokenTransfer{account: account, amount: new(big.Int)}
+		var transferType w_common.Type
+		var tokenAddress, from, to []byte
+		var amount string
+		err = rows.Scan(&t.id, &t.chainID, &t.timestamp, &transferType, &tokenAddress, &from, &to, &amount)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := t.amount.SetString(amount, 16); !ok {
+			continue
+		}
+		t.native = transferType == w_common.EthTransfer
+		if !t.native {
+			t.tokenAddress = common.BytesToAddress(tokenAddress)
+		}
+		t.from = common.BytesToAddress(from)
+		t.to = common.BytesToAddress(to)
+		transfers = append(transfers, t)
+	}
+	return transfers, rows.Err()
+}
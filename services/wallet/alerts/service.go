@@ -0,0 +1,392 @@
+package alerts
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	localnotifications "github.com/status-im/status-go/services/local-notifications"
+	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// maxRules is the number of rules a user can keep
+	maxRules = 100
+
+	// pricesRefreshInterval is how often the price rules are checked, their prices are only fetched when older
+	pricesRefreshInterval = 10 * time.Minute
+
+	walletDeeplinkPrefix = "status-im://wallet/"
+)
+
+// Prices refreshes the prices checked by the price rules. It is implemented by market.Manager, which sends
+// market.EventMarketPricesUpdated with the refreshed prices
+type Prices interface {
+	RefreshPrices(symbols []string, currencies []string, maxAgeInSeconds int64) (market.DataPerTokenAndCurrency, error)
+}
+
+type Service struct {
+	db         *DB
+	walletFeed *event.Feed
+	prices     Prices
+	tokenInfo  func(chainID uint64, address common.Address, native bool) *token.Token
+	notify     func([]*localnotifications.Notification)
+
+	// mutex serializes the checks and changes of the rules
+	mutex    sync.Mutex
+	cancelFn context.CancelFunc
+	group    sync.WaitGroup
+}
+
+func NewService(db *sql.DB, tokenManager *token.Manager, walletFeed *event.Feed, prices Prices) *Service {
+	return &Service{
+		db:         NewDB(db),
+		walletFeed: walletFeed,
+		prices:     prices,
+		tokenInfo:  tokenManager.LookupTokenIdentity,
+		notify:     localnotifications.PushMessages,
+	}
+}
+
+// Start checks the rules when prices are fetched and when new transfers arrive
+func (s *Service) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelFn = cancel
+
+	ch := make(chan walletevent.Event, 10)
+	sub := s.walletFeed.Subscribe(ch)
+
+	s.group.Add(2)
+	go func() {
+		defer s.group.Done()
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-sub.Err():
+				if err != nil {
+					log.Error("alerts wallet feed subscription failed", "err", err)
+				}
+				return
+			case ev := <-ch:
+				switch ev.Type {
+				case market.EventMarketPricesUpdated:
+					prices := make(map[string]map[string]float64)
+					if err := json.Unmarshal([]byte(ev.Message), &prices); err != nil {
+						log.Error("failed to decode updated prices", "err", err)
+						continue
+					}
+					s.checkPrices(prices)
+				case transfer.EventNewTransfers:
+					s.checkTransfers(ev.Accounts)
+				}
+			}
+		}
+	}()
+
+	// Prices are fetched in their own routine, as the fetch sends an event to the one above
+	go func() {
+		defer s.group.Done()
+		ticker := time.NewTicker(pricesRefreshInterval)
+		defer ticker.Stop()
+		for {
+			s.refreshPrices()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Service) Stop() {
+	if s.cancelFn != nil {
+		s.cancelFn()
+		s.group.Wait()
+		s.cancelFn = nil
+	}
+}
+
+// refreshPrices refreshes the prices of the enabled price rules, fetching the ones not fetched recently
+func (s *Service) refreshPrices() {
+	rules, err := s.db.GetRules()
+	if err != nil {
+		log.Error("failed to get alert rules", "err", err)
+		return
+	}
+
+	symbols := make(map[string][]string)
+	for _, r := range rules {
+		if r.Enabled && r.isPriceRule() {
+			symbols[r.Currency] = append(symbols[r.Currency], r.Symbol)
+		}
+	}
+	for currency, currencySymbols := range symbols {
+		_, err := s.prices.RefreshPrices(currencySymbols, []string{currency}, int64(pricesRefreshInterval.Seconds()))
+		if err != nil {
+			log.Warn("failed to fetch prices for alert rules", "currency", currency, "err", err)
+		}
+	}
+}
+
+// GetRules returns all the alert rules
+func (s *Service) GetRules() ([]*Rule, error) {
+	return s.db.GetRules()
+}
+
+// AddRule validates and stores an enabled rule
+func (s *Service) AddRule(rule Rule) (*Rule, error) {
+	if err := rule.normalize(); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	count, err := s.db.CountRules()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxRules {
+		return nil, ErrTooManyAlertRules
+	}
+
+	now := time.Now().Unix()
+	r := &Rule{
+		ID:        uuid.New().String(),
+		Type:      rule.Type,
+		Symbol:    rule.Symbol,
+		Currency:  rule.Currency,
+		Threshold: rule.Threshold,
+		Account:   rule.Account,
+		ChainID:   rule.ChainID,
+		Enabled:   true,
+		CreatedAt: now,
+	}
+	if r.isTransferRule() {
+		r.Checkpoint = now
+	}
+	return r, s.db.SaveRule(r)
+}
+
+// SetRuleEnabled enables or disables a rule. A rule enabled again starts over: a price-change rule from the next
+// price and a transfer rule with the transfers arriving from now on
+func (s *Service) SetRuleEnabled(id string, enabled bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, err := s.db.GetRule(id)
+	if err != nil {
+		return err
+	}
+	if r.Enabled == enabled {
+		return nil
+	}
+	r.Enabled = enabled
+	if enabled {
+		r.ReferencePrice = 0
+		r.Triggered = false
+		if r.isTransferRule() {
+			r.Checkpoint = time.Now().Unix()
+		}
+	}
+	return s.db.SaveRule(r)
+}
+
+func (s *Service) DeleteRule(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.db.DeleteRule(id)
+}
+
+// checkPrices checks the enabled price rules with the fetched prices, per symbol and currency
+func (s *Service) checkPrices(prices map[string]map[string]float64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rules, err := s.db.GetRules()
+	if err != nil {
+		log.Error("failed to get alert rules", "err", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	var notifications []*localnotifications.Notification
+	for _, r := range rules {
+		if !r.Enabled || !r.isPriceRule() {
+			continue
+		}
+		price, ok := prices[r.Symbol][r.Currency]
+		// Providers return zero for the prices they don't know
+		if !ok || price <= 0 {
+			continue
+		}
+
+		before := *r
+		fire, change := r.checkPrice(price)
+		if fire {
+			r.LastFiredAt = now
+			notifications = append(notifications, s.notification(&Alert{
+				RuleID:   r.ID,
+				Type:     r.Type,
+				Symbol:   r.Symbol,
+				Currency: r.Currency,
+				Price:    price,
+				Change:   change,
+				At:       now,
+			}, ""))
+		}
+		if *r != before {
+			if err := s.db.SaveRule(r); err != nil {
+				log.Error("failed to save alert rule", "id", r.ID, "err", err)
+			}
+		}
+	}
+
+	if len(notifications) > 0 {
+		s.notify(notifications)
+	}
+}
+
+// checkTransfers checks the enabled transfer rules with the transfers of accounts which arrived since they were last checked
+func (s *Service) checkTransfers(accounts []common.Address) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rules, err := s.db.GetRules()
+	if err != nil {
+		log.Error("failed to get alert rules", "err", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	var notifications []*localnotifications.Notification
+	for _, account := range accounts {
+		for _, r := range rules {
+			if !r.Enabled || !r.isTransferRule() || (r.Account != nil && *r.Account != account) {
+				continue
+			}
+			fired, err := s.checkRuleTransfers(r, account, now)
+			if err != nil {
+				log.Error("failed to check alert rule transfers", "id", r.ID, "account", account, "err", err)
+				continue
+			}
+			notifications = append(notifications, fired...)
+		}
+	}
+
+	if len(notifications) > 0 {
+		s.notify(notifications)
+	}
+}
+
+func (s *Service) checkRuleTransfers(r *Rule, account common.Address, now int64) ([]*localnotifications.Notification, error) {
+	checkpoint, err := s.db.getCheckpoint(r.ID, account)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint < r.Checkpoint {
+		checkpoint = r.Checkpoint
+	}
+	transfers, err := s.db.getTransfersAfter(account, checkpoint)
+	if err != nil || len(transfers) == 0 {
+		return nil, err
+	}
+
+	var notifications []*localnotifications.Notification
+	for _, t := range transfers {
+		if r.ChainID != 0 && t.chainID != r.ChainID {
+			continue
+		}
+		incoming := t.to == account && t.from != account
+		outgoing := t.from == account && t.to != account
+		if (r.Type == RuleIncomingTransfer && !incoming) || (r.Type == RuleOutgoingTransfer && !outgoing) {
+			continue
+		}
+		info := s.tokenInfo(t.chainID, t.tokenAddress, t.native)
+		if info == nil || !strings.EqualFold(info.Symbol, r.Symbol) {
+			continue
+		}
+		amount := unitsAmount(t.amount, info.Decimals)
+		if amount.Cmp(new(big.Rat).SetFloat64(r.Threshold)) <= 0 {
+			continue
+		}
+
+		from, to, id := t.from, t.to, t.id
+		notifications = append(notifications, s.notification(&Alert{
+			RuleID:     r.ID,
+			Type:       r.Type,
+			Symbol:     r.Symbol,
+			Account:    &account,
+			ChainID:    t.chainID,
+			TransferID: &id,
+			From:       &from,
+			To:         &to,
+			Amount:     (*hexutil.Big)(t.amount),
+			Decimals:   info.Decimals,
+			At:         now,
+		}, formatAmount(amount, info.Decimals)))
+	}
+
+	if len(notifications) > 0 {
+		r.LastFiredAt = now
+		if err := s.db.SaveRule(r); err != nil {
+			return nil, err
+		}
+	}
+	return notifications, s.db.setCheckpoint(r.ID, account, transfers[len(transfers)-1].timestamp)
+}
+
+func (s *Service) notification(alert *Alert, amount string) *localnotifications.Notification {
+	title, message := alert.text(amount)
+	var id common.Hash
+	var deeplink string
+	if alert.TransferID != nil {
+		id = crypto.Keccak256Hash([]byte(alert.RuleID), alert.TransferID.Bytes())
+		deeplink = walletDeeplinkPrefix + alert.Account.String()
+	} else {
+		id = crypto.Keccak256Hash([]byte(alert.RuleID), big.NewInt(alert.At).Bytes())
+	}
+	return &localnotifications.Notification{
+		ID:        id,
+		BodyType:  localnotifications.TypeWalletAlert,
+		Body:      alert,
+		Title:     title,
+		Message:   message,
+		Category:  localnotifications.CategoryWalletAlert,
+		Deeplink:  deeplink,
+		Timestamp: uint64(alert.At) * 1000,
+	}
+}
+
+// unitsAmount converts amount from the smallest unit of a token with decimals to its main unit
+func unitsAmount(amount *big.Int, decimals uint) *big.Rat {
+	return new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+}
+
+// formatAmount formats an amount in main unit without trailing zeros
+func formatAmount(amount *big.Rat, decimals uint) string {
+	formatted := amount.FloatString(int(decimals))
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return formatted
+}
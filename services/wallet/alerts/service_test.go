@@ -0,0 +1,243 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/appdatabase"
+	localnotifications "github.com/status-im/status-go/services/local-notifications"
+	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+var testToken = common.Address{9}
+
+type testPrices struct{}
+
+func (p *testPrices) RefreshPrices(symbols []string, currencies []string, maxAgeInSeconds int64) (market.DataPerTokenAndCurrency, error) {
+	return nil, nil
+}
+
+func setupTestAlertsDB(t *testing.T) (*DB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-alerts-tests")
+	require.NoError(t, err)
+	return NewDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+// testTokenInfo knows the native ETH and testToken as SNT
+func testTokenInfo(chainID uint64, address common.Address, native bool) *token.Token {
+	if native {
+		return &token.Token{Symbol: "ETH", Decimals: 18}
+	}
+	if address == testToken {
+		return &token.Token{Address: address, Symbol: "SNT", Decimals: 2}
+	}
+	return nil
+}
+
+type testNotifier struct {
+	notifications []*localnotifications.Notification
+}
+
+func (n *testNotifier) notify(ns []*localnotifications.Notification) {
+	n.notifications = append(n.notifications, ns...)
+}
+
+// insertTokenTransfer stores a transfer of value, in the smallest unit of SNT, between from and to for account
+func insertTokenTransfer(t *testing.T, db *DB, account, from, to common.Address, value int64, timestamp int64, seed int) {
+	transfers, _, _ := transfer.GenerateTestTransfers(t, db.db, seed, 1)
+	tr := transfers[0]
+	tr.ChainID = 1
+	tr.From = from
+	tr.To = to
+	tr.Value = value
+	tr.Timestamp = timestamp
+	tr.Success = true
+	transfer.InsertTestTransferWithOptions(t, db.db, account, &tr, &transfer.TestTransferOptions{TokenAddress: testToken})
+}
+
+func TestAddRuleValidation(t *testing.T) {
+	db, stop := setupTestAlertsDB(t)
+	defer stop()
+
+	s := &Service{db: db}
+
+	testCases := []struct {
+		name string
+		rule Rule
+		err  error
+	}{
+		{"unknown type", Rule{Type: "price-sideways", Symbol: "SNT", Currency: "usd", Threshold: 1}, ErrUnknownRuleType},
+		{"price without currency", Rule{Type: RulePriceBelow, Symbol: "SNT", Threshold: 1}, ErrMissingCurrency},
+		{"change below -100%", Rule{Type: RulePriceChange, Symbol: "SNT", Currency: "usd", Threshold: -100}, ErrInvalidThreshold},
+		{"transfer without symbol", Rule{Type: RuleIncomingTransfer, Threshold: 1}, ErrMissingSymbol},
+		{"price change", Rule{Type: RulePriceChange, Symbol: "SNT", Currency: "usd", Threshold: -10}, nil},
+		{"outgoing transfer", Rule{Type: RuleOutgoingTransfer, Symbol: "SNT", Threshold: 100}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.AddRule(tc.rule)
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	db, stop := setupTestAlertsDB(t)
+	defer stop()
+
+	s := &Service{db: db}
+
+	rule, err := s.AddRule(Rule{Type: RulePriceChange, Symbol: " snt", Currency: "usd", Threshold: -10})
+	require.NoError(t, err)
+	require.NotEmpty(t, rule.ID)
+	require.Equal(t, "SNT", rule.Symbol)
+	require.Equal(t, "USD", rule.Currency)
+	require.True(t, rule.Enabled)
+
+	rules, err := s.GetRules()
+	require.NoError(t, err)
+	require.Equal(t, []*Rule{rule}, rules)
+
+	require.NoError(t, s.SetRuleEnabled(rule.ID, false))
+	rules, err = s.GetRules()
+	require.NoError(t, err)
+	require.False(t, rules[0].Enabled)
+
+	require.NoError(t, s.DeleteRule(rule.ID))
+	require.Equal(t, ErrRuleNotFound, s.DeleteRule(rule.ID))
+	require.Equal(t, ErrRuleNotFound, s.SetRuleEnabled(rule.ID, true))
+}
+
+func TestPriceRules(t *testing.T) {
+	db, stop := setupTestAlertsDB(t)
+	defer stop()
+
+	notifier := &testNotifier{}
+	s := &Service{db: db, tokenInfo: testTokenInfo, notify: notifier.notify}
+
+	below, err := s.AddRule(Rule{Type: RulePriceBelow, Symbol: "SNT", Currency: "USD", Threshold: 0.02})
+	require.NoError(t, err)
+	drop, err := s.AddRule(Rule{Type: RulePriceChange, Symbol: "SNT", Currency: "USD", Threshold: -10})
+	require.NoError(t, err)
+	_, err = s.AddRule(Rule{Type: RulePriceAbove, Symbol: "ETH", Currency: "EUR", Threshold: 2000})
+	require.NoError(t, err)
+
+	prices := func(snt float64) map[string]map[string]float64 {
+		return map[string]map[string]float64{"SNT": {"USD": snt}, "ETH": {"USD": 2500}}
+	}
+
+	// The first price is the reference of the price-change rule
+	s.checkPrices(prices(0.025))
+	require.Empty(t, notifier.notifications)
+
+	s.checkPrices(prices(0.023))
+	require.Empty(t, notifier.notifications)
+
+	// Dropped 12% from the reference, then below the threshold
+	s.checkPrices(prices(0.022))
+	s.checkPrices(prices(0.0199))
+	require.Len(t, notifier.notifications, 2)
+	require.Equal(t, "SNT dropped 12.0% to 0.022 USD", notifier.notifications[0].Message)
+	require.Equal(t, drop.ID, notifier.notifications[0].Body.(*Alert).RuleID)
+	require.Equal(t, localnotifications.CategoryWalletAlert, notifier.notifications[0].Category)
+	require.Equal(t, "SNT dropped to 0.0199 USD", notifier.notifications[1].Message)
+	require.Equal(t, below.ID, notifier.notifications[1].Body.(*Alert).RuleID)
+
+	// Below the threshold again only fires after the price went back above it
+	s.checkPrices(prices(0.0199))
+	require.Len(t, notifier.notifications, 2)
+	s.checkPrices(prices(0.0205))
+	s.checkPrices(prices(0.0199))
+	require.Len(t, notifier.notifications, 3)
+	require.Equal(t, below.ID, notifier.notifications[2].Body.(*Alert).RuleID)
+
+	rule, err := db.GetRule(drop.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0.022, rule.ReferencePrice)
+	require.NotZero(t, rule.LastFiredAt)
+}
+
+func TestTransferRules(t *testing.T) {
+	db, stop := setupTestAlertsDB(t)
+	defer stop()
+
+	notifier := &testNotifier{}
+	s := &Service{db: db, tokenInfo: testTokenInfo, notify: notifier.notify}
+
+	account := common.Address{1}
+	other := common.Address{2}
+	incoming, err := s.AddRule(Rule{Type: RuleIncomingTransfer, Symbol: "SNT", Threshold: 10, Account: &account})
+	require.NoError(t, err)
+	outgoing, err := s.AddRule(Rule{Type: RuleOutgoingTransfer, Symbol: "SNT", Threshold: 100})
+	require.NoError(t, err)
+	_, err = s.AddRule(Rule{Type: RuleIncomingTransfer, Symbol: "SNT", Threshold: 1, ChainID: 10})
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	// Transfers before the rules were added are not checked
+	insertTokenTransfer(t, db, account, other, account, 5000, now-100, 1)
+	insertTokenTransfer(t, db, account, other, account, 1000, now+1, 2)
+	insertTokenTransfer(t, db, account, other, account, 1050, now+2, 3)
+	insertTokenTransfer(t, db, account, account, other, 20000, now+3, 4)
+
+	s.checkTransfers([]common.Address{account})
+	require.Len(t, notifier.notifications, 2)
+	received := findNotification(t, notifier.notifications, incoming.ID)
+	require.Equal(t, "Received 10.5 SNT", received.Message)
+	require.Equal(t, "status-im://wallet/"+account.String(), received.Deeplink)
+	require.Equal(t, "Sent 200 SNT", findNotification(t, notifier.notifications, outgoing.ID).Message)
+
+	// Checked transfers don't fire again
+	s.checkTransfers([]common.Address{account})
+	require.Len(t, notifier.notifications, 2)
+
+	// Transfers of other accounts only fire the rules of all the accounts
+	insertTokenTransfer(t, db, other, account, other, 100000, now+4, 5)
+	insertTokenTransfer(t, db, other, other, account, 100000, now+5, 6)
+	s.checkTransfers([]common.Address{other})
+	require.Len(t, notifier.notifications, 3)
+	require.Equal(t, "Sent 1000 SNT", notifier.notifications[2].Message)
+	require.Equal(t, other, *notifier.notifications[2].Body.(*Alert).Account)
+}
+
+func findNotification(t *testing.T, notifications []*localnotifications.Notification, ruleID string) *localnotifications.Notification {
+	for _, n := range notifications {
+		if n.Body.(*Alert).RuleID == ruleID {
+			return n
+		}
+	}
+	require.FailNow(t, "no notification of rule", ruleID)
+	return nil
+}
+
+func TestEvents(t *testing.T) {
+	db, stop := setupTestAlertsDB(t)
+	defer stop()
+
+	notifier := &testNotifier{}
+	s := &Service{db: db, walletFeed: &event.Feed{}, prices: &testPrices{}, tokenInfo: testTokenInfo, notify: notifier.notify}
+
+	_, err := s.AddRule(Rule{Type: RulePriceAbove, Symbol: "SNT", Currency: "USD", Threshold: 0.03})
+	require.NoError(t, err)
+
+	s.Start()
+	defer s.Stop()
+
+	s.walletFeed.Send(walletevent.Event{Type: market.EventMarketPricesUpdated, Message: `{"SNT":{"USD":0.031}}`})
+	require.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(notifier.notifications) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -14,12 +14,21 @@ import (
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc/chain"
 	"github.com/status-im/status-go/rpc/network"
+	"github.com/status-im/status-go/services/typeddata"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/addressbook"
+	"github.com/status-im/status-go/services/wallet/alerts"
+	"github.com/status-im/status-go/services/wallet/approvals"
 	"github.com/status-im/status-go/services/wallet/bridge"
 	wcommon "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/currency"
 	"github.com/status-im/status-go/services/wallet/history"
+	"github.com/status-im/status-go/services/wallet/payments"
+	"github.com/status-im/status-go/services/wallet/portfolio"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/services/wallet/simulation"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
 	"github.com/status-im/status-go/services/wallet/token"
@@ -95,7 +104,12 @@ func (api *API) GetTransfersByAddress(ctx context.Context, address common.Addres
 	if limit != nil {
 		intLimit = limit.ToInt().Int64()
 	}
-	return api.s.transferController.GetTransfersByAddress(ctx, api.s.rpcClient.UpstreamChainID, address, hexBigToBN(toBlock), intLimit, fetchMore)
+	views, err := api.s.transferController.GetTransfersByAddress(ctx, api.s.rpcClient.UpstreamChainID, address, hexBigToBN(toBlock), intLimit, fetchMore)
+	if err != nil {
+		return nil, err
+	}
+	api.s.setTransferNames(views)
+	return views, nil
 }
 
 // LoadTransferByHash loads transfer to the database
@@ -107,13 +121,23 @@ func (api *API) LoadTransferByHash(ctx context.Context, address common.Address,
 
 func (api *API) GetTransfersByAddressAndChainID(ctx context.Context, chainID uint64, address common.Address, toBlock, limit *hexutil.Big, fetchMore bool) ([]transfer.View, error) {
 	log.Debug("[WalletAPI:: GetTransfersByAddressAndChainIDs] get transfers for an address", "address", address)
-	return api.s.transferController.GetTransfersByAddress(ctx, chainID, address, hexBigToBN(toBlock), limit.ToInt().Int64(), fetchMore)
+	views, err := api.s.transferController.GetTransfersByAddress(ctx, chainID, address, hexBigToBN(toBlock), limit.ToInt().Int64(), fetchMore)
+	if err != nil {
+		return nil, err
+	}
+	api.s.setTransferNames(views)
+	return views, nil
 }
 
 func (api *API) GetTransfersForIdentities(ctx context.Context, identities []transfer.TransactionIdentity) ([]transfer.View, error) {
 	log.Debug("wallet.api.GetTransfersForIdentities", "identities.len", len(identities))
 
-	return api.s.transferController.GetTransfersForIdentities(ctx, identities)
+	views, err := api.s.transferController.GetTransfersForIdentities(ctx, identities)
+	if err != nil {
+		return nil, err
+	}
+	api.s.setTransferNames(views)
+	return views, nil
 }
 
 func (api *API) FetchDecodedTxData(ctx context.Context, data string) (*thirdparty.DataParsed, error) {
@@ -210,6 +234,40 @@ func (api *API) DeleteCustomTokenByChainID(ctx context.Context, chainID uint64,
 	return err
 }
 
+// GetTokenLists returns the imported token lists with the chains they are enabled on
+func (api *API) GetTokenLists(ctx context.Context) ([]*token.TokenList, error) {
+	log.Debug("wallet.api.GetTokenLists")
+	return api.s.tokenManager.GetTokenLists()
+}
+
+// ImportTokenList imports a token list in the tokenlists.org format from an http(s) URL or a file path, or updates it when
+// it was already imported from there. The tokens whose address is already known from another source are returned
+func (api *API) ImportTokenList(ctx context.Context, source string) (*token.TokenListImport, error) {
+	log.Debug("wallet.api.ImportTokenList", "source", source)
+	return api.s.tokenManager.ImportTokenList(ctx, source)
+}
+
+// UpdateTokenList reads an imported token list again from its source
+func (api *API) UpdateTokenList(ctx context.Context, id string) (*token.TokenListImport, error) {
+	log.Debug("wallet.api.UpdateTokenList", "id", id)
+	return api.s.tokenManager.UpdateTokenList(ctx, id)
+}
+
+func (api *API) RemoveTokenList(ctx context.Context, id string) error {
+	log.Debug("wallet.api.RemoveTokenList", "id", id)
+	return api.s.tokenManager.RemoveTokenList(id)
+}
+
+func (api *API) SetTokenListEnabled(ctx context.Context, id string, chainID uint64, enabled bool) error {
+	log.Debug("wallet.api.SetTokenListEnabled", "id", id, "chainID", chainID, "enabled", enabled)
+	return api.s.tokenManager.SetTokenListEnabled(id, chainID, enabled)
+}
+
+func (api *API) GetTokenListCollisions(ctx context.Context, id string) ([]*token.TokenCollision, error) {
+	log.Debug("wallet.api.GetTokenListCollisions", "id", id)
+	return api.s.tokenManager.GetTokenListCollisions(id)
+}
+
 func (api *API) GetSavedAddresses(ctx context.Context) ([]SavedAddress, error) {
 	log.Debug("call to get saved addresses")
 	rst, err := api.s.savedAddressesManager.GetSavedAddresses()
@@ -231,6 +289,20 @@ func (api *API) DeleteSavedAddress(ctx context.Context, address common.Address,
 	return err
 }
 
+// ResolveAddresses returns the display names of addresses on chainID, from the saved addresses, the contacts,
+// the known contracts and the reverse ENS records
+func (api *API) ResolveAddresses(ctx context.Context, chainID uint64, addresses []common.Address) ([]*addressbook.Identity, error) {
+	log.Debug("wallet.api.ResolveAddresses", "chainID", chainID, "addresses.len", len(addresses))
+	return api.s.addressbook.ResolveAddresses(ctx, chainID, addresses)
+}
+
+// InvalidateAddressIdentities drops the cached ENS names of addresses, e.g. after the user changed a reverse record
+func (api *API) InvalidateAddressIdentities(ctx context.Context, addresses []common.Address) error {
+	log.Debug("wallet.api.InvalidateAddressIdentities", "addresses.len", len(addresses))
+	api.s.addressbook.Invalidate(addresses)
+	return nil
+}
+
 func (api *API) GetPendingTransactions(ctx context.Context) ([]*transactions.PendingTransaction, error) {
 	log.Debug("call to get pending transactions")
 	rst, err := api.s.pendingTxManager.GetAllPending([]uint64{api.s.rpcClient.UpstreamChainID})
@@ -284,7 +356,7 @@ func (api *API) WatchTransaction(ctx context.Context, transactionHash common.Has
 	if err != nil {
 		return err
 	}
-	return api.s.pendingTxManager.Watch(ctx, transactionHash, chainClient)
+	return api.s.transactionManager.WatchTransaction(ctx, chainClient, transactionHash)
 }
 
 func (api *API) WatchTransactionByChainID(ctx context.Context, chainID uint64, transactionHash common.Hash) error {
@@ -292,7 +364,27 @@ func (api *API) WatchTransactionByChainID(ctx context.Context, chainID uint64, t
 	if err != nil {
 		return err
 	}
-	return api.s.pendingTxManager.Watch(ctx, transactionHash, chainClient)
+	return api.s.transactionManager.WatchTransaction(ctx, chainClient, transactionHash)
+}
+
+// SpeedUpTransaction replaces a pending transaction with the same transaction paying higher fees
+func (api *API) SpeedUpTransaction(ctx context.Context, command *transfer.ReplaceTransactionCommand, password string) (types.Hash, error) {
+	log.Debug("[WalletAPI:: SpeedUpTransaction] replacing transaction", "chainID", command.ChainID, "hash", command.Hash)
+	chainClient, err := api.s.rpcClient.EthClient(command.ChainID)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return api.s.transactionManager.SpeedUpTransaction(ctx, chainClient, command, password)
+}
+
+// CancelTransaction replaces a pending transaction with a zero-value transaction to self paying higher fees
+func (api *API) CancelTransaction(ctx context.Context, command *transfer.ReplaceTransactionCommand, password string) (types.Hash, error) {
+	log.Debug("[WalletAPI:: CancelTransaction] replacing transaction", "chainID", command.ChainID, "hash", command.Hash)
+	chainClient, err := api.s.rpcClient.EthClient(command.ChainID)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return api.s.transactionManager.CancelTransaction(ctx, chainClient, command, password)
 }
 
 func (api *API) GetCryptoOnRamps(ctx context.Context) ([]CryptoOnRamp, error) {
@@ -343,6 +435,11 @@ func (api *API) FetchBalancesByOwnerAndContractAddress(chainID uint64, ownerAddr
 	return api.s.collectiblesManager.FetchBalancesByOwnerAndContractAddress(chainID, ownerAddress, contractAddresses)
 }
 
+func (api *API) FetchERC1155BalancesByOwner(chainID uint64, ownerAddress common.Address, contractAddresses []common.Address) (thirdparty.TokenBalancesPerContractAddress, error) {
+	log.Debug("call to FetchERC1155BalancesByOwner")
+	return api.s.collectiblesManager.FetchERC1155BalancesByOwner(chainID, ownerAddress, contractAddresses)
+}
+
 func (api *API) AddEthereumChain(ctx context.Context, network params.Network) error {
 	log.Debug("call to AddEthereumChain")
 	return api.s.rpcClient.NetworkManager.Upsert(&network)
@@ -358,6 +455,16 @@ func (api *API) GetEthereumChains(ctx context.Context) ([]*network.CombinedNetwo
 	return api.s.rpcClient.NetworkManager.GetCombinedNetworks()
 }
 
+// GetRPCProvidersStatus returns the health of the RPC providers of a chain, best first
+func (api *API) GetRPCProvidersStatus(ctx context.Context, chainID uint64) (chain.ProvidersStatus, error) {
+	log.Debug("call to GetRPCProvidersStatus")
+	client, err := api.s.rpcClient.EthClient(chainID)
+	if err != nil {
+		return chain.ProvidersStatus{}, err
+	}
+	return client.ProvidersStatus(), nil
+}
+
 func (api *API) FetchPrices(ctx context.Context, symbols []string, currencies []string) (map[string]map[string]float64, error) {
 	log.Debug("call to FetchPrices")
 	return api.s.marketManager.FetchPrices(symbols, currencies)
@@ -500,6 +607,220 @@ func (api *API) CreateMultiTransaction(ctx context.Context, multiTransactionComm
 	return api.s.transactionManager.CreateMultiTransactionFromCommand(ctx, multiTransactionCommand, data, api.router.bridges, password)
 }
 
+// GetApprovals returns the known ERC-20 approvals given by owners on the given chains, all of them if empty
+func (api *API) GetApprovals(ctx context.Context, owners []common.Address, chainIDs []uint64) ([]*approvals.Approval, error) {
+	log.Debug("wallet.api.GetApprovals", "owners.count", len(owners), "chainIDs", chainIDs)
+	return api.s.approvals.GetApprovals(owners, chainIDs)
+}
+
+// RefreshApprovals looks for new approvals given by owners and checks the allowances of the known ones
+func (api *API) RefreshApprovals(ctx context.Context, owners []common.Address, chainIDs []uint64) ([]*approvals.Approval, error) {
+	log.Debug("wallet.api.RefreshApprovals", "owners.count", len(owners), "chainIDs", chainIDs)
+	return api.s.approvals.RefreshApprovals(ctx, owners, chainIDs)
+}
+
+// RevokeApprovals sets the allowances of token given by owner to spenders to zero, in a single multi-transaction
+func (api *API) RevokeApprovals(ctx context.Context, chainID uint64, owner common.Address, token common.Address, spenders []common.Address,
+	password string) (*transfer.MultiTransactionCommandResult, error) {
+	log.Debug("wallet.api.RevokeApprovals", "chainID", chainID, "owner", owner, "token", token, "spenders.count", len(spenders))
+
+	command, data, err := api.s.approvals.BuildRevokeTransactions(owner, chainID, token, spenders)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.transactionManager.CreateMultiTransactionFromCommand(ctx, command, data, api.router.bridges, password)
+}
+
+// PreviewTransaction simulates a transaction before it is signed and returns its expected outcome:
+// the decoded calldata, the balance changes and approvals of the sender and the revert reason if it would fail
+func (api *API) PreviewTransaction(ctx context.Context, chainID uint64, args transactions.SendTxArgs) (*simulation.Preview, error) {
+	log.Debug("wallet.api.PreviewTransaction", "chainID", chainID, "from", args.From)
+	return api.s.simulation.PreviewTransaction(ctx, chainID, args)
+}
+
+// PreviewMultiTransaction simulates the transactions CreateMultiTransaction would send
+func (api *API) PreviewMultiTransaction(ctx context.Context, data []*bridge.TransactionBridge) ([]*simulation.Preview, error) {
+	log.Debug("wallet.api.PreviewMultiTransaction", "data.count", len(data))
+	return api.s.simulation.PreviewMultiTransaction(ctx, data)
+}
+
+// GetPortfolioProfitAndLoss returns the cost basis, realised and unrealised profit and loss of the tokens of accounts on the
+// given chains, valued in currency. method is "fifo" or "average", "fifo" if empty
+func (api *API) GetPortfolioProfitAndLoss(ctx context.Context, accounts []common.Address, chainIDs []uint64, currency string,
+	method portfolio.CostBasisMethod) ([]*portfolio.TokenProfitAndLoss, error) {
+	log.Debug("wallet.api.GetPortfolioProfitAndLoss", "accounts.count", len(accounts), "chainIDs", chainIDs, "currency", currency, "method", method)
+	return api.s.portfolio.GetProfitAndLoss(ctx, accounts, chainIDs, currency, method)
+}
+
+// GetPortfolioLots returns the cost basis lots of account on chainID, as of the last profit and loss computation
+func (api *API) GetPortfolioLots(ctx context.Context, chainID uint64, account common.Address) ([]*portfolio.Lot, error) {
+	log.Debug("wallet.api.GetPortfolioLots", "chainID", chainID, "account", account)
+	return api.s.portfolio.GetLots(chainID, account)
+}
+
+// GetAlertRules returns the price and transfer alert rules
+func (api *API) GetAlertRules(ctx context.Context) ([]*alerts.Rule, error) {
+	log.Debug("wallet.api.GetAlertRules")
+	return api.s.alerts.GetRules()
+}
+
+// AddAlertRule stores an enabled alert rule, notified through local notifications when it fires
+func (api *API) AddAlertRule(ctx context.Context, rule alerts.Rule) (*alerts.Rule, error) {
+	log.Debug("wallet.api.AddAlertRule", "type", rule.Type, "symbol", rule.Symbol)
+	return api.s.alerts.AddRule(rule)
+}
+
+func (api *API) SetAlertRuleEnabled(ctx context.Context, id string, enabled bool) error {
+	log.Debug("wallet.api.SetAlertRuleEnabled", "id", id, "enabled", enabled)
+	return api.s.alerts.SetRuleEnabled(id, enabled)
+}
+
+func (api *API) DeleteAlertRule(ctx context.Context, id string) error {
+	log.Debug("wallet.api.DeleteAlertRule", "id", id)
+	return api.s.alerts.DeleteRule(id)
+}
+
+// CreatePaymentRequest creates a request to pay an account of the user, its URI can be shared or shown as QR code
+func (api *API) CreatePaymentRequest(ctx context.Context, args payments.CreateRequestArgs) (*payments.PaymentRequest, error) {
+	log.Debug("wallet.api.CreatePaymentRequest", "chainID", args.ChainID, "symbol", args.Symbol)
+	return api.s.payments.CreateRequest(args)
+}
+
+// ParsePaymentRequest parses an EIP-681 payment request URI without storing it
+func (api *API) ParsePaymentRequest(ctx context.Context, uri string) (*payments.PaymentRequest, error) {
+	log.Debug("wallet.api.ParsePaymentRequest")
+	return api.s.payments.ParseRequest(uri)
+}
+
+// AddPaymentRequest stores the request of an EIP-681 URI to be paid by the user, it is marked paid once paid
+func (api *API) AddPaymentRequest(ctx context.Context, uri string) (*payments.PaymentRequest, error) {
+	log.Debug("wallet.api.AddPaymentRequest")
+	return api.s.payments.AddRequest(uri)
+}
+
+func (api *API) GetPaymentRequests(ctx context.Context) ([]*payments.PaymentRequest, error) {
+	log.Debug("wallet.api.GetPaymentRequests")
+	return api.s.payments.GetRequests()
+}
+
+func (api *API) CancelPaymentRequest(ctx context.Context, id string) error {
+	log.Debug("wallet.api.CancelPaymentRequest", "id", id)
+	return api.s.payments.CancelRequest(id)
+}
+
+func (api *API) DeletePaymentRequest(ctx context.Context, id string) error {
+	log.Debug("wallet.api.DeletePaymentRequest", "id", id)
+	return api.s.payments.DeleteRequest(id)
+}
+
+// GetPaymentRequestSuggestedRoutes returns the routes to pay the request with id from account, to its chain only.
+// amountIn is only used when the request leaves the amount to the payer
+func (api *API) GetPaymentRequestSuggestedRoutes(ctx context.Context, id string, account common.Address, amountIn *hexutil.Big,
+	gasFeeMode GasFeeMode) (*SuggestedRoutes, error) {
+	log.Debug("wallet.api.GetPaymentRequestSuggestedRoutes", "id", id)
+	request, err := api.s.payments.GetRequest(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != payments.StatusPending {
+		return nil, payments.ErrPaymentRequestNotPending
+	}
+	if request.Amount != nil {
+		amountIn = request.Amount
+	}
+	if amountIn == nil {
+		return nil, payments.ErrPaymentAmountRequired
+	}
+
+	networks, err := api.s.rpcClient.NetworkManager.Get(false)
+	if err != nil {
+		return nil, err
+	}
+	var disabledToChainIDs []uint64
+	for _, network := range networks {
+		if network.ChainID != request.ChainID {
+			disabledToChainIDs = append(disabledToChainIDs, network.ChainID)
+		}
+	}
+	return api.router.suggestedRoutes(ctx, Transfer, account, amountIn.ToInt(), request.Symbol, nil, disabledToChainIDs,
+		[]uint64{request.ChainID}, gasFeeMode, nil)
+}
+
+// AddSafe registers the Safe multisig at address on chainID, which must already be a watch-only account
+func (api *API) AddSafe(ctx context.Context, chainID uint64, address common.Address) (*safe.Safe, error) {
+	log.Debug("wallet.api.AddSafe", "chainID", chainID, "address", address)
+	return api.s.safe.AddSafe(ctx, chainID, address)
+}
+
+func (api *API) GetSafes(ctx context.Context) ([]*safe.Safe, error) {
+	log.Debug("wallet.api.GetSafes")
+	return api.s.safe.GetSafes()
+}
+
+// RefreshSafe reads the owners, threshold and nonce of a Safe from chain
+func (api *API) RefreshSafe(ctx context.Context, chainID uint64, address common.Address) (*safe.Safe, error) {
+	log.Debug("wallet.api.RefreshSafe", "chainID", chainID, "address", address)
+	return api.s.safe.RefreshSafe(ctx, chainID, address)
+}
+
+// RemoveSafe forgets a Safe and its transactions, the watch-only account is kept
+func (api *API) RemoveSafe(ctx context.Context, chainID uint64, address common.Address) error {
+	log.Debug("wallet.api.RemoveSafe", "chainID", chainID, "address", address)
+	return api.s.safe.RemoveSafe(chainID, address)
+}
+
+// ProposeSafeTransaction builds a transaction of a Safe to be signed by its owners
+func (api *API) ProposeSafeTransaction(ctx context.Context, chainID uint64, address common.Address, args safe.TransactionArgs) (*safe.Transaction, error) {
+	log.Debug("wallet.api.ProposeSafeTransaction", "chainID", chainID, "address", address, "to", args.To)
+	return api.s.safe.ProposeTransaction(ctx, chainID, address, args)
+}
+
+// GetSafeTransactions returns the transactions of a Safe which were not executed yet, with their signatures
+func (api *API) GetSafeTransactions(ctx context.Context, chainID uint64, address common.Address) ([]*safe.Transaction, error) {
+	log.Debug("wallet.api.GetSafeTransactions", "chainID", chainID, "address", address)
+	return api.s.safe.GetPendingTransactions(ctx, chainID, address)
+}
+
+// GetSafeTransactionTypedData returns the EIP-712 typed data owners sign to approve a Safe transaction
+func (api *API) GetSafeTransactionTypedData(ctx context.Context, safeTxHash common.Hash) (*typeddata.TypedData, error) {
+	log.Debug("wallet.api.GetSafeTransactionTypedData", "safeTxHash", safeTxHash)
+	return api.s.safe.GetTransactionTypedData(safeTxHash)
+}
+
+// SignSafeTransaction signs a Safe transaction with owner, an account of the user
+func (api *API) SignSafeTransaction(ctx context.Context, safeTxHash common.Hash, owner common.Address, password string) (*safe.Transaction, error) {
+	log.Debug("wallet.api.SignSafeTransaction", "safeTxHash", safeTxHash, "owner", owner)
+	return api.s.safe.SignTransaction(safeTxHash, owner, password)
+}
+
+// AddSafeTransactionSignature adds the EIP-712 signature of a Safe transaction by an owner who signed elsewhere
+func (api *API) AddSafeTransactionSignature(ctx context.Context, safeTxHash common.Hash, signature hexutil.Bytes) (*safe.Transaction, error) {
+	log.Debug("wallet.api.AddSafeTransactionSignature", "safeTxHash", safeTxHash)
+	return api.s.safe.AddSignature(safeTxHash, signature)
+}
+
+// ExecuteSafeTransaction sends the execTransaction call of a Safe transaction with enough signatures from executor,
+// which can be any account of the user. It can be sent again until the nonce of the Safe moved past the transaction
+func (api *API) ExecuteSafeTransaction(ctx context.Context, safeTxHash common.Hash, executor common.Address,
+	password string) (*transfer.MultiTransactionCommandResult, error) {
+	log.Debug("wallet.api.ExecuteSafeTransaction", "safeTxHash", safeTxHash, "executor", executor)
+
+	command, data, err := api.s.safe.BuildExecuteTransaction(ctx, safeTxHash, executor)
+	if err != nil {
+		return nil, err
+	}
+	result, err := api.s.transactionManager.CreateMultiTransactionFromCommand(ctx, command, data, api.router.bridges, password)
+	if err != nil {
+		return nil, err
+	}
+	if hashes := result.Hashes[data[0].ChainID]; len(hashes) > 0 {
+		if err := api.s.safe.SetSubmitted(safeTxHash, common.Hash(hashes[0])); err != nil {
+			log.Error("failed to record the Safe transaction execution", "safeTxHash", safeTxHash, "err", err)
+		}
+	}
+	return result, nil
+}
+
 func (api *API) GetMultiTransactions(ctx context.Context, transactionIDs []transfer.MultiTransactionIDType) ([]*transfer.MultiTransaction, error) {
 	log.Debug("wallet.api.GetMultiTransactions", "IDs.len", len(transactionIDs))
 	return api.s.transactionManager.GetMultiTransactions(ctx, transactionIDs)
@@ -522,6 +843,16 @@ func (api *API) FilterActivityAsync(ctx context.Context, addresses []common.Addr
 	return nil
 }
 
+// ExportActivityAsync exports the activity matching the filter to the file at path, in the "csv" or "json" format.
+// Fiat values are computed in currency
+func (api *API) ExportActivityAsync(ctx context.Context, addresses []common.Address, chainIDs []wcommon.ChainID, filter activity.Filter,
+	format string, currency string, path string) (ignored bool, err error) {
+	log.Debug("wallet.api.ExportActivityAsync", "addr.count", len(addresses), "chainIDs.count", len(chainIDs), "format", format, "currency", currency)
+
+	ignored = api.s.activity.ExportActivityAsync(ctx, addresses, chainIDs, filter, format, currency, path)
+	return ignored, err
+}
+
 func (api *API) GetRecipientsAsync(ctx context.Context, offset int, limit int) (ignored bool, err error) {
 	log.Debug("wallet.api.GetRecipientsAsync", "offset", offset, "limit", limit)
 
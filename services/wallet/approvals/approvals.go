@@ -0,0 +1,83 @@
+package approvals
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/contracts/ierc20"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+// Approval (index_topic_1 address owner, index_topic_2 address spender, uint256 value)
+const erc20ApprovalEventSignature = "Approval(address,address,uint256)"
+
+// ERC-721 approvals share the signature, but also index the token id
+const erc20ApprovalEventIndexedParameters = 3 // signature, owner, spender
+
+var approvalEventSignatureHash = w_common.GetEventSignatureHash(erc20ApprovalEventSignature)
+
+// Approval is an allowance granted by an account to a spender, allowing it to move the account's tokens
+type Approval struct {
+	ChainID     uint64         `json:"chainId"`
+	Owner       common.Address `json:"owner"`
+	Token       common.Address `json:"token"`
+	Spender     common.Address `json:"spender"`
+	Allowance   *hexutil.Big   `json:"allowance"`
+	Unlimited   bool           `json:"unlimited"`
+	SpenderName string         `json:"spenderName,omitempty"`
+	IsContract  bool           `json:"isContract"`
+	// BlockNumber is the block of the latest Approval log of the grant, 0 if unknown
+	BlockNumber uint64 `json:"blockNumber"`
+	UpdatedAt   int64  `json:"updatedAt"`
+}
+
+type tokenSpender struct {
+	token   common.Address
+	spender common.Address
+}
+
+// unlimitedAllowanceThreshold is the allowance above which an approval is considered unlimited.
+// Wallets and dapps usually approve 2^256-1, some tokens lower it when spending, so half of it is used
+var unlimitedAllowanceThreshold = new(big.Int).Lsh(big.NewInt(1), 255)
+
+// IsUnlimited returns whether an allowance is considered unlimited
+func IsUnlimited(allowance *big.Int) bool {
+	return allowance.Cmp(unlimitedAllowanceThreshold) >= 0
+}
+
+// knownSpenders names widely used contracts which ask for approvals, on all chains they are deployed at
+var knownSpenders = map[common.Address]string{
+	common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"): "Uniswap Permit2",
+	common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"): "Uniswap V2 Router",
+	common.HexToAddress("0xE592427A0AEce92De3Edee1F18E0157C05861564"): "Uniswap V3 Router",
+	common.HexToAddress("0x68b3465833fb72A70ecDF485E0e4C7bD8665Fc45"): "Uniswap V3 Router 2",
+	common.HexToAddress("0xEf1c6E67703c7BD7107eed8303Fbe6EC2554BF6B"): "Uniswap Universal Router",
+	common.HexToAddress("0x1111111254EEB25477B68fb85Ed929f73A960582"): "1inch Router",
+	common.HexToAddress("0xDef1C0ded9bec7F1a1670819833240f027b25EfF"): "0x Exchange Proxy",
+}
+
+// approvalFromLog returns the token, owner and spender of an ERC-20 Approval log
+func approvalFromLog(l types.Log) (token, owner, spender common.Address, ok bool) {
+	if l.Removed || len(l.Topics) != erc20ApprovalEventIndexedParameters || l.Topics[0] != approvalEventSignatureHash {
+		return token, owner, spender, false
+	}
+	return l.Address, common.BytesToAddress(l.Topics[1].Bytes()), common.BytesToAddress(l.Topics[2].Bytes()), true
+}
+
+func approvalLogsTopics(owner common.Address) [][]common.Hash {
+	return [][]common.Hash{{approvalEventSignatureHash}, {common.BytesToHash(owner.Bytes())}}
+}
+
+// revokeData is the input of the transaction setting the allowance of spender to zero
+func revokeData(spender common.Address) ([]byte, error) {
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return erc20ABI.Pack("approve", spender, big.NewInt(0))
+}
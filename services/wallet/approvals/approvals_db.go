@@ -0,0 +1,154 @@
+package approvals
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+type DB struct {
+	db *sql.DB
+}
+
+func NewDB(sqlDb *sql.DB) *DB {
+	return &DB{
+		db: sqlDb,
+	}
+}
+
+const selectApprovalColumns = `chain_id, owner, token_address, spender, allowance, spender_name, spender_is_contract, block_number, updated_at`
+
+// GetApprovals returns the approvals of owners on the given chains, all of them if empty
+func (adb *DB) GetApprovals(owners []common.Address, chainIDs []uint64) ([]*Approval, error) {
+	query := fmt.Sprintf("SELECT %s FROM token_approvals WHERE 1", selectApprovalColumns)
+	var args []interface{}
+	if len(owners) > 0 {
+		query += fmt.Sprintf(" AND owner IN (?%s)", strings.Repeat(",?", len(owners)-1))
+		for _, owner := range owners {
+			args = append(args, owner)
+		}
+	}
+	if len(chainIDs) > 0 {
+		query += fmt.Sprintf(" AND chain_id IN (?%s)", strings.Repeat(",?", len(chainIDs)-1))
+		for _, chainID := range chainIDs {
+			args = append(args, chainID)
+		}
+	}
+	query += " ORDER BY chain_id, owner, block_number DESC"
+
+	rows, err := adb.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var approvals []*Approval
+	for rows.Next() {
+		approval := &Approval{}
+		allowance := new(big.Int)
+		err := rows.Scan(&approval.ChainID, &approval.Owner, &approval.Token, &approval.Spender, (*bigint.SQLBigIntBytes)(allowance),
+			&approval.SpenderName, &approval.IsContract, &approval.BlockNumber, &approval.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		approval.Allowance = (*hexutil.Big)(allowance)
+		approval.Unlimited = IsUnlimited(allowance)
+		approvals = append(approvals, approval)
+	}
+	return approvals, rows.Err()
+}
+
+// SaveApproval inserts or updates an approval. Approvals with a zero allowance are deleted
+func (adb *DB) SaveApproval(approval *Approval) error {
+	if approval.Allowance == nil || approval.Allowance.ToInt().Sign() == 0 {
+		return adb.DeleteApproval(approval.ChainID, approval.Owner, approval.Token, approval.Spender)
+	}
+
+	_, err := adb.db.Exec(fmt.Sprintf(`INSERT OR REPLACE INTO token_approvals (%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, selectApprovalColumns),
+		approval.ChainID, approval.Owner, approval.Token, approval.Spender, (*bigint.SQLBigIntBytes)(approval.Allowance.ToInt()),
+		approval.SpenderName, approval.IsContract, approval.BlockNumber, approval.UpdatedAt)
+	return err
+}
+
+func (adb *DB) DeleteApproval(chainID uint64, owner, token, spender common.Address) error {
+	_, err := adb.db.Exec(`DELETE FROM token_approvals WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ?`,
+		chainID, owner, token, spender)
+	return err
+}
+
+// GetLastScannedBlock returns the last block scanned for Approval logs of owner, nil if never scanned
+func (adb *DB) GetLastScannedBlock(chainID uint64, owner common.Address) (*big.Int, error) {
+	var block int64
+	err := adb.db.QueryRow(`SELECT block_number FROM token_approvals_scans WHERE chain_id = ? AND owner = ?`, chainID, owner).Scan(&block)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return big.NewInt(block), nil
+}
+
+func (adb *DB) SetLastScannedBlock(chainID uint64, owner common.Address, block *big.Int) error {
+	_, err := adb.db.Exec(`INSERT OR REPLACE INTO token_approvals_scans (chain_id, owner, block_number) VALUES (?, ?, ?)`,
+		chainID, owner, block.Int64())
+	return err
+}
+
+// GetFirstTransferBlock returns the block of the first known transfer of owner, nil if there is none.
+// Approving needs gas, so there are no approvals before the account received funds
+func (adb *DB) GetFirstTransferBlock(chainID uint64, owner common.Address) (*big.Int, error) {
+	var block sql.NullInt64
+	err := adb.db.QueryRow(`SELECT MIN(blk_number) FROM transfers WHERE network_id = ? AND address = ?`, chainID, owner).Scan(&block)
+	if err != nil || !block.Valid {
+		return nil, err
+	}
+	return big.NewInt(block.Int64), nil
+}
+
+// GetSpenderCandidates returns the token and contract pairs of the ERC-20 transfers out of owner which were
+// made by a transaction owner sent to another contract than the token, such contracts move tokens using an allowance
+func (adb *DB) GetSpenderCandidates(chainID uint64, owner common.Address) ([]tokenSpender, error) {
+	rows, err := adb.db.Query(`
+		SELECT token_address, tx FROM transfers
+		WHERE network_id = ? AND address = ? AND type = 'erc20' AND tx_from_address = ? AND sender = ?
+			AND token_address IS NOT NULL AND tx IS NOT NULL`,
+		chainID, owner, owner, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []tokenSpender
+	found := make(map[tokenSpender]bool)
+	for rows.Next() {
+		var token common.Address
+		var txJSON []byte
+		if err := rows.Scan(&token, &txJSON); err != nil {
+			return nil, err
+		}
+
+		tx := &types.Transaction{}
+		if err := json.Unmarshal(txJSON, tx); err != nil {
+			return nil, err
+		}
+		if tx.To() == nil || *tx.To() == token {
+			continue
+		}
+
+		candidate := tokenSpender{token, *tx.To()}
+		if !found[candidate] {
+			found[candidate] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, rows.Err()
+}
@@ -0,0 +1,123 @@
+package approvals
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/transfer"
+)
+
+func setupTestApprovalsDB(t *testing.T) (*DB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-approvals-db-tests")
+	require.NoError(t, err)
+	return NewDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestApprovals(t *testing.T) {
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	approval := &Approval{
+		ChainID:     1,
+		Owner:       common.Address{1},
+		Token:       common.Address{2},
+		Spender:     common.Address{3},
+		Allowance:   (*hexutil.Big)(big.NewInt(1000)),
+		SpenderName: "Spender",
+		IsContract:  true,
+		BlockNumber: 10,
+		UpdatedAt:   20,
+	}
+	require.NoError(t, db.SaveApproval(approval))
+
+	other := *approval
+	other.ChainID = 5
+	require.NoError(t, db.SaveApproval(&other))
+
+	rst, err := db.GetApprovals([]common.Address{{1}}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, rst, 1)
+	require.Equal(t, approval, rst[0])
+
+	rst, err = db.GetApprovals(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, rst, 2)
+
+	rst, err = db.GetApprovals([]common.Address{{2}}, nil)
+	require.NoError(t, err)
+	require.Len(t, rst, 0)
+
+	// Zero allowances are deleted
+	approval.Allowance = (*hexutil.Big)(big.NewInt(0))
+	require.NoError(t, db.SaveApproval(approval))
+	rst, err = db.GetApprovals(nil, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, rst, 0)
+}
+
+func TestLastScannedBlock(t *testing.T) {
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	block, err := db.GetLastScannedBlock(1, common.Address{1})
+	require.NoError(t, err)
+	require.Nil(t, block)
+
+	require.NoError(t, db.SetLastScannedBlock(1, common.Address{1}, big.NewInt(100)))
+	block, err = db.GetLastScannedBlock(1, common.Address{1})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), block)
+}
+
+func TestSpenderCandidates(t *testing.T) {
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	owner := common.Address{1}
+	token := common.Address{2}
+	router := common.Address{3}
+
+	trs, _, _ := transfer.GenerateTestTransfers(t, db.db, 1, 4)
+	for i := range trs {
+		trs[i].ChainID = 1
+		trs[i].From = owner
+		trs[i].To = owner
+		transfer.InsertTestTransferWithOptions(t, db.db, owner, &trs[i], &transfer.TestTransferOptions{TokenAddress: token})
+	}
+	setTransaction := func(hash common.Hash, to common.Address) {
+		tx, err := json.Marshal(types.NewTransaction(0, to, big.NewInt(0), 0, big.NewInt(0), nil))
+		require.NoError(t, err)
+		_, err = db.db.Exec("UPDATE transfers SET tx = ? WHERE hash = ?", tx, hash)
+		require.NoError(t, err)
+	}
+	// Tokens moved by a contract called by the owner
+	setTransaction(trs[0].Hash, router)
+	// Tokens sent directly
+	setTransaction(trs[1].Hash, token)
+	// Tokens paid to someone, the recipient of the transfer is not a spender
+	setTransaction(trs[2].Hash, token)
+	_, err := db.db.Exec("UPDATE transfers SET tx_to_address = ? WHERE hash = ?", common.Address{4}, trs[2].Hash)
+	require.NoError(t, err)
+	// Tokens moved by a contract called by someone else
+	setTransaction(trs[3].Hash, common.Address{5})
+	_, err = db.db.Exec("UPDATE transfers SET sender = ? WHERE hash = ?", common.Address{6}, trs[3].Hash)
+	require.NoError(t, err)
+
+	block, err := db.GetFirstTransferBlock(1, owner)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(trs[0].BlkNumber), block)
+
+	candidates, err := db.GetSpenderCandidates(1, owner)
+	require.NoError(t, err)
+	require.Equal(t, []tokenSpender{{token, router}}, candidates)
+}
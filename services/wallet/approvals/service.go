@@ -0,0 +1,296 @@
+package approvals
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/ierc20"
+	gethtypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventApprovalsUpdated is sent with the refreshed approvals of the accounts
+	EventApprovalsUpdated walletevent.EventType = "wallet-approvals-updated"
+
+	// approvalLogsBlockRange is the number of blocks Approval logs are requested for at once
+	approvalLogsBlockRange = 100000
+
+	// approvalLogsMaxRangesPerRefresh bounds the blocks scanned by a refresh, the next
+	// refreshes resume the scan from the last block scanned
+	approvalLogsMaxRangesPerRefresh = 10
+)
+
+var ErrNoApprovalsToRevoke = errors.New("no approvals to revoke")
+
+// chainClient is the subset of the chain client used to find and check approvals
+type chainClient interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+type Service struct {
+	db           *DB
+	tokenManager *token.Manager
+	walletFeed   *event.Feed
+	client       func(chainID uint64) (chainClient, error)
+}
+
+func NewService(db *sql.DB, rpcClient *rpc.Client, tokenManager *token.Manager, walletFeed *event.Feed) *Service {
+	return &Service{
+		db:           NewDB(db),
+		tokenManager: tokenManager,
+		walletFeed:   walletFeed,
+		client: func(chainID uint64) (chainClient, error) {
+			return rpcClient.EthClient(chainID)
+		},
+	}
+}
+
+// GetApprovals returns the stored approvals of owners on the given chains, all of them if empty
+func (s *Service) GetApprovals(owners []common.Address, chainIDs []uint64) ([]*Approval, error) {
+	return s.db.GetApprovals(owners, chainIDs)
+}
+
+// RefreshApprovals looks for new Approval logs of owners, checks the current allowance of all the
+// known and candidate spenders and stores the live approvals. Long histories are scanned over several refreshes
+func (s *Service) RefreshApprovals(ctx context.Context, owners []common.Address, chainIDs []uint64) ([]*Approval, error) {
+	for _, chainID := range chainIDs {
+		client, err := s.client(chainID)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range owners {
+			if err := s.refresh(ctx, client, chainID, owner); err != nil {
+				log.Error("failed to refresh approvals", "chainID", chainID, "owner", owner, "err", err)
+				return nil, err
+			}
+		}
+	}
+
+	approvals, err := s.db.GetApprovals(owners, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(approvals)
+	if err != nil {
+		return nil, err
+	}
+	s.walletFeed.Send(walletevent.Event{
+		Type:     EventApprovalsUpdated,
+		Accounts: owners,
+		Message:  string(payload),
+		At:       time.Now().Unix(),
+	})
+
+	return approvals, nil
+}
+
+func (s *Service) refresh(ctx context.Context, client chainClient, chainID uint64, owner common.Address) error {
+	// Latest Approval block of each grant, 0 if unknown
+	candidates := make(map[tokenSpender]uint64)
+
+	stored, err := s.db.GetApprovals([]common.Address{owner}, []uint64{chainID})
+	if err != nil {
+		return err
+	}
+	for _, approval := range stored {
+		candidates[tokenSpender{approval.Token, approval.Spender}] = approval.BlockNumber
+	}
+
+	fromTransfers, err := s.db.GetSpenderCandidates(chainID, owner)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range fromTransfers {
+		if _, ok := candidates[candidate]; !ok {
+			candidates[candidate] = 0
+		}
+	}
+
+	latest, err := s.scanApprovalLogs(ctx, client, chainID, owner, candidates)
+	if err != nil {
+		return err
+	}
+
+	isContract := make(map[common.Address]bool)
+	now := time.Now().Unix()
+	for candidate, block := range candidates {
+		allowance, err := allowance(ctx, client, candidate.token, owner, candidate.spender)
+		if err != nil {
+			return err
+		}
+
+		contract, ok := isContract[candidate.spender]
+		if !ok && allowance.Sign() > 0 {
+			code, err := client.CodeAt(ctx, candidate.spender, nil)
+			if err != nil {
+				return err
+			}
+			contract = len(code) > 0
+			isContract[candidate.spender] = contract
+		}
+
+		err = s.db.SaveApproval(&Approval{
+			ChainID:     chainID,
+			Owner:       owner,
+			Token:       candidate.token,
+			Spender:     candidate.spender,
+			Allowance:   (*hexutil.Big)(allowance),
+			SpenderName: knownSpenders[candidate.spender],
+			IsContract:  contract,
+			BlockNumber: block,
+			UpdatedAt:   now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if latest != nil {
+		return s.db.SetLastScannedBlock(chainID, owner, latest)
+	}
+	return nil
+}
+
+// scanApprovalLogs adds the grants found in the Approval logs of owner since the last scan to candidates,
+// scanning up to approvalLogsMaxRangesPerRefresh ranges of blocks.
+// It returns the last block scanned, nil if there was nothing to scan
+func (s *Service) scanApprovalLogs(ctx context.Context, client chainClient, chainID uint64, owner common.Address,
+	candidates map[tokenSpender]uint64) (*big.Int, error) {
+	from, err := s.db.GetLastScannedBlock(chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil {
+		from.Add(from, big.NewInt(1))
+	} else {
+		from, err = s.db.GetFirstTransferBlock(chainID, owner)
+		if err != nil || from == nil {
+			return nil, err
+		}
+	}
+
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head := new(big.Int).SetUint64(blockNumber)
+
+	var latest *big.Int
+	for i := 0; i < approvalLogsMaxRangesPerRefresh && from.Cmp(head) <= 0; i++ {
+		to := new(big.Int).Add(from, big.NewInt(approvalLogsBlockRange-1))
+		if to.Cmp(head) > 0 {
+			to.Set(head)
+		}
+
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: from,
+			ToBlock:   to,
+			Topics:    approvalLogsTopics(owner),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			token, logOwner, spender, ok := approvalFromLog(l)
+			if !ok || logOwner != owner {
+				continue
+			}
+			key := tokenSpender{token, spender}
+			if block, found := candidates[key]; !found || l.BlockNumber > block {
+				candidates[key] = l.BlockNumber
+			}
+		}
+
+		latest = to
+		from = new(big.Int).Add(to, big.NewInt(1))
+	}
+	return latest, nil
+}
+
+func allowance(ctx context.Context, client chainClient, token, owner, spender common.Address) (*big.Int, error) {
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	data, err := erc20ABI.Pack("allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := erc20ABI.Unpack("allowance", result)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(values[0], new(big.Int)).(*big.Int), nil
+}
+
+// BuildRevokeTransactions returns the multi-transaction command and the approve(spender, 0) transactions
+// revoking the approvals of token given by owner to spenders, to be sent with CreateMultiTransaction
+func (s *Service) BuildRevokeTransactions(owner common.Address, chainID uint64, tokenAddress common.Address, spenders []common.Address) (
+	*transfer.MultiTransactionCommand, []*bridge.TransactionBridge, error) {
+	if len(spenders) == 0 {
+		return nil, nil, ErrNoApprovalsToRevoke
+	}
+
+	data := make([]*bridge.TransactionBridge, 0, len(spenders))
+	for _, spender := range spenders {
+		input, err := revokeData(spender)
+		if err != nil {
+			return nil, nil, err
+		}
+		to := gethtypes.Address(tokenAddress)
+		data = append(data, &bridge.TransactionBridge{
+			BridgeName: bridge.SimpleBridgeName,
+			ChainID:    chainID,
+			SimpleTx: &transactions.SendTxArgs{
+				From:  gethtypes.Address(owner),
+				To:    &to,
+				Value: (*hexutil.Big)(big.NewInt(0)),
+				Data:  input,
+			},
+		})
+	}
+
+	symbol := ""
+	if s.tokenManager != nil {
+		if t := s.tokenManager.FindTokenByAddress(chainID, tokenAddress); t != nil {
+			symbol = t.Symbol
+		}
+	}
+
+	command := &transfer.MultiTransactionCommand{
+		FromAddress: owner,
+		ToAddress:   tokenAddress,
+		FromAsset:   symbol,
+		ToAsset:     symbol,
+		FromAmount:  (*hexutil.Big)(big.NewInt(0)),
+		Type:        transfer.MultiTransactionSend,
+	}
+	return command, data, nil
+}
@@ -0,0 +1,258 @@
+package approvals
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+type testChainClient struct {
+	head       uint64
+	logs       []types.Log
+	allowances map[tokenSpender]*big.Int
+	contracts  map[common.Address]bool
+	queries    []ethereum.FilterQuery
+}
+
+func (c *testChainClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *testChainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, q)
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *testChainClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if c.contracts[account] {
+		return []byte{1}, nil
+	}
+	return nil, nil
+}
+
+func (c *testChainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	// allowance(address owner, address spender)
+	spender := common.BytesToAddress(msg.Data[4+32 : 4+64])
+	allowance, ok := c.allowances[tokenSpender{*msg.To, spender}]
+	if !ok {
+		allowance = big.NewInt(0)
+	}
+	return math.U256Bytes(new(big.Int).Set(allowance)), nil
+}
+
+func approvalLog(token, owner, spender common.Address, block uint64) types.Log {
+	return types.Log{
+		Address:     token,
+		Topics:      []common.Hash{approvalEventSignatureHash, common.BytesToHash(owner.Bytes()), common.BytesToHash(spender.Bytes())},
+		BlockNumber: block,
+	}
+}
+
+func (c *testChainClient) get(chainID uint64) (chainClient, error) {
+	return c, nil
+}
+
+func TestRefreshApprovals(t *testing.T) {
+	owner := common.Address{1}
+	token := common.Address{2}
+	router := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+	revoked := common.Address{3}
+	eoa := common.Address{4}
+	client := &testChainClient{
+		head: 250000,
+		logs: []types.Log{
+			approvalLog(token, owner, router, 120000),
+			approvalLog(token, owner, revoked, 130000),
+			approvalLog(token, owner, eoa, 240000),
+		},
+		allowances: map[tokenSpender]*big.Int{
+			{token, router}: math.MaxBig256,
+			{token, eoa}:    big.NewInt(100),
+		},
+		contracts: map[common.Address]bool{router: true},
+	}
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	feed := &event.Feed{}
+	s := &Service{db: db, walletFeed: feed, client: client.get}
+
+	// The scan starts at the first transfer of the account
+	transfers, _, _ := transfer.GenerateTestTransfers(t, db.db, 1, 1)
+	transfers[0].ChainID = 1
+	transfers[0].To = owner
+	transfers[0].BlkNumber = 100000
+	transfer.InsertTestTransfer(t, db.db, owner, &transfers[0])
+
+	ch := make(chan walletevent.Event, 1)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	approvals, err := s.RefreshApprovals(context.Background(), []common.Address{owner}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, approvals, 2)
+	require.Len(t, client.queries, 2)
+	require.Equal(t, big.NewInt(100000), client.queries[0].FromBlock)
+	require.Equal(t, big.NewInt(250000), client.queries[1].ToBlock)
+
+	event := <-ch
+	require.Equal(t, EventApprovalsUpdated, event.Type)
+	require.Equal(t, []common.Address{owner}, event.Accounts)
+
+	// Ordered by latest approval first
+	require.Equal(t, eoa, approvals[0].Spender)
+	require.Equal(t, uint64(240000), approvals[0].BlockNumber)
+	require.False(t, approvals[0].IsContract)
+	require.False(t, approvals[0].Unlimited)
+	require.Equal(t, router, approvals[1].Spender)
+	require.Equal(t, "Uniswap V2 Router", approvals[1].SpenderName)
+	require.True(t, approvals[1].IsContract)
+	require.True(t, approvals[1].Unlimited)
+
+	// Only new blocks are scanned and revoked approvals are removed
+	client.head = 260000
+	delete(client.allowances, tokenSpender{token, eoa})
+	approvals, err = s.RefreshApprovals(context.Background(), []common.Address{owner}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	require.Equal(t, router, approvals[0].Spender)
+	require.Len(t, client.queries, 3)
+	require.Equal(t, big.NewInt(250001), client.queries[2].FromBlock)
+}
+
+func TestRefreshApprovalsResumesScan(t *testing.T) {
+	owner := common.Address{1}
+	token := common.Address{2}
+	spender := common.Address{3}
+	client := &testChainClient{
+		head: 1499999,
+		logs: []types.Log{
+			approvalLog(token, owner, spender, 1400000),
+		},
+		allowances: map[tokenSpender]*big.Int{
+			{token, spender}: big.NewInt(100),
+		},
+	}
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	s := &Service{db: db, walletFeed: &event.Feed{}, client: client.get}
+
+	transfers, _, _ := transfer.GenerateTestTransfers(t, db.db, 1, 1)
+	transfers[0].ChainID = 1
+	transfers[0].To = owner
+	transfers[0].BlkNumber = 100000
+	transfer.InsertTestTransfer(t, db.db, owner, &transfers[0])
+
+	// A refresh scans a bounded number of blocks
+	approvals, err := s.RefreshApprovals(context.Background(), []common.Address{owner}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, approvals, 0)
+	require.Len(t, client.queries, approvalLogsMaxRangesPerRefresh)
+	require.Equal(t, big.NewInt(1099999), client.queries[approvalLogsMaxRangesPerRefresh-1].ToBlock)
+
+	// and the next one resumes where it stopped
+	approvals, err = s.RefreshApprovals(context.Background(), []common.Address{owner}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	require.Equal(t, spender, approvals[0].Spender)
+	require.Len(t, client.queries, approvalLogsMaxRangesPerRefresh+4)
+	require.Equal(t, big.NewInt(1100000), client.queries[approvalLogsMaxRangesPerRefresh].FromBlock)
+	require.Equal(t, big.NewInt(1499999), client.queries[approvalLogsMaxRangesPerRefresh+3].ToBlock)
+}
+
+func TestBuildRevokeTransactions(t *testing.T) {
+	owner := common.Address{1}
+	token := common.Address{2}
+
+	testCases := []struct {
+		name     string
+		spenders []common.Address
+		err      error
+	}{
+		{"no spenders", nil, ErrNoApprovalsToRevoke},
+		{"one spender", []common.Address{{3}}, nil},
+		{"several spenders", []common.Address{{3}, {4}}, nil},
+	}
+
+	s := &Service{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, data, err := s.BuildRevokeTransactions(owner, 5, token, tc.spenders)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, owner, command.FromAddress)
+			require.Equal(t, token, command.ToAddress)
+			require.Equal(t, int64(0), command.FromAmount.ToInt().Int64())
+			require.Len(t, data, len(tc.spenders))
+
+			for i, tx := range data {
+				require.Equal(t, bridge.SimpleBridgeName, tx.BridgeName)
+				require.Equal(t, uint64(5), tx.ChainID)
+				require.Equal(t, token, common.Address(*tx.SimpleTx.To))
+				require.Equal(t, int64(0), tx.Value().Int64())
+
+				expected, err := revokeData(tc.spenders[i])
+				require.NoError(t, err)
+				require.Equal(t, expected, []byte(tx.SimpleTx.Data))
+				// approve(address,uint256)
+				require.Equal(t, []byte{0x09, 0x5e, 0xa7, 0xb3}, []byte(tx.SimpleTx.Data[:4]))
+			}
+		})
+	}
+}
+
+func TestApprovalFromLog(t *testing.T) {
+	erc20 := approvalLog(common.Address{2}, common.Address{1}, common.Address{3}, 1)
+	// ERC-721 approvals also index the token id
+	erc721 := approvalLog(common.Address{2}, common.Address{1}, common.Address{3}, 1)
+	erc721.Topics = append(erc721.Topics, common.Hash{1})
+	removed := approvalLog(common.Address{2}, common.Address{1}, common.Address{3}, 1)
+	removed.Removed = true
+	other := approvalLog(common.Address{2}, common.Address{1}, common.Address{3}, 1)
+	other.Topics[0] = common.Hash{1}
+
+	testCases := []struct {
+		name string
+		log  types.Log
+		ok   bool
+	}{
+		{"erc20", erc20, true},
+		{"erc721", erc721, false},
+		{"removed", removed, false},
+		{"other event", other, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, owner, spender, ok := approvalFromLog(tc.log)
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				require.Equal(t, common.Address{2}, token)
+				require.Equal(t, common.Address{1}, owner)
+				require.Equal(t, common.Address{3}, spender)
+			}
+		})
+	}
+}
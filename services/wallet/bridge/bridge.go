@@ -22,11 +22,12 @@ func getSigner(chainID uint64, from types.Address, verifiedAccount *account.Sele
 }
 
 type TransactionBridge struct {
-	BridgeName string
-	ChainID    uint64
-	SimpleTx   *transactions.SendTxArgs
-	HopTx      *HopTxArgs
-	CbridgeTx  *CBridgeTxArgs
+	BridgeName        string
+	ChainID           uint64
+	SimpleTx          *transactions.SendTxArgs
+	HopTx             *HopTxArgs
+	CbridgeTx         *CBridgeTxArgs
+	ERC1155TransferTx *ERC1155TransferTxArgs
 }
 
 func (t *TransactionBridge) Value() *big.Int {
@@ -36,6 +37,8 @@ func (t *TransactionBridge) Value() *big.Int {
 		return t.HopTx.Amount.ToInt()
 	} else if t.CbridgeTx != nil {
 		return t.CbridgeTx.Amount.ToInt()
+	} else if t.ERC1155TransferTx != nil {
+		return t.ERC1155TransferTx.Amount.ToInt()
 	}
 
 	return big.NewInt(0)
@@ -48,6 +51,8 @@ func (t *TransactionBridge) From() types.Address {
 		return t.HopTx.From
 	} else if t.CbridgeTx != nil {
 		return t.CbridgeTx.From
+	} else if t.ERC1155TransferTx != nil {
+		return t.ERC1155TransferTx.From
 	}
 
 	return types.HexToAddress("0x0")
@@ -60,6 +65,8 @@ func (t *TransactionBridge) To() types.Address {
 		return types.Address(t.HopTx.Recipient)
 	} else if t.CbridgeTx != nil {
 		return types.Address(t.HopTx.Recipient)
+	} else if t.ERC1155TransferTx != nil {
+		return types.Address(t.ERC1155TransferTx.Recipient)
 	}
 
 	return types.HexToAddress("0x0")
@@ -72,6 +79,8 @@ func (t *TransactionBridge) Data() types.HexBytes {
 		return types.HexBytes("")
 	} else if t.CbridgeTx != nil {
 		return types.HexBytes("")
+	} else if t.ERC1155TransferTx != nil {
+		return types.HexBytes("")
 	}
 
 	return types.HexBytes("")
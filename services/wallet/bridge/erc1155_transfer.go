@@ -0,0 +1,96 @@
+package bridge
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/contracts"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/transactions"
+)
+
+const ERC1155TransferBridgeName = "ERC1155Transfer"
+
+// ERC1155TransferTxArgs sends Amount items of TokenID to Recipient, To is the ERC-1155 contract
+type ERC1155TransferTxArgs struct {
+	transactions.SendTxArgs
+	TokenID   *hexutil.Big   `json:"tokenId"`
+	Recipient common.Address `json:"recipient"`
+	Amount    *hexutil.Big   `json:"amount"`
+}
+
+type ERC1155TransferBridge struct {
+	rpcClient     *rpc.Client
+	transactor    *transactions.Transactor
+	contractMaker *contracts.ContractMaker
+}
+
+func NewERC1155TransferBridge(rpcClient *rpc.Client, transactor *transactions.Transactor) *ERC1155TransferBridge {
+	return &ERC1155TransferBridge{
+		rpcClient:     rpcClient,
+		transactor:    transactor,
+		contractMaker: &contracts.ContractMaker{RPCClient: rpcClient},
+	}
+}
+
+func (s *ERC1155TransferBridge) Name() string {
+	return ERC1155TransferBridgeName
+}
+
+func (s *ERC1155TransferBridge) Can(from, to *params.Network, token *token.Token, balance *big.Int) (bool, error) {
+	return from.ChainID == to.ChainID, nil
+}
+
+func (s *ERC1155TransferBridge) CalculateFees(from, to *params.Network, token *token.Token, amountIn *big.Int, nativeTokenPrice, tokenPrice float64, gasPrice *big.Float) (*big.Int, *big.Int, error) {
+	return big.NewInt(0), big.NewInt(0), nil
+}
+
+func (s *ERC1155TransferBridge) EstimateGas(from, to *params.Network, token *token.Token, amountIn *big.Int) (uint64, error) {
+	// TODO: replace by estimate function
+	return 200000, nil // default gas limit for erc1155 safeTransferFrom
+}
+
+func (s *ERC1155TransferBridge) Send(sendArgs *TransactionBridge, verifiedAccount *account.SelectedExtKey) (hash types.Hash, err error) {
+	args := sendArgs.ERC1155TransferTx
+	contract, err := s.contractMaker.NewERC1155(sendArgs.ChainID, common.Address(*args.To))
+	if err != nil {
+		return hash, err
+	}
+
+	nonce, unlock, err := s.transactor.NextNonce(s.rpcClient, sendArgs.ChainID, args.From)
+	if err != nil {
+		return hash, err
+	}
+	defer func() {
+		unlock(err == nil, nonce)
+	}()
+	argNonce := hexutil.Uint64(nonce)
+	args.Nonce = &argNonce
+
+	txOpts := args.ToTransactOpts(getSigner(sendArgs.ChainID, args.From, verifiedAccount))
+	tx, err := contract.SafeTransferFrom(
+		txOpts,
+		common.Address(args.From),
+		args.Recipient,
+		args.TokenID.ToInt(),
+		args.Amount.ToInt(),
+		[]byte{},
+	)
+	if err != nil {
+		return hash, err
+	}
+	return types.Hash(tx.Hash()), nil
+}
+
+func (s *ERC1155TransferBridge) CalculateAmountOut(from, to *params.Network, amountIn *big.Int, symbol string) (*big.Int, error) {
+	return amountIn, nil
+}
+
+func (s *ERC1155TransferBridge) GetContractAddress(network *params.Network, token *token.Token) *common.Address {
+	return nil
+}
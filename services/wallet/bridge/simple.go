@@ -11,6 +11,8 @@ import (
 	"github.com/status-im/status-go/transactions"
 )
 
+const SimpleBridgeName = "Simple"
+
 type SimpleBridge struct {
 	transactor *transactions.Transactor
 }
@@ -20,7 +22,7 @@ func NewSimpleBridge(transactor *transactions.Transactor) *SimpleBridge {
 }
 
 func (s *SimpleBridge) Name() string {
-	return "Simple"
+	return SimpleBridgeName
 }
 
 func (s *SimpleBridge) Can(from, to *params.Network, token *token.Token, balance *big.Int) (bool, error) {
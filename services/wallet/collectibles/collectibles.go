@@ -2,6 +2,7 @@ package collectibles
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"strings"
@@ -34,6 +35,7 @@ var noTokenURIErrorPrefixes = []string{
 }
 
 type Manager struct {
+	db                                *sql.DB
 	rpcClient                         *rpc.Client
 	mainContractOwnershipProvider     thirdparty.NFTContractOwnershipProvider
 	fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider
@@ -44,7 +46,7 @@ type Manager struct {
 	walletFeed                        *event.Feed
 }
 
-func NewManager(rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, openseaAPIKey string, walletFeed *event.Feed) *Manager {
+func NewManager(db *sql.DB, rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, openseaAPIKey string, walletFeed *event.Feed) *Manager {
 	hystrix.ConfigureCommand(hystrixContractOwnershipClientName, hystrix.CommandConfig{
 		Timeout:               10000,
 		MaxConcurrentRequests: 100,
@@ -53,6 +55,7 @@ func NewManager(rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.
 	})
 
 	return &Manager{
+		db:                                db,
 		rpcClient:                         rpcClient,
 		mainContractOwnershipProvider:     mainContractOwnershipProvider,
 		fallbackContractOwnershipProvider: fallbackContractOwnershipProvider,
@@ -162,6 +165,12 @@ func (o *Manager) FetchBalancesByOwnerAndContractAddress(chainID uint64, ownerAd
 		return nil, err
 	}
 
+	erc1155, err := erc1155Balances(o.db, chainID, ownerAddress, contractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	mergeERC1155Balances(ret, erc1155)
+
 	return ret, nil
 }
 
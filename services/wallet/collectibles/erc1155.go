@@ -0,0 +1,115 @@
+package collectibles
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+// erc1155Balances returns the balance of each ERC-1155 token ID owned by owner, computed from the loaded
+// transfers of owner. Only contracts in contractAddresses are considered, all of them if empty
+func erc1155Balances(db *sql.DB, chainID uint64, owner common.Address, contractAddresses []common.Address) (thirdparty.TokenBalancesPerContractAddress, error) {
+	query := `SELECT token_address, token_id, tx_from_address, tx_to_address, amount_padded128hex FROM transfers
+		WHERE network_id = ? AND address = ? AND type = ? AND loaded = 1 AND token_address IS NOT NULL AND token_id IS NOT NULL`
+	args := []interface{}{chainID, owner, w_common.Erc1155Transfer}
+	if len(contractAddresses) > 0 {
+		query += fmt.Sprintf(" AND token_address IN (?%s)", strings.Repeat(",?", len(contractAddresses)-1))
+		for _, contractAddress := range contractAddresses {
+			args = append(args, contractAddress)
+		}
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := make(map[common.Address]map[string]*big.Int)
+	for rows.Next() {
+		var contractAddress common.Address
+		var from, to sql.RawBytes
+		var amount sql.NullString
+		tokenID := new(big.Int)
+		err := rows.Scan(&contractAddress, (*bigint.SQLBigIntBytes)(tokenID), &from, &to, &amount)
+		if err != nil {
+			return nil, err
+		}
+
+		value := new(big.Int)
+		if amount.Valid {
+			if _, ok := value.SetString(amount.String, 16); !ok {
+				continue
+			}
+		}
+
+		if balances[contractAddress] == nil {
+			balances[contractAddress] = make(map[string]*big.Int)
+		}
+		balance, ok := balances[contractAddress][tokenID.String()]
+		if !ok {
+			balance = new(big.Int)
+			balances[contractAddress][tokenID.String()] = balance
+		}
+		if common.BytesToAddress(to) == owner {
+			balance.Add(balance, value)
+		}
+		if common.BytesToAddress(from) == owner {
+			balance.Sub(balance, value)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ret := make(thirdparty.TokenBalancesPerContractAddress)
+	for contractAddress, tokens := range balances {
+		for id, balance := range tokens {
+			if balance.Sign() <= 0 {
+				continue
+			}
+			tokenID, _ := new(big.Int).SetString(id, 10)
+			ret[contractAddress] = append(ret[contractAddress], thirdparty.TokenBalance{
+				TokenID: &bigint.BigInt{Int: tokenID},
+				Balance: &bigint.BigInt{Int: balance},
+			})
+		}
+		sort.Slice(ret[contractAddress], func(i, j int) bool {
+			return ret[contractAddress][i].TokenID.Cmp(ret[contractAddress][j].TokenID.Int) < 0
+		})
+	}
+	return ret, nil
+}
+
+// mergeERC1155Balances replaces the balances of ERC-1155 items in ret by the ones computed from transfers.
+// Providers don't know about fungible items and report them as owned once
+func mergeERC1155Balances(ret thirdparty.TokenBalancesPerContractAddress, erc1155 thirdparty.TokenBalancesPerContractAddress) {
+	for contractAddress, balances := range erc1155 {
+		for _, balance := range balances {
+			found := false
+			for i := range ret[contractAddress] {
+				if ret[contractAddress][i].TokenID.Cmp(balance.TokenID.Int) == 0 {
+					ret[contractAddress][i].Balance = balance.Balance
+					found = true
+					break
+				}
+			}
+			if !found {
+				ret[contractAddress] = append(ret[contractAddress], balance)
+			}
+		}
+	}
+}
+
+// FetchERC1155BalancesByOwner returns the ERC-1155 items of owner with their balance, from the loaded transfers
+func (o *Manager) FetchERC1155BalancesByOwner(chainID uint64, owner common.Address, contractAddresses []common.Address) (thirdparty.TokenBalancesPerContractAddress, error) {
+	return erc1155Balances(o.db, chainID, owner, contractAddresses)
+}
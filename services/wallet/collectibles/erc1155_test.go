@@ -0,0 +1,85 @@
+package collectibles
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/transfer"
+)
+
+func TestERC1155Balances(t *testing.T) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-collectibles-tests")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	owner := common.Address{1}
+	other := common.Address{2}
+	collection := common.Address{3}
+	otherCollection := common.Address{4}
+
+	trs, _, _ := transfer.GenerateTestTransfers(t, db, 0, 5)
+	insert := func(tr *transfer.TestTransfer, from, to common.Address, contract common.Address, tokenID, value int64) {
+		tr.ChainID = 1
+		tr.From = from
+		tr.To = to
+		tr.Value = value
+		transfer.InsertTestTransferWithOptions(t, db, owner, tr, &transfer.TestTransferOptions{
+			TokenAddress: contract,
+			TokenID:      big.NewInt(tokenID),
+		})
+	}
+	insert(&trs[0], other, owner, collection, 1, 10)
+	insert(&trs[1], owner, other, collection, 1, 4)
+	insert(&trs[2], other, owner, collection, 2, 1)
+	insert(&trs[3], owner, other, collection, 2, 1)
+	insert(&trs[4], other, owner, otherCollection, 0, 7)
+
+	balances, err := erc1155Balances(db, 1, owner, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	// Items sent away are not owned anymore
+	require.Len(t, balances[collection], 1)
+	require.Equal(t, int64(1), balances[collection][0].TokenID.Int64())
+	require.Equal(t, int64(6), balances[collection][0].Balance.Int64())
+	require.Equal(t, int64(0), balances[otherCollection][0].TokenID.Int64())
+	require.Equal(t, int64(7), balances[otherCollection][0].Balance.Int64())
+
+	balances, err = erc1155Balances(db, 1, owner, []common.Address{otherCollection})
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	require.Contains(t, balances, otherCollection)
+
+	balances, err = erc1155Balances(db, 5, owner, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 0)
+}
+
+func TestMergeERC1155Balances(t *testing.T) {
+	collection := common.Address{3}
+	ret := thirdparty.TokenBalancesPerContractAddress{
+		collection: {
+			{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+			{TokenID: &bigint.BigInt{Int: big.NewInt(2)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+		},
+	}
+	mergeERC1155Balances(ret, thirdparty.TokenBalancesPerContractAddress{
+		collection: {
+			{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(6)}},
+			{TokenID: &bigint.BigInt{Int: big.NewInt(3)}, Balance: &bigint.BigInt{Int: big.NewInt(2)}},
+		},
+	})
+	require.Len(t, ret[collection], 3)
+	require.Equal(t, int64(6), ret[collection][0].Balance.Int64())
+	require.Equal(t, int64(1), ret[collection][1].Balance.Int64())
+	require.Equal(t, int64(3), ret[collection][2].TokenID.Int64())
+	require.Equal(t, int64(2), ret[collection][2].Balance.Int64())
+}
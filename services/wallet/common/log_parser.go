@@ -23,6 +23,7 @@ const (
 	EthTransfer        Type = "eth"
 	Erc20Transfer      Type = "erc20"
 	Erc721Transfer     Type = "erc721"
+	Erc1155Transfer    Type = "erc1155"
 	UniswapV2Swap      Type = "uniswapV2Swap"
 	UniswapV3Swap      Type = "uniswapV3Swap"
 	HopBridgeFrom      Type = "HopBridgeFrom"
@@ -34,6 +35,8 @@ const (
 	WETHWithdrawalEventType                   EventType = "wethWithdrawalEvent"
 	Erc20TransferEventType                    EventType = "erc20Event"
 	Erc721TransferEventType                   EventType = "erc721Event"
+	Erc1155TransferSingleEventType            EventType = "erc1155TransferSingleEvent"
+	Erc1155TransferBatchEventType             EventType = "erc1155TransferBatchEvent"
 	UniswapV2SwapEventType                    EventType = "uniswapV2SwapEvent"
 	UniswapV3SwapEventType                    EventType = "uniswapV3SwapEvent"
 	HopBridgeTransferSentToL2EventType        EventType = "hopBridgeTransferSentToL2Event"
@@ -54,6 +57,13 @@ const (
 	erc20TransferEventIndexedParameters  = 3 // signature, from, to
 	erc721TransferEventIndexedParameters = 4 // signature, from, to, tokenId
 
+	// TransferSingle (index_topic_1 address operator, index_topic_2 address from, index_topic_3 address to, uint256 id, uint256 value)
+	Erc1155TransferSingleEventSignature = "TransferSingle(address,address,address,uint256,uint256)"
+	// TransferBatch (index_topic_1 address operator, index_topic_2 address from, index_topic_3 address to, uint256[] ids, uint256[] values)
+	Erc1155TransferBatchEventSignature = "TransferBatch(address,address,address,uint256[],uint256[])"
+
+	erc1155TransferEventIndexedParameters = 4 // signature, operator, from, to
+
 	// Swap (index_topic_1 address sender, uint256 amount0In, uint256 amount1In, uint256 amount0Out, uint256 amount1Out, index_topic_2 address to)
 	uniswapV2SwapEventSignature = "Swap(address,uint256,uint256,uint256,uint256,address)" // also used by SushiSwap
 	// Swap (index_topic_1 address sender, index_topic_2 address recipient, int256 amount0, int256 amount1, uint160 sqrtPriceX96, uint128 liquidity, int24 tick)
@@ -79,6 +89,8 @@ func GetEventType(log *types.Log) EventType {
 	wethDepositEventSignatureHash := GetEventSignatureHash(wethDepositEventSignature)
 	wethWithdrawalEventSignatureHash := GetEventSignatureHash(wethWithdrawalEventSignature)
 	erc20_721TransferEventSignatureHash := GetEventSignatureHash(Erc20_721TransferEventSignature)
+	erc1155TransferSingleEventSignatureHash := GetEventSignatureHash(Erc1155TransferSingleEventSignature)
+	erc1155TransferBatchEventSignatureHash := GetEventSignatureHash(Erc1155TransferBatchEventSignature)
 	uniswapV2SwapEventSignatureHash := GetEventSignatureHash(uniswapV2SwapEventSignature)
 	uniswapV3SwapEventSignatureHash := GetEventSignatureHash(uniswapV3SwapEventSignature)
 	hopBridgeTransferSentToL2EventSignatureHash := GetEventSignatureHash(hopBridgeTransferSentToL2EventSignature)
@@ -99,6 +111,14 @@ func GetEventType(log *types.Log) EventType {
 			case erc721TransferEventIndexedParameters:
 				return Erc721TransferEventType
 			}
+		case erc1155TransferSingleEventSignatureHash:
+			if len(log.Topics) == erc1155TransferEventIndexedParameters {
+				return Erc1155TransferSingleEventType
+			}
+		case erc1155TransferBatchEventSignatureHash:
+			if len(log.Topics) == erc1155TransferEventIndexedParameters {
+				return Erc1155TransferBatchEventType
+			}
 		case uniswapV2SwapEventSignatureHash:
 			return UniswapV2SwapEventType
 		case uniswapV3SwapEventSignatureHash:
@@ -123,6 +143,8 @@ func EventTypeToSubtransactionType(eventType EventType) Type {
 		return Erc20Transfer
 	case Erc721TransferEventType:
 		return Erc721Transfer
+	case Erc1155TransferSingleEventType, Erc1155TransferBatchEventType:
+		return Erc1155Transfer
 	case UniswapV2SwapEventType:
 		return UniswapV2Swap
 	case UniswapV3SwapEventType:
@@ -248,6 +270,93 @@ func ParseErc721TransferLog(ethlog *types.Log) (from, to common.Address, tokenID
 	return
 }
 
+func parseErc1155TransferLogTopics(ethlog *types.Log) (operator, from, to common.Address, err error) {
+	if len(ethlog.Topics) < erc1155TransferEventIndexedParameters {
+		err = fmt.Errorf("not enough topics for erc1155 transfer %s, %v", "topics", ethlog.Topics)
+		return
+	}
+	if len(ethlog.Topics[1]) != 32 {
+		err = fmt.Errorf("second topic is not padded to 32 byte address %s, %v", "topic", ethlog.Topics[1])
+		return
+	}
+	if len(ethlog.Topics[2]) != 32 {
+		err = fmt.Errorf("third topic is not padded to 32 byte address %s, %v", "topic", ethlog.Topics[2])
+		return
+	}
+	if len(ethlog.Topics[3]) != 32 {
+		err = fmt.Errorf("fourth topic is not padded to 32 byte address %s, %v", "topic", ethlog.Topics[3])
+		return
+	}
+	copy(operator[:], ethlog.Topics[1][12:])
+	copy(from[:], ethlog.Topics[2][12:])
+	copy(to[:], ethlog.Topics[3][12:])
+
+	return
+}
+
+func ParseErc1155TransferSingleLog(ethlog *types.Log) (operator, from, to common.Address, tokenID *big.Int, value *big.Int, err error) {
+	tokenID = new(big.Int)
+	value = new(big.Int)
+
+	operator, from, to, err = parseErc1155TransferLogTopics(ethlog)
+	if err != nil {
+		return
+	}
+	if len(ethlog.Data) != 32*2 {
+		err = fmt.Errorf("data is not padded to 2 * 32 bytes big int %s, %v", "data", ethlog.Data)
+		return
+	}
+	tokenID.SetBytes(ethlog.Data[0:32])
+	value.SetBytes(ethlog.Data[32:64])
+
+	return
+}
+
+// ParseErc1155TransferBatchLog returns the ids and values of all the items of a TransferBatch log, in the same order
+func ParseErc1155TransferBatchLog(ethlog *types.Log) (operator, from, to common.Address, tokenIDs []*big.Int, values []*big.Int, err error) {
+	operator, from, to, err = parseErc1155TransferLogTopics(ethlog)
+	if err != nil {
+		return
+	}
+
+	tokenIDs, err = readUint256Array(ethlog.Data, 0)
+	if err != nil {
+		return
+	}
+	values, err = readUint256Array(ethlog.Data, 32)
+	if err != nil {
+		return
+	}
+	if len(tokenIDs) != len(values) {
+		err = fmt.Errorf("erc1155 batch ids and values lengths differ %d, %d", len(tokenIDs), len(values))
+	}
+
+	return
+}
+
+// readUint256Array decodes the ABI encoded uint256[] whose offset is stored at headPosition of data
+func readUint256Array(data []byte, headPosition int) ([]*big.Int, error) {
+	if len(data) < headPosition+32 {
+		return nil, fmt.Errorf("data too short for array offset %d, %d", len(data), headPosition)
+	}
+	offset := new(big.Int).SetBytes(data[headPosition : headPosition+32])
+	if !offset.IsInt64() || offset.Int64()+32 > int64(len(data)) {
+		return nil, fmt.Errorf("array offset out of bounds %s, %d", offset, len(data))
+	}
+	start := int(offset.Int64())
+	length := new(big.Int).SetBytes(data[start : start+32])
+	start += 32
+	if !length.IsInt64() || length.Int64() > int64((len(data)-start)/32) {
+		return nil, fmt.Errorf("array length out of bounds %s, %d", length, len(data))
+	}
+
+	items := make([]*big.Int, length.Int64())
+	for i := range items {
+		items[i] = new(big.Int).SetBytes(data[start+i*32 : start+(i+1)*32])
+	}
+	return items, nil
+}
+
 func ParseUniswapV2Log(ethlog *types.Log) (pairAddress common.Address, from common.Address, to common.Address, amount0In *big.Int, amount1In *big.Int, amount0Out *big.Int, amount1Out *big.Int, err error) {
 	amount0In = new(big.Int)
 	amount1In = new(big.Int)
@@ -502,6 +611,24 @@ func ExtractTokenIdentity(dbEntryType Type, log *types.Log, tx *types.Transactio
 		txTokenID = tokenID
 		txFrom = &from
 		txTo = &to
+	case Erc1155Transfer:
+		tokenAddress = new(common.Address)
+		*tokenAddress = log.Address
+		// Batch transfers are stored once per item, callers override the first item returned here
+		var from, to common.Address
+		switch GetEventType(log) {
+		case Erc1155TransferSingleEventType:
+			_, from, to, txTokenID, txValue, _ = ParseErc1155TransferSingleLog(log)
+		case Erc1155TransferBatchEventType:
+			var tokenIDs, values []*big.Int
+			var err error
+			_, from, to, tokenIDs, values, err = ParseErc1155TransferBatchLog(log)
+			if err == nil && len(tokenIDs) > 0 {
+				txTokenID, txValue = tokenIDs[0], values[0]
+			}
+		}
+		txFrom = &from
+		txTo = &to
 	}
 
 	return
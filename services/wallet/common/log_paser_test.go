@@ -3,6 +3,7 @@ package common
 import (
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -60,3 +61,77 @@ func TestLogHopBridgeTransferFromL1Completed(t *testing.T) {
 	eventType := GetEventType(&eventLog)
 	require.Equal(t, HopBridgeTransferFromL1CompletedEventType, eventType)
 }
+
+func erc1155TestLog(signature string, data []byte) *types.Log {
+	return &types.Log{
+		Address: common.HexToAddress("0x76be3b62873462d2142405439777e971754e8e77"),
+		Topics: []common.Hash{
+			GetEventSignatureHash(signature),
+			common.BytesToHash(common.HexToAddress("0x00000000000000adc04c56bf30ac9d3c0aaf14dc").Bytes()),
+			common.BytesToHash(common.HexToAddress("0xd6255ae13ac335b347aa846802ad6ac39dd2543a").Bytes()),
+			common.BytesToHash(common.HexToAddress("0x710bda329b2a6224e4b44833de30f38e7f81d564").Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func uint256Words(values ...int64) []byte {
+	data := make([]byte, 0, 32*len(values))
+	for _, v := range values {
+		data = append(data, common.BigToHash(big.NewInt(v)).Bytes()...)
+	}
+	return data
+}
+
+func TestLogErc1155TransferSingle(t *testing.T) {
+	eventLog := erc1155TestLog(Erc1155TransferSingleEventSignature, uint256Words(10, 3))
+
+	eventType := GetEventType(eventLog)
+	require.Equal(t, Erc1155TransferSingleEventType, eventType)
+	require.Equal(t, Erc1155Transfer, EventTypeToSubtransactionType(eventType))
+
+	operator, from, to, tokenID, value, err := ParseErc1155TransferSingleLog(eventLog)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x00000000000000adc04c56bf30ac9d3c0aaf14dc"), operator)
+	require.Equal(t, common.HexToAddress("0xd6255ae13ac335b347aa846802ad6ac39dd2543a"), from)
+	require.Equal(t, common.HexToAddress("0x710bda329b2a6224e4b44833de30f38e7f81d564"), to)
+	require.Equal(t, big.NewInt(10), tokenID)
+	require.Equal(t, big.NewInt(3), value)
+
+	// Downloader stores ERC-1155 logs as erc20 until they are loaded
+	correctType, tokenAddress, txTokenID, txValue, txFrom, txTo := ExtractTokenIdentity(Erc20Transfer, eventLog, nil)
+	require.Equal(t, Erc1155Transfer, correctType)
+	require.Equal(t, eventLog.Address, *tokenAddress)
+	require.Equal(t, big.NewInt(10), txTokenID)
+	require.Equal(t, big.NewInt(3), txValue)
+	require.Equal(t, from, *txFrom)
+	require.Equal(t, to, *txTo)
+}
+
+func TestLogErc1155TransferBatch(t *testing.T) {
+	// ids: [1, 2], values: [10, 20]
+	eventLog := erc1155TestLog(Erc1155TransferBatchEventSignature, uint256Words(0x40, 0xa0, 2, 1, 2, 2, 10, 20))
+
+	eventType := GetEventType(eventLog)
+	require.Equal(t, Erc1155TransferBatchEventType, eventType)
+	require.Equal(t, Erc1155Transfer, EventTypeToSubtransactionType(eventType))
+
+	_, from, to, tokenIDs, values, err := ParseErc1155TransferBatchLog(eventLog)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xd6255ae13ac335b347aa846802ad6ac39dd2543a"), from)
+	require.Equal(t, common.HexToAddress("0x710bda329b2a6224e4b44833de30f38e7f81d564"), to)
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2)}, tokenIDs)
+	require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20)}, values)
+
+	_, _, txTokenID, txValue, _, _ := ExtractTokenIdentity(Erc1155Transfer, eventLog, nil)
+	require.Equal(t, big.NewInt(1), txTokenID)
+	require.Equal(t, big.NewInt(10), txValue)
+
+	// Malformed arrays are rejected
+	eventLog.Data = uint256Words(0x40, 0xa0, 2, 1, 2, 3, 10, 20)
+	_, _, _, _, _, err = ParseErc1155TransferBatchLog(eventLog)
+	require.Error(t, err)
+	eventLog.Data = uint256Words(0x40, 0x400)
+	_, _, _, _, _, err = ParseErc1155TransferBatchLog(eventLog)
+	require.Error(t, err)
+}
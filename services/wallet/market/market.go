@@ -1,6 +1,7 @@
 package market
 
 import (
+	"encoding/json"
 	"sync"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/walletevent"
@@ -15,6 +17,8 @@ import (
 
 const (
 	EventMarketStatusChanged walletevent.EventType = "wallet-market-status-changed"
+	// EventMarketPricesUpdated is sent by RefreshPrices with the prices, per symbol and currency, encoded in the message
+	EventMarketPricesUpdated walletevent.EventType = "wallet-market-prices-updated"
 )
 
 type DataPoint struct {
@@ -201,6 +205,23 @@ func (pm *Manager) FetchPrices(symbols []string, currencies []string) (map[strin
 	return prices, nil
 }
 
+func (pm *Manager) notifyPricesUpdated(prices map[string]map[string]float64) {
+	if pm.feed == nil || len(prices) == 0 {
+		return
+	}
+	message, err := json.Marshal(prices)
+	if err != nil {
+		log.Error("failed to encode updated prices", "err", err)
+		return
+	}
+	pm.feed.Send(walletevent.Event{
+		Type:     EventMarketPricesUpdated,
+		Accounts: []common.Address{},
+		Message:  string(message),
+		At:       time.Now().Unix(),
+	})
+}
+
 func (pm *Manager) getCachedPricesFor(symbols []string, currencies []string) DataPerTokenAndCurrency {
 	prices := make(DataPerTokenAndCurrency)
 
@@ -277,3 +298,28 @@ func (pm *Manager) GetOrFetchPrices(symbols []string, currencies []string, maxAg
 
 	return prices, nil
 }
+
+// RefreshPrices gets the prices as GetOrFetchPrices does and sends them with EventMarketPricesUpdated.
+// It is meant for the periodic refreshes, the other fetches of prices don't send the event
+func (pm *Manager) RefreshPrices(symbols []string, currencies []string, maxAgeInSeconds int64) (DataPerTokenAndCurrency, error) {
+	prices, err := pm.GetOrFetchPrices(symbols, currencies, maxAgeInSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make(map[string]map[string]float64)
+	for symbol, pricesPerCurrency := range prices {
+		for currency, price := range pricesPerCurrency {
+			// Not returned by the provider
+			if price.UpdatedAt == 0 {
+				continue
+			}
+			if updated[symbol] == nil {
+				updated[symbol] = make(map[string]float64)
+			}
+			updated[symbol][currency] = price.Price
+		}
+	}
+	pm.notifyPricesUpdated(updated)
+	return prices, nil
+}
@@ -1,14 +1,17 @@
 package market
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/event"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/walletevent"
 )
 
 type MockPriceProvider struct {
@@ -113,3 +116,42 @@ func TestPrice(t *testing.T) {
 		}
 	}
 }
+
+func TestPricesUpdatedEvent(t *testing.T) {
+	priceProvider := NewMockPriceProvider()
+	priceProvider.setMockPrices(map[string]map[string]float64{
+		"SNT": {"USD": 0.025},
+	})
+	manager := setupTestPrice(t, priceProvider)
+
+	ch := make(chan walletevent.Event, 10)
+	sub := manager.feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	// Only the periodic refreshes send the prices
+	_, err := manager.FetchPrices([]string{"SNT"}, []string{"USD"})
+	require.NoError(t, err)
+	select {
+	case ev := <-ch:
+		require.NotEqual(t, EventMarketPricesUpdated, ev.Type)
+	default:
+	}
+
+	_, err = manager.RefreshPrices([]string{"SNT"}, []string{"USD"}, 60)
+	require.NoError(t, err)
+
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != EventMarketPricesUpdated {
+				continue
+			}
+			prices := make(map[string]map[string]float64)
+			require.NoError(t, json.Unmarshal([]byte(ev.Message), &prices))
+			require.Equal(t, map[string]map[string]float64{"SNT": {"USD": 0.025}}, prices)
+			return
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for prices updated event")
+		}
+	}
+}
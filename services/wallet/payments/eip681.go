@@ -0,0 +1,146 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	uriScheme     = "ethereum:"
+	payPrefix     = "pay-"
+	transferFunc  = "transfer"
+	defaultChain  = 1
+	maxURILength  = 2048
+	memoParameter = "message"
+	// maxAmountDigits is the number of digits of the largest uint256
+	maxAmountDigits = 78
+)
+
+var (
+	ErrInvalidPaymentURI           = errors.New("invalid payment request URI")
+	ErrUnsupportedPaymentTarget    = errors.New("payment request target must be an address")
+	ErrUnsupportedPaymentFunction  = errors.New("unsupported payment request function")
+	ErrInvalidPaymentRequestAmount = errors.New("invalid payment request amount")
+)
+
+var hexAddressRegex = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+
+// paymentURI is the content of an EIP-681 URI requesting a payment of the native token of a chain or of an ERC-20 token
+type paymentURI struct {
+	ChainID   uint64
+	Recipient common.Address
+	// TokenAddress is nil for the native token
+	TokenAddress *common.Address
+	// Amount is in the smallest unit of the token, nil when the payer chooses it
+	Amount *big.Int
+	Memo   string
+}
+
+// String formats the URI as `ethereum:<recipient>@<chain>?value=<amount>` for the native token and as
+// `ethereum:<token>@<chain>/transfer?address=<recipient>&uint256=<amount>` for an ERC-20 token.
+// The memo is not part of EIP-681, it is added as a message parameter which wallets ignore
+func (u *paymentURI) String() string {
+	var builder strings.Builder
+	var params []string
+	builder.WriteString(uriScheme)
+	if u.TokenAddress == nil {
+		fmt.Fprintf(&builder, "%s@%d", u.Recipient.Hex(), u.ChainID)
+		if u.Amount != nil {
+			params = append(params, "value="+u.Amount.String())
+		}
+	} else {
+		fmt.Fprintf(&builder, "%s@%d/%s", u.TokenAddress.Hex(), u.ChainID, transferFunc)
+		params = append(params, "address="+u.Recipient.Hex())
+		if u.Amount != nil {
+			params = append(params, "uint256="+u.Amount.String())
+		}
+	}
+	if u.Memo != "" {
+		params = append(params, memoParameter+"="+url.QueryEscape(u.Memo))
+	}
+	if len(params) > 0 {
+		builder.WriteString("?" + strings.Join(params, "&"))
+	}
+	return builder.String()
+}
+
+// parsePaymentURI parses an EIP-681 payment URI, as decoded from a QR code. ENS names are not accepted as target
+func parsePaymentURI(raw string) (*paymentURI, error) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) > maxURILength || !strings.HasPrefix(strings.ToLower(raw), uriScheme) {
+		return nil, ErrInvalidPaymentURI
+	}
+	raw = raw[len(uriScheme):]
+	raw = strings.TrimPrefix(raw, payPrefix)
+
+	path, query, _ := strings.Cut(raw, "?")
+	target, function, _ := strings.Cut(path, "/")
+	target, chain, hasChain := strings.Cut(target, "@")
+	if !hexAddressRegex.MatchString(target) {
+		return nil, ErrUnsupportedPaymentTarget
+	}
+
+	u := &paymentURI{ChainID: defaultChain}
+	if hasChain {
+		chainID, err := strconv.ParseUint(chain, 10, 64)
+		if err != nil || chainID == 0 {
+			return nil, ErrInvalidPaymentURI
+		}
+		u.ChainID = chainID
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, ErrInvalidPaymentURI
+	}
+	u.Memo = params.Get(memoParameter)
+
+	var amount string
+	switch function {
+	case "":
+		u.Recipient = common.HexToAddress(target)
+		amount = params.Get("value")
+	case transferFunc:
+		token := common.HexToAddress(target)
+		u.TokenAddress = &token
+		recipient := params.Get("address")
+		if !hexAddressRegex.MatchString(recipient) {
+			return nil, ErrUnsupportedPaymentTarget
+		}
+		u.Recipient = common.HexToAddress(recipient)
+		amount = params.Get("uint256")
+	default:
+		return nil, ErrUnsupportedPaymentFunction
+	}
+
+	if amount != "" {
+		u.Amount, err = parseAmount(amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// parseAmount parses an integer amount, possibly in scientific notation such as 2.014e18
+func parseAmount(value string) (*big.Int, error) {
+	// Huge exponents would take ages to expand
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(value[i+1:])
+		if err != nil || exponent > maxAmountDigits || exponent < -maxAmountDigits {
+			return nil, ErrInvalidPaymentRequestAmount
+		}
+	}
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok || !amount.IsInt() || amount.Sign() < 0 || amount.Num().BitLen() > 256 {
+		return nil, ErrInvalidPaymentRequestAmount
+	}
+	return amount.Num(), nil
+}
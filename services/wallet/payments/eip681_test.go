@@ -0,0 +1,64 @@
+package payments
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParsePaymentURI(t *testing.T) {
+	recipient := common.HexToAddress("0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359")
+	token := common.HexToAddress("0x744d70FDBE2Ba4CF95131626614a1763DF805B9E")
+
+	u, err := parsePaymentURI("ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=2.014e18")
+	require.NoError(t, err)
+	require.Equal(t, &paymentURI{ChainID: 1, Recipient: recipient, Amount: big.NewInt(2014000000000000000)}, u)
+
+	u, err = parsePaymentURI("ethereum:pay-0x744d70FDBE2Ba4CF95131626614a1763DF805B9E@10/transfer?address=0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359" +
+		"&uint256=1e18&message=Lunch%20money")
+	require.NoError(t, err)
+	require.Equal(t, &paymentURI{ChainID: 10, Recipient: recipient, TokenAddress: &token, Amount: big.NewInt(1e18), Memo: "Lunch money"}, u)
+
+	// The payer chooses the amount
+	u, err = parsePaymentURI("ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359@5")
+	require.NoError(t, err)
+	require.Nil(t, u.Amount)
+	require.Equal(t, uint64(5), u.ChainID)
+
+	for uri, expected := range map[string]error{
+		"bitcoin:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359":                                         ErrInvalidPaymentURI,
+		"ethereum:vitalik.eth?value=1":                                                               ErrUnsupportedPaymentTarget,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359@x":                                      ErrInvalidPaymentURI,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359/approve?address=0x0":                    ErrUnsupportedPaymentFunction,
+		"ethereum:0x744d70FDBE2Ba4CF95131626614a1763DF805B9E/transfer?uint256=1":                     ErrUnsupportedPaymentTarget,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=1.5":                              ErrInvalidPaymentRequestAmount,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=-1":                               ErrInvalidPaymentRequestAmount,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=1e999999999":                      ErrInvalidPaymentRequestAmount,
+		"ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=1e78":                             ErrInvalidPaymentRequestAmount,
+		"ethereum:0x744d70FDBE2Ba4CF95131626614a1763DF805B9E/transfer?address=vitalik.eth&uint256=1": ErrUnsupportedPaymentTarget,
+	} {
+		_, err := parsePaymentURI(uri)
+		require.Equal(t, expected, err, uri)
+	}
+}
+
+func TestFormatPaymentURI(t *testing.T) {
+	recipient := common.HexToAddress("0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359")
+	token := common.HexToAddress("0x744d70FDBE2Ba4CF95131626614a1763DF805B9E")
+
+	for _, u := range []*paymentURI{
+		{ChainID: 1, Recipient: recipient, Amount: big.NewInt(1000)},
+		{ChainID: 42161, Recipient: recipient},
+		{ChainID: 10, Recipient: recipient, TokenAddress: &token, Amount: big.NewInt(5), Memo: "Invoice #12 & more"},
+	} {
+		parsed, err := parsePaymentURI(u.String())
+		require.NoError(t, err, u.String())
+		require.Equal(t, u, parsed)
+	}
+
+	require.Equal(t, "ethereum:0x744d70FDBE2Ba4CF95131626614a1763DF805B9E@10/transfer?address=0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359&uint256=5",
+		(&paymentURI{ChainID: 10, Recipient: recipient, TokenAddress: &token, Amount: big.NewInt(5)}).String())
+}
@@ -0,0 +1,77 @@
+package payments
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Status is the state of a payment request
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	// StatusPaid is set once a transfer of at least the amount is found to the recipient
+	StatusPaid Status = "paid"
+	// StatusExpired is set once the request expired unpaid. A payment made before the expiry but found later still marks it paid
+	StatusExpired   Status = "expired"
+	StatusCancelled Status = "cancelled"
+)
+
+var (
+	ErrPaymentRequestNotFound   = errors.New("payment request not found")
+	ErrPaymentRequestNotPending = errors.New("payment request is not pending")
+	ErrNotAnAccount             = errors.New("payment request recipient is not an account of the user")
+	ErrUnknownToken             = errors.New("unknown payment request token")
+	ErrInvalidExpiry            = errors.New("invalid payment request expiry")
+	ErrMemoTooLong              = errors.New("payment request memo is too long")
+	ErrPaymentAmountRequired    = errors.New("payment request amount is required")
+)
+
+// PaymentRequest is a request to pay an amount of a token on a chain to a recipient. The requests created by the user
+// are paid to one of its accounts, the requests added from URIs are paid by the user
+type PaymentRequest struct {
+	ID        string         `json:"id,omitempty"`
+	ChainID   uint64         `json:"chainId"`
+	Recipient common.Address `json:"recipient"`
+	// TokenAddress is nil for the native token of the chain
+	TokenAddress *common.Address `json:"tokenAddress,omitempty"`
+	Symbol       string          `json:"symbol"`
+	Decimals     uint            `json:"decimals"`
+	// Amount is in the smallest unit of the token, nil when the payer chooses it
+	Amount *hexutil.Big `json:"amount,omitempty"`
+	Memo   string       `json:"memo,omitempty"`
+	Status Status       `json:"status"`
+	// ExpiresAt is zero for requests which don't expire
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+	CreatedAt int64 `json:"createdAt,omitempty"`
+	PaidAt    int64 `json:"paidAt,omitempty"`
+	// TransferID and PaidBy identify the transfer which paid the request
+	TransferID *common.Hash    `json:"transferId,omitempty"`
+	PaidBy     *common.Address `json:"paidBy,omitempty"`
+	// URI is the EIP-681 URI of the request, to share or to show as a QR code
+	URI string `json:"uri"`
+}
+
+func (r *PaymentRequest) paymentURI() *paymentURI {
+	u := &paymentURI{
+		ChainID:      r.ChainID,
+		Recipient:    r.Recipient,
+		TokenAddress: r.TokenAddress,
+		Memo:         r.Memo,
+	}
+	if r.Amount != nil {
+		u.Amount = r.Amount.ToInt()
+	}
+	return u
+}
+
+// paidBy tells whether a transfer of amount is enough to pay the request
+func (r *PaymentRequest) paidBy(amount *big.Int) bool {
+	if r.Amount == nil {
+		return amount.Sign() > 0
+	}
+	return amount.Cmp(r.Amount.ToInt()) >= 0
+}
@@ -0,0 +1,210 @@
+package payments
+
+import (
+	"database/sql"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+type DB struct {
+	db *sql.DB
+}
+
+func NewDB(sqlDb *sql.DB) *DB {
+	return &DB{
+		db: sqlDb,
+	}
+}
+
+const selectRequestColumns = `id, chain_id, recipient, token_address, symbol, decimals, amount, memo, status, created_at, expires_at,
+	paid_at, transfer_id, paid_by`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRequest(scanner scanner) (*PaymentRequest, error) {
+	r := &PaymentRequest{}
+	var recipient, tokenAddress, transferID, paidBy []byte
+	var amount sql.NullString
+	err := scanner.Scan(&r.ID, &r.ChainID, &recipient, &tokenAddress, &r.Symbol, &r.Decimals, &amount, &r.Memo, &r.Status, &r.CreatedAt,
+		&r.ExpiresAt, &r.PaidAt, &transferID, &paidBy)
+	if err != nil {
+		return nil, err
+	}
+	r.Recipient = common.BytesToAddress(recipient)
+	if len(tokenAddress) > 0 {
+		address := common.BytesToAddress(tokenAddress)
+		r.TokenAddress = &address
+	}
+	if amount.Valid {
+		value, err := hexutil.DecodeBig(amount.String)
+		if err != nil {
+			return nil, err
+		}
+		r.Amount = (*hexutil.Big)(value)
+	}
+	if len(transferID) > 0 {
+		id := common.BytesToHash(transferID)
+		r.TransferID = &id
+	}
+	if len(paidBy) > 0 {
+		address := common.BytesToAddress(paidBy)
+		r.PaidBy = &address
+	}
+	return r, nil
+}
+
+func (pdb *DB) getRequests(where string, args ...interface{}) ([]*PaymentRequest, error) {
+	rows, err := pdb.db.Query(`SELECT `+selectRequestColumns+` FROM payment_requests `+where+` ORDER BY created_at DESC, id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*PaymentRequest
+	for rows.Next() {
+		r, err := scanRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, r)
+	}
+	return requests, rows.Err()
+}
+
+// GetRequests returns all the payment requests, newest first
+func (pdb *DB) GetRequests() ([]*PaymentRequest, error) {
+	return pdb.getRequests("")
+}
+
+// getUnpaidRequests returns the pending and expired requests, which a transfer can still pay
+func (pdb *DB) getUnpaidRequests() ([]*PaymentRequest, error) {
+	return pdb.getRequests("WHERE status IN (?, ?)", StatusPending, StatusExpired)
+}
+
+func (pdb *DB) GetRequest(id string) (*PaymentRequest, error) {
+	r, err := scanRequest(pdb.db.QueryRow(`SELECT `+selectRequestColumns+` FROM payment_requests WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrPaymentRequestNotFound
+	}
+	return r, err
+}
+
+func (pdb *DB) SaveRequest(r *PaymentRequest) error {
+	var tokenAddress, transferID, paidBy []byte
+	var amount *string
+	if r.TokenAddress != nil {
+		tokenAddress = r.TokenAddress.Bytes()
+	}
+	if r.Amount != nil {
+		value := r.Amount.String()
+		amount = &value
+	}
+	if r.TransferID != nil {
+		transferID = r.TransferID.Bytes()
+	}
+	if r.PaidBy != nil {
+		paidBy = r.PaidBy.Bytes()
+	}
+	_, err := pdb.db.Exec(`INSERT OR REPLACE INTO payment_requests (`+selectRequestColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		r.ID, r.ChainID, r.Recipient.Bytes(), tokenAddress, r.Symbol, r.Decimals, amount, r.Memo, r.Status, r.CreatedAt, r.ExpiresAt,
+		r.PaidAt, transferID, paidBy)
+	return err
+}
+
+// setStatus changes the status of a request from one of the statuses in from, it returns whether it did
+func (pdb *DB) setStatus(id string, status Status, from ...Status) (bool, error) {
+	query := `UPDATE payment_requests SET status = ? WHERE id = ? AND status IN (?` + strings.Repeat(", ?", len(from)-1) + `)`
+	args := []interface{}{status, id}
+	for _, s := range from {
+		args = append(args, s)
+	}
+	res, err := pdb.db.Exec(query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+// expireRequests marks the pending requests which expired before now as expired
+func (pdb *DB) expireRequests(now int64) (int64, error) {
+	res, err := pdb.db.Exec(`UPDATE payment_requests SET status = ? WHERE status = ? AND expires_at > 0 AND expires_at <= ?`,
+		StatusExpired, StatusPending, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (pdb *DB) DeleteRequest(id string) error {
+	res, err := pdb.db.Exec(`DELETE FROM payment_requests WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPaymentRequestNotFound
+	}
+	return nil
+}
+
+// tokenTransfer is a successful transfer of the token of a payment request to its recipient
+type tokenTransfer struct {
+	id        common.Hash
+	timestamp int64
+	from      common.Address
+	amount    *big.Int
+}
+
+// getPaymentTransfers returns the transfers which may pay r, oldest first: the successful transfers of its token to its
+// recipient made while it was open, which didn't already pay another request
+func (pdb *DB) getPaymentTransfers(r *PaymentRequest) ([]*tokenTransfer, error) {
+	transferType, tokenAddress := w_common.EthTransfer, interface{}(nil)
+	if r.TokenAddress != nil {
+		transferType, tokenAddress = w_common.Erc20Transfer, r.TokenAddress.Bytes()
+	}
+	expiresAt := r.ExpiresAt
+	if expiresAt == 0 {
+		expiresAt = math.MaxInt64
+	}
+	rows, err := pdb.db.Query(`
+		SELECT DISTINCT hash, timestamp, tx_from_address, amount_padded128hex
+		FROM transfers
+		WHERE network_id = ? AND tx_to_address = ? AND type = ? AND (? IS NULL OR token_address = ?)
+			AND loaded = 1 AND status = 1 AND timestamp >= ? AND timestamp <= ?
+			AND tx_from_address IS NOT NULL AND amount_padded128hex IS NOT NULL
+			AND hash NOT IN (SELECT transfer_id FROM payment_requests WHERE transfer_id IS NOT NULL)
+		ORDER BY timestamp, hash`,
+		r.ChainID, r.Recipient, transferType, tokenAddress, tokenAddress, r.CreatedAt, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*tokenTransfer
+	for rows.Next() {
+		t := &tokenTransfer{amount: new(big.Int)}
+		var from []byte
+		var amount string
+		if err := rows.Scan(&t.id, &t.timestamp, &from, &amount); err != nil {
+			return nil, err
+		}
+		if _, ok := t.amount.SetString(amount, 16); !ok {
+			continue
+		}
+		t.from = common.BytesToAddress(from)
+		transfers = append(transfers, t)
+	}
+	return transfers, rows.Err()
+}
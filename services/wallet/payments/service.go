@@ -0,0 +1,307 @@
+package payments
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	gethtypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventPaymentRequestsUpdated is sent with the recipients of the payment requests a transfer paid
+	EventPaymentRequestsUpdated walletevent.EventType = "wallet-payment-requests-updated"
+
+	maxMemoLength = 256
+)
+
+type Service struct {
+	db            *DB
+	walletFeed    *event.Feed
+	tokenInfo     func(chainID uint64, address common.Address, native bool) *token.Token
+	findToken     func(chainID uint64, symbol string) (*token.Token, bool)
+	accountExists func(address common.Address) (bool, error)
+
+	// mutex serializes the checks of the transfers and the changes of the requests
+	mutex    sync.Mutex
+	cancelFn context.CancelFunc
+	group    sync.WaitGroup
+}
+
+func NewService(db *sql.DB, accountsDB *accounts.Database, tokenManager *token.Manager, walletFeed *event.Feed) *Service {
+	return &Service{
+		db:         NewDB(db),
+		walletFeed: walletFeed,
+		tokenInfo:  tokenManager.LookupTokenIdentity,
+		findToken: func(chainID uint64, symbol string) (*token.Token, bool) {
+			return tokenManager.LookupToken(&chainID, symbol)
+		},
+		accountExists: func(address common.Address) (bool, error) {
+			return accountsDB.AddressExists(gethtypes.Address(address))
+		},
+	}
+}
+
+// Start checks the unpaid requests when new transfers arrive
+func (s *Service) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelFn = cancel
+
+	ch := make(chan walletevent.Event, 10)
+	sub := s.walletFeed.Subscribe(ch)
+
+	s.group.Add(1)
+	go func() {
+		defer s.group.Done()
+		defer sub.Unsubscribe()
+		// Transfers may have arrived while stopped
+		s.checkTransfers()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-sub.Err():
+				if err != nil {
+					log.Error("payment requests wallet feed subscription failed", "err", err)
+				}
+				return
+			case ev := <-ch:
+				if ev.Type == transfer.EventNewTransfers {
+					s.checkTransfers()
+				}
+			}
+		}
+	}()
+}
+
+func (s *Service) Stop() {
+	if s.cancelFn != nil {
+		s.cancelFn()
+		s.group.Wait()
+		s.cancelFn = nil
+	}
+}
+
+// CreateRequestArgs are the parameters of a payment request to one of the accounts of the user
+type CreateRequestArgs struct {
+	ChainID   uint64         `json:"chainId"`
+	Recipient common.Address `json:"recipient"`
+	Symbol    string         `json:"symbol"`
+	// Amount is in the smallest unit of the token
+	Amount *hexutil.Big `json:"amount"`
+	Memo   string       `json:"memo"`
+	// ExpiresIn is the number of seconds the request can be paid in, zero for a request which doesn't expire
+	ExpiresIn int64 `json:"expiresIn"`
+}
+
+// CreateRequest stores a pending request to pay an account of the user, to share as URI or QR code
+func (s *Service) CreateRequest(args CreateRequestArgs) (*PaymentRequest, error) {
+	if args.Amount == nil || args.Amount.ToInt().Sign() <= 0 {
+		return nil, ErrPaymentAmountRequired
+	}
+	if args.ExpiresIn < 0 {
+		return nil, ErrInvalidExpiry
+	}
+	exists, err := s.accountExists(args.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotAnAccount
+	}
+	info, native := s.findToken(args.ChainID, strings.TrimSpace(args.Symbol))
+	if info == nil {
+		return nil, ErrUnknownToken
+	}
+
+	r := &PaymentRequest{
+		ChainID:   args.ChainID,
+		Recipient: args.Recipient,
+		Symbol:    info.Symbol,
+		Decimals:  info.Decimals,
+		Amount:    args.Amount,
+		Memo:      args.Memo,
+	}
+	if !native {
+		address := info.Address
+		r.TokenAddress = &address
+	}
+	return r, s.save(r, args.ExpiresIn)
+}
+
+// ParseRequest parses an EIP-681 URI, e.g. decoded from a QR code, without storing it
+func (s *Service) ParseRequest(uri string) (*PaymentRequest, error) {
+	u, err := parsePaymentURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	var tokenAddress common.Address
+	if u.TokenAddress != nil {
+		tokenAddress = *u.TokenAddress
+	}
+	info := s.tokenInfo(u.ChainID, tokenAddress, u.TokenAddress == nil)
+	if info == nil {
+		return nil, ErrUnknownToken
+	}
+
+	r := &PaymentRequest{
+		ChainID:      u.ChainID,
+		Recipient:    u.Recipient,
+		TokenAddress: u.TokenAddress,
+		Symbol:       info.Symbol,
+		Decimals:     info.Decimals,
+		Memo:         u.Memo,
+		Status:       StatusPending,
+	}
+	if u.Amount != nil {
+		r.Amount = (*hexutil.Big)(u.Amount)
+	}
+	r.URI = r.paymentURI().String()
+	return r, nil
+}
+
+// AddRequest stores the request of an EIP-681 URI, to be paid by the user
+func (s *Service) AddRequest(uri string) (*PaymentRequest, error) {
+	r, err := s.ParseRequest(uri)
+	if err != nil {
+		return nil, err
+	}
+	return r, s.save(r, 0)
+}
+
+// save stores r as a new pending request, which expires in expiresIn seconds unless zero
+func (s *Service) save(r *PaymentRequest, expiresIn int64) error {
+	if len(r.Memo) > maxMemoLength {
+		return ErrMemoTooLong
+	}
+	r.ID = uuid.New().String()
+	r.Status = StatusPending
+	r.CreatedAt = time.Now().Unix()
+	if expiresIn > 0 {
+		r.ExpiresAt = r.CreatedAt + expiresIn
+	}
+	r.URI = r.paymentURI().String()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.db.SaveRequest(r)
+}
+
+// GetRequests returns all the payment requests, newest first
+func (s *Service) GetRequests() ([]*PaymentRequest, error) {
+	if _, err := s.db.expireRequests(time.Now().Unix()); err != nil {
+		return nil, err
+	}
+	requests, err := s.db.GetRequests()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range requests {
+		r.URI = r.paymentURI().String()
+	}
+	return requests, nil
+}
+
+func (s *Service) GetRequest(id string) (*PaymentRequest, error) {
+	if _, err := s.db.expireRequests(time.Now().Unix()); err != nil {
+		return nil, err
+	}
+	r, err := s.db.GetRequest(id)
+	if err != nil {
+		return nil, err
+	}
+	r.URI = r.paymentURI().String()
+	return r, nil
+}
+
+// CancelRequest cancels an unpaid request, it is no longer marked paid by transfers
+func (s *Service) CancelRequest(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cancelled, err := s.db.setStatus(id, StatusCancelled, StatusPending, StatusExpired)
+	if err != nil || cancelled {
+		return err
+	}
+	if _, err := s.db.GetRequest(id); err != nil {
+		return err
+	}
+	return ErrPaymentRequestNotPending
+}
+
+func (s *Service) DeleteRequest(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.db.DeleteRequest(id)
+}
+
+// checkTransfers marks paid the unpaid requests for which a transfer was found and expires the others
+func (s *Service) checkTransfers() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	requests, err := s.db.getUnpaidRequests()
+	if err != nil {
+		log.Error("failed to get unpaid payment requests", "err", err)
+		return
+	}
+
+	paid := make(map[uint64][]common.Address)
+	for _, r := range requests {
+		transfers, err := s.db.getPaymentTransfers(r)
+		if err != nil {
+			log.Error("failed to get payment request transfers", "id", r.ID, "err", err)
+			continue
+		}
+		for _, t := range transfers {
+			if !r.paidBy(t.amount) {
+				continue
+			}
+			id, from := t.id, t.from
+			r.Status = StatusPaid
+			r.PaidAt = t.timestamp
+			r.TransferID = &id
+			r.PaidBy = &from
+			if err := s.db.SaveRequest(r); err != nil {
+				log.Error("failed to save paid payment request", "id", r.ID, "err", err)
+				break
+			}
+			paid[r.ChainID] = append(paid[r.ChainID], r.Recipient)
+			break
+		}
+	}
+
+	if _, err := s.db.expireRequests(time.Now().Unix()); err != nil {
+		log.Error("failed to expire payment requests", "err", err)
+	}
+
+	if len(paid) == 0 {
+		return
+	}
+
+	var events []walletevent.Event
+	for chainID, recipients := range paid {
+		events = append(events, walletevent.Event{
+			Type:     EventPaymentRequestsUpdated,
+			Accounts: recipients,
+			At:       time.Now().Unix(),
+			ChainID:  chainID,
+		})
+	}
+	// checkTransfers is called by the loop subscribed to the feed
+	walletevent.SendAsync(s.walletFeed, &s.group, events...)
+}
@@ -0,0 +1,251 @@
+package payments
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+var (
+	testToken   = common.Address{9}
+	testAccount = common.Address{0xaa}
+	testPayer   = common.Address{0xbb}
+	testSNT     = &token.Token{Address: testToken, Symbol: "SNT", Decimals: 18, ChainID: 1}
+	testETH     = &token.Token{Symbol: "ETH", Decimals: 18, ChainID: 1}
+)
+
+func setupTestPaymentsDB(t *testing.T) (*DB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-payments-tests")
+	require.NoError(t, err)
+	return NewDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func testTokenInfo(chainID uint64, address common.Address, native bool) *token.Token {
+	if native {
+		return testETH
+	}
+	if address == testToken {
+		return testSNT
+	}
+	return nil
+}
+
+func testFindToken(chainID uint64, symbol string) (*token.Token, bool) {
+	switch symbol {
+	case "ETH":
+		return testETH, true
+	case "SNT":
+		return testSNT, false
+	}
+	return nil, false
+}
+
+// isTestAccount only knows testAccount as an account of the user
+func isTestAccount(address common.Address) (bool, error) {
+	return address == testAccount, nil
+}
+
+// insertTransfer stores a transfer of value of the token, or ETH if zero, from testPayer to to for account
+func insertTransfer(t *testing.T, db *DB, account, to common.Address, tokenAddress common.Address, value int64, timestamp int64, seed int) common.Hash {
+	transfers, _, _ := transfer.GenerateTestTransfers(t, db.db, seed, 1)
+	tr := transfers[0]
+	tr.ChainID = 1
+	tr.From = testPayer
+	tr.To = to
+	tr.Value = value
+	tr.Timestamp = timestamp
+	tr.Success = true
+	// The block of the transfer is only stored for the recipient
+	_, err := db.db.Exec(`INSERT OR IGNORE INTO blocks (network_id, address, blk_number, blk_hash, loaded) VALUES (?, ?, ?, ?, 1)`,
+		tr.ChainID, account, (*bigint.SQLBigInt)(big.NewInt(tr.BlkNumber)), common.HexToHash("4"))
+	require.NoError(t, err)
+	transfer.InsertTestTransferWithOptions(t, db.db, account, &tr, &transfer.TestTransferOptions{TokenAddress: tokenAddress})
+	return tr.Hash
+}
+
+func TestCreateRequestValidation(t *testing.T) {
+	db, stop := setupTestPaymentsDB(t)
+	defer stop()
+
+	s := &Service{db: db, findToken: testFindToken, accountExists: isTestAccount}
+	amount := (*hexutil.Big)(big.NewInt(100))
+
+	testCases := []struct {
+		name string
+		args CreateRequestArgs
+		err  error
+	}{
+		{"no amount", CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "SNT"}, ErrPaymentAmountRequired},
+		{"not an account", CreateRequestArgs{ChainID: 1, Recipient: testPayer, Symbol: "SNT", Amount: amount}, ErrNotAnAccount},
+		{"unknown token", CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "XYZ", Amount: amount}, ErrUnknownToken},
+		{"token", CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "SNT", Amount: amount}, nil},
+		{"native", CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "ETH", Amount: amount}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.CreateRequest(tc.args)
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestCreateRequest(t *testing.T) {
+	db, stop := setupTestPaymentsDB(t)
+	defer stop()
+
+	s := &Service{db: db, tokenInfo: testTokenInfo, findToken: testFindToken, accountExists: isTestAccount}
+
+	r, err := s.CreateRequest(CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "SNT", Amount: (*hexutil.Big)(big.NewInt(100)), Memo: "Invoice", ExpiresIn: 3600})
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, r.Status)
+	require.Equal(t, testToken, *r.TokenAddress)
+	require.Equal(t, r.CreatedAt+3600, r.ExpiresAt)
+
+	// The URI of a request gives the request back
+	parsed, err := s.ParseRequest(r.URI)
+	require.NoError(t, err)
+	require.Equal(t, r.Recipient, parsed.Recipient)
+	require.Equal(t, r.TokenAddress, parsed.TokenAddress)
+	require.Equal(t, r.Amount, parsed.Amount)
+	require.Equal(t, "Invoice", parsed.Memo)
+
+	requests, err := s.GetRequests()
+	require.NoError(t, err)
+	require.Equal(t, []*PaymentRequest{r}, requests)
+
+	require.NoError(t, s.CancelRequest(r.ID))
+	require.Equal(t, ErrPaymentRequestNotPending, s.CancelRequest(r.ID))
+	require.NoError(t, s.DeleteRequest(r.ID))
+	require.Equal(t, ErrPaymentRequestNotFound, s.CancelRequest(r.ID))
+}
+
+func TestRequestPaidByTransfer(t *testing.T) {
+	db, stop := setupTestPaymentsDB(t)
+	defer stop()
+
+	s := &Service{db: db, walletFeed: &event.Feed{}, tokenInfo: testTokenInfo, findToken: testFindToken, accountExists: isTestAccount}
+
+	ch := make(chan walletevent.Event, 10)
+	sub := s.walletFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	args := CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "SNT", Amount: (*hexutil.Big)(big.NewInt(100))}
+	first, err := s.CreateRequest(args)
+	require.NoError(t, err)
+	second, err := s.CreateRequest(args)
+	require.NoError(t, err)
+	now := time.Now().Unix()
+
+	// Before the request, too small and of another token
+	insertTransfer(t, db, testAccount, testAccount, testToken, 100, now-3600, 1)
+	insertTransfer(t, db, testAccount, testAccount, testToken, 99, now, 2)
+	insertTransfer(t, db, testAccount, testAccount, common.Address{}, 100, now, 3)
+	s.checkTransfers()
+	requests, err := s.GetRequests()
+	require.NoError(t, err)
+	for _, r := range requests {
+		require.Equal(t, StatusPending, r.Status)
+	}
+
+	// A transfer pays one request only
+	paying := insertTransfer(t, db, testAccount, testAccount, testToken, 150, now+1, 4)
+	s.checkTransfers()
+	requests, err = s.GetRequests()
+	require.NoError(t, err)
+	paid := 0
+	for _, r := range requests {
+		if r.Status == StatusPaid {
+			paid++
+			require.Equal(t, paying, *r.TransferID)
+			require.Equal(t, testPayer, *r.PaidBy)
+			require.Equal(t, now+1, r.PaidAt)
+		}
+	}
+	require.Equal(t, 1, paid)
+
+	select {
+	case ev := <-ch:
+		require.Equal(t, EventPaymentRequestsUpdated, ev.Type)
+		require.Equal(t, []common.Address{testAccount}, ev.Accounts)
+	case <-time.After(time.Second):
+		t.Fatal("no payment requests event")
+	}
+
+	insertTransfer(t, db, testAccount, testAccount, testToken, 100, now+2, 5)
+	s.checkTransfers()
+	for _, id := range []string{first.ID, second.ID} {
+		r, err := s.GetRequest(id)
+		require.NoError(t, err)
+		require.Equal(t, StatusPaid, r.Status)
+	}
+}
+
+func TestRequestExpiry(t *testing.T) {
+	db, stop := setupTestPaymentsDB(t)
+	defer stop()
+
+	s := &Service{db: db, walletFeed: &event.Feed{}, tokenInfo: testTokenInfo, findToken: testFindToken, accountExists: isTestAccount}
+
+	r, err := s.CreateRequest(CreateRequestArgs{ChainID: 1, Recipient: testAccount, Symbol: "ETH", Amount: (*hexutil.Big)(big.NewInt(10)), ExpiresIn: 60})
+	require.NoError(t, err)
+	_, err = db.db.Exec(`UPDATE payment_requests SET created_at = created_at - 120, expires_at = expires_at - 120 WHERE id = ?`, r.ID)
+	require.NoError(t, err)
+
+	r, err = s.GetRequest(r.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusExpired, r.Status)
+
+	// Paid after the expiry
+	insertTransfer(t, db, testAccount, testAccount, common.Address{}, 10, r.ExpiresAt+1, 1)
+	s.checkTransfers()
+	r, err = s.GetRequest(r.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusExpired, r.Status)
+
+	// Paid in time but found late
+	insertTransfer(t, db, testAccount, testAccount, common.Address{}, 10, r.ExpiresAt-1, 2)
+	s.checkTransfers()
+	r, err = s.GetRequest(r.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusPaid, r.Status)
+}
+
+func TestAddRequest(t *testing.T) {
+	db, stop := setupTestPaymentsDB(t)
+	defer stop()
+
+	s := &Service{db: db, walletFeed: &event.Feed{}, tokenInfo: testTokenInfo, findToken: testFindToken, accountExists: isTestAccount}
+
+	recipient := common.Address{0xcc}
+	_, err := s.AddRequest("ethereum:0x0000000000000000000000000000000000000001@1/transfer?address=" + recipient.Hex())
+	require.Equal(t, ErrUnknownToken, err)
+
+	// The payer chooses the amount
+	r, err := s.AddRequest("ethereum:" + recipient.Hex())
+	require.NoError(t, err)
+	require.Equal(t, "ETH", r.Symbol)
+	require.Nil(t, r.Amount)
+
+	// Paid from an account of the user
+	insertTransfer(t, db, testPayer, recipient, common.Address{}, 1, time.Now().Unix()+1, 1)
+	s.checkTransfers()
+	r, err = s.GetRequest(r.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusPaid, r.Status)
+	require.Equal(t, testPayer, *r.PaidBy)
+}
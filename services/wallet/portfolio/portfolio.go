@@ -0,0 +1,179 @@
+package portfolio
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CostBasisMethod decides which lots are consumed when tokens leave an account
+type CostBasisMethod = string
+
+const (
+	// CostBasisFIFO consumes the oldest lots first
+	CostBasisFIFO CostBasisMethod = "fifo"
+	// CostBasisAverage consumes all the lots proportionally, at their average cost
+	CostBasisAverage CostBasisMethod = "average"
+)
+
+var ErrUnknownCostBasisMethod = errors.New("unknown cost basis method")
+
+func validMethod(method CostBasisMethod) bool {
+	return method == CostBasisFIFO || method == CostBasisAverage
+}
+
+// Lot is an amount of a token acquired by an account in a transfer, valued in fiat at the day it arrived
+type Lot struct {
+	ChainID      uint64         `json:"chainId"`
+	Account      common.Address `json:"account"`
+	TokenAddress common.Address `json:"tokenAddress"`
+	// TransferID is the hash of the transfer which brought the tokens
+	TransferID common.Hash  `json:"transferId"`
+	AcquiredAt int64        `json:"acquiredAt"`
+	Amount     *hexutil.Big `json:"amount"`
+	Remaining  *hexutil.Big `json:"remaining"`
+	// Cost is the fiat value of Amount when it was acquired
+	Cost float64 `json:"cost"`
+	// Priced is false when no price was known for the day, Cost is then zero
+	Priced bool `json:"priced"`
+}
+
+// remainingCost is the part of the cost of the lot which was not disposed yet
+func (l *Lot) remainingCost() float64 {
+	return proportion(l.Cost, l.Remaining.ToInt(), l.Amount.ToInt())
+}
+
+// Disposal is an amount of a token which left an account, with its realised profit or loss
+type Disposal struct {
+	ChainID      uint64         `json:"chainId"`
+	Account      common.Address `json:"account"`
+	TokenAddress common.Address `json:"tokenAddress"`
+	TransferID   common.Hash    `json:"transferId"`
+	DisposedAt   int64          `json:"disposedAt"`
+	Amount       *hexutil.Big   `json:"amount"`
+	// Proceeds is the fiat value of Amount when it left the account
+	Proceeds float64 `json:"proceeds"`
+	// Cost is the cost basis of Amount, taken from the consumed lots
+	Cost float64 `json:"cost"`
+	// Priced is false when the proceeds or a part of the cost are unknown
+	Priced bool `json:"priced"`
+}
+
+// TokenProfitAndLoss is the profit and loss of a token held by an account on a chain
+type TokenProfitAndLoss struct {
+	ChainID      uint64         `json:"chainId"`
+	Account      common.Address `json:"account"`
+	TokenAddress common.Address `json:"tokenAddress"`
+	Symbol       string         `json:"symbol"`
+	Decimals     uint           `json:"decimals"`
+	// Balance is the amount of the token left in the lots
+	Balance   *hexutil.Big `json:"balance"`
+	CostBasis float64      `json:"costBasis"`
+	// Value is the current fiat value of Balance, zero if the current price is unknown
+	Value          float64 `json:"value"`
+	RealisedPnL    float64 `json:"realisedPnL"`
+	UnrealisedPnL  float64 `json:"unrealisedPnL"`
+	Incomplete     bool    `json:"incomplete"`
+	DisposalsCount int     `json:"disposalsCount"`
+}
+
+// proportion returns total * part / whole
+func proportion(total float64, part, whole *big.Int) float64 {
+	if whole.Sign() == 0 {
+		return 0
+	}
+	ratio, _ := new(big.Rat).SetFrac(part, whole).Float64()
+	return total * ratio
+}
+
+// ledger holds the lots of a token of an account, ordered by acquisition
+type ledger struct {
+	method CostBasisMethod
+	lots   []*Lot
+}
+
+func newLedger(method CostBasisMethod, lots []*Lot) *ledger {
+	return &ledger{method: method, lots: lots}
+}
+
+func (l *ledger) acquire(lot *Lot) {
+	l.lots = append(l.lots, lot)
+}
+
+// dispose consumes amount from the lots and returns its cost basis, whether all of it was priced,
+// and the lots which changed
+func (l *ledger) dispose(amount *big.Int) (cost float64, priced bool, changed []*Lot) {
+	priced = true
+	available := new(big.Int)
+	for _, lot := range l.lots {
+		available.Add(available, lot.Remaining.ToInt())
+	}
+
+	// Tokens which arrived before the known history have no cost basis
+	if amount.Cmp(available) > 0 {
+		priced = false
+		amount = available
+	}
+	if amount.Sign() == 0 {
+		return 0, priced, nil
+	}
+
+	switch l.method {
+	case CostBasisAverage:
+		for _, lot := range l.lots {
+			remaining := lot.Remaining.ToInt()
+			if remaining.Sign() == 0 {
+				continue
+			}
+			// Every lot keeps the same share of what remains, so the average cost doesn't change
+			take := new(big.Int).Mul(remaining, amount)
+			take.Div(take, available)
+			if take.Sign() == 0 {
+				continue
+			}
+			cost += proportion(lot.Cost, take, lot.Amount.ToInt())
+			priced = priced && lot.Priced
+			lot.Remaining = (*hexutil.Big)(new(big.Int).Sub(remaining, take))
+			changed = append(changed, lot)
+		}
+	default:
+		left := new(big.Int).Set(amount)
+		for _, lot := range l.lots {
+			remaining := lot.Remaining.ToInt()
+			if left.Sign() == 0 {
+				break
+			}
+			if remaining.Sign() == 0 {
+				continue
+			}
+			take := new(big.Int).Set(left)
+			if take.Cmp(remaining) > 0 {
+				take.Set(remaining)
+			}
+			cost += proportion(lot.Cost, take, lot.Amount.ToInt())
+			priced = priced && lot.Priced
+			lot.Remaining = (*hexutil.Big)(new(big.Int).Sub(remaining, take))
+			left.Sub(left, take)
+			changed = append(changed, lot)
+		}
+	}
+
+	return cost, priced, changed
+}
+
+// balance returns the amount left in the lots, its cost basis and whether all of it was priced
+func (l *ledger) balance() (amount *big.Int, cost float64, priced bool) {
+	amount = new(big.Int)
+	priced = true
+	for _, lot := range l.lots {
+		if lot.Remaining.ToInt().Sign() == 0 {
+			continue
+		}
+		amount.Add(amount, lot.Remaining.ToInt())
+		cost += lot.remainingCost()
+		priced = priced && lot.Priced
+	}
+	return amount, cost, priced
+}
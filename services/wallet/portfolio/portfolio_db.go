@@ -0,0 +1,253 @@
+package portfolio
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+type DB struct {
+	db *sql.DB
+}
+
+func NewDB(sqlDb *sql.DB) *DB {
+	return &DB{
+		db: sqlDb,
+	}
+}
+
+// cursor marks the transfers of an account already accounted for in its lots
+type cursor struct {
+	currency string
+	method   CostBasisMethod
+	// timestamp of the last transfer accounted for
+	timestamp int64
+	// transfersCount is the number of transfers up to timestamp, more of them means older history was loaded since
+	transfersCount int64
+}
+
+// tokenTransfer is a fungible token transfer of an account
+type tokenTransfer struct {
+	id           common.Hash
+	timestamp    int64
+	tokenAddress common.Address
+	native       bool
+	from         common.Address
+	to           common.Address
+	amount       *big.Int
+}
+
+func (pdb *DB) getCursor(chainID uint64, account common.Address) (*cursor, error) {
+	c := &cursor{}
+	err := pdb.db.QueryRow(`SELECT currency, method, timestamp, transfers_count FROM portfolio_cursors WHERE chain_id = ? AND account = ?`,
+		chainID, account).Scan(&c.currency, &c.method, &c.timestamp, &c.transfersCount)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// getCursorChains returns the chains the lots of account are tracked on, with their cursor
+func (pdb *DB) getCursorChains(account common.Address) (map[uint64]*cursor, error) {
+	rows, err := pdb.db.Query(`SELECT chain_id, currency, method, timestamp, transfers_count FROM portfolio_cursors WHERE account = ?`, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cursors := make(map[uint64]*cursor)
+	for rows.Next() {
+		var chainID uint64
+		c := &cursor{}
+		if err := rows.Scan(&chainID, &c.currency, &c.method, &c.timestamp, &c.transfersCount); err != nil {
+			return nil, err
+		}
+		cursors[chainID] = c
+	}
+	return cursors, rows.Err()
+}
+
+// countTransfers returns the number of loaded transfers of account up to timestamp
+func (pdb *DB) countTransfers(chainID uint64, account common.Address, timestamp int64) (int64, error) {
+	var count int64
+	err := pdb.db.QueryRow(`SELECT COUNT(*) FROM transfers WHERE network_id = ? AND address = ? AND loaded = 1 AND timestamp <= ?`,
+		chainID, account, timestamp).Scan(&count)
+	return count, err
+}
+
+// getTransfersAfter returns the successful ETH and ERC-20 transfers of account after timestamp, oldest first,
+// and the timestamp and number of all the transfers of account
+func (pdb *DB) getTransfersAfter(chainID uint64, account common.Address, timestamp int64) (transfers []*tokenTransfer, last int64, count int64, err error) {
+	err = pdb.db.QueryRow(`SELECT COALESCE(MAX(timestamp), 0), COUNT(*) FROM transfers WHERE network_id = ? AND address = ? AND loaded = 1`,
+		chainID, account).Scan(&last, &count)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	rows, err := pdb.db.Query(`
+		SELECT hash, timestamp, type, token_address, tx_from_address, tx_to_address, amount_padded128hex
+		FROM transfers
+		WHERE network_id = ? AND address = ? AND loaded = 1 AND timestamp > ? AND timestamp <= ? AND status = 1
+			AND type IN (?, ?) AND tx_from_address IS NOT NULL AND tx_to_address IS NOT NULL
+		ORDER BY timestamp, log_index, hash`,
+		chainID, account, timestamp, last, w_common.EthTransfer, w_common.Erc20Transfer)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t := &tokenTransfer{amount: new(big.Int)}
+		var transferType w_common.Type
+		var tokenAddress, from, to sql.RawBytes
+		var amount sql.NullString
+		err = rows.Scan(&t.id, &t.timestamp, &transferType, &tokenAddress, &from, &to, &amount)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if !amount.Valid {
+			continue
+		}
+		if _, ok := t.amount.SetString(amount.String, 16); !ok || t.amount.Sign() == 0 {
+			continue
+		}
+		t.native = transferType == w_common.EthTransfer
+		if !t.native {
+			t.tokenAddress = common.BytesToAddress(tokenAddress)
+		}
+		t.from = common.BytesToAddress(from)
+		t.to = common.BytesToAddress(to)
+		transfers = append(transfers, t)
+	}
+	return transfers, last, count, rows.Err()
+}
+
+const selectLotColumns = `chain_id, account, token_address, transfer_id, acquired_at, amount, remaining, cost, priced`
+
+// GetLots returns the lots of account on chainID ordered by acquisition, only the ones with a remaining
+// amount if open is set
+func (pdb *DB) GetLots(chainID uint64, account common.Address, open bool) ([]*Lot, error) {
+	query := fmt.Sprintf(`SELECT %s FROM portfolio_lots WHERE chain_id = ? AND account = ?`, selectLotColumns)
+	if open {
+		// Zero is stored as an empty blob
+		query += ` AND LENGTH(remaining) > 0`
+	}
+	query += ` ORDER BY acquired_at, transfer_id`
+
+	rows, err := pdb.db.Query(query, chainID, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lots []*Lot
+	for rows.Next() {
+		lot := &Lot{}
+		amount, remaining := new(big.Int), new(big.Int)
+		err := rows.Scan(&lot.ChainID, &lot.Account, &lot.TokenAddress, &lot.TransferID, &lot.AcquiredAt,
+			(*bigint.SQLBigIntBytes)(amount), (*bigint.SQLBigIntBytes)(remaining), &lot.Cost, &lot.Priced)
+		if err != nil {
+			return nil, err
+		}
+		lot.Amount = (*hexutil.Big)(amount)
+		lot.Remaining = (*hexutil.Big)(remaining)
+		lots = append(lots, lot)
+	}
+	return lots, rows.Err()
+}
+
+// GetDisposals returns the disposals of accounts on the given chains, all of them if empty
+func (pdb *DB) GetDisposals(accounts []common.Address, chainIDs []uint64) ([]*Disposal, error) {
+	query := `SELECT chain_id, account, token_address, transfer_id, disposed_at, amount, proceeds, cost, priced FROM portfolio_disposals WHERE 1`
+	var args []interface{}
+	if len(accounts) > 0 {
+		query += fmt.Sprintf(" AND account IN (?%s)", strings.Repeat(",?", len(accounts)-1))
+		for _, account := range accounts {
+			args = append(args, account)
+		}
+	}
+	if len(chainIDs) > 0 {
+		query += fmt.Sprintf(" AND chain_id IN (?%s)", strings.Repeat(",?", len(chainIDs)-1))
+		for _, chainID := range chainIDs {
+			args = append(args, chainID)
+		}
+	}
+	query += " ORDER BY disposed_at, transfer_id"
+
+	rows, err := pdb.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var disposals []*Disposal
+	for rows.Next() {
+		d := &Disposal{}
+		amount := new(big.Int)
+		err := rows.Scan(&d.ChainID, &d.Account, &d.TokenAddress, &d.TransferID, &d.DisposedAt, (*bigint.SQLBigIntBytes)(amount),
+			&d.Proceeds, &d.Cost, &d.Priced)
+		if err != nil {
+			return nil, err
+		}
+		d.Amount = (*hexutil.Big)(amount)
+		disposals = append(disposals, d)
+	}
+	return disposals, rows.Err()
+}
+
+// saveUpdate stores the new and changed lots and the disposals of account, moving its cursor.
+// When reset is set the previous lots and disposals of the account are removed first
+func (pdb *DB) saveUpdate(chainID uint64, account common.Address, reset bool, lots []*Lot, disposals []*Disposal, c *cursor) (err error) {
+	tx, err := pdb.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	if reset {
+		for _, table := range []string{"portfolio_lots", "portfolio_disposals"} {
+			_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE chain_id = ? AND account = ?`, table), chainID, account)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, lot := range lots {
+		_, err = tx.Exec(fmt.Sprintf(`INSERT OR REPLACE INTO portfolio_lots (%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, selectLotColumns),
+			lot.ChainID, lot.Account, lot.TokenAddress, lot.TransferID, lot.AcquiredAt, (*bigint.SQLBigIntBytes)(lot.Amount.ToInt()),
+			(*bigint.SQLBigIntBytes)(lot.Remaining.ToInt()), lot.Cost, lot.Priced)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d := range disposals {
+		_, err = tx.Exec(`INSERT OR REPLACE INTO portfolio_disposals (chain_id, account, token_address, transfer_id, disposed_at, amount, proceeds, cost, priced)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			d.ChainID, d.Account, d.TokenAddress, d.TransferID, d.DisposedAt, (*bigint.SQLBigIntBytes)(d.Amount.ToInt()), d.Proceeds, d.Cost, d.Priced)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO portfolio_cursors (chain_id, account, currency, method, timestamp, transfers_count) VALUES (?, ?, ?, ?, ?, ?)`,
+		chainID, account, c.currency, c.method, c.timestamp, c.transfersCount)
+	return err
+}
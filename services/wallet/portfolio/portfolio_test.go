@@ -0,0 +1,67 @@
+package portfolio
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func testLot(id byte, amount int64, cost float64) *Lot {
+	return &Lot{
+		TransferID: common.Hash{id},
+		Amount:     (*hexutil.Big)(big.NewInt(amount)),
+		Remaining:  (*hexutil.Big)(big.NewInt(amount)),
+		Cost:       cost,
+		Priced:     true,
+	}
+}
+
+func TestLedgerFIFO(t *testing.T) {
+	l := newLedger(CostBasisFIFO, []*Lot{testLot(1, 100, 100), testLot(2, 100, 300)})
+
+	cost, priced, changed := l.dispose(big.NewInt(150))
+	require.True(t, priced)
+	require.Equal(t, float64(250), cost)
+	require.Len(t, changed, 2)
+	require.Equal(t, int64(0), l.lots[0].Remaining.ToInt().Int64())
+	require.Equal(t, int64(50), l.lots[1].Remaining.ToInt().Int64())
+
+	amount, cost, priced := l.balance()
+	require.True(t, priced)
+	require.Equal(t, int64(50), amount.Int64())
+	require.Equal(t, float64(150), cost)
+
+	// Tokens without known lots have no cost basis
+	cost, priced, changed = l.dispose(big.NewInt(80))
+	require.False(t, priced)
+	require.Equal(t, float64(150), cost)
+	require.Len(t, changed, 1)
+	amount, _, _ = l.balance()
+	require.Equal(t, int64(0), amount.Int64())
+}
+
+func TestLedgerAverage(t *testing.T) {
+	l := newLedger(CostBasisAverage, []*Lot{testLot(1, 100, 100), testLot(2, 100, 300)})
+
+	cost, priced, changed := l.dispose(big.NewInt(100))
+	require.True(t, priced)
+	require.Equal(t, float64(200), cost)
+	require.Len(t, changed, 2)
+	require.Equal(t, int64(50), l.lots[0].Remaining.ToInt().Int64())
+	require.Equal(t, int64(50), l.lots[1].Remaining.ToInt().Int64())
+
+	// The average cost of what remains doesn't change
+	amount, cost, _ := l.balance()
+	require.Equal(t, int64(100), amount.Int64())
+	require.Equal(t, float64(200), cost)
+
+	l.acquire(testLot(3, 100, 400))
+	l.lots[2].Priced = false
+	cost, priced, _ = l.dispose(big.NewInt(100))
+	require.False(t, priced)
+	require.Equal(t, float64(300), cost)
+}
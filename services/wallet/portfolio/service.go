@@ -0,0 +1,417 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventPortfolioUpdated is sent with the accounts whose lots were updated after new transfers arrived
+	EventPortfolioUpdated walletevent.EventType = "wallet-portfolio-updated"
+)
+
+// HistoricalPrices provides the daily exchange rates used to value the lots and disposals.
+// It is implemented by history.Exchange
+type HistoricalPrices interface {
+	FetchAndCacheMissingRates(token string, currency string) error
+	GetExchangeRateForDay(token string, currency string, date time.Time) (float32, error)
+}
+
+// CurrentPrices provides the prices used to value the lots still held. It is implemented by market.Manager
+type CurrentPrices interface {
+	FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error)
+}
+
+type Service struct {
+	db            *DB
+	walletFeed    *event.Feed
+	prices        HistoricalPrices
+	currentPrices CurrentPrices
+	tokenInfo     func(chainID uint64, address common.Address, native bool) *token.Token
+
+	// updateMutex serializes the updates of the lots
+	updateMutex sync.Mutex
+	cancelFn    context.CancelFunc
+	group       sync.WaitGroup
+}
+
+func NewService(db *sql.DB, tokenManager *token.Manager, walletFeed *event.Feed, prices HistoricalPrices, currentPrices CurrentPrices) *Service {
+	return &Service{
+		db:            NewDB(db),
+		walletFeed:    walletFeed,
+		prices:        prices,
+		currentPrices: currentPrices,
+		tokenInfo:     tokenManager.LookupTokenIdentity,
+	}
+}
+
+// Start updates the lots of the tracked accounts when new transfers arrive
+func (s *Service) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelFn = cancel
+
+	ch := make(chan walletevent.Event, 10)
+	sub := s.walletFeed.Subscribe(ch)
+
+	s.group.Add(1)
+	go func() {
+		defer s.group.Done()
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-sub.Err():
+				if err != nil {
+					log.Error("portfolio wallet feed subscription failed", "err", err)
+				}
+				return
+			case ev := <-ch:
+				if ev.Type == transfer.EventNewTransfers {
+					s.onNewTransfers(ev.Accounts)
+				}
+			}
+		}
+	}()
+}
+
+func (s *Service) Stop() {
+	if s.cancelFn != nil {
+		s.cancelFn()
+		s.group.Wait()
+		s.cancelFn = nil
+	}
+}
+
+// onNewTransfers updates the lots of accounts on the chains they are already tracked on
+func (s *Service) onNewTransfers(accounts []common.Address) {
+	var updated []common.Address
+	for _, account := range accounts {
+		cursors, err := s.db.getCursorChains(account)
+		if err != nil {
+			log.Error("failed to get portfolio cursors", "account", account, "err", err)
+			continue
+		}
+		for chainID, c := range cursors {
+			if err := s.update(chainID, account, c.currency, c.method); err != nil {
+				log.Error("failed to update portfolio lots", "chainID", chainID, "account", account, "err", err)
+				continue
+			}
+		}
+		if len(cursors) > 0 {
+			updated = append(updated, account)
+		}
+	}
+
+	if len(updated) > 0 {
+		// onNewTransfers is called by the loop subscribed to the feed
+		walletevent.SendAsync(s.walletFeed, &s.group, walletevent.Event{
+			Type:     EventPortfolioUpdated,
+			Accounts: updated,
+			At:       time.Now().Unix(),
+		})
+	}
+}
+
+// update accounts for the transfers of account which arrived since the last update. All the lots are rebuilt
+// if older transfers were loaded since, or if the currency or the cost basis method changed
+func (s *Service) update(chainID uint64, account common.Address, currency string, method CostBasisMethod) error {
+	s.updateMutex.Lock()
+	defer s.updateMutex.Unlock()
+
+	c, err := s.db.getCursor(chainID, account)
+	if err != nil {
+		return err
+	}
+
+	reset := c == nil || c.currency != currency || c.method != method
+	if !reset {
+		count, err := s.db.countTransfers(chainID, account, c.timestamp)
+		if err != nil {
+			return err
+		}
+		reset = count != c.transfersCount
+	}
+
+	from := int64(0)
+	if !reset {
+		from = c.timestamp
+	}
+	transfers, last, count, err := s.db.getTransfersAfter(chainID, account, from)
+	if err != nil {
+		return err
+	}
+	if !reset && last == c.timestamp {
+		return nil
+	}
+
+	var lots []*Lot
+	if !reset {
+		lots, err = s.db.GetLots(chainID, account, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	ledgers := make(map[common.Address]*ledger)
+	for _, lot := range lots {
+		if ledgers[lot.TokenAddress] == nil {
+			ledgers[lot.TokenAddress] = newLedger(method, nil)
+		}
+		ledgers[lot.TokenAddress].acquire(lot)
+	}
+
+	valuer := newValuer(s, chainID, currency)
+	changedLots := make(map[common.Hash]*Lot)
+	var newLots []*Lot
+	var disposals []*Disposal
+	for _, t := range transfers {
+		// Tokens sent to self don't change the position
+		if t.from == t.to {
+			continue
+		}
+		l := ledgers[t.tokenAddress]
+		if l == nil {
+			l = newLedger(method, nil)
+			ledgers[t.tokenAddress] = l
+		}
+
+		value, priced := valuer.value(t)
+		if t.to == account {
+			lot := &Lot{
+				ChainID:      chainID,
+				Account:      account,
+				TokenAddress: t.tokenAddress,
+				TransferID:   t.id,
+				AcquiredAt:   t.timestamp,
+				Amount:       (*hexutil.Big)(t.amount),
+				Remaining:    (*hexutil.Big)(new(big.Int).Set(t.amount)),
+				Cost:         value,
+				Priced:       priced,
+			}
+			l.acquire(lot)
+			newLots = append(newLots, lot)
+		} else if t.from == account {
+			cost, costPriced, changed := l.dispose(t.amount)
+			for _, lot := range changed {
+				changedLots[lot.TransferID] = lot
+			}
+			disposals = append(disposals, &Disposal{
+				ChainID:      chainID,
+				Account:      account,
+				TokenAddress: t.tokenAddress,
+				TransferID:   t.id,
+				DisposedAt:   t.timestamp,
+				Amount:       (*hexutil.Big)(t.amount),
+				Proceeds:     value,
+				Cost:         cost,
+				Priced:       priced && costPriced,
+			})
+		}
+	}
+
+	// New lots are stored once, with their remaining amount after the later disposals
+	for _, lot := range newLots {
+		changedLots[lot.TransferID] = lot
+	}
+	toSave := make([]*Lot, 0, len(changedLots))
+	for _, lot := range changedLots {
+		toSave = append(toSave, lot)
+	}
+
+	return s.db.saveUpdate(chainID, account, reset, toSave, disposals, &cursor{
+		currency:       currency,
+		method:         method,
+		timestamp:      last,
+		transfersCount: count,
+	})
+}
+
+// GetProfitAndLoss updates the lots of accounts on the given chains and returns the profit and loss of each of their tokens.
+// Transfers are valued in currency with the exchange rate of their day, moves between the accounts are valued as any other transfer
+func (s *Service) GetProfitAndLoss(ctx context.Context, accounts []common.Address, chainIDs []uint64, currency string,
+	method CostBasisMethod) ([]*TokenProfitAndLoss, error) {
+	if method == "" {
+		method = CostBasisFIFO
+	}
+	if !validMethod(method) {
+		return nil, ErrUnknownCostBasisMethod
+	}
+	currency = strings.ToUpper(currency)
+
+	type positionKey struct {
+		chainID uint64
+		account common.Address
+		token   common.Address
+	}
+	positions := make(map[positionKey]*TokenProfitAndLoss)
+	var ordered []*TokenProfitAndLoss
+	position := func(chainID uint64, account, tokenAddress common.Address) *TokenProfitAndLoss {
+		key := positionKey{chainID, account, tokenAddress}
+		p, ok := positions[key]
+		if !ok {
+			p = &TokenProfitAndLoss{ChainID: chainID, Account: account, TokenAddress: tokenAddress, Balance: (*hexutil.Big)(big.NewInt(0))}
+			if info := s.tokenInfo(chainID, tokenAddress, tokenAddress == common.Address{}); info != nil {
+				p.Symbol = info.Symbol
+				p.Decimals = info.Decimals
+			}
+			positions[key] = p
+			ordered = append(ordered, p)
+		}
+		return p
+	}
+
+	for _, chainID := range chainIDs {
+		for _, account := range accounts {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if err := s.update(chainID, account, currency, method); err != nil {
+				return nil, err
+			}
+
+			lots, err := s.db.GetLots(chainID, account, true)
+			if err != nil {
+				return nil, err
+			}
+			ledgers := make(map[common.Address]*ledger)
+			for _, lot := range lots {
+				if ledgers[lot.TokenAddress] == nil {
+					ledgers[lot.TokenAddress] = newLedger(method, nil)
+				}
+				ledgers[lot.TokenAddress].acquire(lot)
+			}
+			for tokenAddress, l := range ledgers {
+				p := position(chainID, account, tokenAddress)
+				amount, cost, priced := l.balance()
+				p.Balance = (*hexutil.Big)(amount)
+				p.CostBasis = cost
+				p.Incomplete = p.Incomplete || !priced
+			}
+		}
+	}
+
+	disposals, err := s.db.GetDisposals(accounts, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range disposals {
+		p := position(d.ChainID, d.Account, d.TokenAddress)
+		p.RealisedPnL += d.Proceeds - d.Cost
+		p.DisposalsCount++
+		p.Incomplete = p.Incomplete || !d.Priced
+	}
+
+	sort.Slice(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		if a.Account != b.Account {
+			return a.Account.Hex() < b.Account.Hex()
+		}
+		return a.TokenAddress.Hex() < b.TokenAddress.Hex()
+	})
+
+	s.setCurrentValues(ordered, currency)
+	return ordered, nil
+}
+
+// setCurrentValues sets the value of the balances and the unrealised profit and loss with the current prices
+func (s *Service) setCurrentValues(positions []*TokenProfitAndLoss, currency string) {
+	var symbols []string
+	seen := make(map[string]bool)
+	for _, p := range positions {
+		if p.Symbol != "" && p.Balance.ToInt().Sign() > 0 && !seen[p.Symbol] {
+			seen[p.Symbol] = true
+			symbols = append(symbols, p.Symbol)
+		}
+	}
+
+	var prices map[string]map[string]float64
+	if len(symbols) > 0 && s.currentPrices != nil {
+		var err error
+		prices, err = s.currentPrices.FetchPrices(symbols, []string{currency})
+		if err != nil {
+			log.Warn("failed to fetch current prices for portfolio", "err", err)
+		}
+	}
+
+	for _, p := range positions {
+		if p.Balance.ToInt().Sign() == 0 {
+			continue
+		}
+		price, ok := prices[p.Symbol][currency]
+		if !ok {
+			p.Incomplete = true
+			continue
+		}
+		p.Value = amountValue(p.Balance.ToInt(), p.Decimals, price)
+		p.UnrealisedPnL = p.Value - p.CostBasis
+	}
+}
+
+// GetLots returns all the lots of account on chainID, as of the last update
+func (s *Service) GetLots(chainID uint64, account common.Address) ([]*Lot, error) {
+	return s.db.GetLots(chainID, account, false)
+}
+
+// amountValue is the fiat value of amount, in the smallest unit of a token with decimals, at price
+func amountValue(amount *big.Int, decimals uint, price float64) float64 {
+	value := new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	v, _ := value.Float64()
+	return v * price
+}
+
+// valuer values transfers with the exchange rate of their day, fetching the rates of each token once
+type valuer struct {
+	s        *Service
+	chainID  uint64
+	currency string
+	fetched  map[string]bool
+}
+
+func newValuer(s *Service, chainID uint64, currency string) *valuer {
+	return &valuer{s: s, chainID: chainID, currency: currency, fetched: make(map[string]bool)}
+}
+
+// value returns the fiat value of the transfer at its day, false if it is unknown
+func (v *valuer) value(t *tokenTransfer) (float64, bool) {
+	info := v.s.tokenInfo(v.chainID, t.tokenAddress, t.native)
+	if info == nil || v.s.prices == nil || v.currency == "" {
+		return 0, false
+	}
+
+	fetched, found := v.fetched[info.Symbol]
+	if !found {
+		err := v.s.prices.FetchAndCacheMissingRates(info.Symbol, v.currency)
+		if err != nil {
+			log.Warn("failed to fetch exchange rates for portfolio", "symbol", info.Symbol, "currency", v.currency, "err", err)
+		}
+		fetched = err == nil
+		v.fetched[info.Symbol] = fetched
+	}
+	if !fetched {
+		return 0, false
+	}
+
+	rate, err := v.s.prices.GetExchangeRateForDay(info.Symbol, v.currency, time.Unix(t.timestamp, 0).UTC())
+	if err != nil {
+		return 0, false
+	}
+	return amountValue(t.amount, info.Decimals, float64(rate)), true
+}
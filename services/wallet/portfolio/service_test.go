@@ -0,0 +1,231 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const testDay = int64(24 * 60 * 60)
+
+var (
+	testStart = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC).Unix()
+	testToken = common.Address{9}
+)
+
+// testPrices has a rate for each day since testStart
+type testPrices struct {
+	rates []float32
+	calls int
+}
+
+func (p *testPrices) FetchAndCacheMissingRates(token string, currency string) error {
+	return nil
+}
+
+func (p *testPrices) GetExchangeRateForDay(token string, currency string, date time.Time) (float32, error) {
+	p.calls++
+	day := (date.Unix() - testStart) / testDay
+	if day < 0 || day >= int64(len(p.rates)) {
+		return 0, errors.New("missing day")
+	}
+	return p.rates[day], nil
+}
+
+type testCurrentPrices struct {
+	price float64
+}
+
+func (p *testCurrentPrices) FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error) {
+	prices := make(map[string]map[string]float64)
+	for _, symbol := range symbols {
+		prices[symbol] = map[string]float64{currencies[0]: p.price}
+	}
+	return prices, nil
+}
+
+func setupTestPortfolioDB(t *testing.T) (*DB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-portfolio-tests")
+	require.NoError(t, err)
+	return NewDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+// testTokenInfo knows the native ETH and any token as TKN without decimals
+func testTokenInfo(chainID uint64, address common.Address, native bool) *token.Token {
+	if native {
+		return &token.Token{Symbol: "ETH", Decimals: 18}
+	}
+	return &token.Token{Address: address, Symbol: "TKN", Decimals: 0}
+}
+
+// insertTokenTransfer stores a transfer of value TKN between from and to, for account, on the given day
+func insertTokenTransfer(t *testing.T, db *DB, account, from, to common.Address, value int64, day int64, seed int) {
+	transfers, _, _ := transfer.GenerateTestTransfers(t, db.db, seed, 1)
+	tr := transfers[0]
+	tr.ChainID = 1
+	tr.From = from
+	tr.To = to
+	tr.Value = value
+	tr.Timestamp = testStart + day*testDay
+	tr.BlkNumber = day + 1
+	tr.Success = true
+	transfer.InsertTestTransferWithOptions(t, db.db, account, &tr, &transfer.TestTransferOptions{TokenAddress: testToken})
+}
+
+func TestProfitAndLoss(t *testing.T) {
+	account := common.Address{1}
+	other := common.Address{2}
+
+	// Received 100 at 1 and 100 at 3, sold 150 at 4, 50 left valued at 5
+	testCases := []struct {
+		name          string
+		method        CostBasisMethod
+		costBasis     float64
+		realisedPnL   float64
+		unrealisedPnL float64
+		remaining     []int64
+		err           error
+	}{
+		{"default", "", 150, 350, 100, []int64{0, 50}, nil},
+		{"fifo", CostBasisFIFO, 150, 350, 100, []int64{0, 50}, nil},
+		{"average", CostBasisAverage, 100, 300, 150, []int64{25, 25}, nil},
+		{"unknown", "lifo", 0, 0, 0, nil, ErrUnknownCostBasisMethod},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, stop := setupTestPortfolioDB(t)
+			defer stop()
+
+			prices := &testPrices{rates: []float32{1, 1, 3, 4, 4}}
+			s := &Service{db: db, walletFeed: &event.Feed{}, prices: prices, currentPrices: &testCurrentPrices{price: 5}, tokenInfo: testTokenInfo}
+
+			insertTokenTransfer(t, db, account, other, account, 100, 1, 1)
+			insertTokenTransfer(t, db, account, other, account, 100, 2, 2)
+			insertTokenTransfer(t, db, account, account, other, 150, 3, 3)
+
+			positions, err := s.GetProfitAndLoss(context.Background(), []common.Address{account}, []uint64{1}, "usd", tc.method)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, positions, 1)
+			p := positions[0]
+			require.Equal(t, testToken, p.TokenAddress)
+			require.Equal(t, "TKN", p.Symbol)
+			require.Equal(t, int64(50), p.Balance.ToInt().Int64())
+			require.Equal(t, float64(250), p.Value)
+			require.Equal(t, tc.costBasis, p.CostBasis)
+			require.Equal(t, tc.realisedPnL, p.RealisedPnL)
+			require.Equal(t, tc.unrealisedPnL, p.UnrealisedPnL)
+			require.Equal(t, 1, p.DisposalsCount)
+			require.False(t, p.Incomplete)
+
+			lots, err := s.GetLots(1, account)
+			require.NoError(t, err)
+			require.Len(t, lots, len(tc.remaining))
+			for i, remaining := range tc.remaining {
+				require.Equal(t, remaining, lots[i].Remaining.ToInt().Int64())
+			}
+		})
+	}
+}
+
+func TestIncrementalUpdate(t *testing.T) {
+	db, stop := setupTestPortfolioDB(t)
+	defer stop()
+
+	prices := &testPrices{rates: []float32{2, 1, 3, 4, 4}}
+	feed := &event.Feed{}
+	s := &Service{db: db, walletFeed: feed, prices: prices, currentPrices: &testCurrentPrices{price: 5}, tokenInfo: testTokenInfo}
+
+	account := common.Address{1}
+	other := common.Address{2}
+	insertTokenTransfer(t, db, account, other, account, 100, 1, 1)
+	insertTokenTransfer(t, db, account, account, other, 50, 3, 2)
+
+	positions, err := s.GetProfitAndLoss(context.Background(), []common.Address{account}, []uint64{1}, "usd", "")
+	require.NoError(t, err)
+	require.Equal(t, float64(150), positions[0].RealisedPnL)
+	require.Equal(t, 2, prices.calls)
+
+	// Nothing new, nothing is valued again
+	_, err = s.GetProfitAndLoss(context.Background(), []common.Address{account}, []uint64{1}, "usd", "")
+	require.NoError(t, err)
+	require.Equal(t, 2, prices.calls)
+
+	ch := make(chan walletevent.Event, 10)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	s.Start()
+	defer s.Stop()
+
+	// New transfers are accounted for as they arrive, only they are valued
+	insertTokenTransfer(t, db, account, other, account, 10, 4, 3)
+	feed.Send(walletevent.Event{Type: transfer.EventNewTransfers, Accounts: []common.Address{account}})
+	waitForEvent(t, ch, EventPortfolioUpdated)
+	require.Equal(t, 3, prices.calls)
+
+	lots, err := s.GetLots(1, account)
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+	require.Equal(t, int64(10), lots[1].Remaining.ToInt().Int64())
+	require.Equal(t, float64(40), lots[1].Cost)
+
+	// Older history rebuilds all the lots, the first tokens received are sold first
+	insertTokenTransfer(t, db, account, other, account, 100, 0, 4)
+	positions, err = s.GetProfitAndLoss(context.Background(), []common.Address{account}, []uint64{1}, "usd", "")
+	require.NoError(t, err)
+	require.Equal(t, 7, prices.calls)
+	require.Equal(t, int64(160), positions[0].Balance.ToInt().Int64())
+	require.Equal(t, float64(100), positions[0].RealisedPnL)
+
+	disposals, err := db.GetDisposals([]common.Address{account}, nil)
+	require.NoError(t, err)
+	require.Len(t, disposals, 1)
+	require.Equal(t, float64(200), disposals[0].Proceeds)
+	require.Equal(t, float64(100), disposals[0].Cost)
+}
+
+func TestUnpricedTransfers(t *testing.T) {
+	db, stop := setupTestPortfolioDB(t)
+	defer stop()
+
+	s := &Service{db: db, walletFeed: &event.Feed{}, prices: &testPrices{rates: []float32{1}}, currentPrices: &testCurrentPrices{price: 5}, tokenInfo: testTokenInfo}
+
+	account := common.Address{1}
+	insertTokenTransfer(t, db, account, common.Address{2}, account, 100, 2, 1)
+
+	positions, err := s.GetProfitAndLoss(context.Background(), []common.Address{account}, []uint64{1}, "usd", "")
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	require.True(t, positions[0].Incomplete)
+	require.Equal(t, float64(0), positions[0].CostBasis)
+}
+
+func waitForEvent(t *testing.T, ch chan walletevent.Event, eventType walletevent.EventType) {
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type == eventType {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for event", eventType)
+		}
+	}
+}
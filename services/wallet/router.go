@@ -36,15 +36,20 @@ const (
 	ENSSetPubKey
 	StickersBuy
 	Bridge
+	ERC1155Transfer
 )
 const EstimateUsername = "RandomUsername"
 const EstimatePubKey = "0x04bb2024ce5d72e45d4a4f8589ae657ef9745855006996115a23a1af88d536cf02c0524a585fce7bfa79d6a9669af735eda6205d6c7e5b3cdc2b8ff7b2fa1f0b56"
 
 func (s SendType) isTransfer() bool {
-	return s == Transfer
+	return s == Transfer || s == ERC1155Transfer
 }
 
 func (s SendType) isAvailableBetween(from, to *params.Network) bool {
+	if s == ERC1155Transfer {
+		return from.ChainID == to.ChainID
+	}
+
 	if s != Bridge {
 		return true
 	}
@@ -53,7 +58,7 @@ func (s SendType) isAvailableBetween(from, to *params.Network) bool {
 }
 
 func (s SendType) isAvailableFor(network *params.Network) bool {
-	if s == Transfer || s == Bridge {
+	if s == Transfer || s == Bridge || s == ERC1155Transfer {
 		return true
 	}
 
@@ -106,8 +111,29 @@ func (s SendType) EstimateGas(service *Service, network *params.Network) uint64
 	return 0
 }
 
+// canUseBridge tells if b can send the assets of s, ERC-1155 items have their own bridge
+func (s SendType) canUseBridge(b bridge.Bridge) bool {
+	return (s == ERC1155Transfer) == (b.Name() == bridge.ERC1155TransferBridgeName)
+}
+
 var zero = big.NewInt(0)
 
+var errInvalidERC1155Symbol = errors.New("invalid erc1155 symbol, expected <contract address>:<token id>")
+
+// parseERC1155Symbol returns the contract and token ID of the symbol of an ERC-1155 item,
+// given as "<contract address>:<token id>"
+func parseERC1155Symbol(symbol string) (common.Address, *big.Int, error) {
+	parts := strings.Split(symbol, ":")
+	if len(parts) != 2 || !common.IsHexAddress(parts[0]) {
+		return common.Address{}, nil, errInvalidERC1155Symbol
+	}
+	tokenID, ok := new(big.Int).SetString(parts[1], 0)
+	if !ok {
+		return common.Address{}, nil, errInvalidERC1155Symbol
+	}
+	return common.HexToAddress(parts[0]), tokenID, nil
+}
+
 type Path struct {
 	BridgeName              string
 	From                    *params.Network
@@ -349,6 +375,8 @@ func NewRouter(s *Service) *Router {
 	bridges[simple.Name()] = simple
 	bridges[hop.Name()] = hop
 	bridges[cbridge.Name()] = cbridge
+	erc1155Transfer := bridge.NewERC1155TransferBridge(s.rpcClient, s.transactor)
+	bridges[erc1155Transfer.Name()] = erc1155Transfer
 
 	return &Router{s, bridges, s.rpcClient}
 }
@@ -435,6 +463,42 @@ func (r *Router) getBalance(ctx context.Context, network *params.Network, token
 	return r.s.tokenManager.GetBalance(ctx, client, account, token.Address)
 }
 
+func (r *Router) getERC1155Balance(ctx context.Context, network *params.Network, token *token.Token, account common.Address) (*big.Int, error) {
+	_, tokenID, err := parseERC1155Symbol(token.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	contractMaker := &contracts.ContractMaker{RPCClient: r.rpcClient}
+	contract, err := contractMaker.NewERC1155(network.ChainID, token.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.BalanceOf(&bind.CallOpts{
+		Context: ctx,
+	}, account, tokenID)
+}
+
+// findToken returns the token sent on network, ERC-1155 items are not listed by the token manager
+func (r *Router) findToken(sendType SendType, network *params.Network, tokenSymbol string) *token.Token {
+	if sendType != ERC1155Transfer {
+		return r.s.tokenManager.FindToken(network, tokenSymbol)
+	}
+
+	contractAddress, _, err := parseERC1155Symbol(tokenSymbol)
+	if err != nil {
+		return nil
+	}
+	return &token.Token{
+		Address:  contractAddress,
+		Name:     tokenSymbol,
+		Symbol:   tokenSymbol,
+		Decimals: 0,
+		ChainID:  network.ChainID,
+	}
+}
+
 func (r *Router) suggestedRoutes(
 	ctx context.Context,
 	sendType SendType,
@@ -457,7 +521,18 @@ func (r *Router) suggestedRoutes(
 		return nil, err
 	}
 
-	pricesMap, err := r.s.marketManager.FetchPrices([]string{"ETH", tokenSymbol}, []string{"USD"})
+	if sendType == ERC1155Transfer {
+		if _, _, err := parseERC1155Symbol(tokenSymbol); err != nil {
+			return nil, err
+		}
+	}
+
+	priceSymbols := []string{"ETH", tokenSymbol}
+	if sendType == ERC1155Transfer {
+		// Collectibles have no market price
+		priceSymbols = []string{"ETH"}
+	}
+	pricesMap, err := r.s.marketManager.FetchPrices(priceSymbols, []string{"USD"})
 	if err != nil {
 		return nil, err
 	}
@@ -483,7 +558,7 @@ func (r *Router) suggestedRoutes(
 		if !sendType.isAvailableFor(network) {
 			continue
 		}
-		token := r.s.tokenManager.FindToken(network, tokenSymbol)
+		token := r.findToken(sendType, network, tokenSymbol)
 		if token == nil {
 			continue
 		}
@@ -497,7 +572,12 @@ func (r *Router) suggestedRoutes(
 				return err
 			}
 
-			balance, err := r.getBalance(ctx, network, token, account)
+			var balance *big.Int
+			if sendType == ERC1155Transfer {
+				balance, err = r.getERC1155Balance(ctx, network, token, account)
+			} else {
+				balance, err = r.getBalance(ctx, network, token, account)
+			}
 			if err != nil {
 				return err
 			}
@@ -519,6 +599,10 @@ func (r *Router) suggestedRoutes(
 			estimatedTime := r.s.feesManager.transactionEstimatedTime(ctx, network.ChainID, maxFees)
 
 			for _, bridge := range r.bridges {
+				if !sendType.canUseBridge(bridge) {
+					continue
+				}
+
 				for _, dest := range networks {
 					if dest.IsTest != areTestNetworksEnabled {
 						continue
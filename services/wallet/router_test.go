@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/bridge"
+)
+
+func TestParseERC1155Symbol(t *testing.T) {
+	contractAddress, tokenID, err := parseERC1155Symbol("0x76be3b62873462d2142405439777e971754e8e77:10")
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x76be3b62873462d2142405439777e971754e8e77"), contractAddress)
+	require.Equal(t, big.NewInt(10), tokenID)
+
+	_, tokenID, err = parseERC1155Symbol("0x76be3b62873462d2142405439777e971754e8e77:0x1f")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(31), tokenID)
+
+	for _, symbol := range []string{"ETH", "0x76be3b62873462d2142405439777e971754e8e77", "notAnAddress:1", "0x76be3b62873462d2142405439777e971754e8e77:id"} {
+		_, _, err = parseERC1155Symbol(symbol)
+		require.Equal(t, errInvalidERC1155Symbol, err, symbol)
+	}
+}
+
+func TestSendTypeCanUseBridge(t *testing.T) {
+	simple := bridge.NewSimpleBridge(nil)
+	erc1155 := bridge.NewERC1155TransferBridge(nil, nil)
+
+	require.True(t, Transfer.canUseBridge(simple))
+	require.False(t, Transfer.canUseBridge(erc1155))
+	require.False(t, ERC1155Transfer.canUseBridge(simple))
+	require.True(t, ERC1155Transfer.canUseBridge(erc1155))
+}
@@ -0,0 +1,207 @@
+package safe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/services/typeddata"
+)
+
+// Operation is the kind of call a Safe transaction makes
+type Operation uint8
+
+const (
+	OperationCall         Operation = 0
+	OperationDelegateCall Operation = 1
+)
+
+// minVersion is the first Safe version with the chain id in its EIP-712 domain
+var minVersion = version.Must(version.NewVersion("1.3.0"))
+
+var (
+	ErrNotASafe                  = errors.New("address is not a Safe")
+	ErrUnsupportedSafeVersion    = errors.New("unsupported Safe version, 1.3.0 or later is required")
+	ErrSafeNotAnAccount          = errors.New("the Safe must be added as a watch-only account first")
+	ErrSafeNotFound              = errors.New("the Safe was not found")
+	ErrSafeTransactionNotFound   = errors.New("the Safe transaction was not found")
+	ErrSafeTransactionExecuted   = errors.New("the Safe transaction was already executed")
+	ErrSafeTransactionHashChange = errors.New("the Safe transaction hash doesn't match the one of the contract")
+	ErrNotAnOwner                = errors.New("signer is not an owner of the Safe")
+	ErrInvalidSignature          = errors.New("invalid Safe transaction signature")
+	ErrThresholdNotMet           = errors.New("not enough owner signatures to execute the Safe transaction")
+	ErrInvalidOperation          = errors.New("invalid Safe transaction operation")
+)
+
+// Safe is a Safe multisig account with its owners, threshold and nonce as last read from chain
+type Safe struct {
+	ChainID   uint64           `json:"chainId"`
+	Address   common.Address   `json:"address"`
+	Version   string           `json:"version"`
+	Owners    []common.Address `json:"owners"`
+	Threshold uint64           `json:"threshold"`
+	Nonce     uint64           `json:"nonce"`
+	UpdatedAt int64            `json:"updatedAt"`
+}
+
+func (s *Safe) isOwner(address common.Address) bool {
+	for _, owner := range s.Owners {
+		if owner == address {
+			return true
+		}
+	}
+	return false
+}
+
+// TransactionArgs are the parameters of a new Safe transaction
+type TransactionArgs struct {
+	To        common.Address `json:"to"`
+	Value     *hexutil.Big   `json:"value"`
+	Data      hexutil.Bytes  `json:"data"`
+	Operation Operation      `json:"operation"`
+	// Nonce is the nonce of the Safe when nil, a later one can be used to queue transactions
+	Nonce *uint64 `json:"nonce,omitempty"`
+}
+
+// Signature is the EIP-712 signature of a Safe transaction by an owner
+type Signature struct {
+	Owner     common.Address `json:"owner"`
+	Signature hexutil.Bytes  `json:"signature"`
+}
+
+// Transaction is a Safe transaction and the signatures collected for it
+type Transaction struct {
+	SafeTxHash     common.Hash    `json:"safeTxHash"`
+	ChainID        uint64         `json:"chainId"`
+	Safe           common.Address `json:"safe"`
+	To             common.Address `json:"to"`
+	Value          *hexutil.Big   `json:"value"`
+	Data           hexutil.Bytes  `json:"data"`
+	Operation      Operation      `json:"operation"`
+	SafeTxGas      *hexutil.Big   `json:"safeTxGas"`
+	BaseGas        *hexutil.Big   `json:"baseGas"`
+	GasPrice       *hexutil.Big   `json:"gasPrice"`
+	GasToken       common.Address `json:"gasToken"`
+	RefundReceiver common.Address `json:"refundReceiver"`
+	Nonce          uint64         `json:"nonce"`
+	Signatures     []Signature    `json:"signatures"`
+	// SubmittedHash is the hash of the last transaction sent from here to execute it
+	SubmittedHash *common.Hash `json:"submittedHash,omitempty"`
+	// ExecutedHash is the hash of the transaction which executed it, when sent from here. It is set once the nonce
+	// of the Safe moved past the one of the transaction
+	ExecutedHash *common.Hash `json:"executedHash,omitempty"`
+	CreatedAt    int64        `json:"createdAt"`
+}
+
+func newTransaction(chainID uint64, safe common.Address, args TransactionArgs, nonce uint64) *Transaction {
+	value := args.Value
+	if value == nil {
+		value = (*hexutil.Big)(big.NewInt(0))
+	}
+	return &Transaction{
+		ChainID:   chainID,
+		Safe:      safe,
+		To:        args.To,
+		Value:     value,
+		Data:      args.Data,
+		Operation: args.Operation,
+		SafeTxGas: (*hexutil.Big)(big.NewInt(0)),
+		BaseGas:   (*hexutil.Big)(big.NewInt(0)),
+		GasPrice:  (*hexutil.Big)(big.NewInt(0)),
+		Nonce:     nonce,
+	}
+}
+
+func mustMarshal(v interface{}) json.RawMessage {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+// TypedData returns the EIP-712 typed data owners sign to approve the transaction
+func (t *Transaction) TypedData() typeddata.TypedData {
+	data := t.Data
+	if data == nil {
+		data = hexutil.Bytes{}
+	}
+	return typeddata.TypedData{
+		Types: typeddata.Types{
+			"EIP712Domain": {
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: map[string]json.RawMessage{
+			"chainId":           mustMarshal(t.ChainID),
+			"verifyingContract": mustMarshal(t.Safe),
+		},
+		Message: map[string]json.RawMessage{
+			"to":             mustMarshal(t.To),
+			"value":          mustMarshal(t.Value.ToInt()),
+			"data":           mustMarshal(data),
+			"operation":      mustMarshal(t.Operation),
+			"safeTxGas":      mustMarshal(t.SafeTxGas.ToInt()),
+			"baseGas":        mustMarshal(t.BaseGas.ToInt()),
+			"gasPrice":       mustMarshal(t.GasPrice.ToInt()),
+			"gasToken":       mustMarshal(t.GasToken),
+			"refundReceiver": mustMarshal(t.RefundReceiver),
+			"nonce":          mustMarshal(t.Nonce),
+		},
+	}
+}
+
+// hash returns the safeTxHash, the EIP-712 hash of the transaction
+func (t *Transaction) hash() (common.Hash, error) {
+	return typeddata.ValidateAndHash(t.TypedData(), new(big.Int).SetUint64(t.ChainID))
+}
+
+// packedSignatures returns the signatures in the format execTransaction expects: concatenated, ordered by owner
+func (t *Transaction) packedSignatures() []byte {
+	signatures := make([]Signature, len(t.Signatures))
+	copy(signatures, t.Signatures)
+	sort.Slice(signatures, func(i, j int) bool {
+		return bytes.Compare(signatures[i].Owner.Bytes(), signatures[j].Owner.Bytes()) < 0
+	})
+	packed := make([]byte, 0, len(signatures)*65)
+	for _, s := range signatures {
+		packed = append(packed, s.Signature...)
+	}
+	return packed
+}
+
+// supportedVersion returns whether the transactions of a Safe with the given version can be signed with TypedData
+func supportedVersion(v string) bool {
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return false
+	}
+	// Pre-releases and builds of a version sign as the version itself
+	core, err := version.NewVersion(fmt.Sprintf("%d.%d.%d", parsed.Segments64()[0], parsed.Segments64()[1], parsed.Segments64()[2]))
+	if err != nil {
+		return false
+	}
+	return core.GreaterThanOrEqual(minVersion)
+}
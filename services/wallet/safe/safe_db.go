@@ -0,0 +1,216 @@
+package safe
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+type DB struct {
+	db *sql.DB
+}
+
+func NewDB(sqlDb *sql.DB) *DB {
+	return &DB{
+		db: sqlDb,
+	}
+}
+
+func (sdb *DB) SaveSafe(s *Safe) error {
+	owners, err := json.Marshal(s.Owners)
+	if err != nil {
+		return err
+	}
+	// Replacing the row would delete the transactions of the Safe
+	res, err := sdb.db.Exec(`UPDATE safe_accounts SET version = ?, owners = ?, threshold = ?, nonce = ?, updated_at = ?
+		WHERE chain_id = ? AND address = ?`, s.Version, string(owners), s.Threshold, s.Nonce, s.UpdatedAt, s.ChainID, s.Address)
+	if err != nil {
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil || updated > 0 {
+		return err
+	}
+	_, err = sdb.db.Exec(`INSERT INTO safe_accounts (chain_id, address, version, owners, threshold, nonce, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`, s.ChainID, s.Address, s.Version, string(owners), s.Threshold, s.Nonce, s.UpdatedAt)
+	return err
+}
+
+func (sdb *DB) GetSafe(chainID uint64, address common.Address) (*Safe, error) {
+	safes, err := sdb.getSafes(`WHERE chain_id = ? AND address = ?`, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(safes) == 0 {
+		return nil, ErrSafeNotFound
+	}
+	return safes[0], nil
+}
+
+// GetSafes returns all the Safes, on all the chains
+func (sdb *DB) GetSafes() ([]*Safe, error) {
+	return sdb.getSafes("")
+}
+
+func (sdb *DB) getSafes(where string, args ...interface{}) ([]*Safe, error) {
+	rows, err := sdb.db.Query(`SELECT chain_id, address, version, owners, threshold, nonce, updated_at FROM safe_accounts `+where+
+		` ORDER BY chain_id, address`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var safes []*Safe
+	for rows.Next() {
+		s := &Safe{}
+		var owners string
+		err := rows.Scan(&s.ChainID, &s.Address, &s.Version, &owners, &s.Threshold, &s.Nonce, &s.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(owners), &s.Owners); err != nil {
+			return nil, err
+		}
+		safes = append(safes, s)
+	}
+	return safes, rows.Err()
+}
+
+// DeleteSafe removes a Safe with its transactions
+func (sdb *DB) DeleteSafe(chainID uint64, address common.Address) error {
+	_, err := sdb.db.Exec(`DELETE FROM safe_accounts WHERE chain_id = ? AND address = ?`, chainID, address)
+	return err
+}
+
+// SaveTransaction stores a new transaction, the same transaction proposed again is ignored
+func (sdb *DB) SaveTransaction(t *Transaction) error {
+	_, err := sdb.db.Exec(`INSERT OR IGNORE INTO safe_transactions (safe_tx_hash, chain_id, safe, to_address, value, data, operation,
+		safe_tx_gas, base_gas, gas_price, gas_token, refund_receiver, nonce, executed_hash, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		t.SafeTxHash, t.ChainID, t.Safe, t.To, (*bigint.SQLBigIntBytes)(t.Value.ToInt()), []byte(t.Data), t.Operation,
+		(*bigint.SQLBigIntBytes)(t.SafeTxGas.ToInt()), (*bigint.SQLBigIntBytes)(t.BaseGas.ToInt()), (*bigint.SQLBigIntBytes)(t.GasPrice.ToInt()),
+		t.GasToken, t.RefundReceiver, t.Nonce, t.ExecutedHash, t.CreatedAt)
+	return err
+}
+
+// SetSubmitted records the hash of a transaction sent to execute a Safe transaction
+func (sdb *DB) SetSubmitted(safeTxHash common.Hash, hash common.Hash) error {
+	res, err := sdb.db.Exec(`UPDATE safe_transactions SET submitted_hash = ? WHERE safe_tx_hash = ?`, hash, safeTxHash)
+	if err != nil {
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrSafeTransactionNotFound
+	}
+	return nil
+}
+
+// SetExecuted records as executed the transactions of a Safe sent from here with a nonce lower than nonce,
+// the current nonce of the Safe, and returns how many there were
+func (sdb *DB) SetExecuted(chainID uint64, safe common.Address, nonce uint64) (int64, error) {
+	res, err := sdb.db.Exec(`UPDATE safe_transactions SET executed_hash = submitted_hash
+		WHERE chain_id = ? AND safe = ? AND nonce < ? AND executed_hash IS NULL AND submitted_hash IS NOT NULL`, chainID, safe, nonce)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (sdb *DB) SaveSignature(safeTxHash common.Hash, s Signature) error {
+	_, err := sdb.db.Exec(`INSERT OR REPLACE INTO safe_signatures (safe_tx_hash, owner, signature) VALUES (?, ?, ?)`,
+		safeTxHash, s.Owner, []byte(s.Signature))
+	return err
+}
+
+func (sdb *DB) GetTransaction(safeTxHash common.Hash) (*Transaction, error) {
+	transactions, err := sdb.getTransactions(`WHERE safe_tx_hash = ?`, safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return nil, ErrSafeTransactionNotFound
+	}
+	return transactions[0], nil
+}
+
+// GetTransactions returns the transactions of a Safe with a nonce from fromNonce, ordered by nonce
+func (sdb *DB) GetTransactions(chainID uint64, safe common.Address, fromNonce uint64) ([]*Transaction, error) {
+	return sdb.getTransactions(`WHERE chain_id = ? AND safe = ? AND nonce >= ?`, chainID, safe, fromNonce)
+}
+
+func (sdb *DB) getTransactions(where string, args ...interface{}) ([]*Transaction, error) {
+	rows, err := sdb.db.Query(`SELECT safe_tx_hash, chain_id, safe, to_address, value, data, operation, safe_tx_gas, base_gas, gas_price,
+		gas_token, refund_receiver, nonce, submitted_hash, executed_hash, created_at FROM safe_transactions `+where+` ORDER BY nonce, created_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []*Transaction
+	for rows.Next() {
+		t := &Transaction{}
+		value, safeTxGas, baseGas, gasPrice := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+		var data []byte
+		var submittedHash, executedHash sql.RawBytes
+		err := rows.Scan(&t.SafeTxHash, &t.ChainID, &t.Safe, &t.To, (*bigint.SQLBigIntBytes)(value), &data, &t.Operation,
+			(*bigint.SQLBigIntBytes)(safeTxGas), (*bigint.SQLBigIntBytes)(baseGas), (*bigint.SQLBigIntBytes)(gasPrice),
+			&t.GasToken, &t.RefundReceiver, &t.Nonce, &submittedHash, &executedHash, &t.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		t.Value = (*hexutil.Big)(value)
+		t.SafeTxGas = (*hexutil.Big)(safeTxGas)
+		t.BaseGas = (*hexutil.Big)(baseGas)
+		t.GasPrice = (*hexutil.Big)(gasPrice)
+		t.Data = data
+		if len(submittedHash) > 0 {
+			hash := common.BytesToHash(submittedHash)
+			t.SubmittedHash = &hash
+		}
+		if len(executedHash) > 0 {
+			hash := common.BytesToHash(executedHash)
+			t.ExecutedHash = &hash
+		}
+		transactions = append(transactions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range transactions {
+		t.Signatures, err = sdb.getSignatures(t.SafeTxHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return transactions, nil
+}
+
+func (sdb *DB) getSignatures(safeTxHash common.Hash) ([]Signature, error) {
+	rows, err := sdb.db.Query(`SELECT owner, signature FROM safe_signatures WHERE safe_tx_hash = ? ORDER BY owner`, safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	signatures := []Signature{}
+	for rows.Next() {
+		var s Signature
+		var signature []byte
+		if err := rows.Scan(&s.Owner, &signature); err != nil {
+			return nil, err
+		}
+		s.Signature = signature
+		signatures = append(signatures, s)
+	}
+	return signatures, rows.Err()
+}
@@ -0,0 +1,372 @@
+package safe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/contracts/gnosissafe"
+	gethtypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/typeddata"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventSafeTransactionsUpdated is sent with the Safe when one of its transactions was proposed, signed or executed
+	EventSafeTransactionsUpdated walletevent.EventType = "wallet-safe-transactions-updated"
+)
+
+type Service struct {
+	db            *DB
+	walletFeed    *event.Feed
+	client        func(chainID uint64) (bind.ContractCaller, error)
+	accountExists func(address common.Address) (bool, error)
+	// accountKey returns the private key of an owner account of the user
+	accountKey func(address common.Address, password string) (*ecdsa.PrivateKey, error)
+}
+
+func NewService(db *sql.DB, accountsDB *accounts.Database, rpcClient *rpc.Client, gethManager *account.GethManager, keyStoreDir string,
+	walletFeed *event.Feed) *Service {
+	return &Service{
+		db:         NewDB(db),
+		walletFeed: walletFeed,
+		client: func(chainID uint64) (bind.ContractCaller, error) {
+			return rpcClient.EthClient(chainID)
+		},
+		accountExists: func(address common.Address) (bool, error) {
+			return accountsDB.AddressExists(gethtypes.Address(address))
+		},
+		accountKey: func(address common.Address, password string) (*ecdsa.PrivateKey, error) {
+			key, err := gethManager.VerifyAccountPassword(keyStoreDir, address.Hex(), password)
+			if err != nil {
+				return nil, err
+			}
+			return key.PrivateKey, nil
+		},
+	}
+}
+
+func (s *Service) caller(chainID uint64, address common.Address) (*gnosissafe.GnosisSafeCaller, error) {
+	client, err := s.client(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return gnosissafe.NewGnosisSafeCaller(address, client)
+}
+
+// read returns the Safe at address with its owners, threshold and nonce read from chain
+func (s *Service) read(ctx context.Context, chainID uint64, address common.Address) (*Safe, error) {
+	caller, err := s.caller(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	v, err := caller.VERSION(opts)
+	if err != nil {
+		// Not a contract, or a contract without the Safe interface
+		log.Debug("failed to read Safe version", "chainID", chainID, "address", address, "err", err)
+		return nil, ErrNotASafe
+	}
+	if !supportedVersion(v) {
+		return nil, ErrUnsupportedSafeVersion
+	}
+	owners, err := caller.GetOwners(opts)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := caller.GetThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := caller.Nonce(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Safe{
+		ChainID:   chainID,
+		Address:   address,
+		Version:   v,
+		Owners:    owners,
+		Threshold: threshold.Uint64(),
+		Nonce:     nonce.Uint64(),
+		UpdatedAt: time.Now().Unix(),
+	}, nil
+}
+
+// AddSafe registers the Safe at address on chainID, reading its owners, threshold and nonce. The Safe must be a
+// watch-only account, its balances and activity are loaded as the ones of any other account
+func (s *Service) AddSafe(ctx context.Context, chainID uint64, address common.Address) (*Safe, error) {
+	exists, err := s.accountExists(address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrSafeNotAnAccount
+	}
+	return s.RefreshSafe(ctx, chainID, address)
+}
+
+// RefreshSafe reads the owners, threshold and nonce of the Safe from chain again
+func (s *Service) RefreshSafe(ctx context.Context, chainID uint64, address common.Address) (*Safe, error) {
+	safe, err := s.read(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.SaveSafe(safe); err != nil {
+		return nil, err
+	}
+
+	// The transactions sent from here are executed once the nonce moved past them, the ones
+	// which reverted or were dropped can be executed again
+	executed, err := s.db.SetExecuted(chainID, address, safe.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if executed > 0 {
+		s.notifyTransactionsUpdated(safe)
+	}
+	return safe, nil
+}
+
+func (s *Service) GetSafes() ([]*Safe, error) {
+	return s.db.GetSafes()
+}
+
+func (s *Service) RemoveSafe(chainID uint64, address common.Address) error {
+	return s.db.DeleteSafe(chainID, address)
+}
+
+// GetPendingTransactions returns the transactions of the Safe which can still be executed, the ones with a nonce
+// from the current nonce of the Safe
+func (s *Service) GetPendingTransactions(ctx context.Context, chainID uint64, address common.Address) ([]*Transaction, error) {
+	safe, err := s.RefreshSafe(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.GetTransactions(chainID, address, safe.Nonce)
+}
+
+// ProposeTransaction builds a transaction of the Safe and stores it for its owners to sign. Its hash is
+// checked against the one computed by the Safe contract
+func (s *Service) ProposeTransaction(ctx context.Context, chainID uint64, address common.Address, args TransactionArgs) (*Transaction, error) {
+	if args.Operation != OperationCall && args.Operation != OperationDelegateCall {
+		return nil, ErrInvalidOperation
+	}
+	safe, err := s.RefreshSafe(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := safe.Nonce
+	if args.Nonce != nil {
+		nonce = *args.Nonce
+	}
+	t := newTransaction(chainID, address, args, nonce)
+	t.SafeTxHash, err = t.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.caller(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	contractHash, err := caller.GetTransactionHash(&bind.CallOpts{Context: ctx}, t.To, t.Value.ToInt(), t.Data, uint8(t.Operation),
+		t.SafeTxGas.ToInt(), t.BaseGas.ToInt(), t.GasPrice.ToInt(), t.GasToken, t.RefundReceiver, new(big.Int).SetUint64(t.Nonce))
+	if err != nil {
+		return nil, err
+	}
+	if contractHash != t.SafeTxHash {
+		return nil, ErrSafeTransactionHashChange
+	}
+
+	t.CreatedAt = time.Now().Unix()
+	t.Signatures = []Signature{}
+	if err := s.db.SaveTransaction(t); err != nil {
+		return nil, err
+	}
+	s.notifyTransactionsUpdated(safe)
+	return t, nil
+}
+
+// GetTransactionTypedData returns the EIP-712 typed data of a Safe transaction, to be signed by owners elsewhere
+func (s *Service) GetTransactionTypedData(safeTxHash common.Hash) (*typeddata.TypedData, error) {
+	t, err := s.db.GetTransaction(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	typed := t.TypedData()
+	return &typed, nil
+}
+
+// SignTransaction signs a Safe transaction with the key of owner, one of the accounts of the user
+func (s *Service) SignTransaction(safeTxHash common.Hash, owner common.Address, password string) (*Transaction, error) {
+	t, err := s.db.GetTransaction(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.accountKey(owner, password)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := typeddata.Sign(t.TypedData(), key, new(big.Int).SetUint64(t.ChainID))
+	if err != nil {
+		return nil, err
+	}
+	return s.AddSignature(safeTxHash, signature)
+}
+
+// AddSignature adds the signature of a Safe transaction by one of its owners, collected from another device or wallet
+func (s *Service) AddSignature(safeTxHash common.Hash, signature hexutil.Bytes) (*Transaction, error) {
+	t, err := s.db.GetTransaction(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	if t.ExecutedHash != nil {
+		return nil, ErrSafeTransactionExecuted
+	}
+	safe, err := s.db.GetSafe(t.ChainID, t.Safe)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := recoverSigner(safeTxHash, signature)
+	if err != nil {
+		return nil, err
+	}
+	if !safe.isOwner(owner) {
+		return nil, ErrNotAnOwner
+	}
+
+	sig := Signature{Owner: owner, Signature: signature}
+	if err := s.db.SaveSignature(safeTxHash, sig); err != nil {
+		return nil, err
+	}
+	s.notifyTransactionsUpdated(safe)
+	return s.db.GetTransaction(safeTxHash)
+}
+
+// recoverSigner returns the address which signed hash, with a signature whose V is 27 or 28
+func recoverSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength || (signature[64] != 27 && signature[64] != 28) {
+		return common.Address{}, ErrInvalidSignature
+	}
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	sig[64] -= 27
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// BuildExecuteTransaction returns the multi-transaction command and the execTransaction call of a Safe transaction
+// with enough owner signatures, to be sent by executor, any account, with CreateMultiTransaction
+func (s *Service) BuildExecuteTransaction(ctx context.Context, safeTxHash common.Hash, executor common.Address) (
+	*transfer.MultiTransactionCommand, []*bridge.TransactionBridge, error) {
+	t, err := s.db.GetTransaction(safeTxHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t.ExecutedHash != nil {
+		return nil, nil, ErrSafeTransactionExecuted
+	}
+
+	// Owners and threshold may have changed since the signatures were collected
+	safe, err := s.RefreshSafe(ctx, t.ChainID, t.Safe)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t.Nonce < safe.Nonce {
+		return nil, nil, ErrSafeTransactionExecuted
+	}
+	signatures := make([]Signature, 0, len(t.Signatures))
+	for _, sig := range t.Signatures {
+		if safe.isOwner(sig.Owner) {
+			signatures = append(signatures, sig)
+		}
+	}
+	if uint64(len(signatures)) < safe.Threshold {
+		return nil, nil, ErrThresholdNotMet
+	}
+	t.Signatures = signatures
+
+	safeABI, err := abi.JSON(strings.NewReader(gnosissafe.GnosisSafeABI))
+	if err != nil {
+		return nil, nil, err
+	}
+	input, err := safeABI.Pack("execTransaction", t.To, t.Value.ToInt(), []byte(t.Data), uint8(t.Operation), t.SafeTxGas.ToInt(),
+		t.BaseGas.ToInt(), t.GasPrice.ToInt(), t.GasToken, t.RefundReceiver, t.packedSignatures())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	to := gethtypes.Address(t.Safe)
+	data := []*bridge.TransactionBridge{{
+		BridgeName: bridge.SimpleBridgeName,
+		ChainID:    t.ChainID,
+		SimpleTx: &transactions.SendTxArgs{
+			From:  gethtypes.Address(executor),
+			To:    &to,
+			Value: (*hexutil.Big)(big.NewInt(0)),
+			Data:  input,
+		},
+	}}
+	command := &transfer.MultiTransactionCommand{
+		FromAddress: executor,
+		ToAddress:   t.Safe,
+		FromAmount:  (*hexutil.Big)(big.NewInt(0)),
+		Type:        transfer.MultiTransactionSend,
+	}
+	return command, data, nil
+}
+
+// SetSubmitted records the hash of the transaction sent to execute a Safe transaction. The Safe transaction is
+// recorded as executed by RefreshSafe once the nonce of the Safe moved past it
+func (s *Service) SetSubmitted(safeTxHash common.Hash, hash common.Hash) error {
+	if err := s.db.SetSubmitted(safeTxHash, hash); err != nil {
+		return err
+	}
+	t, err := s.db.GetTransaction(safeTxHash)
+	if err != nil {
+		return err
+	}
+	safe, err := s.db.GetSafe(t.ChainID, t.Safe)
+	if err != nil {
+		return err
+	}
+	s.notifyTransactionsUpdated(safe)
+	return nil
+}
+
+func (s *Service) notifyTransactionsUpdated(safe *Safe) {
+	if s.walletFeed == nil {
+		return
+	}
+	s.walletFeed.Send(walletevent.Event{
+		Type:     EventSafeTransactionsUpdated,
+		Accounts: []common.Address{safe.Address},
+		At:       time.Now().Unix(),
+		ChainID:  safe.ChainID,
+	})
+}
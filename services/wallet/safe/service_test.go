@@ -0,0 +1,321 @@
+package safe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/contracts/gnosissafe"
+	"github.com/status-im/status-go/services/typeddata"
+)
+
+var (
+	testSafe     = common.Address{0x5a}
+	testExecutor = common.Address{0xee}
+)
+
+// testSafeContract answers the calls of a Safe contract
+type testSafeContract struct {
+	abi       abi.ABI
+	version   string
+	owners    []common.Address
+	threshold uint64
+	nonce     uint64
+	// wrongHash makes getTransactionHash return a hash different from the one of the Safe
+	wrongHash bool
+}
+
+func (c *testSafeContract) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *testSafeContract) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := c.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if c.version == "" {
+		return nil, errors.New("execution reverted")
+	}
+	switch method.Name {
+	case "VERSION":
+		return method.Outputs.Pack(c.version)
+	case "getOwners":
+		return method.Outputs.Pack(c.owners)
+	case "getThreshold":
+		return method.Outputs.Pack(new(big.Int).SetUint64(c.threshold))
+	case "nonce":
+		return method.Outputs.Pack(new(big.Int).SetUint64(c.nonce))
+	case "getTransactionHash":
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		hash := safeTxHash(*call.To, args)
+		if c.wrongHash {
+			hash[0]++
+		}
+		return method.Outputs.Pack(hash)
+	}
+	return nil, errors.New("unexpected call " + method.Name)
+}
+
+// safeTxHash computes the hash of a transaction of safe on chain 1 as the Safe 1.3.0 contract does
+func safeTxHash(safe common.Address, args []interface{}) common.Hash {
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	uint256, _ := abi.NewType("uint256", "", nil)
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+
+	domain, _ := abi.Arguments{{Type: bytes32}, {Type: uint256}, {Type: address}}.Pack(
+		crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)")), big.NewInt(1), safe)
+	txTypeHash := crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas," +
+		"uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+	tx, _ := abi.Arguments{{Type: bytes32}, {Type: address}, {Type: uint256}, {Type: bytes32}, {Type: uint8Type}, {Type: uint256},
+		{Type: uint256}, {Type: uint256}, {Type: address}, {Type: address}, {Type: uint256}}.Pack(
+		txTypeHash, args[0], args[1], crypto.Keccak256Hash(args[2].([]byte)), args[3], args[4], args[5], args[6], args[7], args[8], args[9])
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, crypto.Keccak256(domain), crypto.Keccak256(tx))
+}
+
+func setupTestSafeDB(t *testing.T) (*DB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("wallet-safe-tests")
+	require.NoError(t, err)
+	return NewDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+// newTestSafeContract returns testSafe owned by new keys, version 1.3.0 with a threshold of 2 and a nonce of 3
+func newTestSafeContract(t *testing.T, owners int) (*testSafeContract, testOwnerKeys) {
+	parsed, err := abi.JSON(strings.NewReader(gnosissafe.GnosisSafeABI))
+	require.NoError(t, err)
+	contract := &testSafeContract{abi: parsed, version: "1.3.0", threshold: 2, nonce: 3}
+
+	keys := make(testOwnerKeys, owners)
+	for i := range keys {
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		contract.owners = append(contract.owners, crypto.PubkeyToAddress(keys[i].PublicKey))
+	}
+	return contract, keys
+}
+
+func (c *testSafeContract) get(chainID uint64) (bind.ContractCaller, error) {
+	return c, nil
+}
+
+// testOwnerKeys are the keys of the owner accounts of the user, all with the password "password"
+type testOwnerKeys []*ecdsa.PrivateKey
+
+func (keys testOwnerKeys) get(address common.Address, password string) (*ecdsa.PrivateKey, error) {
+	for _, key := range keys {
+		if crypto.PubkeyToAddress(key.PublicKey) == address && password == "password" {
+			return key, nil
+		}
+	}
+	return nil, errors.New("could not decrypt key with given password")
+}
+
+// isTestSafe only knows testSafe as an account of the user
+func isTestSafe(address common.Address) (bool, error) {
+	return address == testSafe, nil
+}
+
+func TestAddSafe(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address common.Address
+		version string
+		err     error
+	}{
+		{"not an account", common.Address{1}, "1.3.0", ErrSafeNotAnAccount},
+		{"unsupported version", testSafe, "1.1.1", ErrUnsupportedSafeVersion},
+		{"not a safe", testSafe, "", ErrNotASafe},
+		{"supported version", testSafe, "1.3.0", nil},
+		{"l2 version", testSafe, "1.3.0+L2", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, stop := setupTestSafeDB(t)
+			defer stop()
+
+			contract, _ := newTestSafeContract(t, 3)
+			contract.version = tc.version
+			s := &Service{db: db, walletFeed: &event.Feed{}, client: contract.get, accountExists: isTestSafe}
+
+			safe, err := s.AddSafe(context.Background(), 1, tc.address)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, contract.owners, safe.Owners)
+			require.Equal(t, uint64(2), safe.Threshold)
+			require.Equal(t, uint64(3), safe.Nonce)
+
+			safes, err := s.GetSafes()
+			require.NoError(t, err)
+			require.Equal(t, []*Safe{safe}, safes)
+
+			require.NoError(t, s.RemoveSafe(1, testSafe))
+			safes, err = s.GetSafes()
+			require.NoError(t, err)
+			require.Empty(t, safes)
+		})
+	}
+}
+
+func TestProposeTransaction(t *testing.T) {
+	db, stop := setupTestSafeDB(t)
+	defer stop()
+
+	contract, _ := newTestSafeContract(t, 3)
+	s := &Service{db: db, walletFeed: &event.Feed{}, client: contract.get, accountExists: isTestSafe}
+
+	_, err := s.AddSafe(context.Background(), 1, testSafe)
+	require.NoError(t, err)
+
+	args := TransactionArgs{To: common.Address{7}, Value: (*hexutil.Big)(big.NewInt(1000)), Data: hexutil.Bytes{1, 2, 3}}
+	_, err = s.ProposeTransaction(context.Background(), 1, testSafe, TransactionArgs{To: common.Address{7}, Operation: 2})
+	require.Equal(t, ErrInvalidOperation, err)
+
+	contract.wrongHash = true
+	_, err = s.ProposeTransaction(context.Background(), 1, testSafe, args)
+	require.Equal(t, ErrSafeTransactionHashChange, err)
+
+	contract.wrongHash = false
+	tx, err := s.ProposeTransaction(context.Background(), 1, testSafe, args)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), tx.Nonce)
+	require.Empty(t, tx.Signatures)
+
+	nonce := uint64(4)
+	args.Nonce = &nonce
+	queued, err := s.ProposeTransaction(context.Background(), 1, testSafe, args)
+	require.NoError(t, err)
+	require.NotEqual(t, tx.SafeTxHash, queued.SafeTxHash)
+
+	pending, err := s.GetPendingTransactions(context.Background(), 1, testSafe)
+	require.NoError(t, err)
+	require.Equal(t, []*Transaction{tx, queued}, pending)
+
+	// Executed elsewhere, the first transaction is no longer pending
+	contract.nonce = 4
+	pending, err = s.GetPendingTransactions(context.Background(), 1, testSafe)
+	require.NoError(t, err)
+	require.Equal(t, []*Transaction{queued}, pending)
+}
+
+func TestSignAndExecute(t *testing.T) {
+	db, stop := setupTestSafeDB(t)
+	defer stop()
+
+	contract, keys := newTestSafeContract(t, 3)
+	s := &Service{db: db, walletFeed: &event.Feed{}, client: contract.get, accountExists: isTestSafe, accountKey: keys.get}
+
+	_, err := s.AddSafe(context.Background(), 1, testSafe)
+	require.NoError(t, err)
+	tx, err := s.ProposeTransaction(context.Background(), 1, testSafe, TransactionArgs{To: common.Address{7}, Value: (*hexutil.Big)(big.NewInt(1))})
+	require.NoError(t, err)
+
+	// An owner account of the user
+	_, err = s.SignTransaction(tx.SafeTxHash, contract.owners[2], "wrong")
+	require.Error(t, err)
+	tx, err = s.SignTransaction(tx.SafeTxHash, contract.owners[2], "password")
+	require.NoError(t, err)
+	require.Len(t, tx.Signatures, 1)
+	require.Equal(t, contract.owners[2], tx.Signatures[0].Owner)
+
+	_, _, err = s.BuildExecuteTransaction(context.Background(), tx.SafeTxHash, testExecutor)
+	require.Equal(t, ErrThresholdNotMet, err)
+
+	// Signatures collected elsewhere
+	typed, err := s.GetTransactionTypedData(tx.SafeTxHash)
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := typeddata.Sign(*typed, other, big.NewInt(1))
+	require.NoError(t, err)
+	_, err = s.AddSignature(tx.SafeTxHash, signature)
+	require.Equal(t, ErrNotAnOwner, err)
+	_, err = s.AddSignature(tx.SafeTxHash, signature[:64])
+	require.Equal(t, ErrInvalidSignature, err)
+
+	signature, err = typeddata.Sign(*typed, keys[0], big.NewInt(1))
+	require.NoError(t, err)
+	tx, err = s.AddSignature(tx.SafeTxHash, signature)
+	require.NoError(t, err)
+	require.Len(t, tx.Signatures, 2)
+
+	command, data, err := s.BuildExecuteTransaction(context.Background(), tx.SafeTxHash, testExecutor)
+	require.NoError(t, err)
+	require.Equal(t, testExecutor, command.FromAddress)
+	require.Len(t, data, 1)
+	require.Equal(t, uint64(1), data[0].ChainID)
+	require.Equal(t, testSafe, common.Address(*data[0].SimpleTx.To))
+
+	input := []byte(data[0].SimpleTx.Data)
+	method, err := contract.abi.MethodById(input[:4])
+	require.NoError(t, err)
+	require.Equal(t, "execTransaction", method.Name)
+	values, err := method.Inputs.Unpack(input[4:])
+	require.NoError(t, err)
+	require.Equal(t, common.Address{7}, values[0])
+	packed := values[9].([]byte)
+	require.Len(t, packed, 130)
+	// Signatures are ordered by owner address
+	first, second := contract.owners[0], contract.owners[2]
+	if strings.ToLower(first.Hex()) > strings.ToLower(second.Hex()) {
+		first, second = second, first
+	}
+	for i, owner := range []common.Address{first, second} {
+		signer, err := recoverSigner(tx.SafeTxHash, packed[i*65:(i+1)*65])
+		require.NoError(t, err)
+		require.Equal(t, owner, signer)
+	}
+
+	// A transaction sent which reverted or was dropped can be sent again
+	require.NoError(t, s.SetSubmitted(tx.SafeTxHash, common.Hash{1}))
+	_, err = s.RefreshSafe(context.Background(), 1, testSafe)
+	require.NoError(t, err)
+	tx, err = db.GetTransaction(tx.SafeTxHash)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{1}, *tx.SubmittedHash)
+	require.Nil(t, tx.ExecutedHash)
+	_, _, err = s.BuildExecuteTransaction(context.Background(), tx.SafeTxHash, testExecutor)
+	require.NoError(t, err)
+
+	// Signatures of removed owners don't count
+	owners := contract.owners
+	contract.owners = contract.owners[1:]
+	_, _, err = s.BuildExecuteTransaction(context.Background(), tx.SafeTxHash, testExecutor)
+	require.Equal(t, ErrThresholdNotMet, err)
+	contract.owners = owners
+
+	// Executed once the nonce of the Safe moved past it
+	require.NoError(t, s.SetSubmitted(tx.SafeTxHash, common.Hash{2}))
+	contract.nonce++
+	_, _, err = s.BuildExecuteTransaction(context.Background(), tx.SafeTxHash, testExecutor)
+	require.Equal(t, ErrSafeTransactionExecuted, err)
+	_, err = s.AddSignature(tx.SafeTxHash, signature)
+	require.Equal(t, ErrSafeTransactionExecuted, err)
+	tx, err = db.GetTransaction(tx.SafeTxHash)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{2}, *tx.ExecutedHash)
+}
@@ -16,14 +16,22 @@ import (
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/rpc/chain"
 	"github.com/status-im/status-go/services/ens"
 	"github.com/status-im/status-go/services/rpcfilters"
 	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/addressbook"
+	"github.com/status-im/status-go/services/wallet/alerts"
+	"github.com/status-im/status-go/services/wallet/approvals"
 	"github.com/status-im/status-go/services/wallet/collectibles"
 	"github.com/status-im/status-go/services/wallet/currency"
 	"github.com/status-im/status-go/services/wallet/history"
 	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/payments"
+	"github.com/status-im/status-go/services/wallet/portfolio"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/services/wallet/simulation"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/alchemy"
 	"github.com/status-im/status-go/services/wallet/thirdparty/coingecko"
@@ -36,7 +44,8 @@ import (
 )
 
 const (
-	EventBlockchainStatusChanged walletevent.EventType = "wallet-blockchain-status-changed"
+	EventBlockchainStatusChanged   walletevent.EventType = "wallet-blockchain-status-changed"
+	EventRPCProvidersStatusChanged walletevent.EventType = "wallet-rpc-providers-status-changed"
 )
 
 // NewService initializes service instance.
@@ -90,6 +99,20 @@ func NewService(
 			ChainID:  chainID,
 		})
 	})
+	rpcClient.SetProviderNotifier(func(chainID uint64, status chain.ProvidersStatus) {
+		encodedmessage, err := json.Marshal(status)
+		if err != nil {
+			return
+		}
+
+		walletFeed.Send(walletevent.Event{
+			Type:     EventRPCProvidersStatusChanged,
+			Accounts: []common.Address{},
+			Message:  string(encodedmessage),
+			At:       time.Now().Unix(),
+			ChainID:  chainID,
+		})
+	})
 	tokenManager := token.NewTokenManager(db, rpcClient, rpcClient.NetworkManager)
 	savedAddressesManager := &SavedAddressesManager{db: db}
 	pendingTxManager := transactions.NewTransactionManager(db, rpcFilterSrvc.TransactionSentToUpstreamEvent(), walletFeed)
@@ -100,13 +123,22 @@ func NewService(
 	coingecko := coingecko.NewClient()
 	marketManager := market.NewManager(cryptoCompare, coingecko, walletFeed)
 	reader := NewReader(rpcClient, tokenManager, marketManager, accountsDB, NewPersistence(db), walletFeed)
+	exchange := history.NewExchange(marketManager)
 	history := history.NewService(db, walletFeed, rpcClient, tokenManager, marketManager)
 	currency := currency.NewService(db, walletFeed, tokenManager, marketManager)
-	activity := activity.NewService(db, tokenManager, walletFeed)
+	addressbook := addressbook.NewResolver(db, rpcClient, walletFeed)
+	activity := activity.NewService(db, tokenManager, walletFeed, exchange, addressbook)
+	approvals := approvals.NewService(db, rpcClient, tokenManager, walletFeed)
+	portfolio := portfolio.NewService(db, tokenManager, walletFeed, exchange, marketManager)
+	alerts := alerts.NewService(db, tokenManager, walletFeed, marketManager)
+	safe := safe.NewService(db, accountsDB, rpcClient, gethManager, config.KeyStoreDir, walletFeed)
+	payments := payments.NewService(db, accountsDB, tokenManager, walletFeed)
+	decoder := NewDecoder()
+	simulation := simulation.NewService(rpcClient, tokenManager, decoder)
 
 	alchemyClient := alchemy.NewClient(config.WalletConfig.AlchemyAPIKeys)
 	infuraClient := infura.NewClient(config.WalletConfig.InfuraAPIKey, config.WalletConfig.InfuraAPIKeySecret)
-	collectiblesManager := collectibles.NewManager(rpcClient, alchemyClient, infuraClient, config.WalletConfig.OpenseaAPIKey, walletFeed)
+	collectiblesManager := collectibles.NewManager(db, rpcClient, alchemyClient, infuraClient, config.WalletConfig.OpenseaAPIKey, walletFeed)
 	return &Service{
 		db:                    db,
 		accountsDB:            accountsDB,
@@ -131,7 +163,14 @@ func NewService(
 		history:               history,
 		currency:              currency,
 		activity:              activity,
-		decoder:               NewDecoder(),
+		approvals:             approvals,
+		decoder:               decoder,
+		simulation:            simulation,
+		portfolio:             portfolio,
+		alerts:                alerts,
+		safe:                  safe,
+		addressbook:           addressbook,
+		payments:              payments,
 	}
 }
 
@@ -161,7 +200,14 @@ type Service struct {
 	history               *history.Service
 	currency              *currency.Service
 	activity              *activity.Service
+	approvals             *approvals.Service
 	decoder               *Decoder
+	simulation            *simulation.Service
+	portfolio             *portfolio.Service
+	alerts                *alerts.Service
+	safe                  *safe.Service
+	addressbook           *addressbook.Resolver
+	payments              *payments.Service
 }
 
 // Start signals transmitter.
@@ -170,6 +216,9 @@ func (s *Service) Start() error {
 	s.currency.Start()
 	err := s.signals.Start()
 	s.history.Start()
+	s.portfolio.Start()
+	s.alerts.Start()
+	s.payments.Start()
 	_ = s.pendingTxManager.Start()
 	s.started = true
 	return err
@@ -185,6 +234,47 @@ func (s *Service) SetNFTMetadataProvider(provider thirdparty.NFTMetadataProvider
 	s.collectiblesManager.SetMetadataProvider(provider)
 }
 
+// Set external contacts provider, used to name the addresses of contacts
+func (s *Service) SetContactsProvider(provider addressbook.ContactsProvider) {
+	s.addressbook.SetContactsProvider(provider)
+}
+
+// setTransferNames fills the display names of the senders and recipients of views, leaving them empty on failure.
+// ENS names which aren't cached are left empty too, they are sent with addressbook.EventAddressIdentitiesResolved
+func (s *Service) setTransferNames(views []transfer.View) {
+	byChain := make(map[uint64][]int)
+	for i, view := range views {
+		byChain[view.NetworkID] = append(byChain[view.NetworkID], i)
+	}
+
+	for chainID, indexes := range byChain {
+		seen := make(map[common.Address]bool)
+		var addresses []common.Address
+		for _, i := range indexes {
+			for _, address := range []common.Address{views[i].From, views[i].To} {
+				if !seen[address] {
+					seen[address] = true
+					addresses = append(addresses, address)
+				}
+			}
+		}
+
+		names := make(map[common.Address]string)
+		identities, err := s.addressbook.ResolveCachedAddresses(chainID, addresses)
+		if err != nil {
+			log.Warn("failed to resolve transfer address names", "chainID", chainID, "err", err)
+		}
+		for _, identity := range identities {
+			names[identity.Address] = identity.DisplayName
+		}
+
+		for _, i := range indexes {
+			views[i].FromName = names[views[i].From]
+			views[i].ToName = names[views[i].To]
+		}
+	}
+}
+
 // Stop reactor and close db.
 func (s *Service) Stop() error {
 	log.Info("wallet will be stopped")
@@ -194,6 +284,9 @@ func (s *Service) Stop() error {
 	s.reader.Stop()
 	s.history.Stop()
 	s.activity.Stop()
+	s.portfolio.Stop()
+	s.alerts.Stop()
+	s.payments.Stop()
 	s.pendingTxManager.Stop()
 	s.started = false
 	log.Info("wallet stopped")
@@ -0,0 +1,363 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/services/wallet/approvals"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+const (
+	// Approval (index_topic_1 address owner, index_topic_2 address spender, uint256 value)
+	// Approval (index_topic_1 address owner, index_topic_2 address approved, index_topic_3 uint256 tokenId)
+	approvalEventSignature = "Approval(address,address,uint256)"
+	// ApprovalForAll (index_topic_1 address owner, index_topic_2 address operator, bool approved)
+	approvalForAllEventSignature = "ApprovalForAll(address,address,bool)"
+
+	erc20ApprovalEventIndexedParameters  = 3 // signature, owner, spender
+	erc721ApprovalEventIndexedParameters = 4 // signature, owner, approved, tokenId
+)
+
+var (
+	approvalEventSignatureHash       = w_common.GetEventSignatureHash(approvalEventSignature)
+	approvalForAllEventSignatureHash = w_common.GetEventSignatureHash(approvalForAllEventSignature)
+
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons describes the codes of the Panic(uint256) errors raised by solidity
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// tokenMethodsABI holds the token methods whose effects can be guessed from the calldata alone.
+// ERC-721 transferFrom and approve share the selector of their ERC-20 counterpart and are reported as such
+const tokenMethodsABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}]},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}]},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"amount","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"safeBatchTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"ids","type":"uint256[]"},{"name":"amounts","type":"uint256[]"},{"name":"data","type":"bytes"}]}
+]`
+
+var tokenMethods = mustParseABI(tokenMethodsABI)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// Preview is the expected outcome of a transaction, simulated before it is signed
+type Preview struct {
+	ChainID uint64          `json:"chainId"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *hexutil.Big    `json:"value"`
+	// Simulated is false when the transaction is only built when sending it, as for bridges
+	Simulated    bool                   `json:"simulated"`
+	Success      bool                   `json:"success"`
+	RevertReason string                 `json:"revertReason,omitempty"`
+	GasEstimate  uint64                 `json:"gasEstimate"`
+	Decoded      *thirdparty.DataParsed `json:"decoded,omitempty"`
+	// Traced is set when the effects come from a trace of the call, otherwise they are guessed from the calldata
+	Traced         bool              `json:"traced"`
+	BalanceChanges []*BalanceChange  `json:"balanceChanges"`
+	Approvals      []*ApprovalChange `json:"approvals"`
+}
+
+// BalanceChange is the change of the balance of an asset of the sender
+type BalanceChange struct {
+	Type         w_common.Type   `json:"type"`
+	TokenAddress *common.Address `json:"tokenAddress,omitempty"`
+	TokenID      *hexutil.Big    `json:"tokenId,omitempty"`
+	Symbol       string          `json:"symbol,omitempty"`
+	Decimals     uint            `json:"decimals,omitempty"`
+	// Amount is negative when the balance decreases
+	Amount *hexutil.Big `json:"amount"`
+}
+
+// ApprovalChange is an approval granted or revoked by the sender
+type ApprovalChange struct {
+	TokenAddress common.Address `json:"tokenAddress"`
+	Spender      common.Address `json:"spender"`
+	// Amount is the new allowance of ERC-20 approvals
+	Amount *hexutil.Big `json:"amount,omitempty"`
+	// TokenID is the only token the spender can move, for ERC-721 approvals
+	TokenID *hexutil.Big `json:"tokenId,omitempty"`
+	// All is set when the spender can move all the tokens of the collection
+	All       bool `json:"all"`
+	Unlimited bool `json:"unlimited"`
+	Revoked   bool `json:"revoked"`
+}
+
+// callFrame is a call of the callTracer result
+type callFrame struct {
+	Type   string         `json:"type"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Output hexutil.Bytes  `json:"output"`
+	Error  string         `json:"error"`
+	Calls  []callFrame    `json:"calls"`
+	Logs   []callLog      `json:"logs"`
+}
+
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// effects accumulates the balance changes and approvals of account
+type effects struct {
+	account   common.Address
+	changes   []*BalanceChange
+	approvals []*ApprovalChange
+}
+
+func newEffects(account common.Address) *effects {
+	return &effects{account: account}
+}
+
+func sameTokenID(a *hexutil.Big, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.ToInt().Cmp(b) == 0
+}
+
+func (e *effects) addBalance(assetType w_common.Type, tokenAddress *common.Address, tokenID *big.Int, amount *big.Int) {
+	for _, change := range e.changes {
+		if change.Type != assetType || !sameTokenID(change.TokenID, tokenID) {
+			continue
+		}
+		if (change.TokenAddress == nil) != (tokenAddress == nil) || (tokenAddress != nil && *change.TokenAddress != *tokenAddress) {
+			continue
+		}
+		change.Amount = (*hexutil.Big)(new(big.Int).Add(change.Amount.ToInt(), amount))
+		return
+	}
+
+	change := &BalanceChange{
+		Type:   assetType,
+		Amount: (*hexutil.Big)(new(big.Int).Set(amount)),
+	}
+	if tokenAddress != nil {
+		address := *tokenAddress
+		change.TokenAddress = &address
+	}
+	if tokenID != nil {
+		change.TokenID = (*hexutil.Big)(new(big.Int).Set(tokenID))
+	}
+	e.changes = append(e.changes, change)
+}
+
+func (e *effects) transfer(assetType w_common.Type, tokenAddress *common.Address, tokenID *big.Int, from, to common.Address, amount *big.Int) {
+	if amount == nil || amount.Sign() == 0 {
+		return
+	}
+	if from == e.account {
+		e.addBalance(assetType, tokenAddress, tokenID, new(big.Int).Neg(amount))
+	}
+	if to == e.account {
+		e.addBalance(assetType, tokenAddress, tokenID, amount)
+	}
+}
+
+// approve records an approval of the account, replacing a previous one of the same grant
+func (e *effects) approve(owner common.Address, approval *ApprovalChange) {
+	if owner != e.account {
+		return
+	}
+	for i, a := range e.approvals {
+		if a.TokenAddress == approval.TokenAddress && a.Spender == approval.Spender && a.All == approval.All {
+			e.approvals[i] = approval
+			return
+		}
+	}
+	e.approvals = append(e.approvals, approval)
+}
+
+func erc20Approval(tokenAddress, spender common.Address, amount *big.Int) *ApprovalChange {
+	return &ApprovalChange{
+		TokenAddress: tokenAddress,
+		Spender:      spender,
+		Amount:       (*hexutil.Big)(amount),
+		Unlimited:    approvals.IsUnlimited(amount),
+		Revoked:      amount.Sign() == 0,
+	}
+}
+
+func approvalForAll(tokenAddress, operator common.Address, approved bool) *ApprovalChange {
+	return &ApprovalChange{
+		TokenAddress: tokenAddress,
+		Spender:      operator,
+		All:          true,
+		Unlimited:    approved,
+		Revoked:      !approved,
+	}
+}
+
+// addLog records the token transfers and approvals of a log
+func (e *effects) addLog(l *types.Log) {
+	switch w_common.GetEventType(l) {
+	case w_common.Erc20TransferEventType:
+		from, to, amount := w_common.ParseErc20TransferLog(l)
+		e.transfer(w_common.Erc20Transfer, &l.Address, nil, from, to, amount)
+	case w_common.Erc721TransferEventType:
+		from, to, tokenID := w_common.ParseErc721TransferLog(l)
+		e.transfer(w_common.Erc721Transfer, &l.Address, tokenID, from, to, big.NewInt(1))
+	case w_common.Erc1155TransferSingleEventType:
+		_, from, to, tokenID, value, err := w_common.ParseErc1155TransferSingleLog(l)
+		if err == nil {
+			e.transfer(w_common.Erc1155Transfer, &l.Address, tokenID, from, to, value)
+		}
+	case w_common.Erc1155TransferBatchEventType:
+		_, from, to, tokenIDs, values, err := w_common.ParseErc1155TransferBatchLog(l)
+		if err == nil {
+			for i := range tokenIDs {
+				e.transfer(w_common.Erc1155Transfer, &l.Address, tokenIDs[i], from, to, values[i])
+			}
+		}
+	case w_common.WETHDepositEventType:
+		dst, amount := w_common.ParseWETHDepositLog(l)
+		e.transfer(w_common.Erc20Transfer, &l.Address, nil, common.Address{}, dst, amount)
+	case w_common.WETHWithdrawalEventType:
+		src, amount := w_common.ParseWETHWithdrawLog(l)
+		e.transfer(w_common.Erc20Transfer, &l.Address, nil, src, common.Address{}, amount)
+	default:
+		e.addApprovalLog(l)
+	}
+}
+
+func (e *effects) addApprovalLog(l *types.Log) {
+	if len(l.Topics) < 3 {
+		return
+	}
+	owner := common.BytesToAddress(l.Topics[1].Bytes())
+	spender := common.BytesToAddress(l.Topics[2].Bytes())
+
+	switch {
+	case l.Topics[0] == approvalEventSignatureHash && len(l.Topics) == erc20ApprovalEventIndexedParameters && len(l.Data) == 32:
+		e.approve(owner, erc20Approval(l.Address, spender, new(big.Int).SetBytes(l.Data)))
+	case l.Topics[0] == approvalEventSignatureHash && len(l.Topics) == erc721ApprovalEventIndexedParameters:
+		e.approve(owner, &ApprovalChange{
+			TokenAddress: l.Address,
+			Spender:      spender,
+			TokenID:      (*hexutil.Big)(l.Topics[3].Big()),
+			Revoked:      spender == common.Address{},
+		})
+	case l.Topics[0] == approvalForAllEventSignatureHash && len(l.Data) == 32:
+		e.approve(owner, approvalForAll(l.Address, spender, new(big.Int).SetBytes(l.Data).Sign() != 0))
+	}
+}
+
+// addFrame records the native transfers and the logs of a call and of its successful sub calls
+func (e *effects) addFrame(frame *callFrame) {
+	if frame.Error != "" {
+		return
+	}
+	// Delegate and static calls don't move funds, their value is the one of the parent call
+	if frame.Type != "DELEGATECALL" && frame.Type != "STATICCALL" && frame.Value != nil {
+		e.transfer(w_common.EthTransfer, nil, nil, frame.From, frame.To, frame.Value.ToInt())
+	}
+	for _, l := range frame.Logs {
+		e.addLog(&types.Log{Address: l.Address, Topics: l.Topics, Data: l.Data})
+	}
+	for i := range frame.Calls {
+		e.addFrame(&frame.Calls[i])
+	}
+}
+
+// addCalldata guesses the effects of a call from its value and the token method it calls, if any
+func (e *effects) addCalldata(to common.Address, value *big.Int, data []byte) {
+	e.transfer(w_common.EthTransfer, nil, nil, e.account, to, value)
+
+	if len(data) < 4 {
+		return
+	}
+	method, err := tokenMethods.MethodById(data[:4])
+	if err != nil {
+		return
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return
+	}
+
+	switch method.Sig {
+	case "transfer(address,uint256)":
+		e.transfer(w_common.Erc20Transfer, &to, nil, e.account, args[0].(common.Address), args[1].(*big.Int))
+	case "transferFrom(address,address,uint256)":
+		e.transfer(w_common.Erc20Transfer, &to, nil, args[0].(common.Address), args[1].(common.Address), args[2].(*big.Int))
+	case "approve(address,uint256)":
+		e.approve(e.account, erc20Approval(to, args[0].(common.Address), args[1].(*big.Int)))
+	case "setApprovalForAll(address,bool)":
+		e.approve(e.account, approvalForAll(to, args[0].(common.Address), args[1].(bool)))
+	case "safeTransferFrom(address,address,uint256)", "safeTransferFrom(address,address,uint256,bytes)":
+		e.transfer(w_common.Erc721Transfer, &to, args[2].(*big.Int), args[0].(common.Address), args[1].(common.Address), big.NewInt(1))
+	case "safeTransferFrom(address,address,uint256,uint256,bytes)":
+		e.transfer(w_common.Erc1155Transfer, &to, args[2].(*big.Int), args[0].(common.Address), args[1].(common.Address), args[3].(*big.Int))
+	case "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)":
+		tokenIDs, amounts := args[2].([]*big.Int), args[3].([]*big.Int)
+		for i := 0; i < len(tokenIDs) && i < len(amounts); i++ {
+			e.transfer(w_common.Erc1155Transfer, &to, tokenIDs[i], args[0].(common.Address), args[1].(common.Address), amounts[i])
+		}
+	}
+}
+
+// result returns the non zero balance changes and the approvals
+func (e *effects) result() ([]*BalanceChange, []*ApprovalChange) {
+	changes := make([]*BalanceChange, 0, len(e.changes))
+	for _, change := range e.changes {
+		if change.Amount.ToInt().Sign() != 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes, append([]*ApprovalChange{}, e.approvals...)
+}
+
+// revertReason decodes the Error(string) and Panic(uint256) errors returned by a reverted call
+func revertReason(data []byte) string {
+	if len(data) < 4 {
+		return "execution reverted"
+	}
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err == nil {
+			return reason
+		}
+	case bytes.Equal(data[:4], panicSelector) && len(data) == 36:
+		code := new(big.Int).SetBytes(data[4:])
+		if reason, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+			return fmt.Sprintf("panic: %s", reason)
+		}
+		return fmt.Sprintf("panic: code 0x%x", code)
+	}
+	return fmt.Sprintf("execution reverted with custom error 0x%x", data[:4])
+}
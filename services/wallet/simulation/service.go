@@ -0,0 +1,265 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/contracts/ierc1155"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/transactions"
+)
+
+// executionRevertedErrorCode is the code of the JSON-RPC error returned for reverted calls
+const executionRevertedErrorCode = 3
+
+// chainClient is the subset of the chain client used to simulate transactions
+type chainClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Decoder decodes the calldata of a transaction using its method signature
+type Decoder interface {
+	Decode(data string) (*thirdparty.DataParsed, error)
+}
+
+type Service struct {
+	tokenManager *token.Manager
+	decoder      Decoder
+	client       func(chainID uint64) (chainClient, error)
+}
+
+func NewService(rpcClient *rpc.Client, tokenManager *token.Manager, decoder Decoder) *Service {
+	return &Service{
+		tokenManager: tokenManager,
+		decoder:      decoder,
+		client: func(chainID uint64) (chainClient, error) {
+			return rpcClient.EthClient(chainID)
+		},
+	}
+}
+
+// PreviewTransaction dry-runs a transaction against the latest block and returns its expected outcome.
+// The balance changes and approvals of the sender are read from a trace of the call when the node supports
+// debug_traceCall, otherwise they are guessed from the value and calldata of the transaction
+func (s *Service) PreviewTransaction(ctx context.Context, chainID uint64, args transactions.SendTxArgs) (*Preview, error) {
+	client, err := s.client(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := callMsg(args)
+	preview := &Preview{
+		ChainID:   chainID,
+		From:      msg.From,
+		To:        msg.To,
+		Value:     (*hexutil.Big)(msg.Value),
+		Simulated: true,
+	}
+
+	if s.decoder != nil && len(msg.Data) >= 4 {
+		decoded, err := s.decoder.Decode(hexutil.Encode(msg.Data))
+		if err != nil {
+			log.Debug("failed to decode calldata", "err", err)
+		} else {
+			preview.Decoded = decoded
+		}
+	}
+
+	failed, err := s.dryRun(ctx, client, msg, preview)
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		preview.BalanceChanges = []*BalanceChange{}
+		preview.Approvals = []*ApprovalChange{}
+		return preview, nil
+	}
+
+	effects := newEffects(msg.From)
+	frame, err := traceCall(ctx, client, msg)
+	if err == nil {
+		preview.Traced = true
+		effects.addFrame(frame)
+	} else {
+		log.Debug("debug_traceCall not available, guessing effects from calldata", "chainID", chainID, "err", err)
+		to := common.Address{}
+		if msg.To != nil {
+			to = *msg.To
+		}
+		effects.addCalldata(to, msg.Value, msg.Data)
+	}
+	preview.BalanceChanges, preview.Approvals = effects.result()
+	s.setTokenInfo(chainID, preview.BalanceChanges)
+
+	return preview, nil
+}
+
+// PreviewMultiTransaction previews the transactions of a multi-transaction before CreateMultiTransaction sends them.
+// Bridge transactions are built when sending them and are returned without simulating them
+func (s *Service) PreviewMultiTransaction(ctx context.Context, data []*bridge.TransactionBridge) ([]*Preview, error) {
+	previews := make([]*Preview, 0, len(data))
+	for _, tx := range data {
+		args, ok, err := transactionArgs(tx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			to := common.Address(tx.To())
+			previews = append(previews, &Preview{
+				ChainID:        tx.ChainID,
+				From:           common.Address(tx.From()),
+				To:             &to,
+				Value:          (*hexutil.Big)(tx.Value()),
+				BalanceChanges: []*BalanceChange{},
+				Approvals:      []*ApprovalChange{},
+			})
+			continue
+		}
+
+		preview, err := s.PreviewTransaction(ctx, tx.ChainID, args)
+		if err != nil {
+			return nil, err
+		}
+		previews = append(previews, preview)
+	}
+	return previews, nil
+}
+
+// transactionArgs returns the arguments of the transaction sent for a bridge transaction, if known before sending it
+func transactionArgs(tx *bridge.TransactionBridge) (transactions.SendTxArgs, bool, error) {
+	if tx.SimpleTx != nil {
+		return *tx.SimpleTx, true, nil
+	}
+	if tx.ERC1155TransferTx != nil {
+		erc1155ABI, err := abi.JSON(strings.NewReader(ierc1155.IERC1155ABI))
+		if err != nil {
+			return transactions.SendTxArgs{}, false, err
+		}
+		transfer := tx.ERC1155TransferTx
+		input, err := erc1155ABI.Pack("safeTransferFrom", common.Address(transfer.From), transfer.Recipient,
+			transfer.TokenID.ToInt(), transfer.Amount.ToInt(), []byte{})
+		if err != nil {
+			return transactions.SendTxArgs{}, false, err
+		}
+		args := transfer.SendTxArgs
+		args.Value = (*hexutil.Big)(big.NewInt(0))
+		args.Input = input
+		args.Data = nil
+		return args, true, nil
+	}
+	return transactions.SendTxArgs{}, false, nil
+}
+
+func callMsg(args transactions.SendTxArgs) ethereum.CallMsg {
+	msg := ethereum.CallMsg{
+		From:  common.Address(args.From),
+		Value: big.NewInt(0),
+		Data:  args.GetInput(),
+	}
+	if args.To != nil {
+		to := common.Address(*args.To)
+		msg.To = &to
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	return msg
+}
+
+// dryRun calls the transaction and estimates its gas. It returns whether the transaction would fail,
+// setting the reason in the preview, or an error if the node could not execute the call
+func (s *Service) dryRun(ctx context.Context, client chainClient, msg ethereum.CallMsg, preview *Preview) (bool, error) {
+	_, err := client.CallContract(ctx, msg, nil)
+	if err == nil {
+		preview.GasEstimate, err = client.EstimateGas(ctx, msg)
+	}
+	if err == nil {
+		preview.Success = true
+		return false, nil
+	}
+
+	reason, failed := failureReason(err)
+	if !failed {
+		return false, err
+	}
+	preview.RevertReason = reason
+	return true, nil
+}
+
+// failureReason returns the reason of a call reverted by the node, false if the call could not be executed
+func failureReason(err error) (string, bool) {
+	var rpcErr gethrpc.Error
+	reverted := errors.As(err, &rpcErr) && rpcErr.ErrorCode() == executionRevertedErrorCode
+	if !reverted && !strings.HasPrefix(err.Error(), "execution reverted") {
+		return "", false
+	}
+
+	var dataErr gethrpc.DataError
+	if errors.As(err, &dataErr) {
+		if encoded, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(encoded); decodeErr == nil && len(data) > 0 {
+				return revertReason(data), true
+			}
+		}
+	}
+	return err.Error(), true
+}
+
+// traceCall traces the call with the callTracer of the node, including the logs of the calls
+func traceCall(ctx context.Context, client chainClient, msg ethereum.CallMsg) (*callFrame, error) {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	config := map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	}
+
+	var frame callFrame
+	if err := client.CallContext(ctx, &frame, "debug_traceCall", arg, "latest", config); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+func (s *Service) setTokenInfo(chainID uint64, changes []*BalanceChange) {
+	if s.tokenManager == nil {
+		return
+	}
+	for _, change := range changes {
+		if change.TokenAddress == nil {
+			continue
+		}
+		if t := s.tokenManager.FindTokenByAddress(chainID, *change.TokenAddress); t != nil {
+			change.Symbol = t.Symbol
+			change.Decimals = t.Decimals
+		}
+	}
+}
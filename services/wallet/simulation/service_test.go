@@ -0,0 +1,410 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/status-im/status-go/contracts/assets"
+	gethtypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/transactions"
+)
+
+const devChainGasLimit = 30000000
+
+// devChain is a local chain executing calls with the EVM on an in-memory state.
+// It answers debug_traceCall with a single call frame holding all the logs when tracing is enabled
+type devChain struct {
+	t       *testing.T
+	state   *state.StateDB
+	tracing bool
+	txCount int64
+}
+
+type revertError struct {
+	reason string
+	data   []byte
+}
+
+func (e *revertError) Error() string          { return "execution reverted: " + e.reason }
+func (e *revertError) ErrorCode() int         { return 3 }
+func (e *revertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// jsonError is an error returned by the node, as the ones of calls which can't be executed
+type jsonError struct {
+	message string
+	code    int
+}
+
+func (e *jsonError) Error() string  { return e.message }
+func (e *jsonError) ErrorCode() int { return e.code }
+
+func newDevChain(t *testing.T, funded ...common.Address) *devChain {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	for _, account := range funded {
+		statedb.AddBalance(account, new(big.Int).Mul(big.NewInt(100), big.NewInt(gethparams.Ether)))
+	}
+	return &devChain{t: t, state: statedb}
+}
+
+// apply executes msg, keeping its state changes if commit is set
+func (c *devChain) apply(msg ethereum.CallMsg, commit bool) (*core.ExecutionResult, []*types.Log, error) {
+	statedb := c.state
+	if !commit {
+		statedb = c.state.Copy()
+	}
+	// Logs are kept by transaction hash
+	c.txCount++
+	txHash := common.BigToHash(big.NewInt(c.txCount))
+	statedb.Prepare(txHash, 0)
+
+	gas := msg.Gas
+	if gas == 0 {
+		gas = devChainGasLimit
+	}
+	value := msg.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	blockContext := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		Difficulty:  big.NewInt(1),
+		GasLimit:    devChainGasLimit,
+		BaseFee:     big.NewInt(0),
+	}
+	evm := vm.NewEVM(blockContext, vm.TxContext{Origin: msg.From, GasPrice: big.NewInt(0)}, statedb,
+		gethparams.AllEthashProtocolChanges, vm.Config{NoBaseFee: true})
+	message := types.NewMessage(msg.From, msg.To, statedb.GetNonce(msg.From), value, gas,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), msg.Data, nil, false)
+
+	result, err := core.ApplyMessage(evm, message, new(core.GasPool).AddGas(gas))
+	if err != nil {
+		return nil, nil, &jsonError{message: err.Error(), code: -32000}
+	}
+	statedb.Finalise(true)
+	return result, statedb.GetLogs(txHash, common.Hash{}), nil
+}
+
+func (c *devChain) send(from common.Address, to *common.Address, data []byte) {
+	result, _, err := c.apply(ethereum.CallMsg{From: from, To: to, Data: data}, true)
+	require.NoError(c.t, err)
+	require.NoError(c.t, result.Err)
+}
+
+func (c *devChain) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	result, _, err := c.apply(msg, false)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failed() {
+		return nil, &revertError{reason: result.Err.Error(), data: result.Revert()}
+	}
+	return result.Return(), nil
+}
+
+func (c *devChain) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	result, _, err := c.apply(msg, false)
+	if err != nil {
+		return 0, err
+	}
+	if result.Failed() {
+		return 0, &revertError{reason: result.Err.Error(), data: result.Revert()}
+	}
+	return result.UsedGas, nil
+}
+
+func (c *devChain) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "debug_traceCall" || !c.tracing {
+		return &jsonError{message: "the method " + method + " does not exist/is not available", code: -32601}
+	}
+	// Round trip the arguments as a node would receive them
+	encoded, err := json.Marshal(args[0])
+	require.NoError(c.t, err)
+	var arg struct {
+		From  common.Address  `json:"from"`
+		To    *common.Address `json:"to"`
+		Value *hexutil.Big    `json:"value"`
+		Data  hexutil.Bytes   `json:"data"`
+	}
+	require.NoError(c.t, json.Unmarshal(encoded, &arg))
+
+	res, logs, err := c.apply(ethereum.CallMsg{From: arg.From, To: arg.To, Value: arg.Value.ToInt(), Data: arg.Data}, false)
+	if err != nil {
+		return err
+	}
+	frame := callFrame{Type: "CALL", From: arg.From, To: *arg.To, Value: arg.Value, Output: res.ReturnData}
+	if res.Failed() {
+		frame.Error = res.Err.Error()
+	}
+	for _, l := range logs {
+		frame.Logs = append(frame.Logs, callLog{Address: l.Address, Topics: l.Topics, Data: l.Data})
+	}
+
+	encoded, err = json.Marshal(frame)
+	require.NoError(c.t, err)
+	return json.Unmarshal(encoded, result)
+}
+
+type testDecoder struct{}
+
+func (d *testDecoder) Decode(data string) (*thirdparty.DataParsed, error) {
+	return &thirdparty.DataParsed{ID: data[:10], Name: "transfer"}, nil
+}
+
+// setupTestToken deploys an ERC-20 token on a dev chain and mints 1000 units to owner
+func setupTestToken(t *testing.T, owner common.Address) (*devChain, *Service, common.Address, abi.ABI) {
+	chain := newDevChain(t, owner)
+	s := &Service{
+		decoder: &testDecoder{},
+		client: func(chainID uint64) (chainClient, error) {
+			return chain, nil
+		},
+	}
+
+	tokenABI, err := abi.JSON(strings.NewReader(assets.AssetsABI))
+	require.NoError(t, err)
+	constructor, err := tokenABI.Pack("", "Test", "TST", big.NewInt(1000000))
+	require.NoError(t, err)
+	token := crypto.CreateAddress(owner, chain.state.GetNonce(owner))
+	chain.send(owner, nil, append(common.FromHex(assets.AssetsBin), constructor...))
+
+	mint, err := tokenABI.Pack("mintTo", []common.Address{owner}, []*big.Int{big.NewInt(1000)})
+	require.NoError(t, err)
+	chain.send(owner, &token, mint)
+
+	return chain, s, token, tokenABI
+}
+
+func sendTxArgs(from, to common.Address, value *big.Int, data []byte) transactions.SendTxArgs {
+	txTo := gethtypes.Address(to)
+	return transactions.SendTxArgs{
+		From:  gethtypes.Address(from),
+		To:    &txTo,
+		Value: (*hexutil.Big)(value),
+		Data:  data,
+	}
+}
+
+func TestPreviewTokenTransfer(t *testing.T) {
+	owner := common.Address{1}
+	recipient := common.Address{2}
+	chain, s, token, tokenABI := setupTestToken(t, owner)
+
+	data, err := tokenABI.Pack("transfer", recipient, big.NewInt(300))
+	require.NoError(t, err)
+	args := sendTxArgs(owner, token, big.NewInt(0), data)
+
+	for _, tracing := range []bool{true, false} {
+		chain.tracing = tracing
+		preview, err := s.PreviewTransaction(context.Background(), 1, args)
+		require.NoError(t, err)
+		require.True(t, preview.Simulated)
+		require.True(t, preview.Success)
+		require.Empty(t, preview.RevertReason)
+		require.Greater(t, preview.GasEstimate, uint64(21000))
+		require.Equal(t, "transfer", preview.Decoded.Name)
+		require.Equal(t, tracing, preview.Traced)
+		require.Len(t, preview.BalanceChanges, 1)
+		require.Equal(t, w_common.Erc20Transfer, preview.BalanceChanges[0].Type)
+		require.Equal(t, token, *preview.BalanceChanges[0].TokenAddress)
+		require.Equal(t, big.NewInt(-300), preview.BalanceChanges[0].Amount.ToInt())
+		require.Empty(t, preview.Approvals)
+	}
+
+	// Nothing was sent
+	result, _, err := chain.apply(ethereum.CallMsg{From: owner, To: &token, Data: mustPack(t, tokenABI, "balanceOf", owner)}, false)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), new(big.Int).SetBytes(result.Return()))
+}
+
+func TestPreviewRevertedTransaction(t *testing.T) {
+	owner := common.Address{1}
+	chain, s, token, tokenABI := setupTestToken(t, owner)
+	chain.tracing = true
+
+	data, err := tokenABI.Pack("transfer", common.Address{2}, big.NewInt(5000))
+	require.NoError(t, err)
+	preview, err := s.PreviewTransaction(context.Background(), 1, sendTxArgs(owner, token, big.NewInt(0), data))
+	require.NoError(t, err)
+	require.False(t, preview.Success)
+	require.Equal(t, "ERC20: transfer amount exceeds balance", preview.RevertReason)
+	require.Empty(t, preview.BalanceChanges)
+	require.Empty(t, preview.Approvals)
+
+	// Only the owner can mint
+	data, err = tokenABI.Pack("mintTo", []common.Address{{2}}, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	preview, err = s.PreviewTransaction(context.Background(), 1, sendTxArgs(common.Address{2}, token, big.NewInt(0), data))
+	require.NoError(t, err)
+	require.False(t, preview.Success)
+	require.Equal(t, "Ownable: caller is not the owner", preview.RevertReason)
+}
+
+func TestPreviewApproval(t *testing.T) {
+	owner := common.Address{1}
+	spender := common.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D")
+	chain, s, token, tokenABI := setupTestToken(t, owner)
+
+	data, err := tokenABI.Pack("approve", spender, math.MaxBig256)
+	require.NoError(t, err)
+
+	for _, tracing := range []bool{true, false} {
+		chain.tracing = tracing
+		preview, err := s.PreviewTransaction(context.Background(), 1, sendTxArgs(owner, token, big.NewInt(0), data))
+		require.NoError(t, err)
+		require.True(t, preview.Success)
+		require.Equal(t, tracing, preview.Traced)
+		require.Empty(t, preview.BalanceChanges)
+		require.Len(t, preview.Approvals, 1)
+		require.Equal(t, token, preview.Approvals[0].TokenAddress)
+		require.Equal(t, spender, preview.Approvals[0].Spender)
+		require.Equal(t, math.MaxBig256, preview.Approvals[0].Amount.ToInt())
+		require.True(t, preview.Approvals[0].Unlimited)
+		require.False(t, preview.Approvals[0].Revoked)
+	}
+}
+
+func TestPreviewEthTransfer(t *testing.T) {
+	owner := common.Address{1}
+	chain := newDevChain(t, owner)
+	chain.tracing = true
+	s := &Service{
+		client: func(chainID uint64) (chainClient, error) {
+			return chain, nil
+		},
+	}
+
+	value := big.NewInt(gethparams.Ether)
+	preview, err := s.PreviewTransaction(context.Background(), 1, sendTxArgs(owner, common.Address{2}, value, nil))
+	require.NoError(t, err)
+	require.True(t, preview.Success)
+	require.Equal(t, uint64(21000), preview.GasEstimate)
+	require.Nil(t, preview.Decoded)
+	require.Len(t, preview.BalanceChanges, 1)
+	require.Equal(t, w_common.EthTransfer, preview.BalanceChanges[0].Type)
+	require.Nil(t, preview.BalanceChanges[0].TokenAddress)
+	require.Equal(t, new(big.Int).Neg(value), preview.BalanceChanges[0].Amount.ToInt())
+
+	// Not enough funds, the node refuses to execute the call
+	_, err = s.PreviewTransaction(context.Background(), 1, sendTxArgs(common.Address{3}, common.Address{2}, value, nil))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "insufficient")
+}
+
+func TestPreviewMultiTransaction(t *testing.T) {
+	owner := common.Address{1}
+	collection := common.Address{5}
+	chain := newDevChain(t, owner)
+	s := &Service{
+		client: func(chainID uint64) (chainClient, error) {
+			return chain, nil
+		},
+	}
+
+	previews, err := s.PreviewMultiTransaction(context.Background(), []*bridge.TransactionBridge{
+		{
+			BridgeName: bridge.ERC1155TransferBridgeName,
+			ChainID:    1,
+			ERC1155TransferTx: &bridge.ERC1155TransferTxArgs{
+				SendTxArgs: sendTxArgs(owner, collection, nil, nil),
+				TokenID:    (*hexutil.Big)(big.NewInt(7)),
+				Recipient:  common.Address{2},
+				Amount:     (*hexutil.Big)(big.NewInt(3)),
+			},
+		},
+		{
+			BridgeName: "Hop",
+			ChainID:    1,
+			HopTx: &bridge.HopTxArgs{
+				SendTxArgs: sendTxArgs(owner, common.Address{2}, nil, nil),
+				Recipient:  common.Address{2},
+				Amount:     (*hexutil.Big)(big.NewInt(10)),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, previews, 2)
+
+	require.True(t, previews[0].Simulated)
+	require.Len(t, previews[0].BalanceChanges, 1)
+	require.Equal(t, w_common.Erc1155Transfer, previews[0].BalanceChanges[0].Type)
+	require.Equal(t, collection, *previews[0].BalanceChanges[0].TokenAddress)
+	require.Equal(t, big.NewInt(7), previews[0].BalanceChanges[0].TokenID.ToInt())
+	require.Equal(t, big.NewInt(-3), previews[0].BalanceChanges[0].Amount.ToInt())
+
+	require.False(t, previews[1].Simulated)
+	require.Equal(t, big.NewInt(10), previews[1].Value.ToInt())
+}
+
+func TestRevertReason(t *testing.T) {
+	panicData := append(common.CopyBytes(panicSelector), common.LeftPadBytes([]byte{0x11}, 32)...)
+	require.Equal(t, "panic: arithmetic overflow or underflow", revertReason(panicData))
+
+	panicData[35] = 0x99
+	require.Equal(t, "panic: code 0x99", revertReason(panicData))
+
+	require.Equal(t, "execution reverted with custom error 0x01020304", revertReason([]byte{1, 2, 3, 4}))
+	require.Equal(t, "execution reverted", revertReason(nil))
+}
+
+func TestFailureReason(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("no")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		err    error
+		reason string
+		failed bool
+	}{
+		{"revert with reason", &revertError{reason: "no", data: append(common.CopyBytes(errorSelector), reason...)}, "no", true},
+		{"revert without data", &revertError{reason: "no"}, "execution reverted: no", true},
+		{"revert message", errors.New("execution reverted"), "execution reverted", true},
+		{"method not found", &jsonError{message: "the method eth_call does not exist/is not available", code: -32601}, "", false},
+		{"rate limited", &jsonError{message: "too many requests", code: -32005}, "", false},
+		{"node error", &jsonError{message: "insufficient funds for gas * price + value", code: -32000}, "", false},
+		{"unreachable", errors.New("connection refused"), "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, failed := failureReason(tc.err)
+			require.Equal(t, tc.failed, failed)
+			require.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func mustPack(t *testing.T, parsed abi.ABI, method string, args ...interface{}) []byte {
+	data, err := parsed.Pack(method, args...)
+	require.NoError(t, err)
+	return data
+}
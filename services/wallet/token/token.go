@@ -62,7 +62,8 @@ func NewTokenManager(
 	networkManager *network.Manager,
 ) *Manager {
 	// Order of stores is important when merging token lists. The former prevale
-	tokenManager := &Manager{db, RPCClient, networkManager, []store{newUniswapStore(), newDefaultStore()}, nil, nil, false}
+	stores := []store{newUniswapStore(), newDefaultStore(), newTokenListStore(db)}
+	tokenManager := &Manager{db, RPCClient, networkManager, stores, nil, nil, false}
 	return tokenManager
 }
 
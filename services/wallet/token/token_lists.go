@@ -0,0 +1,581 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// maxTokenListSize is the size of the largest token list document accepted, 10000 tokens take about 3MB
+	maxTokenListSize = 8 * 1024 * 1024
+
+	// Sources of the tokens a token list token can collide with, besides other imported lists
+	BuiltInTokensSource = "built-in"
+	CustomTokensSource  = "custom"
+)
+
+var (
+	ErrInvalidTokenList          = errors.New("invalid token list")
+	ErrTokenListTooLarge         = errors.New("token list is too large")
+	ErrTokenListNotFound         = errors.New("token list not found")
+	ErrTokenListVersionDowngrade = errors.New("token list version is older than the imported one")
+	ErrTokenListVersionUnchanged = errors.New("token list changed without a new version")
+)
+
+// tokenListSchema is the JSON schema of token lists, https://uniswap.org/tokenlist.schema.json
+const tokenListSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"definitions": {
+		"Version": {
+			"type": "object",
+			"properties": {
+				"major": {"type": "integer", "minimum": 0},
+				"minor": {"type": "integer", "minimum": 0},
+				"patch": {"type": "integer", "minimum": 0}
+			},
+			"required": ["major", "minor", "patch"],
+			"additionalProperties": false
+		},
+		"TokenInfo": {
+			"type": "object",
+			"properties": {
+				"chainId": {"type": "integer", "minimum": 1},
+				"address": {"type": "string", "pattern": "^0x[a-fA-F0-9]{40}$"},
+				"decimals": {"type": "integer", "minimum": 0, "maximum": 255},
+				"name": {"type": "string", "minLength": 1, "maxLength": 60},
+				"symbol": {"type": "string", "minLength": 1, "maxLength": 20},
+				"logoURI": {"type": "string", "format": "uri"},
+				"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 10},
+				"extensions": {"type": "object"}
+			},
+			"required": ["chainId", "address", "decimals", "name", "symbol"],
+			"additionalProperties": false
+		}
+	},
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "maxLength": 30},
+		"timestamp": {"type": "string", "format": "date-time"},
+		"version": {"$ref": "#/definitions/Version"},
+		"tokens": {"type": "array", "items": {"$ref": "#/definitions/TokenInfo"}, "minItems": 1, "maxItems": 10000},
+		"tokenMap": {"type": "object"},
+		"keywords": {"type": "array", "items": {"type": "string"}, "maxItems": 20},
+		"tags": {"type": "object"},
+		"logoURI": {"type": "string", "format": "uri"}
+	},
+	"required": ["name", "timestamp", "version", "tokens"],
+	"additionalProperties": false
+}`
+
+var tokenListSchemaLoader = gojsonschema.NewStringLoader(tokenListSchema)
+
+type TokenListVersion struct {
+	Major uint `json:"major"`
+	Minor uint `json:"minor"`
+	Patch uint `json:"patch"`
+}
+
+func (v TokenListVersion) compare(other TokenListVersion) int {
+	switch {
+	case v.Major != other.Major:
+		return compareUint(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareUint(v.Minor, other.Minor)
+	default:
+		return compareUint(v.Patch, other.Patch)
+	}
+}
+
+func compareUint(a, b uint) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func (v TokenListVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// TokenListChain tells whether the tokens of a list on a chain are used
+type TokenListChain struct {
+	ChainID     uint64 `json:"chainId"`
+	Enabled     bool   `json:"enabled"`
+	TokensCount int    `json:"tokensCount"`
+}
+
+// TokenList is an imported token list with its provenance: the file or URL it was read from, its version and the hash of
+// the document
+type TokenList struct {
+	ID          string            `json:"id"`
+	Source      string            `json:"source"`
+	Name        string            `json:"name"`
+	LogoURI     string            `json:"logoURI"`
+	Version     TokenListVersion  `json:"version"`
+	Timestamp   string            `json:"timestamp"`
+	ContentHash common.Hash       `json:"contentHash"`
+	ImportedAt  int64             `json:"importedAt"`
+	UpdatedAt   int64             `json:"updatedAt"`
+	Chains      []*TokenListChain `json:"chains"`
+}
+
+// TokenCollision is a token of an imported list whose address is already known from another source
+type TokenCollision struct {
+	ChainID  uint64         `json:"chainId"`
+	Address  common.Address `json:"address"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint           `json:"decimals"`
+	// Source is BuiltInTokensSource, CustomTokensSource or the id of another imported list
+	Source         string `json:"source"`
+	SourceSymbol   string `json:"sourceSymbol"`
+	SourceDecimals uint   `json:"sourceDecimals"`
+}
+
+type TokenListImport struct {
+	List       *TokenList        `json:"list"`
+	Collisions []*TokenCollision `json:"collisions"`
+	// Updated is false when the list was already imported with the same content
+	Updated bool `json:"updated"`
+}
+
+type tokenListEntry struct {
+	ChainID  uint64         `json:"chainId"`
+	Address  common.Address `json:"address"`
+	Name     string         `json:"name"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint           `json:"decimals"`
+	LogoURI  string         `json:"logoURI"`
+}
+
+type tokenListDocument struct {
+	Name      string           `json:"name"`
+	LogoURI   string           `json:"logoURI"`
+	Timestamp string           `json:"timestamp"`
+	Version   TokenListVersion `json:"version"`
+	Tokens    []tokenListEntry `json:"tokens"`
+}
+
+// parseTokenList validates a token list against the schema, then checks the list has no token twice
+func parseTokenList(raw []byte) (*tokenListDocument, error) {
+	result, err := gojsonschema.Validate(tokenListSchemaLoader, gojsonschema.NewBytesLoader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTokenList, err)
+	}
+	if !result.Valid() {
+		details := make([]string, 0, len(result.Errors()))
+		for _, e := range result.Errors() {
+			details = append(details, e.String())
+		}
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTokenList, strings.Join(details, "; "))
+	}
+
+	doc := &tokenListDocument{}
+	if err := json.Unmarshal(raw, doc); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTokenList, err)
+	}
+	seen := make(map[tokenKey]bool)
+	for _, t := range doc.Tokens {
+		key := tokenKey{t.ChainID, t.Address}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: token %s on chain %d is listed twice", ErrInvalidTokenList, t.Address, t.ChainID)
+		}
+		seen[key] = true
+	}
+	return doc, nil
+}
+
+// readTokenList reads a token list document from an http(s) URL or a file path
+func readTokenList(ctx context.Context, source string) ([]byte, error) {
+	var reader io.Reader
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{Timeout: requestTimeout}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch token list: %s", resp.Status)
+		}
+		reader = resp.Body
+	} else {
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(reader, maxTokenListSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxTokenListSize {
+		return nil, ErrTokenListTooLarge
+	}
+	return raw, nil
+}
+
+// tokenListStore is the store of the tokens of the imported lists, on the chains they are enabled on. The lists imported first
+// prevail when lists share a token
+type tokenListStore struct {
+	db *sql.DB
+}
+
+func newTokenListStore(db *sql.DB) *tokenListStore {
+	return &tokenListStore{db: db}
+}
+
+func (ts *tokenListStore) GetTokens() []*Token {
+	rows, err := ts.db.Query(`SELECT t.chain_id, t.address, t.name, t.symbol, t.decimals FROM token_list_tokens t
+		JOIN token_list_chains c ON c.list_id = t.list_id AND c.chain_id = t.chain_id
+		JOIN token_lists l ON l.id = t.list_id
+		WHERE c.enabled ORDER BY l.imported_at, l.id`)
+	if err != nil {
+		log.Error("can't fetch token list tokens", "error", err)
+		return nil
+	}
+	defer rows.Close()
+
+	var tokens []*Token
+	for rows.Next() {
+		token := &Token{}
+		if err := rows.Scan(&token.ChainID, &token.Address, &token.Name, &token.Symbol, &token.Decimals); err != nil {
+			log.Error("can't scan token list token", "error", err)
+			return nil
+		}
+		token.PegSymbol = GetTokenPegSymbol(token.Symbol)
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// ImportTokenList imports the token list at source, an http(s) URL or a file path. A list imported again from the same source
+// is updated when its version increased
+func (tm *Manager) ImportTokenList(ctx context.Context, source string) (*TokenListImport, error) {
+	raw, err := readTokenList(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	return tm.importTokenList(source, raw)
+}
+
+// UpdateTokenList reads an imported token list again from its source
+func (tm *Manager) UpdateTokenList(ctx context.Context, id string) (*TokenListImport, error) {
+	list, err := tm.GetTokenList(id)
+	if err != nil {
+		return nil, err
+	}
+	return tm.ImportTokenList(ctx, list.Source)
+}
+
+func (tm *Manager) importTokenList(source string, raw []byte) (*TokenListImport, error) {
+	doc, err := parseTokenList(raw)
+	if err != nil {
+		return nil, err
+	}
+	hash := crypto.Keccak256Hash(raw)
+
+	existing, err := tm.getTokenLists(`WHERE source = ?`, source)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	list := &TokenList{
+		ID:          uuid.New().String(),
+		Source:      source,
+		Name:        doc.Name,
+		LogoURI:     doc.LogoURI,
+		Version:     doc.Version,
+		Timestamp:   doc.Timestamp,
+		ContentHash: hash,
+		ImportedAt:  now,
+		UpdatedAt:   now,
+	}
+	if len(existing) > 0 {
+		previous := existing[0]
+		switch cmp := doc.Version.compare(previous.Version); {
+		case cmp < 0:
+			return nil, ErrTokenListVersionDowngrade
+		case cmp == 0 && hash != previous.ContentHash:
+			return nil, ErrTokenListVersionUnchanged
+		case cmp == 0:
+			collisions, err := tm.GetTokenListCollisions(previous.ID)
+			if err != nil {
+				return nil, err
+			}
+			return &TokenListImport{List: previous, Collisions: collisions}, nil
+		}
+		list.ID = previous.ID
+		list.ImportedAt = previous.ImportedAt
+	}
+
+	if err := tm.saveTokenList(list, doc.Tokens); err != nil {
+		return nil, err
+	}
+	tm.areTokensFetched = false
+
+	list, err = tm.GetTokenList(list.ID)
+	if err != nil {
+		return nil, err
+	}
+	collisions, err := tm.GetTokenListCollisions(list.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenListImport{List: list, Collisions: collisions, Updated: true}, nil
+}
+
+// saveTokenList stores a list and replaces its tokens. Chains new to the list are enabled, the others keep their setting
+func (tm *Manager) saveTokenList(list *TokenList, tokens []tokenListEntry) (err error) {
+	tx, err := tm.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	// Replacing the row would delete the chain settings of the list
+	res, err := tx.Exec(`UPDATE token_lists SET name = ?, logo_uri = ?, version_major = ?, version_minor = ?, version_patch = ?,
+		timestamp = ?, content_hash = ?, updated_at = ? WHERE id = ?`, list.Name, list.LogoURI, list.Version.Major, list.Version.Minor,
+		list.Version.Patch, list.Timestamp, list.ContentHash, list.UpdatedAt, list.ID)
+	if err != nil {
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		_, err = tx.Exec(`INSERT INTO token_lists (id, source, name, logo_uri, version_major, version_minor, version_patch, timestamp,
+			content_hash, imported_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, list.ID, list.Source, list.Name, list.LogoURI,
+			list.Version.Major, list.Version.Minor, list.Version.Patch, list.Timestamp, list.ContentHash, list.ImportedAt, list.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.Exec(`DELETE FROM token_list_tokens WHERE list_id = ?`, list.ID); err != nil {
+		return err
+	}
+	insert, err := tx.Prepare(`INSERT INTO token_list_tokens (list_id, chain_id, address, name, symbol, decimals, logo_uri)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	for _, t := range tokens {
+		if _, err = insert.Exec(list.ID, t.ChainID, t.Address, t.Name, t.Symbol, t.Decimals, t.LogoURI); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT OR IGNORE INTO token_list_chains (list_id, chain_id, enabled)
+		SELECT DISTINCT list_id, chain_id, 1 FROM token_list_tokens WHERE list_id = ?`, list.ID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM token_list_chains WHERE list_id = ? AND chain_id NOT IN
+		(SELECT chain_id FROM token_list_tokens WHERE list_id = ?)`, list.ID, list.ID)
+	return err
+}
+
+// GetTokenLists returns the imported token lists, in the order they were imported
+func (tm *Manager) GetTokenLists() ([]*TokenList, error) {
+	return tm.getTokenLists("")
+}
+
+func (tm *Manager) GetTokenList(id string) (*TokenList, error) {
+	lists, err := tm.getTokenLists(`WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, ErrTokenListNotFound
+	}
+	return lists[0], nil
+}
+
+func (tm *Manager) getTokenLists(where string, args ...interface{}) ([]*TokenList, error) {
+	rows, err := tm.db.Query(`SELECT id, source, name, logo_uri, version_major, version_minor, version_patch, timestamp, content_hash,
+		imported_at, updated_at FROM token_lists `+where+` ORDER BY imported_at, id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := []*TokenList{}
+	for rows.Next() {
+		l := &TokenList{}
+		err := rows.Scan(&l.ID, &l.Source, &l.Name, &l.LogoURI, &l.Version.Major, &l.Version.Minor, &l.Version.Patch, &l.Timestamp,
+			&l.ContentHash, &l.ImportedAt, &l.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, l := range lists {
+		l.Chains, err = tm.getTokenListChains(l.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lists, nil
+}
+
+func (tm *Manager) getTokenListChains(id string) ([]*TokenListChain, error) {
+	rows, err := tm.db.Query(`SELECT c.chain_id, c.enabled, COUNT(t.address) FROM token_list_chains c
+		LEFT JOIN token_list_tokens t ON t.list_id = c.list_id AND t.chain_id = c.chain_id
+		WHERE c.list_id = ? GROUP BY c.chain_id, c.enabled ORDER BY c.chain_id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chains := []*TokenListChain{}
+	for rows.Next() {
+		c := &TokenListChain{}
+		if err := rows.Scan(&c.ChainID, &c.Enabled, &c.TokensCount); err != nil {
+			return nil, err
+		}
+		chains = append(chains, c)
+	}
+	return chains, rows.Err()
+}
+
+// SetTokenListEnabled enables or disables the tokens of a list on a chain
+func (tm *Manager) SetTokenListEnabled(id string, chainID uint64, enabled bool) error {
+	res, err := tm.db.Exec(`UPDATE token_list_chains SET enabled = ? WHERE list_id = ? AND chain_id = ?`, enabled, id, chainID)
+	if err != nil {
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrTokenListNotFound
+	}
+	tm.areTokensFetched = false
+	return nil
+}
+
+// RemoveTokenList removes an imported list with its tokens
+func (tm *Manager) RemoveTokenList(id string) error {
+	res, err := tm.db.Exec(`DELETE FROM token_lists WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return ErrTokenListNotFound
+	}
+	tm.areTokensFetched = false
+	return nil
+}
+
+type tokenKey struct {
+	chainID uint64
+	address common.Address
+}
+
+type sourcedToken struct {
+	source string
+	token  *Token
+}
+
+// GetTokenListCollisions returns the tokens of an imported list whose address is already known from the built-in tokens, the
+// custom tokens or the other imported lists, whether those are enabled or not
+func (tm *Manager) GetTokenListCollisions(id string) ([]*TokenCollision, error) {
+	known := make(map[tokenKey][]sourcedToken)
+	add := func(source string, tokens []*Token) {
+		for _, t := range tokens {
+			key := tokenKey{t.ChainID, t.Address}
+			known[key] = append(known[key], sourcedToken{source, t})
+		}
+	}
+	for _, s := range tm.stores {
+		if _, imported := s.(*tokenListStore); !imported {
+			add(BuiltInTokensSource, s.GetTokens())
+		}
+	}
+	customs, err := tm.GetCustoms()
+	if err != nil {
+		return nil, err
+	}
+	add(CustomTokensSource, customs)
+
+	rows, err := tm.db.Query(`SELECT list_id, chain_id, address, symbol, decimals FROM token_list_tokens ORDER BY list_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tokens []*Token
+	for rows.Next() {
+		var listID string
+		t := &Token{}
+		if err := rows.Scan(&listID, &t.ChainID, &t.Address, &t.Symbol, &t.Decimals); err != nil {
+			return nil, err
+		}
+		if listID == id {
+			tokens = append(tokens, t)
+		} else {
+			add(listID, []*Token{t})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	collisions := []*TokenCollision{}
+	for _, t := range tokens {
+		for _, other := range known[tokenKey{t.ChainID, t.Address}] {
+			collisions = append(collisions, &TokenCollision{
+				ChainID:        t.ChainID,
+				Address:        t.Address,
+				Symbol:         t.Symbol,
+				Decimals:       t.Decimals,
+				Source:         other.source,
+				SourceSymbol:   other.token.Symbol,
+				SourceDecimals: other.token.Decimals,
+			})
+		}
+	}
+	return collisions, nil
+}
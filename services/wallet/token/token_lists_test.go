@@ -0,0 +1,168 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func testTokenList(name string, version string, tokens ...string) string {
+	list := fmt.Sprintf(`{"name": %q, "timestamp": "2023-07-01T00:00:00.000Z", "version": %s, "tokens": [`, name, version)
+	for i, t := range tokens {
+		if i > 0 {
+			list += ","
+		}
+		list += t
+	}
+	return list + "]}"
+}
+
+func testTokenListToken(chainID uint64, address common.Address, symbol string, decimals uint) string {
+	return fmt.Sprintf(`{"chainId": %d, "address": %q, "name": "%s token", "symbol": %q, "decimals": %d}`,
+		chainID, address.Hex(), symbol, symbol, decimals)
+}
+
+func writeTokenList(t *testing.T, dir string, content string) string {
+	path := filepath.Join(dir, "tokens.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestParseTokenList(t *testing.T) {
+	for _, doc := range []string{
+		`{"name": "Test"}`,
+		testTokenList("Test", `{"major": 1, "minor": 0}`, testTokenListToken(1, common.Address{1}, "AAA", 18)),
+		testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`),
+		testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`, `{"chainId": 1, "address": "0x01", "name": "A", "symbol": "A", "decimals": 1}`),
+		testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`, testTokenListToken(1, common.Address{1}, "AAA", 256)),
+		testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`,
+			testTokenListToken(1, common.Address{1}, "AAA", 18), testTokenListToken(1, common.Address{1}, "BBB", 18)),
+	} {
+		_, err := parseTokenList([]byte(doc))
+		require.True(t, errors.Is(err, ErrInvalidTokenList), doc)
+	}
+
+	doc, err := parseTokenList([]byte(testTokenList("Test", `{"major": 1, "minor": 2, "patch": 3}`,
+		testTokenListToken(1, common.Address{1}, "AAA", 18), testTokenListToken(10, common.Address{1}, "AAA", 18))))
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", doc.Version.String())
+	require.Len(t, doc.Tokens, 2)
+}
+
+func TestImportTokenList(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+	dir := t.TempDir()
+
+	_, err := manager.ImportTokenList(context.Background(), filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+
+	path := writeTokenList(t, dir, testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`,
+		testTokenListToken(1, common.Address{1}, "AAA", 18), testTokenListToken(10, common.Address{2}, "BBB", 6)))
+	result, err := manager.ImportTokenList(context.Background(), path)
+	require.NoError(t, err)
+	require.True(t, result.Updated)
+	require.Empty(t, result.Collisions)
+	list := result.List
+	require.Equal(t, "Test", list.Name)
+	require.Equal(t, path, list.Source)
+	require.Equal(t, []*TokenListChain{{ChainID: 1, Enabled: true, TokensCount: 1}, {ChainID: 10, Enabled: true, TokensCount: 1}}, list.Chains)
+
+	tokens := newTokenListStore(manager.db).GetTokens()
+	require.Len(t, tokens, 2)
+
+	// Same content
+	result, err = manager.UpdateTokenList(context.Background(), list.ID)
+	require.NoError(t, err)
+	require.False(t, result.Updated)
+
+	// Changes require a new version
+	writeTokenList(t, dir, testTokenList("Test", `{"major": 1, "minor": 0, "patch": 0}`, testTokenListToken(1, common.Address{1}, "AAA", 18)))
+	_, err = manager.UpdateTokenList(context.Background(), list.ID)
+	require.Equal(t, ErrTokenListVersionUnchanged, err)
+	writeTokenList(t, dir, testTokenList("Test", `{"major": 0, "minor": 9, "patch": 0}`, testTokenListToken(1, common.Address{1}, "AAA", 18)))
+	_, err = manager.UpdateTokenList(context.Background(), list.ID)
+	require.Equal(t, ErrTokenListVersionDowngrade, err)
+
+	require.NoError(t, manager.SetTokenListEnabled(list.ID, 1, false))
+	require.Equal(t, ErrTokenListNotFound, manager.SetTokenListEnabled(list.ID, 5, false))
+	require.Len(t, newTokenListStore(manager.db).GetTokens(), 1)
+
+	writeTokenList(t, dir, testTokenList("Test", `{"major": 1, "minor": 1, "patch": 0}`,
+		testTokenListToken(1, common.Address{1}, "AAA", 18), testTokenListToken(1, common.Address{3}, "CCC", 8)))
+	result, err = manager.UpdateTokenList(context.Background(), list.ID)
+	require.NoError(t, err)
+	require.True(t, result.Updated)
+	require.Equal(t, list.ID, result.List.ID)
+	require.Equal(t, list.ImportedAt, result.List.ImportedAt)
+	require.Equal(t, TokenListVersion{1, 1, 0}, result.List.Version)
+	// The disabled chain stays disabled, the chain no longer in the list is removed
+	require.Equal(t, []*TokenListChain{{ChainID: 1, Enabled: false, TokensCount: 2}}, result.List.Chains)
+	require.Empty(t, newTokenListStore(manager.db).GetTokens())
+
+	require.NoError(t, manager.RemoveTokenList(list.ID))
+	require.Equal(t, ErrTokenListNotFound, manager.RemoveTokenList(list.ID))
+	lists, err := manager.GetTokenLists()
+	require.NoError(t, err)
+	require.Empty(t, lists)
+}
+
+func TestImportTokenListFromURL(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	content := testTokenList("Remote", `{"major": 2, "minor": 0, "patch": 0}`, testTokenListToken(1, common.Address{1}, "AAA", 18))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tokens.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	_, err := manager.ImportTokenList(context.Background(), server.URL+"/missing.json")
+	require.Error(t, err)
+
+	result, err := manager.ImportTokenList(context.Background(), server.URL+"/tokens.json")
+	require.NoError(t, err)
+	require.Equal(t, "Remote", result.List.Name)
+	require.Equal(t, TokenListVersion{2, 0, 0}, result.List.Version)
+}
+
+func TestTokenListCollisions(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+	manager.stores = []store{&DefaultStore{[]*Token{{Address: common.Address{1}, Symbol: "AAA", Decimals: 18, ChainID: 1}}}}
+	require.NoError(t, manager.UpsertCustom(Token{Address: common.Address{2}, Symbol: "CUS", Decimals: 2, ChainID: 1}))
+
+	dir := t.TempDir()
+	first, err := manager.importTokenList(filepath.Join(dir, "first.json"), []byte(testTokenList("First", `{"major": 1, "minor": 0, "patch": 0}`,
+		testTokenListToken(1, common.Address{3}, "THR", 18))))
+	require.NoError(t, err)
+	require.Empty(t, first.Collisions)
+
+	second, err := manager.importTokenList(filepath.Join(dir, "second.json"), []byte(testTokenList("Second", `{"major": 1, "minor": 0, "patch": 0}`,
+		testTokenListToken(1, common.Address{1}, "AAA", 18), testTokenListToken(1, common.Address{2}, "FAKE", 18),
+		testTokenListToken(1, common.Address{3}, "THR", 18), testTokenListToken(10, common.Address{1}, "AAA", 18))))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*TokenCollision{
+		{ChainID: 1, Address: common.Address{1}, Symbol: "AAA", Decimals: 18, Source: BuiltInTokensSource, SourceSymbol: "AAA", SourceDecimals: 18},
+		{ChainID: 1, Address: common.Address{2}, Symbol: "FAKE", Decimals: 18, Source: CustomTokensSource, SourceSymbol: "CUS", SourceDecimals: 2},
+		{ChainID: 1, Address: common.Address{3}, Symbol: "THR", Decimals: 18, Source: first.List.ID, SourceSymbol: "THR", SourceDecimals: 18},
+	}, second.Collisions)
+
+	collisions, err := manager.GetTokenListCollisions(first.List.ID)
+	require.NoError(t, err)
+	require.Len(t, collisions, 1)
+	require.Equal(t, second.List.ID, collisions[0].Source)
+}
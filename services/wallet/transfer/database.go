@@ -465,6 +465,10 @@ func updateOrInsertTransfers(chainID uint64, creator statementCreator, transfers
 		if t.Transaction != nil {
 			if t.Log != nil {
 				_, tokenAddress, tokenID, txValue, txFrom, txTo = w_common.ExtractTokenIdentity(t.Type, t.Log, t.Transaction)
+				if t.TokenID != nil {
+					tokenID = t.TokenID
+					txValue = t.TokenValue
+				}
 			} else {
 				txValue = new(big.Int).Set(t.Transaction.Value())
 				txFrom = &t.From
@@ -116,7 +116,7 @@ func TestDBReorgTransfers(t *testing.T) {
 	}
 	require.NoError(t, db.ProcessBlocks(777, original.Address, original.Number, lastBlock, []*DBHeader{original}))
 	require.NoError(t, db.ProcessTransfers(777, []Transfer{
-		{w_common.EthTransfer, common.Hash{1}, *originalTX.To(), original.Number, original.Hash, 100, originalTX, true, 1777, common.Address{1}, rcpt, nil, "2100", NoMultiTransactionID},
+		{w_common.EthTransfer, common.Hash{1}, *originalTX.To(), original.Number, original.Hash, 100, originalTX, true, 1777, common.Address{1}, rcpt, nil, "2100", NoMultiTransactionID, nil, nil},
 	}, []*DBHeader{}))
 	nonce = int64(0)
 	lastBlock = &Block{
@@ -126,7 +126,7 @@ func TestDBReorgTransfers(t *testing.T) {
 	}
 	require.NoError(t, db.ProcessBlocks(777, replaced.Address, replaced.Number, lastBlock, []*DBHeader{replaced}))
 	require.NoError(t, db.ProcessTransfers(777, []Transfer{
-		{w_common.EthTransfer, common.Hash{2}, *replacedTX.To(), replaced.Number, replaced.Hash, 100, replacedTX, true, 1777, common.Address{1}, rcpt, nil, "2100", NoMultiTransactionID},
+		{w_common.EthTransfer, common.Hash{2}, *replacedTX.To(), replaced.Number, replaced.Hash, 100, replacedTX, true, 1777, common.Address{1}, rcpt, nil, "2100", NoMultiTransactionID, nil, nil},
 	}, []*DBHeader{original}))
 
 	all, err := db.GetTransfers(777, big.NewInt(0), nil)
@@ -207,3 +207,74 @@ func TestGetTransfersForIdentities(t *testing.T) {
 	require.Equal(t, MultiTransactionIDType(0), entries[0].MultiTransactionID)
 	require.Equal(t, MultiTransactionIDType(0), entries[1].MultiTransactionID)
 }
+
+func TestDBERC1155BatchTransfers(t *testing.T) {
+	db, _, stop := setupTestDB(t)
+	defer stop()
+
+	address := common.Address{1}
+	header := &DBHeader{
+		Number:  big.NewInt(1),
+		Hash:    common.Hash{1},
+		Address: address,
+	}
+	nonce := int64(0)
+	lastBlock := &Block{
+		Number:  header.Number,
+		Balance: big.NewInt(0),
+		Nonce:   &nonce,
+	}
+	require.NoError(t, db.ProcessBlocks(777, address, header.Number, lastBlock, []*DBHeader{header}))
+
+	// TransferBatch of ids [1, 2] and values [10, 20] to address
+	data := make([]byte, 0, 8*32)
+	for _, word := range []int64{0x40, 0xa0, 2, 1, 2, 2, 10, 20} {
+		data = append(data, common.BigToHash(big.NewInt(word)).Bytes()...)
+	}
+	tx := types.NewTransaction(1, common.Address{2}, nil, 10, big.NewInt(10), nil)
+	batchLog := &types.Log{
+		Address: common.Address{2},
+		Topics: []common.Hash{
+			w_common.GetEventSignatureHash(w_common.Erc1155TransferBatchEventSignature),
+			common.BytesToHash(common.Address{3}.Bytes()),
+			common.BytesToHash(common.Address{3}.Bytes()),
+			common.BytesToHash(address.Bytes()),
+		},
+		Data:      data,
+		TxHash:    tx.Hash(),
+		BlockHash: header.Hash,
+	}
+	require.Len(t, erc1155ItemTransfers(w_common.Erc1155TransferSingleEventType, batchLog, address), 0)
+	require.Len(t, erc1155ItemTransfers(w_common.Erc1155TransferBatchEventType, batchLog, common.Address{4}), 0)
+
+	transfers := erc1155ItemTransfers(w_common.Erc1155TransferBatchEventType, batchLog, address)
+	require.Len(t, transfers, 2)
+	require.Equal(t, getLogSubTxID(*batchLog), transfers[0].ID)
+	require.NotEqual(t, transfers[0].ID, transfers[1].ID)
+	receipt := types.NewReceipt(nil, false, 100)
+	receipt.Logs = []*types.Log{batchLog}
+	for i := range transfers {
+		transfers[i].BlockNumber = header.Number
+		transfers[i].BlockHash = header.Hash
+		transfers[i].Transaction = tx
+		transfers[i].Receipt = receipt
+	}
+	require.NoError(t, db.ProcessTransfers(777, transfers, []*DBHeader{}))
+
+	rst, err := db.GetTransfersByAddress(777, address, big.NewInt(1), 10)
+	require.NoError(t, err)
+	require.Len(t, rst, 2)
+	values := map[int64]int64{}
+	for _, tr := range rst {
+		require.Equal(t, w_common.Erc1155Transfer, tr.Type)
+		values[tr.TokenID.Int64()] = tr.TokenValue.Int64()
+
+		view := CastToTransferView(tr)
+		require.Equal(t, common.Address{2}, view.Contract)
+		require.Equal(t, common.Address{3}, view.From)
+		require.Equal(t, address, view.To)
+		require.Equal(t, tr.TokenID, view.TokenID.ToInt())
+		require.Equal(t, tr.TokenValue, view.Value.ToInt())
+	}
+	require.Equal(t, map[int64]int64{1: 10, 2: 20}, values)
+}
@@ -25,6 +25,17 @@ func getLogSubTxID(log types.Log) common.Hash {
 	return crypto.Keccak256Hash(log.TxHash.Bytes(), index[:])
 }
 
+func getBatchLogSubTxID(log types.Log, item int) common.Hash {
+	// The first item keeps the log ID, so that it replaces the preloaded transaction
+	if item == 0 {
+		return getLogSubTxID(log)
+	}
+	index := [8]byte{}
+	binary.BigEndian.PutUint32(index[:4], uint32(log.Index))
+	binary.BigEndian.PutUint32(index[4:], uint32(item))
+	return crypto.Keccak256Hash(log.TxHash.Bytes(), index[:])
+}
+
 var (
 	zero = big.NewInt(0)
 	one  = big.NewInt(1)
@@ -70,6 +81,10 @@ type Transfer struct {
 	BaseGasFees string
 	// Internal field that is used to track multi-transaction transfers.
 	MultiTransactionID MultiTransactionIDType `json:"multi_transaction_id"`
+	// Item of an ERC-1155 transfer log. A TransferBatch log is split into one transfer per item.
+	// Nil for other transfers
+	TokenID    *big.Int `json:"tokenId,omitempty"`
+	TokenValue *big.Int `json:"tokenValue,omitempty"`
 }
 
 // ETHDownloader downloads regular eth transfers.
@@ -238,12 +253,15 @@ func (d *ETHDownloader) getTransfersInBlock(ctx context.Context, blk *types.Bloc
 // NewERC20TransfersDownloader returns new instance.
 func NewERC20TransfersDownloader(client *chain.ClientWithFallback, accounts []common.Address, signer types.Signer) *ERC20TransfersDownloader {
 	signature := w_common.GetEventSignatureHash(w_common.Erc20_721TransferEventSignature)
+	erc1155SingleSignature := w_common.GetEventSignatureHash(w_common.Erc1155TransferSingleEventSignature)
+	erc1155BatchSignature := w_common.GetEventSignatureHash(w_common.Erc1155TransferBatchEventSignature)
 
 	return &ERC20TransfersDownloader{
-		client:    client,
-		accounts:  accounts,
-		signature: signature,
-		signer:    signer,
+		client:            client,
+		accounts:          accounts,
+		signature:         signature,
+		erc1155Signatures: []common.Hash{erc1155SingleSignature, erc1155BatchSignature},
+		signer:            signer,
 	}
 }
 
@@ -252,12 +270,16 @@ func NewERC20TransfersDownloader(client *chain.ClientWithFallback, accounts []co
 // type Erc20Transfer. Until the downloader gets refactored and a migration of the
 // database gets implemented, differentiation between erc20 and erc721 will handled
 // in the controller.
+// ERC-1155 TransferSingle and TransferBatch logs are downloaded as well, they are
+// told apart by their signature once the transaction is loaded.
 type ERC20TransfersDownloader struct {
 	client   *chain.ClientWithFallback
 	accounts []common.Address
 
 	// hash of the Transfer event signature
 	signature common.Hash
+	// hashes of the ERC-1155 TransferSingle and TransferBatch event signatures
+	erc1155Signatures []common.Hash
 
 	// signer is used to derive tx sender from tx signature
 	signer types.Signer
@@ -269,14 +291,21 @@ func (d *ERC20TransfersDownloader) paddedAddress(address common.Address) common.
 	return rst
 }
 
+// ERC-1155 logs index the operator first, so their sender is indexed where the recipient of
+// ERC-20 and ERC-721 logs is and outbound ERC-1155 logs are matched along with inbound transfers
 func (d *ERC20TransfersDownloader) inboundTopics(address common.Address) [][]common.Hash {
-	return [][]common.Hash{{d.signature}, {}, {d.paddedAddress(address)}}
+	signatures := append([]common.Hash{d.signature}, d.erc1155Signatures...)
+	return [][]common.Hash{signatures, {}, {d.paddedAddress(address)}}
 }
 
 func (d *ERC20TransfersDownloader) outboundTopics(address common.Address) [][]common.Hash {
 	return [][]common.Hash{{d.signature}, {d.paddedAddress(address)}, {}}
 }
 
+func (d *ERC20TransfersDownloader) inboundERC1155Topics(address common.Address) [][]common.Hash {
+	return [][]common.Hash{d.erc1155Signatures, {}, {}, {d.paddedAddress(address)}}
+}
+
 func (d *ETHDownloader) subTransactionsFromTransactionHash(parent context.Context, txHash common.Hash, address common.Address) ([]Transfer, error) {
 	ctx, cancel := context.WithTimeout(parent, 3*time.Second)
 	tx, _, err := d.chainClient.TransactionByHash(ctx, txHash)
@@ -334,6 +363,20 @@ func (d *ETHDownloader) subTransactionsFromTransactionData(tx *types.Transaction
 			if trFrom == address || trTo == address {
 				mustAppend = true
 			}
+		case w_common.Erc1155TransferSingleEventType, w_common.Erc1155TransferBatchEventType:
+			transfers := erc1155ItemTransfers(eventType, log, address)
+			for i := range transfers {
+				transfers[i].BlockNumber = new(big.Int).SetUint64(log.BlockNumber)
+				transfers[i].BlockHash = log.BlockHash
+				transfers[i].Loaded = true
+				transfers[i].NetworkID = d.signer.ChainID().Uint64()
+				transfers[i].From = from
+				transfers[i].BaseGasFees = baseGasFee
+				transfers[i].Transaction = tx
+				transfers[i].Receipt = receipt
+				transfers[i].Timestamp = blk.Time()
+			}
+			rst = append(rst, transfers...)
 		case w_common.UniswapV2SwapEventType, w_common.UniswapV3SwapEventType:
 			mustAppend = true
 		case w_common.HopBridgeTransferSentToL2EventType, w_common.HopBridgeTransferFromL1CompletedEventType:
@@ -367,6 +410,41 @@ func (d *ETHDownloader) subTransactionsFromTransactionData(tx *types.Transaction
 	return rst, nil
 }
 
+// erc1155ItemTransfers returns one transfer per item of an ERC-1155 log moving tokens from or to address
+func erc1155ItemTransfers(eventType w_common.EventType, ethlog *types.Log, address common.Address) []Transfer {
+	var trFrom, trTo common.Address
+	var tokenIDs, values []*big.Int
+	var err error
+	if eventType == w_common.Erc1155TransferSingleEventType {
+		var tokenID, value *big.Int
+		_, trFrom, trTo, tokenID, value, err = w_common.ParseErc1155TransferSingleLog(ethlog)
+		tokenIDs, values = []*big.Int{tokenID}, []*big.Int{value}
+	} else {
+		_, trFrom, trTo, tokenIDs, values, err = w_common.ParseErc1155TransferBatchLog(ethlog)
+	}
+	if err != nil {
+		log.Warn("can't parse erc1155 transfer", "txHash", ethlog.TxHash, "index", ethlog.Index, "err", err)
+		return nil
+	}
+	if trFrom != address && trTo != address {
+		return nil
+	}
+
+	rst := make([]Transfer, 0, len(tokenIDs))
+	for i := range tokenIDs {
+		rst = append(rst, Transfer{
+			Type:               w_common.Erc1155Transfer,
+			ID:                 getBatchLogSubTxID(*ethlog, i),
+			Address:            address,
+			Log:                ethlog,
+			MultiTransactionID: NoMultiTransactionID,
+			TokenID:            tokenIDs[i],
+			TokenValue:         values[i],
+		})
+	}
+	return rst
+}
+
 func (d *ERC20TransfersDownloader) blocksFromLogs(parent context.Context, logs []types.Log, address common.Address) ([]*DBHeader, error) {
 	concurrent := NewConcurrentDownloader(parent, NoThreadLimit)
 	for i := range logs {
@@ -420,23 +498,18 @@ func (d *ERC20TransfersDownloader) GetHeadersInRange(parent context.Context, fro
 	headers := []*DBHeader{}
 	ctx := context.Background()
 	for _, address := range d.accounts {
-		outbound, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
-			FromBlock: from,
-			ToBlock:   to,
-			Topics:    d.outboundTopics(address),
-		})
-		if err != nil {
-			return nil, err
-		}
-		inbound, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
-			FromBlock: from,
-			ToBlock:   to,
-			Topics:    d.inboundTopics(address),
-		})
-		if err != nil {
-			return nil, err
+		var logs []types.Log
+		for _, topics := range [][][]common.Hash{d.outboundTopics(address), d.inboundTopics(address), d.inboundERC1155Topics(address)} {
+			found, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
+				FromBlock: from,
+				ToBlock:   to,
+				Topics:    topics,
+			})
+			if err != nil {
+				return nil, err
+			}
+			logs = append(logs, found...)
 		}
-		logs := append(outbound, inbound...)
 		if len(logs) == 0 {
 			continue
 		}
@@ -8,9 +8,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
 )
 
-const baseTransfersQuery = "SELECT hash, type, blk_hash, blk_number, timestamp, address, tx, sender, receipt, log, network_id, base_gas_fee, COALESCE(multi_transaction_id, 0) FROM transfers"
+const baseTransfersQuery = "SELECT hash, type, blk_hash, blk_number, timestamp, address, tx, sender, receipt, log, network_id, base_gas_fee, COALESCE(multi_transaction_id, 0), token_id, amount_padded128hex FROM transfers"
 
 type transfersQuery struct {
 	buf        *bytes.Buffer
@@ -154,13 +155,23 @@ func (q *transfersQuery) TransferScan(rows *sql.Rows) (rst []Transfer, err error
 			Receipt:     &types.Receipt{},
 			Log:         &types.Log{},
 		}
+		var tokenIDBytes []byte
+		var amount sql.NullString
 		err = rows.Scan(
 			&transfer.ID, &transfer.Type, &transfer.BlockHash,
 			(*bigint.SQLBigInt)(transfer.BlockNumber), &transfer.Timestamp, &transfer.Address,
-			&JSONBlob{transfer.Transaction}, &transfer.From, &JSONBlob{transfer.Receipt}, &JSONBlob{transfer.Log}, &transfer.NetworkID, &transfer.BaseGasFees, &transfer.MultiTransactionID)
+			&JSONBlob{transfer.Transaction}, &transfer.From, &JSONBlob{transfer.Receipt}, &JSONBlob{transfer.Log}, &transfer.NetworkID, &transfer.BaseGasFees, &transfer.MultiTransactionID,
+			&tokenIDBytes, &amount)
 		if err != nil {
 			return nil, err
 		}
+		// Only ERC-1155 transfers need the stored item, the log describes other transfers
+		if transfer.Type == w_common.Erc1155Transfer && tokenIDBytes != nil {
+			transfer.TokenID = new(big.Int).SetBytes(tokenIDBytes)
+			if amount.Valid {
+				transfer.TokenValue, _ = new(big.Int).SetString(amount.String, 16)
+			}
+		}
 		rst = append(rst, transfer)
 	}
 
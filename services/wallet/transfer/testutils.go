@@ -215,6 +215,8 @@ func InsertTestTransfer(tb testing.TB, db *sql.DB, address eth_common.Address, t
 type TestTransferOptions struct {
 	TokenAddress     eth_common.Address
 	NullifyAddresses []eth_common.Address
+	// TokenID makes it an ERC-1155 transfer of tr.Value items of TokenAddress
+	TokenID *big.Int
 }
 
 func InsertTestTransferWithOptions(tb testing.TB, db *sql.DB, address eth_common.Address, tr *TestTransfer, opt *TestTransferOptions) {
@@ -253,6 +255,9 @@ func InsertTestTransferWithOptions(tb testing.TB, db *sql.DB, address eth_common
 	if (opt.TokenAddress != eth_common.Address{}) {
 		tokenType = "erc20"
 	}
+	if opt.TokenID != nil {
+		tokenType = "erc1155"
+	}
 
 	// Workaround to simulate writing of NULL values for addresses
 	txTo := &tr.To
@@ -282,6 +287,7 @@ func InsertTestTransferWithOptions(tb testing.TB, db *sql.DB, address eth_common
 		txFrom:             txFrom,
 		txTo:               txTo,
 		tokenAddress:       &opt.TokenAddress,
+		tokenID:            opt.TokenID,
 	}
 	err = updateOrInsertTransfersDBFields(tx, []transferDBFields{transfer})
 	require.NoError(tb, err)
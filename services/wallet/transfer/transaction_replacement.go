@@ -0,0 +1,199 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/rpc/chain"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	ErrTransactionNotPending      = errors.New("transaction is not pending")
+	ErrTransactionAlreadyReplaced = errors.New("transaction has already been replaced")
+)
+
+// ReplaceTransactionCommand identifies a pending transaction to speed up or cancel.
+// When fees are not given, the network fees are used, bumped enough for nodes to accept the replacement.
+type ReplaceTransactionCommand struct {
+	ChainID              uint64       `json:"chainId"`
+	Hash                 common.Hash  `json:"hash"`
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
+}
+
+// SpeedUpTransaction sends the same transaction as a pending one with higher fees
+func (tm *TransactionManager) SpeedUpTransaction(ctx context.Context, client *chain.ClientWithFallback,
+	command *ReplaceTransactionCommand, password string) (types.Hash, error) {
+	return tm.replaceTransaction(ctx, client, command, password, false)
+}
+
+// CancelTransaction replaces a pending transaction with a zero-value transaction to self, with higher fees
+func (tm *TransactionManager) CancelTransaction(ctx context.Context, client *chain.ClientWithFallback,
+	command *ReplaceTransactionCommand, password string) (types.Hash, error) {
+	return tm.replaceTransaction(ctx, client, command, password, true)
+}
+
+func (tm *TransactionManager) replaceTransaction(ctx context.Context, client *chain.ClientWithFallback,
+	command *ReplaceTransactionCommand, password string, cancel bool) (types.Hash, error) {
+
+	log.Info("Replacing transaction", "chainID", command.ChainID, "hash", command.Hash, "cancel", cancel)
+
+	var hash types.Hash
+	entry, err := tm.pendingManager.GetPendingEntry(command.ChainID, command.Hash)
+	if err == sql.ErrNoRows {
+		return hash, ErrTransactionNotPending
+	} else if err != nil {
+		return hash, err
+	}
+	if entry.ReplacedBy != nil {
+		return hash, ErrTransactionAlreadyReplaced
+	}
+
+	tx, isPending, err := client.TransactionByHash(ctx, command.Hash)
+	if err != nil {
+		return hash, err
+	}
+	if !isPending {
+		return hash, ErrTransactionNotPending
+	}
+
+	tip, feeCap, err := replacementFees(ctx, client, tx, command)
+	if err != nil {
+		return hash, err
+	}
+
+	selectedAccount, err := tm.getVerifiedWalletAccount(entry.From.Hex(), password)
+	if err != nil {
+		return hash, err
+	}
+
+	from := types.Address(entry.From)
+	nonce := hexutil.Uint64(tx.Nonce())
+	gas := hexutil.Uint64(tx.Gas())
+	args := transactions.SendTxArgs{
+		From:                 from,
+		Nonce:                &nonce,
+		Gas:                  &gas,
+		MaxFeePerGas:         (*hexutil.Big)(feeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tip),
+	}
+
+	replacement := *entry
+	replacement.Timestamp = uint64(time.Now().Unix())
+	replacement.ReplacedBy = nil
+
+	if cancel {
+		gas = hexutil.Uint64(cancelGas(ctx, client, entry.From))
+		args.To = &from
+		args.Value = (*hexutil.Big)(big.NewInt(0))
+
+		replacement.To = entry.From
+		replacement.Value = bigint.BigInt{Int: big.NewInt(0)}
+		replacement.Data = ""
+		replacement.Type = transactions.CancelTransaction
+	} else {
+		if tx.To() != nil {
+			to := types.Address(*tx.To())
+			args.To = &to
+		}
+		args.Value = (*hexutil.Big)(tx.Value())
+		args.Data = tx.Data()
+	}
+	replacement.GasLimit = bigint.BigInt{Int: new(big.Int).SetUint64(uint64(gas))}
+	replacement.GasPrice = bigint.BigInt{Int: feeCap}
+
+	hash, err = tm.transactor.ReplaceTransaction(command.ChainID, args, selectedAccount)
+	if err != nil {
+		return hash, err
+	}
+
+	replacement.Hash = common.Hash(hash)
+	err = tm.pendingManager.ReplacePending(command.Hash, tx.Nonce(), &replacement)
+	return hash, err
+}
+
+func replacementFees(ctx context.Context, client *chain.ClientWithFallback, tx *gethtypes.Transaction,
+	command *ReplaceTransactionCommand) (tip *big.Int, feeCap *big.Int, err error) {
+
+	if command.MaxFeePerGas != nil && command.MaxPriorityFeePerGas != nil {
+		tip, feeCap = command.MaxPriorityFeePerGas.ToInt(), command.MaxFeePerGas.ToInt()
+		return tip, feeCap, transactions.ValidateReplacementFees(tx, tip, feeCap)
+	}
+
+	suggestedTip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tip, feeCap = transactions.ReplacementFees(tx, suggestedTip, header.BaseFee)
+	return tip, feeCap, nil
+}
+
+// cancelGas returns the gas needed to send nothing to self, which is more than the
+// intrinsic gas on some layer 2 networks
+func cancelGas(ctx context.Context, client *chain.ClientWithFallback, from common.Address) uint64 {
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    &from,
+		Value: big.NewInt(0),
+	})
+	if err != nil || gas < params.TxGas {
+		return params.TxGas
+	}
+	return gas
+}
+
+// WatchTransaction waits for a pending transaction, or a transaction replacing it, to land.
+// The multi-transaction it belongs to is updated to point to the replacement which landed,
+// and no longer moves any funds if the transaction was cancelled.
+func (tm *TransactionManager) WatchTransaction(ctx context.Context, client *chain.ClientWithFallback, hash common.Hash) error {
+	landed, err := tm.pendingManager.WatchLanded(ctx, hash, client)
+	if err != nil {
+		return err
+	}
+
+	if landed.MultiTransactionID == int64(NoMultiTransactionID) || landed.Nonce == nil {
+		return nil
+	}
+
+	multiTransactions, err := tm.GetMultiTransactions(ctx, []MultiTransactionIDType{MultiTransactionIDType(landed.MultiTransactionID)})
+	if err != nil {
+		return err
+	}
+	if len(multiTransactions) == 0 {
+		return nil
+	}
+
+	multiTransaction := multiTransactions[0]
+	multiTransaction.FromTxHash = landed.Hash
+	if landed.Type == transactions.CancelTransaction {
+		multiTransaction.ToAddress = multiTransaction.FromAddress
+		multiTransaction.FromAmount = (*hexutil.Big)(big.NewInt(0))
+		multiTransaction.ToAmount = (*hexutil.Big)(big.NewInt(0))
+	}
+
+	err = updateMultiTransaction(tm.db, multiTransaction)
+	if err != nil {
+		return err
+	}
+
+	publishMultiTransactionUpdatedEvent(tm.db, multiTransaction, tm.eventFeed, []uint64{landed.ChainID})
+	return nil
+}
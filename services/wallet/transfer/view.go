@@ -28,10 +28,12 @@ type View struct {
 	TxStatus             hexutil.Uint64 `json:"txStatus"`
 	Input                hexutil.Bytes  `json:"input"`
 	TxHash               common.Hash    `json:"txHash"`
-	Value                *hexutil.Big   `json:"value"`   // Only used for Type EthTransfer and Erc20Transfer
-	TokenID              *hexutil.Big   `json:"tokenId"` // Only used for Type Erc721Transfer
+	Value                *hexutil.Big   `json:"value"`   // Only used for Type EthTransfer, Erc20Transfer and Erc1155Transfer
+	TokenID              *hexutil.Big   `json:"tokenId"` // Only used for Type Erc721Transfer and Erc1155Transfer
 	From                 common.Address `json:"from"`
 	To                   common.Address `json:"to"`
+	FromName             string         `json:"fromName,omitempty"` // Display names, filled by the wallet API
+	ToName               string         `json:"toName,omitempty"`
 	Contract             common.Address `json:"contract"`
 	NetworkID            uint64         `json:"networkId"`
 	MultiTransactionID   int64          `json:"multiTransactionID"`
@@ -42,7 +44,7 @@ func castToTransferViews(transfers []Transfer) []View {
 	views := make([]View, 0, len(transfers))
 	for _, tx := range transfers {
 		switch tx.Type {
-		case w_common.EthTransfer, w_common.Erc20Transfer, w_common.Erc721Transfer:
+		case w_common.EthTransfer, w_common.Erc20Transfer, w_common.Erc721Transfer, w_common.Erc1155Transfer:
 			view := CastToTransferView(tx)
 			views = append(views, view)
 		}
@@ -98,6 +100,13 @@ func CastToTransferView(t Transfer) View {
 		view.Contract = t.Log.Address
 		from, to, tokenIDInt := w_common.ParseErc721TransferLog(t.Log)
 		view.From, view.To, tokenID = from, to, (*hexutil.Big)(tokenIDInt)
+	case w_common.Erc1155Transfer:
+		view.Contract = t.Log.Address
+		_, _, tokenIDInt, valueInt, from, to := w_common.ExtractTokenIdentity(w_common.Erc1155Transfer, t.Log, nil)
+		if t.TokenID != nil {
+			tokenIDInt, valueInt = t.TokenID, t.TokenValue
+		}
+		view.From, view.To, tokenID, value = *from, *to, (*hexutil.Big)(tokenIDInt), (*hexutil.Big)(valueInt)
 	}
 
 	view.MultiTransactionID = int64(t.MultiTransactionID)
@@ -109,6 +118,7 @@ func CastToTransferView(t Transfer) View {
 
 func getFixedTransferType(tx Transfer) w_common.Type {
 	// erc721 transfers share signature with erc20 ones, so they both used to be categorized as erc20
+	// erc1155 logs are also stored as erc20 until their transaction is loaded
 	// by the Downloader. We fix this here since they might be mis-categorized in the db.
 	if tx.Type == w_common.Erc20Transfer {
 		eventType := w_common.GetEventType(tx.Log)
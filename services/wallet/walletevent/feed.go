@@ -0,0 +1,19 @@
+package walletevent
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// SendAsync sends events to feed, in order, from a goroutine tracked by group. Feed.Send blocks until every
+// subscriber received the event, a subscriber sending events while handling one would wait on itself
+func SendAsync(feed *event.Feed, group *sync.WaitGroup, events ...Event) {
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for _, ev := range events {
+			feed.Send(ev)
+		}
+	}()
+}
@@ -0,0 +1,52 @@
+package walletevent
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/event"
+)
+
+func TestSendAsyncFromSubscriber(t *testing.T) {
+	feed := new(event.Feed)
+	var group sync.WaitGroup
+
+	// An unbuffered subscriber answering each event with two more, as the wallet services do
+	ch := make(chan Event)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	received := make(chan EventType, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ev := range ch {
+			received <- ev.Type
+			if ev.Type == "request" {
+				SendAsync(feed, &group, Event{Type: "first"}, Event{Type: "second"})
+			}
+			if ev.Type == "second" {
+				return
+			}
+		}
+	}()
+
+	feed.Send(Event{Type: "request"})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timeout handling the events")
+	}
+	group.Wait()
+
+	close(received)
+	var types []EventType
+	for eventType := range received {
+		types = append(types, eventType)
+	}
+	require.Equal(t, []EventType{"request", "first", "second"}, types)
+}
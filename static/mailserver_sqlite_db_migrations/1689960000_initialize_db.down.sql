@@ -0,0 +1,3 @@
+DROP INDEX topic_idx;
+DROP INDEX id_topic_idx;
+DROP TABLE envelopes;
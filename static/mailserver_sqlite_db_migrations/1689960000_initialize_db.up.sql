@@ -0,0 +1,16 @@
+CREATE TABLE envelopes (
+  id BLOB NOT NULL PRIMARY KEY,
+  data BLOB NOT NULL,
+  topic BLOB NOT NULL,
+  bloom_0 INT NOT NULL,
+  bloom_1 INT NOT NULL,
+  bloom_2 INT NOT NULL,
+  bloom_3 INT NOT NULL,
+  bloom_4 INT NOT NULL,
+  bloom_5 INT NOT NULL,
+  bloom_6 INT NOT NULL,
+  bloom_7 INT NOT NULL
+) WITHOUT ROWID;
+
+CREATE INDEX id_topic_idx ON envelopes (id DESC, topic);
+CREATE INDEX topic_idx ON envelopes (topic);
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -103,6 +104,7 @@ const (
 	AirdropCommunityToken     PendingTrxType = "AirdropCommunityToken"
 	RemoteDestructCollectible PendingTrxType = "RemoteDestructCollectible"
 	BurnCommunityToken        PendingTrxType = "BurnCommunityToken"
+	CancelTransaction         PendingTrxType = "CancelTransaction"
 )
 
 type PendingTransaction struct {
@@ -119,11 +121,14 @@ type PendingTransaction struct {
 	AdditionalData     string         `json:"additionalData"`
 	ChainID            uint64         `json:"network_id"`
 	MultiTransactionID int64          `json:"multi_transaction_id"`
+	// Nonce is only known for transactions which have been replaced or are replacements
+	Nonce      *uint64      `json:"nonce,omitempty"`
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"`
 }
 
 const selectFromPending = `SELECT hash, timestamp, value, from_address, to_address, data,
 								symbol, gas_price, gas_limit, type, additional_data,
-								network_id, COALESCE(multi_transaction_id, 0)
+								network_id, COALESCE(multi_transaction_id, 0), nonce, replaced_by
 							FROM pending_transactions
 							`
 
@@ -134,6 +139,8 @@ func rowsToTransactions(rows *sql.Rows) (transactions []*PendingTransaction, err
 			GasPrice: bigint.BigInt{Int: new(big.Int)},
 			GasLimit: bigint.BigInt{Int: new(big.Int)},
 		}
+		var nonce sql.NullInt64
+		var replacedBy []byte
 		err := rows.Scan(&transaction.Hash,
 			&transaction.Timestamp,
 			(*bigint.SQLBigIntBytes)(transaction.Value.Int),
@@ -147,16 +154,30 @@ func rowsToTransactions(rows *sql.Rows) (transactions []*PendingTransaction, err
 			&transaction.AdditionalData,
 			&transaction.ChainID,
 			&transaction.MultiTransactionID,
+			&nonce,
+			&replacedBy,
 		)
 		if err != nil {
 			return nil, err
 		}
+		setReplacement(transaction, nonce, replacedBy)
 
 		transactions = append(transactions, transaction)
 	}
 	return transactions, nil
 }
 
+func setReplacement(transaction *PendingTransaction, nonce sql.NullInt64, replacedBy []byte) {
+	if nonce.Valid {
+		n := uint64(nonce.Int64)
+		transaction.Nonce = &n
+	}
+	if len(replacedBy) > 0 {
+		hash := common.BytesToHash(replacedBy)
+		transaction.ReplacedBy = &hash
+	}
+}
+
 func (tm *TransactionManager) GetAllPending(chainIDs []uint64) ([]*PendingTransaction, error) {
 	log.Info("Getting all pending transactions", "chainIDs", chainIDs)
 
@@ -170,7 +191,7 @@ func (tm *TransactionManager) GetAllPending(chainIDs []uint64) ([]*PendingTransa
 		parameters = append(parameters, c)
 	}
 
-	rows, err := tm.db.Query(fmt.Sprintf(selectFromPending+"WHERE network_id in (%s)", inVector), parameters...)
+	rows, err := tm.db.Query(fmt.Sprintf(selectFromPending+"WHERE network_id in (%s) AND replaced_by IS NULL", inVector), parameters...)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +215,7 @@ func (tm *TransactionManager) GetPendingByAddress(chainIDs []uint64, address com
 
 	parameters = append(parameters, address)
 
-	rows, err := tm.db.Query(fmt.Sprintf(selectFromPending+"WHERE network_id in (%s) AND from_address = ?", inVector), parameters...)
+	rows, err := tm.db.Query(fmt.Sprintf(selectFromPending+"WHERE network_id in (%s) AND from_address = ? AND replaced_by IS NULL", inVector), parameters...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +231,7 @@ func (tm *TransactionManager) GetPendingEntry(chainID uint64, hash common.Hash)
 
 	row := tm.db.QueryRow(`SELECT timestamp, value, from_address, to_address, data,
 								symbol, gas_price, gas_limit, type, additional_data,
-								network_id, COALESCE(multi_transaction_id, 0)
+								network_id, COALESCE(multi_transaction_id, 0), nonce, replaced_by
 							FROM pending_transactions
 							WHERE network_id = ? AND hash = ?`, chainID, hash)
 	transaction := &PendingTransaction{
@@ -220,6 +241,8 @@ func (tm *TransactionManager) GetPendingEntry(chainID uint64, hash common.Hash)
 		GasLimit: bigint.BigInt{Int: new(big.Int)},
 		ChainID:  chainID,
 	}
+	var nonce sql.NullInt64
+	var replacedBy []byte
 	err := row.Scan(
 		&transaction.Timestamp,
 		(*bigint.SQLBigIntBytes)(transaction.Value.Int),
@@ -233,24 +256,36 @@ func (tm *TransactionManager) GetPendingEntry(chainID uint64, hash common.Hash)
 		&transaction.AdditionalData,
 		&transaction.ChainID,
 		&transaction.MultiTransactionID,
+		&nonce,
+		&replacedBy,
 	)
 	if err != nil {
 		return nil, err
 	}
+	setReplacement(transaction, nonce, replacedBy)
 
 	return transaction, nil
 }
 
 func (tm *TransactionManager) AddPending(transaction *PendingTransaction) error {
-	insert, err := tm.db.Prepare(`INSERT OR REPLACE INTO pending_transactions
+	err := addPending(tm.db, transaction)
+	// Notify listeners of new pending transaction (used in activity history)
+	if err == nil {
+		tm.notifyPendingTransactionListeners(transaction.ChainID, []common.Address{transaction.From, transaction.To}, transaction.Timestamp)
+	}
+	return err
+}
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func addPending(db execer, transaction *PendingTransaction) error {
+	_, err := db.Exec(`INSERT OR REPLACE INTO pending_transactions
                                       (network_id, hash, timestamp, value, from_address, to_address,
-                                       data, symbol, gas_price, gas_limit, type, additional_data, multi_transaction_id)
+                                       data, symbol, gas_price, gas_limit, type, additional_data, multi_transaction_id, nonce)
                                       VALUES
-                                      (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
-	if err != nil {
-		return err
-	}
-	_, err = insert.Exec(
+                                      (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		transaction.ChainID,
 		transaction.Hash,
 		transaction.Timestamp,
@@ -264,14 +299,84 @@ func (tm *TransactionManager) AddPending(transaction *PendingTransaction) error
 		transaction.Type,
 		transaction.AdditionalData,
 		transaction.MultiTransactionID,
+		transaction.Nonce,
 	)
-	// Notify listeners of new pending transaction (used in activity history)
+	return err
+}
+
+// ReplacePending stores a transaction sent with the same nonce as a pending one, to speed it up or cancel it.
+// The replaced transaction is kept until one of them lands, as it may still be mined first.
+func (tm *TransactionManager) ReplacePending(replacedHash common.Hash, nonce uint64, replacement *PendingTransaction) (err error) {
+	tx, err := tm.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.Exec(`UPDATE pending_transactions SET nonce = ?, replaced_by = ? WHERE network_id = ? AND hash = ? AND replaced_by IS NULL`,
+		nonce, replacement.Hash, replacement.ChainID, replacedHash)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		err = sql.ErrNoRows
+		return err
+	}
+
+	replacement.Nonce = &nonce
+	err = addPending(tx, replacement)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
 	if err == nil {
-		tm.notifyPendingTransactionListeners(transaction.ChainID, []common.Address{transaction.From, transaction.To}, transaction.Timestamp)
+		tm.notifyPendingTransactionListeners(replacement.ChainID, []common.Address{replacement.From, replacement.To}, replacement.Timestamp)
 	}
 	return err
 }
 
+// replacementHashes returns the hashes of the transactions sharing the nonce of the given one,
+// that is the transaction itself along with the transactions it replaced or was replaced by
+func (tm *TransactionManager) replacementHashes(chainID uint64, hash common.Hash) ([]common.Hash, error) {
+	entry, err := tm.GetPendingEntry(chainID, hash)
+	if err == sql.ErrNoRows {
+		return []common.Hash{hash}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if entry.Nonce == nil {
+		return []common.Hash{hash}, nil
+	}
+
+	rows, err := tm.db.Query(`SELECT hash FROM pending_transactions WHERE network_id = ? AND from_address = ? AND nonce = ?`,
+		chainID, entry.From, *entry.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []common.Hash
+	for rows.Next() {
+		var h common.Hash
+		err = rows.Scan(&h)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h)
+	}
+	return hashes, rows.Err()
+}
+
 func (tm *TransactionManager) notifyPendingTransactionListeners(chainID uint64, addresses []common.Address, timestamp uint64) {
 	if tm.eventFeed != nil {
 		tm.eventFeed.Send(walletevent.Event{
@@ -283,7 +388,7 @@ func (tm *TransactionManager) notifyPendingTransactionListeners(chainID uint64,
 	}
 }
 
-func (tm *TransactionManager) deletePending(chainID uint64, hash common.Hash) error {
+func (tm *TransactionManager) deletePending(chainID uint64, hashes ...common.Hash) error {
 	tx, err := tm.db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return err
@@ -294,48 +399,70 @@ func (tm *TransactionManager) deletePending(chainID uint64, hash common.Hash) er
 		}
 	}()
 
-	row := tx.QueryRow(`SELECT from_address, to_address, timestamp FROM pending_transactions WHERE network_id = ? AND hash = ?`, chainID, hash)
-	var from, to common.Address
+	var addresses []common.Address
 	var timestamp uint64
-	err = row.Scan(&from, &to, &timestamp)
-	if err != nil {
-		return err
-	}
+	for _, hash := range hashes {
+		row := tx.QueryRow(`SELECT from_address, to_address, timestamp FROM pending_transactions WHERE network_id = ? AND hash = ?`, chainID, hash)
+		var from, to common.Address
+		err = row.Scan(&from, &to, &timestamp)
+		if err != nil {
+			return err
+		}
+		addresses = append(addresses, from, to)
 
-	_, err = tx.Exec(`DELETE FROM pending_transactions WHERE network_id = ? AND hash = ?`, chainID, hash)
-	if err != nil {
-		return err
+		_, err = tx.Exec(`DELETE FROM pending_transactions WHERE network_id = ? AND hash = ?`, chainID, hash)
+		if err != nil {
+			return err
+		}
 	}
 	err = tx.Commit()
 	if err == nil {
-		tm.notifyPendingTransactionListeners(chainID, []common.Address{from, to}, timestamp)
+		tm.notifyPendingTransactionListeners(chainID, addresses, timestamp)
 	}
 	return err
 }
 
 func (tm *TransactionManager) Watch(ctx context.Context, transactionHash common.Hash, client *chain.ClientWithFallback) error {
+	_, err := tm.WatchLanded(ctx, transactionHash, client)
+	return err
+}
+
+// WatchLanded waits for a pending transaction, or any transaction replacing it, to land
+// and returns the pending entry of the one which did
+func (tm *TransactionManager) WatchLanded(ctx context.Context, transactionHash common.Hash, client *chain.ClientWithFallback) (*PendingTransaction, error) {
 	log.Info("Watching transaction", "chainID", client.ChainID, "hash", transactionHash)
 
+	hashes, err := tm.replacementHashes(client.ChainID, transactionHash)
+	if err != nil {
+		return nil, err
+	}
+
 	watchTxCommand := &watchTransactionCommand{
-		hash:   transactionHash,
+		hashes: hashes,
 		client: client,
 	}
 
 	commandContext, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
-	err := watchTxCommand.Command()(commandContext)
+	err = watchTxCommand.Command()(commandContext)
 	if err != nil {
 		log.Error("watchTxCommand error", "error", err, "chainID", client.ChainID, "hash", transactionHash)
-		return err
+		return nil, err
 	}
 
-	return tm.deletePending(client.ChainID, transactionHash)
+	landed, err := tm.GetPendingEntry(client.ChainID, watchTxCommand.landed)
+	if err != nil {
+		return nil, err
+	}
+
+	return landed, tm.deletePending(client.ChainID, hashes...)
 }
 
 type watchTransactionCommand struct {
 	client *chain.ClientWithFallback
-	hash   common.Hash
+	hashes []common.Hash
+	landed common.Hash
 }
 
 func (c *watchTransactionCommand) Command() async.Command {
@@ -348,16 +475,23 @@ func (c *watchTransactionCommand) Command() async.Command {
 func (c *watchTransactionCommand) Run(ctx context.Context) error {
 	requestContext, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	_, isPending, err := c.client.TransactionByHash(requestContext, c.hash)
 
-	if err != nil {
-		log.Error("Watching transaction error", "error", err)
-		return err
-	}
+	for _, hash := range c.hashes {
+		_, isPending, err := c.client.TransactionByHash(requestContext, hash)
+		if err != nil {
+			// Transactions replaced by another one are dropped
+			if err == ethereum.NotFound && len(c.hashes) > 1 {
+				continue
+			}
+			log.Error("Watching transaction error", "error", err)
+			return err
+		}
 
-	if isPending {
-		return errors.New("transaction is pending")
+		if !isPending {
+			c.landed = hash
+			return nil
+		}
 	}
 
-	return nil
+	return errors.New("transaction is pending")
 }
@@ -0,0 +1,60 @@
+package transactions
+
+import (
+	"errors"
+	"math/big"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Nodes only accept a transaction replacing a pending one if both its fee cap and tip
+// are at least this percentage higher
+const replacementPriceBump = 10
+
+// ErrReplacementUnderpriced is returned when the fees of a replacement transaction are too low
+// for nodes to accept it in place of the pending one
+var ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
+func bumpPrice(price *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(price, big.NewInt(100+replacementPriceBump))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// MinReplacementFees returns the lowest EIP-1559 fees a transaction replacing the given one can have.
+// Legacy transactions pay their gas price as both tip and fee cap.
+func MinReplacementFees(tx *gethtypes.Transaction) (tip *big.Int, feeCap *big.Int) {
+	return bumpPrice(tx.GasTipCap()), bumpPrice(tx.GasFeeCap())
+}
+
+// ReplacementFees returns the fees to replace a pending transaction with, that is the current
+// network fees if the pending transaction has become underpriced, or its fees bumped otherwise.
+// The fee cap always leaves room for the base fee to double.
+func ReplacementFees(tx *gethtypes.Transaction, suggestedTip *big.Int, baseFee *big.Int) (tip *big.Int, feeCap *big.Int) {
+	tip, feeCap = MinReplacementFees(tx)
+	if suggestedTip != nil && suggestedTip.Cmp(tip) > 0 {
+		tip = new(big.Int).Set(suggestedTip)
+	}
+
+	if baseFee != nil {
+		suggestedFeeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+		suggestedFeeCap.Add(suggestedFeeCap, tip)
+		if suggestedFeeCap.Cmp(feeCap) > 0 {
+			feeCap = suggestedFeeCap
+		}
+	}
+	if feeCap.Cmp(tip) < 0 {
+		feeCap = new(big.Int).Set(tip)
+	}
+
+	return tip, feeCap
+}
+
+// ValidateReplacementFees checks that the given fees are high enough to replace a pending transaction
+func ValidateReplacementFees(tx *gethtypes.Transaction, tip *big.Int, feeCap *big.Int) error {
+	minTip, minFeeCap := MinReplacementFees(tx)
+	if tip.Cmp(minTip) < 0 || feeCap.Cmp(minFeeCap) < 0 || feeCap.Cmp(tip) < 0 {
+		return ErrReplacementUnderpriced
+	}
+	return nil
+}
@@ -1,12 +1,14 @@
 package transactions
 
 import (
+	"database/sql"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/status-im/status-go/appdatabase"
 	"github.com/status-im/status-go/services/wallet/bigint"
@@ -72,3 +74,87 @@ func TestPendingTransactions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(rst))
 }
+
+func TestReplacePendingTransactions(t *testing.T) {
+	manager, stop := setupTestTransactionDB(t)
+	defer stop()
+
+	trx := PendingTransaction{
+		Hash:               common.Hash{1},
+		From:               common.Address{1},
+		To:                 common.Address{2},
+		Type:               WalletTransfer,
+		Value:              bigint.BigInt{Int: big.NewInt(123)},
+		GasLimit:           bigint.BigInt{Int: big.NewInt(21000)},
+		GasPrice:           bigint.BigInt{Int: big.NewInt(1)},
+		ChainID:            777,
+		MultiTransactionID: 1,
+	}
+	err := manager.AddPending(&trx)
+	require.NoError(t, err)
+
+	cancel := trx
+	cancel.Hash = common.Hash{2}
+	cancel.To = trx.From
+	cancel.Value = bigint.BigInt{Int: big.NewInt(0)}
+	cancel.Type = CancelTransaction
+	err = manager.ReplacePending(trx.Hash, 5, &cancel)
+	require.NoError(t, err)
+
+	// Replaced transactions are not listed anymore
+	rst, err := manager.GetAllPending([]uint64{777})
+	require.NoError(t, err)
+	require.Len(t, rst, 1)
+	require.Equal(t, cancel, *rst[0])
+
+	replaced, err := manager.GetPendingEntry(777, trx.Hash)
+	require.NoError(t, err)
+	require.Equal(t, cancel.Hash, *replaced.ReplacedBy)
+	require.Equal(t, uint64(5), *replaced.Nonce)
+
+	// A transaction can only be replaced once
+	err = manager.ReplacePending(trx.Hash, 5, &cancel)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	hashes, err := manager.replacementHashes(777, cancel.Hash)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []common.Hash{trx.Hash, cancel.Hash}, hashes)
+
+	err = manager.deletePending(777, hashes...)
+	require.NoError(t, err)
+
+	rst, err = manager.GetAllPending([]uint64{777})
+	require.NoError(t, err)
+	require.Len(t, rst, 0)
+}
+
+func TestReplacementFees(t *testing.T) {
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(1000),
+	})
+
+	tip, feeCap := MinReplacementFees(tx)
+	require.Equal(t, big.NewInt(110), tip)
+	require.Equal(t, big.NewInt(1100), feeCap)
+
+	// Fees are bumped when the network did not get more expensive
+	tip, feeCap = ReplacementFees(tx, big.NewInt(50), big.NewInt(200))
+	require.Equal(t, big.NewInt(110), tip)
+	require.Equal(t, big.NewInt(1100), feeCap)
+
+	// Network fees are used otherwise
+	tip, feeCap = ReplacementFees(tx, big.NewInt(200), big.NewInt(1000))
+	require.Equal(t, big.NewInt(200), tip)
+	require.Equal(t, big.NewInt(2200), feeCap)
+
+	// Legacy transactions pay their gas price as tip
+	legacy := gethtypes.NewTx(&gethtypes.LegacyTx{GasPrice: big.NewInt(1000)})
+	tip, feeCap = ReplacementFees(legacy, big.NewInt(10), big.NewInt(100))
+	require.Equal(t, big.NewInt(1100), tip)
+	require.Equal(t, big.NewInt(1300), feeCap)
+
+	require.NoError(t, ValidateReplacementFees(tx, big.NewInt(110), big.NewInt(1100)))
+	require.Equal(t, ErrReplacementUnderpriced, ValidateReplacementFees(tx, big.NewInt(109), big.NewInt(1100)))
+	require.Equal(t, ErrReplacementUnderpriced, ValidateReplacementFees(tx, big.NewInt(2000), big.NewInt(1500)))
+}
@@ -93,6 +93,33 @@ func (t *Transactor) SendTransactionWithChainID(chainID uint64, sendArgs SendTxA
 	return
 }
 
+// ReplaceTransaction signs and sends a transaction with the nonce of a pending one, to speed it up or cancel it.
+// The nonce, gas and EIP-1559 fees must be set, and the local nonce is left untouched.
+func (t *Transactor) ReplaceTransaction(chainID uint64, args SendTxArgs, verifiedAccount *account.SelectedExtKey) (hash types.Hash, err error) {
+	if err = t.validateAccount(args, verifiedAccount); err != nil {
+		return hash, err
+	}
+
+	if !args.Valid() || args.Nonce == nil || args.Gas == nil || !args.IsDynamicFeeTx() {
+		return hash, ErrInvalidSendTxArgs
+	}
+
+	wrapper := newRPCWrapper(t.rpcWrapper.RPCClient, chainID)
+	tx := t.buildTransaction(args)
+	signedTx, err := gethtypes.SignTx(tx, gethtypes.NewLondonSigner(new(big.Int).SetUint64(chainID)), verifiedAccount.AccountKey.PrivateKey)
+	if err != nil {
+		return hash, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.rpcCallTimeout)
+	defer cancel()
+
+	if err := wrapper.SendTransaction(ctx, signedTx); err != nil {
+		return hash, err
+	}
+	return types.Hash(signedTx.Hash()), nil
+}
+
 // SendTransactionWithSignature receive a transaction and a signature, serialize them together and propage it to the network.
 // It's different from eth_sendRawTransaction because it receives a signature and not a serialized transaction with signature.
 // Since the transactions is already signed, we assume it was validated and used the right nonce.
@@ -303,6 +303,42 @@ func (s *TransactorSuite) TestLocalNonce() {
 	s.Equal(uint64(nonce)+1, resultNonce.(uint64))
 }
 
+func (s *TransactorSuite) TestReplaceTransaction() {
+	chainID := s.nodeConfig.NetworkID
+	key, _ := gethcrypto.GenerateKey()
+	selectedAccount := &account.SelectedExtKey{
+		Address:    account.FromAddress(utils.TestConfig.Account1.WalletAddress),
+		AccountKey: &types.Key{PrivateKey: key},
+	}
+
+	nonce := hexutil.Uint64(3)
+	args := SendTxArgs{
+		From:                 account.FromAddress(utils.TestConfig.Account1.WalletAddress),
+		To:                   account.ToAddress(utils.TestConfig.Account2.WalletAddress),
+		Value:                (*hexutil.Big)(big.NewInt(0)),
+		Nonce:                &nonce,
+		Gas:                  &testGas,
+		MaxFeePerGas:         testGasPrice,
+		MaxPriorityFeePerGas: testGasPrice,
+	}
+
+	// The replacement is sent right away with the given nonce
+	data := s.rlpEncodeTx(args, s.nodeConfig, selectedAccount, &nonce, testGas, nil)
+	s.txServiceMock.EXPECT().SendRawTransaction(gomock.Any(), data).Return(common.Hash{}, nil)
+
+	hash, err := s.manager.ReplaceTransaction(chainID, args, selectedAccount)
+	s.NoError(err)
+	s.False(reflect.DeepEqual(hash, common.Hash{}))
+
+	// The local nonce is left untouched
+	_, ok := s.manager.nonce.localNonce[chainID]
+	s.False(ok)
+
+	args.Nonce = nil
+	_, err = s.manager.ReplaceTransaction(chainID, args, selectedAccount)
+	s.Equal(ErrInvalidSendTxArgs, err)
+}
+
 func (s *TransactorSuite) TestSendTransactionWithSignature() {
 	privKey, err := crypto.GenerateKey()
 	s.Require().NoError(err)